@@ -38,8 +38,11 @@ type processBlockMsg struct {
 	reply chan processBlockResponse
 }
 
+// Listener is notified of main chain and txpool changes, it is called with
+// chain or txpool locked, so it should return quickly.
 type Listener interface {
 	OnBlockConnected(*wire.MsgBlock) error
+	OnBlockDisconnected(*wire.MsgBlock) error
 	OnTransactionReceived(tx *wire.MsgTx) error
 }
 
@@ -268,6 +271,8 @@ func (chain *Blockchain) blockProcessor() {
 
 // processBlock is the entry for handle block insert
 func (chain *Blockchain) execProcessBlock(block *chainutil.Block, flags BehaviorFlags) (bool, error) {
+	start := time.Now()
+	defer func() { processBlockHistogram.Observe(time.Since(start).Seconds()) }()
 	reply := make(chan processBlockResponse, 1)
 	chain.processBlockCh <- &processBlockMsg{block: block, flags: flags, reply: reply}
 	response := <-reply
@@ -430,6 +435,19 @@ func (chain *Blockchain) notifyBlockConnected(block *chainutil.Block) error {
 	return nil
 }
 
+func (chain *Blockchain) notifyBlockDisconnected(block *chainutil.Block) error {
+	if block == nil {
+		return errNilArgument
+	}
+	for listener := range chain.listeners {
+		err := listener.OnBlockDisconnected(block.MsgBlock())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (chain *Blockchain) notifyTransactionReceived(tx *chainutil.Tx) error {
 	if tx == nil {
 		return errNilArgument
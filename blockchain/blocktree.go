@@ -134,6 +134,7 @@ func (tree *BlockTree) bestBlockNode() *BlockNode {
 
 func (tree *BlockTree) setBestBlockNode(node *BlockNode) {
 	tree.bestNode = node
+	bestHeightGauge.Set(float64(node.Height))
 }
 
 func (tree *BlockTree) rootBlockNode() *BlockNode {
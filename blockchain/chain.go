@@ -327,6 +327,9 @@ func (chain *Blockchain) connectBlock(node *BlockNode, block *chainutil.Block) (
 func (chain *Blockchain) attachBlock(block *chainutil.Block) {
 	chain.proposalPool.SyncAttachBlock(block)
 	chain.txPool.SyncAttachBlock(block)
+	if err := chain.notifyBlockConnected(block); err != nil {
+		logging.CPrint(logging.WARN, "fail to notify block connected", logging.LogFormat{"hash": block.Hash(), "err": err})
+	}
 	return
 }
 
@@ -369,6 +372,9 @@ func (chain *Blockchain) disconnectBlock(node *BlockNode, block *chainutil.Block
 func (chain *Blockchain) detachBlock(block *chainutil.Block) {
 	chain.proposalPool.SyncDetachBlock(block)
 	chain.txPool.SyncDetachBlock(block)
+	if err := chain.notifyBlockDisconnected(block); err != nil {
+		logging.CPrint(logging.WARN, "fail to notify block disconnected", logging.LogFormat{"hash": block.Hash(), "err": err})
+	}
 	return
 }
 
@@ -591,6 +597,8 @@ func (chain *Blockchain) connectBestChain(node *BlockNode, block *chainutil.Bloc
 	if err != nil {
 		return err
 	}
+	reorgCounter.Inc()
+	reorgDepthHistogram.Observe(float64(detachNodes.Len()))
 
 	// Broadcast new block on best chain
 	chain.cond.Broadcast()
@@ -0,0 +1,98 @@
+package blockchain
+
+import (
+	"time"
+
+	"github.com/Sukhavati-Labs/go-miner/chainutil"
+	"github.com/Sukhavati-Labs/go-miner/config"
+	"github.com/Sukhavati-Labs/go-miner/consensus"
+	"github.com/Sukhavati-Labs/go-miner/consensus/difficulty"
+	"github.com/Sukhavati-Labs/go-miner/pocec"
+	"github.com/Sukhavati-Labs/go-miner/wire"
+)
+
+// NetworkWeight is the network weight estimated from recent blocks.
+type NetworkWeight struct {
+	Height   uint64        // height of the latest block
+	Weight   float64       // sum(SIZE * BitLength) of proofs answering each challenge
+	Blocks   int           // block intervals the weight is estimated from
+	Interval time.Duration // average block interval
+}
+
+// EstimateNetworkWeight estimates the network weight from the targets of at
+// most count blocks on the best chain.
+func (chain *Blockchain) EstimateNetworkWeight(count uint64) (*NetworkWeight, error) {
+	node := chain.blockTree.bestBlockNode()
+	if count > node.Height {
+		count = node.Height
+	}
+	headers := make([]*wire.BlockHeader, count+1)
+	for i := int(count); i >= 0; i-- {
+		if node != nil {
+			headers[i] = node.BlockHeader()
+			node = node.Parent
+			continue
+		}
+		header, err := chain.GetHeaderByHeight(headers[i+1].Height - 1)
+		if err != nil {
+			return nil, err
+		}
+		headers[i] = header
+	}
+	weight, blocks, err := difficulty.EstimateNetworkWeight(headers)
+	if err != nil {
+		return nil, err
+	}
+	first, last := headers[0], headers[len(headers)-1]
+	return &NetworkWeight{
+		Height:   last.Height,
+		Weight:   weight,
+		Blocks:   blocks,
+		Interval: last.Timestamp.Sub(first.Timestamp) / time.Duration(len(headers)-1),
+	}, nil
+}
+
+// BindingRequiredAmount returns the binding amount required for full miner
+// subsidy of bitLength.
+func BindingRequiredAmount(bitLength int) (chainutil.Amount, bool) {
+	return bindingRequired(bitLength)
+}
+
+// FetchMatureBinding returns the binding amount of pubKey that would be
+// counted in the coinbase of the next block of bitLength, in the same way as
+// block templates.
+func (chain *Blockchain) FetchMatureBinding(pubKey *pocec.PublicKey, bitLength int) (chainutil.Amount, error) {
+	totalBinding := chainutil.ZeroAmount()
+	valueRequired, ok := bindingRequired(bitLength)
+	if !ok {
+		return totalBinding, nil
+	}
+	pkScriptHash, err := pkToScriptHash(pubKey.SerializeCompressed(), &config.ChainParams)
+	if err != nil {
+		return totalBinding, err
+	}
+	bindingTxListReply, err := chain.db.FetchScriptHashRelatedBindingTx(pkScriptHash, &config.ChainParams)
+	if err != nil {
+		return totalBinding, err
+	}
+	nextBlockHeight := chain.BestBlockHeight() + 1
+	bindingNum := 0
+	for _, bindingTx := range bindingTxListReply {
+		blocksSincePrev := nextBlockHeight - bindingTx.Height
+		if (bindingTx.IsCoinbase && blocksSincePrev < consensus.CoinbaseMaturity) ||
+			(!bindingTx.IsCoinbase && blocksSincePrev < consensus.TransactionMaturity) {
+			continue
+		}
+		if totalBinding, err = totalBinding.AddInt(bindingTx.Value); err != nil {
+			return totalBinding, err
+		}
+		if totalBinding.Cmp(valueRequired) >= 0 {
+			break
+		}
+		bindingNum++
+		if bindingNum >= MaxBindingNum {
+			break
+		}
+	}
+	return totalBinding, nil
+}
@@ -0,0 +1,77 @@
+package blockchain
+
+import (
+	"github.com/Sukhavati-Labs/go-miner/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	bestHeightGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "chain",
+		Name:      "best_height",
+		Help:      "Height of the best block.",
+	})
+	reorgCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "chain",
+		Name:      "reorgs_total",
+		Help:      "Count of main chain reorganizations.",
+	})
+	reorgDepthHistogram = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "chain",
+		Name:      "reorg_depth",
+		Help:      "Count of blocks disconnected by each reorganization.",
+		Buckets:   []float64{1, 2, 3, 5, 10, 20, 50, 100},
+	})
+	processBlockHistogram = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "chain",
+		Name:      "process_block_seconds",
+		Help:      "Time to process a block, waiting in queue included.",
+		Buckets:   prometheus.ExponentialBuckets(0.001, 2, 15),
+	})
+)
+
+// txPoolCollector reads txpool when metrics are scraped.
+type txPoolCollector struct {
+	tp      *TxPool
+	count   *prometheus.Desc
+	bytes   *prometheus.Desc
+	orphans *prometheus.Desc
+}
+
+// NewTxPoolCollector returns a collector reporting count and size of tp.
+func NewTxPoolCollector(tp *TxPool) prometheus.Collector {
+	return &txPoolCollector{
+		tp:      tp,
+		count:   prometheus.NewDesc(prometheus.BuildFQName(metrics.Namespace, "txpool", "transactions"), "Count of transactions in txpool.", nil, nil),
+		bytes:   prometheus.NewDesc(prometheus.BuildFQName(metrics.Namespace, "txpool", "bytes"), "Total size of transactions in txpool.", nil, nil),
+		orphans: prometheus.NewDesc(prometheus.BuildFQName(metrics.Namespace, "txpool", "orphans"), "Count of orphan transactions.", nil, nil),
+	}
+}
+
+func (c *txPoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.count
+	ch <- c.bytes
+	ch <- c.orphans
+}
+
+func (c *txPoolCollector) Collect(ch chan<- prometheus.Metric) {
+	c.tp.RLock()
+	count, orphans := len(c.tp.pool), c.tp.orphanTxPool.pool.Count()
+	var size int
+	for _, desc := range c.tp.pool {
+		size += desc.Tx.PlainSize()
+	}
+	c.tp.RUnlock()
+
+	ch <- prometheus.MustNewConstMetric(c.count, prometheus.GaugeValue, float64(count))
+	ch <- prometheus.MustNewConstMetric(c.bytes, prometheus.GaugeValue, float64(size))
+	ch <- prometheus.MustNewConstMetric(c.orphans, prometheus.GaugeValue, float64(orphans))
+}
+
+func init() {
+	metrics.Registry.MustRegister(bestHeightGauge, reorgCounter, reorgDepthHistogram, processBlockHistogram)
+}
@@ -312,7 +312,7 @@ func reCreateCoinbaseTx(coinbase *wire.MsgTx, bindingTxListReply []*database.Bin
 	txIns := make([]*wire.TxIn, 0)
 	hasValidBinding := false
 	if len(bindingTxListReply) > 0 {
-		valueRequired, ok := bindingRequiredAmount[bitLength]
+		valueRequired, ok := bindingRequired(bitLength)
 		// valid bit length
 		if ok {
 			var witness [][]byte
@@ -303,6 +303,11 @@ func (tp *TxPool) addTransaction(tx *chainutil.Tx, height uint64, startingPriori
 	tp.lastUpdated = time.Now()
 
 	tp.NewTxCh <- tx
+	if tp.chain != nil {
+		if err := tp.chain.notifyTransactionReceived(tx); err != nil {
+			logging.CPrint(logging.WARN, "fail to notify transaction received", logging.LogFormat{"tx": tx.Hash(), "err": err})
+		}
+	}
 
 	if config.AddrIndex {
 		err := tp.addTransactionToAddrIndex(tx)
@@ -513,19 +518,31 @@ func (tp *TxPool) FilterTransactionsByAddress(addr chainutil.Address) ([]*chainu
 //
 // This function MUST be called with the mempool lock held (for writes).
 func (tp *TxPool) maybeAcceptTransaction(tx *chainutil.Tx, isNew, rateLimit bool) ([]*wire.Hash, error) {
+	missingParents, _, err := tp.checkAcceptTransaction(tx, isNew, rateLimit, false)
+	return missingParents, err
+}
+
+// checkAcceptTransaction runs all the policy and consensus checks of
+// maybeAcceptTransaction and returns the fee of the transaction.
+// In dryRun mode, neither the transaction pool nor the state of rate limiter
+// is changed.
+//
+// This function MUST be called with the mempool lock held (for writes),
+// or for reads in dryRun mode.
+func (tp *TxPool) checkAcceptTransaction(tx *chainutil.Tx, isNew, rateLimit, dryRun bool) ([]*wire.Hash, chainutil.Amount, error) {
 	txHash := tx.Hash()
 
 	// Don't accept the transaction if it already exists in the pool.  This
 	// applies to orphan transactions as well.  This check is intended to
 	// be a quick check to weed out duplicates.
 	if tp.haveTransaction(txHash) {
-		return nil, errors.ErrTxAlreadyExists
+		return nil, chainutil.ZeroAmount(), errors.ErrTxAlreadyExists
 	}
 
 	if len(tx.MsgTx().Payload) > maxTxPoolTxPayload {
 		logging.CPrint(logging.ERROR, "transaction payload size is too big",
 			logging.LogFormat{"size": len(tx.MsgTx().Payload), "max": maxTxPoolTxPayload})
-		return nil, ErrTxMsgPayloadSize
+		return nil, chainutil.ZeroAmount(), ErrTxMsgPayloadSize
 	}
 
 	// Perform preliminary sanity checks on the transaction.  This makes
@@ -533,21 +550,21 @@ func (tp *TxPool) maybeAcceptTransaction(tx *chainutil.Tx, isNew, rateLimit bool
 	// transactions are allowed into blocks.
 	err := CheckTransactionSanity(tx)
 	if err != nil {
-		return nil, err
+		return nil, chainutil.ZeroAmount(), err
 	}
 
 	// A standalone transaction must not be a coinbase transaction.
 	if IsCoinBase(tx) {
 		logging.CPrint(logging.ERROR, "transaction is an individual coinbase",
 			logging.LogFormat{"txHash": txHash})
-		return nil, ErrCoinbaseTx
+		return nil, chainutil.ZeroAmount(), ErrCoinbaseTx
 	}
 	// Don't accept transactions with a lock time after the maximum int64
 	// value for now.
 	if tx.MsgTx().LockTime > math.MaxInt64 {
 		logging.CPrint(logging.ERROR, "transaction has a lock time which is not accepted yet",
 			logging.LogFormat{"txHash": txHash, "lockTime": tx.MsgTx().LockTime})
-		return nil, ErrImmatureSpend
+		return nil, chainutil.ZeroAmount(), ErrImmatureSpend
 	}
 
 	// Get the current height of the main chain.  A standalone transaction
@@ -558,7 +575,7 @@ func (tp *TxPool) maybeAcceptTransaction(tx *chainutil.Tx, isNew, rateLimit bool
 	node := tp.chain.blockTree.bestBlockNode()
 	medianTimePast, err := tp.chain.calcPastMedianTime(node)
 	if err != nil {
-		return nil, err
+		return nil, chainutil.ZeroAmount(), err
 	}
 
 	// Fetch all of the transactions referenced by the inputs to this
@@ -570,7 +587,7 @@ func (tp *TxPool) maybeAcceptTransaction(tx *chainutil.Tx, isNew, rateLimit bool
 	// Don't allow the transaction if it exists in the main chain and is not
 	// not already fully spent.
 	if txD, exists := txStore[*txHash]; exists && txD.Err == nil {
-		return nil, errors.ErrTxAlreadyExists
+		return nil, chainutil.ZeroAmount(), errors.ErrTxAlreadyExists
 	}
 
 	delete(txStore, *txHash)
@@ -580,7 +597,7 @@ func (tp *TxPool) maybeAcceptTransaction(tx *chainutil.Tx, isNew, rateLimit bool
 	if !config.ChainParams.RelayNonStdTxs {
 		err = checkTransactionStandard(tx, nextBlockHeight, chainutil.MinRelayTxFee(), txStore)
 		if err != nil {
-			return nil, err
+			return nil, chainutil.ZeroAmount(), err
 		}
 	}
 
@@ -594,7 +611,7 @@ func (tp *TxPool) maybeAcceptTransaction(tx *chainutil.Tx, isNew, rateLimit bool
 	// which examines the actual spend data and prevents double spends.
 	err = tp.checkPoolDoubleSpend(tx)
 	if err != nil {
-		return nil, err
+		return nil, chainutil.ZeroAmount(), err
 	}
 
 	// Transaction is an orphan if any of the referenced input transactions
@@ -608,7 +625,7 @@ func (tp *TxPool) maybeAcceptTransaction(tx *chainutil.Tx, isNew, rateLimit bool
 		}
 	}
 	if len(missingParents) > 0 {
-		return missingParents, nil
+		return missingParents, chainutil.ZeroAmount(), nil
 	}
 
 	// Perform several checks on the transaction inputs using the invariant
@@ -617,7 +634,7 @@ func (tp *TxPool) maybeAcceptTransaction(tx *chainutil.Tx, isNew, rateLimit bool
 	// used later.
 	txFee, err := CheckTransactionInputs(tx, nextBlockHeight, txStore)
 	if err != nil {
-		return nil, err
+		return nil, chainutil.ZeroAmount(), err
 	}
 
 	// stakingTx
@@ -626,11 +643,11 @@ func (tp *TxPool) maybeAcceptTransaction(tx *chainutil.Tx, isNew, rateLimit bool
 	// with respect to its defined relative lock times.
 	sequenceLock, err := tp.chain.CalcSequenceLock(tx, txStore)
 	if err != nil {
-		return nil, err
+		return nil, chainutil.ZeroAmount(), err
 	}
 	if !SequenceLockActive(sequenceLock, nextBlockHeight,
 		medianTimePast) {
-		return nil, ErrSequenceNotSatisfied
+		return nil, chainutil.ZeroAmount(), ErrSequenceNotSatisfied
 	}
 
 	// Don't allow transactions with non-standard inputs if the network
@@ -638,7 +655,7 @@ func (tp *TxPool) maybeAcceptTransaction(tx *chainutil.Tx, isNew, rateLimit bool
 	if !config.ChainParams.RelayNonStdTxs {
 		err := checkInputsStandard(tx, txStore)
 		if err != nil {
-			return nil, err
+			return nil, chainutil.ZeroAmount(), err
 		}
 	}
 
@@ -655,7 +672,7 @@ func (tp *TxPool) maybeAcceptTransaction(tx *chainutil.Tx, isNew, rateLimit bool
 	if numSigOps > maxSigOpsPerTx {
 		logging.CPrint(logging.ERROR, "transaction contains too many signature operations",
 			logging.LogFormat{"txHash": txHash, "numSigOps": numSigOps, "maxSigOpsPerTx": maxSigOpsPerTx})
-		return nil, ErrTooManySigOps
+		return nil, chainutil.ZeroAmount(), ErrTooManySigOps
 	}
 
 	// Don't allow transactions with fees too low to get into a mined block.
@@ -674,14 +691,14 @@ func (tp *TxPool) maybeAcceptTransaction(tx *chainutil.Tx, isNew, rateLimit bool
 	requiredFee, err := CalcMinRequiredTxRelayFee(serializedSize, chainutil.MinRelayTxFee())
 	if err != nil {
 		logging.CPrint(logging.ERROR, "CalcMinRequiredTxRelayFee error", logging.LogFormat{"err": err})
-		return nil, err
+		return nil, chainutil.ZeroAmount(), err
 	}
 
 	if txFee.Cmp(requiredFee) < 0 {
 		if serializedSize >= (defaultBlockPrioritySize - 1000) {
 			logging.CPrint(logging.ERROR, "transaction`s fees is under the required amount",
 				logging.LogFormat{"txHash": txHash, "txFee": txFee, "requiredFee": requiredFee})
-			return nil, ErrInsufficientFee
+			return nil, chainutil.ZeroAmount(), ErrInsufficientFee
 		}
 
 		// Require that free transactions have sufficient priority to be mined
@@ -691,13 +708,13 @@ func (tp *TxPool) maybeAcceptTransaction(tx *chainutil.Tx, isNew, rateLimit bool
 		if isNew && !config.NoRelayPriority {
 			currentPriority, _, err := currentPriority(tx, txStore, nextBlockHeight)
 			if err != nil {
-				return nil, err
+				return nil, chainutil.ZeroAmount(), err
 			}
 			if currentPriority <= consensus.MinHighPriority {
 				logging.CPrint(logging.ERROR, "transaction has insufficient priority",
 					logging.LogFormat{"txHash": txHash, "currentPriority": currentPriority,
 						"MinHighPriority": consensus.MinHighPriority})
-				return nil, ErrInsufficientPriority
+				return nil, chainutil.ZeroAmount(), ErrInsufficientPriority
 			}
 		}
 
@@ -707,25 +724,28 @@ func (tp *TxPool) maybeAcceptTransaction(tx *chainutil.Tx, isNew, rateLimit bool
 			nowUnix := time.Now().Unix()
 			// we decay passed data with an exponentially decaying ~10
 			// minutes window - matches miner handling.
-			tp.pennyTotal *= math.Pow(1.0-1.0/600.0,
+			pennyTotal := tp.pennyTotal * math.Pow(1.0-1.0/600.0,
 				float64(nowUnix-tp.lastPennyUnix))
-			tp.lastPennyUnix = nowUnix
+			if !dryRun {
+				tp.pennyTotal = pennyTotal
+				tp.lastPennyUnix = nowUnix
+			}
 
 			// Are we still over the limit?
-			if tp.pennyTotal >= config.FreeTxRelayLimit*10*1000 {
+			if pennyTotal >= config.FreeTxRelayLimit*10*1000 {
 				logging.CPrint(logging.ERROR, "transaction has been rejected by the rate limiter due to low fees",
 					logging.LogFormat{"txHash": txHash})
-				return nil, ErrInsufficientFee
+				return nil, chainutil.ZeroAmount(), ErrInsufficientFee
 			}
-			oldTotal := tp.pennyTotal
-
-			tp.pennyTotal += float64(serializedSize)
-			logging.CPrint(logging.TRACE, "rate limit", logging.LogFormat{
-				"curTotal":  oldTotal,
-				"nextTotal": tp.pennyTotal,
-				"limit":     config.FreeTxRelayLimit * 10 * 1000,
-			})
 
+			if !dryRun {
+				tp.pennyTotal += float64(serializedSize)
+				logging.CPrint(logging.TRACE, "rate limit", logging.LogFormat{
+					"curTotal":  pennyTotal,
+					"nextTotal": tp.pennyTotal,
+					"limit":     config.FreeTxRelayLimit * 10 * 1000,
+				})
+			}
 		}
 	}
 
@@ -736,7 +756,7 @@ func (tp *TxPool) maybeAcceptTransaction(tx *chainutil.Tx, isNew, rateLimit bool
 			"error":   err,
 			"payload": hex.EncodeToString(tx.MsgTx().Payload),
 		})
-		return nil, err
+		return nil, chainutil.ZeroAmount(), err
 	}
 	// Verify crypto signatures for each input and reject the transaction if
 	// any don't verify.
@@ -744,20 +764,24 @@ func (tp *TxPool) maybeAcceptTransaction(tx *chainutil.Tx, isNew, rateLimit bool
 		txscript.StandardVerifyFlags, tp.sigCache,
 		tp.hashCache)
 	if err != nil {
-		return nil, err
+		return nil, chainutil.ZeroAmount(), err
+	}
+
+	if dryRun {
+		return nil, txFee, nil
 	}
 
 	// Add to transaction pool.
 	startingPriority, totalInputValue, err := currentPriority(tx, txStore, curHeight)
 	if err != nil {
-		return nil, err
+		return nil, chainutil.ZeroAmount(), err
 	}
 	err = tp.addTransaction(tx, curHeight, startingPriority, totalInputValue, txFee)
 	if err != nil {
-		return nil, err
+		return nil, chainutil.ZeroAmount(), err
 	}
 
-	return nil, nil
+	return nil, txFee, nil
 }
 
 // MaybeAcceptTransaction is the main workhorse for handling insertion of new
@@ -779,6 +803,19 @@ func (tp *TxPool) MaybeAcceptTransaction(tx *chainutil.Tx, isNew, rateLimit bool
 	return tp.maybeAcceptTransaction(tx, isNew, rateLimit)
 }
 
+// TestAcceptTransaction checks whether the transaction would be accepted into
+// the memory pool by ProcessTransaction, without changing the pool.  It returns
+// the fee of an acceptable transaction, the missing parents of an orphan one,
+// or the exact policy or consensus rule the transaction violates.
+//
+// This function is safe for concurrent access.
+func (tp *TxPool) TestAcceptTransaction(tx *chainutil.Tx, rateLimit bool) ([]*wire.Hash, chainutil.Amount, error) {
+	tp.RLock()
+	defer tp.RUnlock()
+
+	return tp.checkAcceptTransaction(tx, true, rateLimit, true)
+}
+
 // processOrphans is the internal function which implements the public
 // ProcessOrphans.  See the comment for ProcessOrphans for more details.
 //
@@ -232,6 +232,35 @@ func TestTxPool_MaybeAcceptTransaction_haveTransaction(t *testing.T) {
 	assert.True(t, have)
 }
 
+func TestTxPool_TestAcceptTransaction(t *testing.T) {
+	txP, close, err := newTxPool(25)
+	if err != nil {
+		t.Error(err)
+	}
+	defer close()
+
+	msgtx, err := getTx("child1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// dry run leaves the pool untouched
+	tx := chainutil.NewTx(msgtx)
+	missingParents, fee, err := txP.TestAcceptTransaction(tx, true)
+	assert.Nil(t, err)
+	assert.Zero(t, len(missingParents))
+	assert.False(t, fee.IsZero())
+	assert.Equal(t, 0, txP.Count())
+
+	_, err = txP.maybeAcceptTransaction(tx, true, true)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, txP.Count())
+
+	_, _, err = txP.TestAcceptTransaction(tx, true)
+	assert.Equal(t, errors.ErrTxAlreadyExists, err)
+	assert.Equal(t, 1, txP.Count())
+}
+
 func TestTxPool_MaybeAcceptTransaction_AlreadyExist(t *testing.T) {
 	txP, close, err := newTxPool(25)
 	if err != nil {
@@ -56,15 +56,27 @@ var (
 )
 
 func init() {
-	for k, limit := range consensus.BindingRequiredSkt {
-		amt, err := chainutil.NewAmountFromSkt(limit)
-		if err != nil {
-			panic(err)
+	for _, required := range []map[int]float64{consensus.BindingRequiredSkt, consensus.RegTestBindingRequiredSkt} {
+		for k, limit := range required {
+			amt, err := chainutil.NewAmountFromSkt(limit)
+			if err != nil {
+				panic(err)
+			}
+			bindingRequiredAmount[k] = amt
 		}
-		bindingRequiredAmount[k] = amt
 	}
 }
 
+// bindingRequired returns the binding amount required for full miner subsidy
+// of bitLength, bitLengths below MinBitLength of the network are never valid.
+func bindingRequired(bitLength int) (chainutil.Amount, bool) {
+	if bitLength < config.ChainParams.MinBitLength {
+		return chainutil.ZeroAmount(), false
+	}
+	amt, ok := bindingRequiredAmount[bitLength]
+	return amt, ok
+}
+
 // isNullOutpoint determines whether or not a previous transaction output point
 // is set.
 func isNullOutpoint(outpoint *wire.OutPoint) bool {
@@ -551,7 +563,7 @@ func CalcBlockSubsidy(height uint64, chainParams *config.Params, totalBinding ch
 	}
 
 	hasValidBinding := false
-	valueRequired, ok := bindingRequiredAmount[bitLength]
+	valueRequired, ok := bindingRequired(bitLength)
 	if !ok {
 		if bitLength != bitLengthMissing {
 			logging.CPrint(logging.ERROR, "invalid bitLength",
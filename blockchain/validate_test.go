@@ -8,6 +8,7 @@ import (
 
 	"github.com/Sukhavati-Labs/go-miner/chainutil"
 	"github.com/Sukhavati-Labs/go-miner/config"
+	"github.com/Sukhavati-Labs/go-miner/poc"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -225,3 +226,35 @@ func TestCalcBlockSubsidy(t *testing.T) {
 		})
 	}
 }
+
+func TestBindingRequiredAmount(t *testing.T) {
+	if _, ok := BindingRequiredAmount(24); !ok {
+		t.Fatal("bitLength 24 should require binding on mainnet")
+	}
+	if _, ok := BindingRequiredAmount(16); ok {
+		t.Fatal("bitLength 16 should be invalid on mainnet")
+	}
+
+	if err := config.SelectChainParams(config.RegressionNetParams.Name); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := config.SelectChainParams("mainnet"); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	for _, bitLength := range poc.ValidBitLength() {
+		if _, ok := BindingRequiredAmount(bitLength); !ok {
+			t.Fatalf("bitLength %d should require binding on regtest", bitLength)
+		}
+	}
+	amt, ok := BindingRequiredAmount(16)
+	if !ok {
+		t.Fatal("bitLength 16 should require binding on regtest")
+	}
+	expected, err := chainutil.NewAmountFromSkt(consensus.RegTestBindingRequiredSkt[16])
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, expected, amt)
+}
@@ -0,0 +1,27 @@
+package chainutil
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteFileAtomic(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data.json")
+	for _, data := range []string{"old", "new"} {
+		if err := WriteFileAtomic(path, []byte(data), 0600); err != nil {
+			t.Fatal(err)
+		}
+		got, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != data {
+			t.Fatalf("expected %s, got %s", data, got)
+		}
+	}
+	if _, err := os.Stat(path + ".tmp"); !os.IsNotExist(err) {
+		t.Fatalf("temporary file is left, %v", err)
+	}
+}
@@ -0,0 +1,20 @@
+// +build !windows
+
+package chainutil
+
+import (
+	"os"
+)
+
+// syncDir makes entries of dir durable, such as a file renamed into dir.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	err = d.Sync()
+	if closeErr := d.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
@@ -0,0 +1,9 @@
+// +build windows
+
+package chainutil
+
+// syncDir does nothing on windows, where directories could not be synced,
+// renaming is durable once MoveFileEx returns.
+func syncDir(dir string) error {
+	return nil
+}
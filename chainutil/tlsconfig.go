@@ -0,0 +1,79 @@
+package chainutil
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	autogenCertOrganization = "skt autogenerated cert"
+	autogenCertValidity     = 10 * 365 * 24 * time.Hour
+)
+
+// ErrNoCertificate describes an error where a file trusted by clients
+// contains no PEM-encoded certificate.
+var ErrNoCertificate = errors.New("no certificate found")
+
+// LoadTLSConfig loads the certificate pair of a TLS listener, a self-signed
+// pair valid for hosts is generated if neither file exists. The returned bool
+// tells whether the pair is generated.
+func LoadTLSConfig(certFile, keyFile string, hosts []string) (*tls.Config, bool, error) {
+	var generated bool
+	_, certErr := os.Stat(certFile)
+	_, keyErr := os.Stat(keyFile)
+	if os.IsNotExist(certErr) && os.IsNotExist(keyErr) {
+		if err := genCertPair(certFile, keyFile, hosts); err != nil {
+			return nil, false, err
+		}
+		generated = true
+	}
+
+	keyPair, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, generated, err
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{keyPair},
+		MinVersion:   tls.VersionTLS12,
+	}, generated, nil
+}
+
+func genCertPair(certFile, keyFile string, hosts []string) error {
+	cert, key, err := NewTLSCertPair(autogenCertOrganization, time.Now().Add(autogenCertValidity), hosts)
+	if err != nil {
+		return err
+	}
+	for _, dir := range []string{filepath.Dir(certFile), filepath.Dir(keyFile)} {
+		if err = os.MkdirAll(dir, 0700); err != nil {
+			return err
+		}
+	}
+	if err = ioutil.WriteFile(certFile, cert, 0644); err != nil {
+		return err
+	}
+	if err = ioutil.WriteFile(keyFile, key, 0600); err != nil {
+		os.Remove(certFile)
+		return err
+	}
+	return nil
+}
+
+// NewClientTLSConfig returns a TLS config of clients trusting only the
+// PEM-encoded certificates in certFile, which is usually the certificate
+// of the server copied from its host.
+func NewClientTLSConfig(certFile string) (*tls.Config, error) {
+	pem, err := ioutil.ReadFile(certFile)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, ErrNoCertificate
+	}
+	return &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}, nil
+}
@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"errors"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/Sukhavati-Labs/go-miner/chainutil"
+	"github.com/Sukhavati-Labs/go-miner/config"
+	configpb "github.com/Sukhavati-Labs/go-miner/config/pb"
+	"github.com/Sukhavati-Labs/go-miner/logging"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/spacekeeper/capacity"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/spacekeeper/remote"
+	"github.com/Sukhavati-Labs/go-miner/poc/wallet"
+	_ "github.com/Sukhavati-Labs/go-miner/poc/wallet/db/ldb"
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultListenAddress = "0.0.0.0:9790"
+	loggingFilename      = "harvester"
+)
+
+var (
+	errMissingPubPass  = errors.New("missing app.pub_password in config")
+	errMissingPrivPass = errors.New("missing miner.private_password in config")
+
+	configFile    string
+	listenAddress string
+	token         string
+)
+
+var rootCmd = &cobra.Command{
+	Use:   filepath.Base(os.Args[0]),
+	Short: "Harvester serving local plots to a remote SKT node",
+	Long: "The harvester loads plots from miner.proof_dir like a node does, and serves proofs and signatures\n" +
+		"to nodes configured with spacekeeper_backend \"" + remote.TypeSpaceKeeperRemote + "\".\n" +
+		"Requests are rejected unless they carry the token, which defaults to miner.harvester_token in config.\n" +
+		"Nodes are served over TLS with the certificate pair of miner.harvester_tls_cert and miner.harvester_tls_key,\n" +
+		"a self-signed pair is generated if neither file exists, whose certificate should be trusted by nodes.",
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := loadConfig(configFile)
+		if err != nil {
+			return err
+		}
+		logging.Init(cfg.Log.LogDir, loggingFilename, cfg.Log.LogLevel, 1, cfg.Log.DisableCprint)
+		if token == "" {
+			token = cfg.Miner.HarvesterToken
+		}
+		return runHarvester(cfg)
+	},
+}
+
+func init() {
+	rootCmd.Flags().StringVarP(&configFile, "config", "c", config.DefaultConfigFilename, "path to configuration file")
+	rootCmd.Flags().StringVarP(&listenAddress, "listen", "l", defaultListenAddress, "address for nodes to connect")
+	rootCmd.Flags().StringVarP(&token, "token", "t", "", "token shared with nodes")
+}
+
+func Execute() {
+	if err := rootCmd.Execute(); err != nil {
+		logging.VPrint(logging.FATAL, "Command failed", logging.LogFormat{"err": err})
+	}
+}
+
+func loadConfig(path string) (*config.Config, error) {
+	cfg, err := config.LoadConfig(&config.Config{ConfigFile: path, Config: configpb.NewConfig()})
+	if err != nil {
+		return nil, err
+	}
+	// harvester never builds blocks, so payout addresses are not required
+	cfg.Miner.Generate = false
+	cfg.Miner.SpacekeeperBackend = capacity.TypeSpaceKeeperV1
+	if cfg, err = config.CheckConfig(cfg); err != nil {
+		return nil, err
+	}
+	// workspaces must be mining to answer challenges
+	cfg.Miner.Generate = true
+	return cfg, nil
+}
+
+func runHarvester(cfg *config.Config) error {
+	if cfg.App.PubPassword == "" {
+		return errMissingPubPass
+	}
+	if cfg.Miner.PrivatePassword == "" {
+		return errMissingPrivPass
+	}
+	pocWallet, err := wallet.NewPoCWallet(wallet.NewPocWalletConfig(cfg.Miner.MinerDir, cfg.Db.DbType), []byte(cfg.App.PubPassword))
+	if err != nil {
+		logging.CPrint(logging.ERROR, "unable to open wallet", logging.LogFormat{"err": err, "path": cfg.Miner.MinerDir})
+		return err
+	}
+	defer pocWallet.Close()
+
+	sk, err := capacity.NewSpaceKeeperV1(cfg, pocWallet)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail on NewSpaceKeeper", logging.LogFormat{"err": err})
+		return err
+	}
+	if err = sk.Start(); err != nil {
+		return err
+	}
+	defer sk.Stop()
+
+	tlsConfig, generated, err := chainutil.LoadTLSConfig(cfg.Miner.HarvesterTlsCert, cfg.Miner.HarvesterTlsKey, cfg.Miner.HarvesterTlsHosts)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to load harvester certificate pair",
+			logging.LogFormat{"cert": cfg.Miner.HarvesterTlsCert, "key": cfg.Miner.HarvesterTlsKey, "err": err})
+		return err
+	}
+	if generated {
+		logging.CPrint(logging.INFO, "generated harvester certificate pair, copy the certificate to nodes",
+			logging.LogFormat{"cert": cfg.Miner.HarvesterTlsCert, "key": cfg.Miner.HarvesterTlsKey})
+	}
+	harvester, err := remote.NewHarvester(sk, token, tlsConfig)
+	if err != nil {
+		return err
+	}
+	lis, err := net.Listen("tcp", listenAddress)
+	if err != nil {
+		return err
+	}
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		sig := <-interrupt
+		logging.CPrint(logging.INFO, "received signal, stopping harvester", logging.LogFormat{"signal": sig})
+		harvester.Stop()
+	}()
+	return harvester.Serve(lis)
+}
@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// timeLayouts are accepted by parseTime besides unix seconds.
+var timeLayouts = []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02"}
+
+func parseUint64(arg, name string) (uint64, error) {
+	n, err := strconv.ParseUint(arg, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q", name, arg)
+	}
+	return n, nil
+}
+
+func parseUint32(arg, name string) (uint32, error) {
+	n, err := strconv.ParseUint(arg, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q", name, arg)
+	}
+	return uint32(n), nil
+}
+
+// parseTime parses unix seconds, RFC3339 time or local date, "" is parsed as 0.
+func parseTime(arg, name string) (int64, error) {
+	if arg == "" {
+		return 0, nil
+	}
+	if sec, err := strconv.ParseInt(arg, 10, 64); err == nil {
+		return sec, nil
+	}
+	for _, layout := range timeLayouts {
+		if t, err := time.ParseInLocation(layout, arg, time.Local); err == nil {
+			return t.Unix(), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid %s %q", name, arg)
+}
@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"context"
+	"strconv"
+
+	pb "github.com/Sukhavati-Labs/go-miner/rpc/proto"
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/spf13/cobra"
+)
+
+var (
+	blockVerbose bool
+	blockV2      bool
+)
+
+var blockCmd = &cobra.Command{
+	Use:   "block",
+	Short: "Queries blocks of the best chain.",
+}
+
+var blockBestCmd = &cobra.Command{
+	Use:   "best",
+	Short: "Shows height and hash of the best block.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.GetBestBlock(ctx, &empty.Empty{})
+		})
+	},
+}
+
+var blockGetCmd = &cobra.Command{
+	Use:   "get <hash|height>",
+	Short: "Shows a block by hash or height.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			switch {
+			case blockVerbose:
+				return client.GetBlockVerbose1V2(ctx, &pb.GetBlockRequestV2{Id: blockID(args[0])})
+			case blockV2:
+				return client.GetBlockV2(ctx, &pb.GetBlockRequestV2{Id: blockID(args[0])})
+			}
+			if height, err := strconv.ParseUint(args[0], 10, 64); err == nil {
+				return client.GetBlockByHeight(ctx, &pb.GetBlockByHeightRequest{Height: height})
+			}
+			return client.GetBlock(ctx, &pb.GetBlockRequest{Hash: args[0]})
+		})
+	},
+}
+
+var blockHeaderCmd = &cobra.Command{
+	Use:   "header <hash|height>",
+	Short: "Shows a block header by hash or height.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			if _, err := strconv.ParseUint(args[0], 10, 64); err == nil {
+				return client.GetBlockHeaderV2(ctx, &pb.GetBlockRequestV2{Id: blockID(args[0])})
+			}
+			return client.GetBlockHeader(ctx, &pb.GetBlockHeaderRequest{Hash: args[0]})
+		})
+	},
+}
+
+var blockHashCmd = &cobra.Command{
+	Use:   "hash <height>",
+	Short: "Shows the hash of block at height.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		height, err := parseUint64(args[0], "height")
+		if err != nil {
+			return err
+		}
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.GetBlockHashByHeight(ctx, &pb.GetBlockHashByHeightRequest{Height: height})
+		})
+	},
+}
+
+var blockByPubKeyCmd = &cobra.Command{
+	Use:   "by-pubkey <public_key>",
+	Short: "Lists heights of blocks mined by a public key.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.GetBlockHeightByPubKey(ctx, &pb.GetBlockHeightByPubKeyRequest{PublicKey: args[0]})
+		})
+	},
+}
+
+var blockGenerateCmd = &cobra.Command{
+	Use:   "generate <count> <payout_address>",
+	Short: "Generates blocks right away, only available on regtest network.",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		count, err := parseUint32(args[0], "count")
+		if err != nil {
+			return err
+		}
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.GenerateBlocks(ctx, &pb.GenerateBlocksRequest{Count: count, PayoutAddress: args[1]})
+		})
+	},
+}
+
+func init() {
+	blockGetCmd.Flags().BoolVarP(&blockVerbose, "verbose", "v", false, "show details of transactions")
+	blockGetCmd.Flags().BoolVar(&blockV2, "v2", false, "show block in v2 format")
+	blockCmd.AddCommand(blockBestCmd, blockGetCmd, blockHeaderCmd, blockHashCmd, blockByPubKeyCmd, blockGenerateCmd)
+}
+
+// blockID converts height or hash into id of v2 APIs.
+func blockID(arg string) string {
+	if _, err := strconv.ParseUint(arg, 10, 64); err == nil {
+		return "height-" + arg
+	}
+	return "hash-" + arg
+}
@@ -1,13 +1,87 @@
 package cmd
 
 import (
-	"github.com/spf13/cobra"
+	"fmt"
 	"os"
 	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// Exit codes of minercli, scripts may rely on them.
+const (
+	ExitOK          = 0
+	ExitFailure     = 1 // unexpected local failure, e.g. fail to print the response
+	ExitUsage       = 2 // invalid command, flags or arguments
+	ExitUnavailable = 3 // node is unreachable or does not respond in time
+	ExitRPCError    = 4 // node rejects the request
+)
+
+const (
+	defaultRPCEndpoint = "localhost:9787"
+	defaultRPCTimeout  = 30 * time.Second
+
+	envAPIKey = "MINERCLI_API_KEY"
+
+	outputTable = "table"
+	outputJSON  = "json"
+	outputCSV   = "csv"
+)
+
+var (
+	rpcEndpoint  string
+	rpcTimeout   time.Duration
+	rpcTLS       bool
+	rpcTLSCert   string
+	rpcAPIKey    string
+	rpcInsecure  bool
+	outputFormat string
 )
 
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
 	Use:   filepath.Base(os.Args[0]),
 	Short: "Command line client for sukhavati miner",
+	Long: "Command line client for sukhavati miner, it talks to the gRPC API of a running node.\n" +
+		"\nExit codes:\n" +
+		"  0   success\n" +
+		"  1   local failure\n" +
+		"  2   invalid command, flags or arguments\n" +
+		"  3   node is unreachable or timed out\n" +
+		"  4   node rejected the request\n",
+	SilenceErrors: true,
+	SilenceUsage:  true,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if outputFormat != outputTable && outputFormat != outputJSON && outputFormat != outputCSV {
+			return fmt.Errorf("invalid output format %q, should be %s, %s or %s", outputFormat, outputTable, outputJSON, outputCSV)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&rpcEndpoint, "rpc", defaultRPCEndpoint, "gRPC endpoint of node")
+	rootCmd.PersistentFlags().DurationVar(&rpcTimeout, "timeout", defaultRPCTimeout, "timeout of each request")
+	rootCmd.PersistentFlags().BoolVar(&rpcTLS, "tls", false, "connect node with TLS")
+	rootCmd.PersistentFlags().StringVar(&rpcTLSCert, "tls-cert", "", "certificate to trust when connecting node with TLS, such as rpc.cert of node")
+	rootCmd.PersistentFlags().StringVar(&rpcAPIKey, "api-key", "", "api key of node, defaults to $"+envAPIKey+", sent only with TLS unless --insecure")
+	rootCmd.PersistentFlags().BoolVar(&rpcInsecure, "insecure", false, "send api key without TLS, such as to a node on localhost")
+	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", outputTable, "output format, "+outputTable+", "+outputJSON+" or "+outputCSV)
+
+	rootCmd.AddCommand(clientCmd, blockCmd, txCmd, txPoolCmd, spaceCmd, miningCmd, walletCmd, governCmd, subscribeCmd)
+}
+
+// Execute runs the command tree and exits with one of the exit codes.
+func Execute() {
+	err := rootCmd.Execute()
+	if err == nil {
+		os.Exit(ExitOK)
+	}
+	code := exitCode(err)
+	fmt.Fprintln(os.Stderr, "Error:", err)
+	if code == ExitUsage {
+		fmt.Fprintf(os.Stderr, "Run '%s --help' for usage.\n", rootCmd.CommandPath())
+	}
+	os.Exit(code)
 }
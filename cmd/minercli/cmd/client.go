@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"context"
+
+	pb "github.com/Sukhavati-Labs/go-miner/rpc/proto"
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/spf13/cobra"
+)
+
+var clientCmd = &cobra.Command{
+	Use:   "client",
+	Short: "Status and control of the node.",
+}
+
+var clientStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Shows status of the node.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.GetClientStatus(ctx, &empty.Empty{})
+		})
+	},
+}
+
+var clientQuitCmd = &cobra.Command{
+	Use:   "quit",
+	Short: "Stops the node.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.QuitClient(ctx, &empty.Empty{})
+		})
+	},
+}
+
+func init() {
+	clientCmd.AddCommand(clientStatusCmd, clientQuitCmd)
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"context"
+
+	pb "github.com/Sukhavati-Labs/go-miner/rpc/proto"
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+)
+
+var governIncludeShadow bool
+
+var governCmd = &cobra.Command{
+	Use:   "govern",
+	Short: "Queries governance configs.",
+}
+
+var governConfigCmd = &cobra.Command{
+	Use:   "config <id>",
+	Short: "Shows the current governance config.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseUint32(args[0], "id")
+		if err != nil {
+			return err
+		}
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.GetGovernConfig(ctx, &pb.GetGovernConfigRequest{Id: id})
+		})
+	},
+}
+
+var governHistoryCmd = &cobra.Command{
+	Use:   "history <id>",
+	Short: "Shows all versions of a governance config.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseUint32(args[0], "id")
+		if err != nil {
+			return err
+		}
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.GetGovernConfigHistory(ctx, &pb.GetGovernConfigHistoryRequest{Id: id, IncludeShadow: governIncludeShadow})
+		})
+	},
+}
+
+func init() {
+	governHistoryCmd.Flags().BoolVar(&governIncludeShadow, "include-shadow", false, "include configs not yet effective")
+	governCmd.AddCommand(governConfigCmd, governHistoryCmd)
+}
@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"context"
+
+	pb "github.com/Sukhavati-Labs/go-miner/rpc/proto"
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/spf13/cobra"
+)
+
+var (
+	miningSince     string
+	miningUntil     string
+	miningPublicKey string
+	payoutWeight    uint32
+	payoutPublicKey string
+)
+
+var miningCmd = &cobra.Command{
+	Use:   "mining",
+	Short: "Reports of the PoC miner.",
+}
+
+var miningShadowCmd = &cobra.Command{
+	Use:   "shadow",
+	Short: "Shows what the miner would have won in shadow mode.",
+	Long: "Shows what the miner would have won in shadow mode, by comparing the best proof of each height\n" +
+		"with the block winning it on chain. Use '-o csv' to export slots as csv.",
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		start, err := parseTime(miningSince, "since")
+		if err != nil {
+			return err
+		}
+		end, err := parseTime(miningUntil, "until")
+		if err != nil {
+			return err
+		}
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.GetShadowReport(ctx, &pb.GetShadowReportRequest{StartTime: start, EndTime: end})
+		})
+	},
+}
+
+var miningBlocksCmd = &cobra.Command{
+	Use:   "blocks",
+	Short: "Lists blocks mined by this node with their rewards.",
+	Long: "Lists blocks mined by this node, with the split of subsidy and blocks orphaned by reorganization.\n" +
+		"Use '-o csv' to export blocks as csv.",
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		start, err := parseTime(miningSince, "since")
+		if err != nil {
+			return err
+		}
+		end, err := parseTime(miningUntil, "until")
+		if err != nil {
+			return err
+		}
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.GetMinedBlocks(ctx, &pb.GetMinedBlocksRequest{StartTime: start, EndTime: end, PublicKey: miningPublicKey})
+		})
+	},
+}
+
+var miningPayoutsCmd = &cobra.Command{
+	Use:   "payouts",
+	Short: "Lists payout addresses and the strategy choosing them for mined blocks.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.GetPayoutAddresses(ctx, &empty.Empty{})
+		})
+	},
+}
+
+var miningPayoutsAddCmd = &cobra.Command{
+	Use:   "add <address>",
+	Short: "Adds a payout address.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.AddPayoutAddress(ctx, &pb.AddPayoutAddressRequest{Address: args[0], Weight: payoutWeight, PublicKey: payoutPublicKey})
+		})
+	},
+}
+
+var miningPayoutsRemoveCmd = &cobra.Command{
+	Use:   "remove <address>",
+	Short: "Removes a payout address, the last one is never removed.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.RemovePayoutAddress(ctx, &pb.RemovePayoutAddressRequest{Address: args[0]})
+		})
+	},
+}
+
+var miningPayoutsStrategyCmd = &cobra.Command{
+	Use:   "strategy <round-robin|weighted|public-key>",
+	Short: "Sets the strategy choosing payout address for mined blocks.",
+	Long: "Sets the strategy choosing payout address for mined blocks:\n" +
+		"  round-robin  pays to addresses in turn\n" +
+		"  weighted     splits blocks among addresses in proportion to their weights\n" +
+		"  public-key   pays to the address added with the public key mining the block,\n" +
+		"               or addresses without public key in turn",
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.SetPayoutStrategy(ctx, &pb.SetPayoutStrategyRequest{Strategy: args[0]})
+		})
+	},
+}
+
+func init() {
+	miningShadowCmd.Flags().StringVar(&miningSince, "since", "", "report slots since the time, in unix seconds, RFC3339 or 2006-01-02")
+	miningShadowCmd.Flags().StringVar(&miningUntil, "until", "", "report slots before the time, in unix seconds, RFC3339 or 2006-01-02")
+	miningBlocksCmd.Flags().StringVar(&miningSince, "since", "", "list blocks since the time, in unix seconds, RFC3339 or 2006-01-02")
+	miningBlocksCmd.Flags().StringVar(&miningUntil, "until", "", "list blocks before the time, in unix seconds, RFC3339 or 2006-01-02")
+	miningBlocksCmd.Flags().StringVar(&miningPublicKey, "public-key", "", "list blocks mined by the public key only")
+
+	miningPayoutsAddCmd.Flags().Uint32Var(&payoutWeight, "weight", 1, "weight of the address in weighted strategy")
+	miningPayoutsAddCmd.Flags().StringVar(&payoutPublicKey, "public-key", "", "pay blocks mined by the public key in public-key strategy")
+	miningPayoutsCmd.AddCommand(miningPayoutsAddCmd, miningPayoutsRemoveCmd, miningPayoutsStrategyCmd)
+
+	miningCmd.AddCommand(miningShadowCmd, miningBlocksCmd, miningPayoutsCmd)
+}
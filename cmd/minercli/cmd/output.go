@@ -0,0 +1,261 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+)
+
+// jsonMarshaler renders responses the same as the http gateway does.
+var jsonMarshaler = &runtime.JSONPb{OrigName: true, EmitDefaults: true, Indent: "  "}
+
+func printResponse(w io.Writer, msg proto.Message) error {
+	if outputFormat == outputJSON {
+		data, err := jsonMarshaler.Marshal(msg)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "%s\n", data)
+		return err
+	}
+	if outputFormat == outputCSV {
+		return printCSV(w, msg)
+	}
+	return printTable(w, msg)
+}
+
+// eventWriter prints streamed messages one per line, as compact json or
+// as tab-separated or csv rows whose header is printed before the first row.
+type eventWriter struct {
+	w      io.Writer
+	header bool
+}
+
+func newEventWriter(w io.Writer) *eventWriter {
+	return &eventWriter{w: w}
+}
+
+func (ew *eventWriter) write(msg proto.Message) error {
+	if outputFormat == outputJSON {
+		data, err := (&runtime.JSONPb{OrigName: true, EmitDefaults: true}).Marshal(msg)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(ew.w, "%s\n", data)
+		return err
+	}
+
+	row := flatten("", reflect.Indirect(reflect.ValueOf(msg)), nil)
+	if outputFormat == outputCSV {
+		cw := csv.NewWriter(ew.w)
+		if !ew.header {
+			cw.Write(csvHeader(row))
+			ew.header = true
+		}
+		cw.Write(csvValues(row))
+		cw.Flush()
+		return cw.Error()
+	}
+	if !ew.header {
+		names := make([]string, 0, len(row))
+		for _, f := range row {
+			names = append(names, strings.ToUpper(f.name))
+		}
+		if _, err := fmt.Fprintln(ew.w, strings.Join(names, "\t")); err != nil {
+			return err
+		}
+		ew.header = true
+	}
+	values := make([]string, 0, len(row))
+	for _, f := range row {
+		values = append(values, f.value)
+	}
+	_, err := fmt.Fprintln(ew.w, strings.Join(values, "\t"))
+	return err
+}
+
+type field struct {
+	name  string
+	value string
+}
+
+type list struct {
+	name  string
+	items reflect.Value
+}
+
+// printTable prints scalar fields of msg as name-value pairs, nested messages
+// are flattened into dotted names, and repeated messages are printed as tables.
+func printTable(w io.Writer, msg interface{}) error {
+	v := reflect.Indirect(reflect.ValueOf(msg))
+	if v.Kind() != reflect.Struct {
+		_, err := fmt.Fprintln(w, formatValue(v))
+		return err
+	}
+
+	var lists []list
+	fields := flatten("", v, &lists)
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	for _, f := range fields {
+		fmt.Fprintf(tw, "%s\t%s\n", f.name, f.value)
+	}
+	for _, l := range lists {
+		fmt.Fprintf(tw, "\n%s (%d):\n", l.name, l.items.Len())
+		if l.items.Len() == 0 {
+			continue
+		}
+		var header []string
+		for i := 0; i < l.items.Len(); i++ {
+			row := flatten("", reflect.Indirect(l.items.Index(i)), nil)
+			if i == 0 {
+				for _, f := range row {
+					header = append(header, strings.ToUpper(f.name))
+				}
+				fmt.Fprintln(tw, strings.Join(header, "\t"))
+			}
+			values := make([]string, 0, len(row))
+			for _, f := range row {
+				values = append(values, f.value)
+			}
+			fmt.Fprintln(tw, strings.Join(values, "\t"))
+		}
+	}
+	return tw.Flush()
+}
+
+// printCSV prints items of the first repeated message of msg as csv rows, or
+// msg itself as a single row if it has no repeated message.
+func printCSV(w io.Writer, msg interface{}) error {
+	v := reflect.Indirect(reflect.ValueOf(msg))
+	if v.Kind() != reflect.Struct {
+		_, err := fmt.Fprintln(w, formatValue(v))
+		return err
+	}
+
+	var lists []list
+	header := flatten("", v, &lists)
+	rows := [][]field{header}
+	if len(lists) != 0 {
+		items := lists[0].items
+		typ := items.Type().Elem()
+		if typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+		header = flatten("", reflect.New(typ).Elem(), nil)
+		rows = make([][]field, items.Len())
+		for i := range rows {
+			rows[i] = flatten("", reflect.Indirect(items.Index(i)), nil)
+		}
+	}
+
+	cw := csv.NewWriter(w)
+	cw.Write(csvHeader(header))
+	for _, row := range rows {
+		cw.Write(csvValues(row))
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func csvHeader(row []field) []string {
+	names := make([]string, len(row))
+	for i, f := range row {
+		names[i] = f.name
+	}
+	return names
+}
+
+// csvValues leaves absent values empty instead of "-".
+func csvValues(row []field) []string {
+	values := make([]string, len(row))
+	for i, f := range row {
+		if f.value != "-" {
+			values[i] = f.value
+		}
+	}
+	return values
+}
+
+// flatten collects fields of struct v, repeated messages are appended to
+// lists if it is not nil, or summarized by count otherwise.
+func flatten(prefix string, v reflect.Value, lists *[]list) []field {
+	var fields []field
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.PkgPath != "" || strings.HasPrefix(sf.Name, "XXX_") {
+			continue
+		}
+		name := prefix + fieldName(sf)
+		fv := v.Field(i)
+
+		switch {
+		case isMessage(fv.Type()):
+			if fv.Kind() == reflect.Ptr && fv.IsNil() {
+				fields = append(fields, field{name, "-"})
+				continue
+			}
+			fields = append(fields, flatten(name+".", reflect.Indirect(fv), lists)...)
+		case fv.Kind() == reflect.Slice && isMessage(fv.Type().Elem()):
+			if lists != nil {
+				*lists = append(*lists, list{name, fv})
+				continue
+			}
+			fields = append(fields, field{name, fmt.Sprintf("<%d items>", fv.Len())})
+		default:
+			fields = append(fields, field{name, formatValue(fv)})
+		}
+	}
+	return fields
+}
+
+func isMessage(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct
+}
+
+func fieldName(sf reflect.StructField) string {
+	if tag := sf.Tag.Get("json"); tag != "" && tag != "-" {
+		return strings.Split(tag, ",")[0]
+	}
+	return sf.Name
+}
+
+func formatValue(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return hex.EncodeToString(v.Bytes())
+		}
+		if v.Len() == 0 {
+			return "-"
+		}
+		items := make([]string, v.Len())
+		for i := range items {
+			items[i] = formatValue(v.Index(i))
+		}
+		return strings.Join(items, ",")
+	case reflect.String:
+		if v.Len() == 0 {
+			return "-"
+		}
+		return v.String()
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return "-"
+		}
+		return fmt.Sprint(v.Elem().Interface())
+	default:
+		return fmt.Sprint(v.Interface())
+	}
+}
@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	pb "github.com/Sukhavati-Labs/go-miner/rpc/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPrintResponse(t *testing.T) {
+	resp := &pb.WorkSpacesResponse{
+		SpaceCount: 2,
+		Spaces: []*pb.WorkSpace{
+			{Ordinal: 1, PublicKey: "pk1", BitLength: 32, State: "mining"},
+			{Ordinal: 2, PublicKey: "pk2", BitLength: 34, State: "ready"},
+		},
+	}
+
+	defer func(format string) { outputFormat = format }(outputFormat)
+	var buf bytes.Buffer
+
+	outputFormat = outputTable
+	if err := printResponse(&buf, resp); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 8 || !strings.HasPrefix(lines[0], "space_count") || !strings.HasPrefix(lines[4], "spaces (2):") {
+		t.Fatalf("unexpected table output:\n%s", buf.String())
+	}
+	if fields := strings.Fields(lines[7]); len(fields) < 4 || fields[0] != "2" || fields[1] != "pk2" {
+		t.Fatalf("unexpected table row %q", lines[7])
+	}
+
+	buf.Reset()
+	outputFormat = outputJSON
+	if err := printResponse(&buf, resp); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), `"space_count": 2`) || !strings.Contains(buf.String(), `"public_key": "pk2"`) {
+		t.Fatalf("unexpected json output:\n%s", buf.String())
+	}
+
+	buf.Reset()
+	outputFormat = outputCSV
+	if err := printResponse(&buf, resp); err != nil {
+		t.Fatal(err)
+	}
+	lines = strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 || !strings.HasPrefix(lines[0], "ordinal,public_key,") || !strings.HasPrefix(lines[2], "2,pk2,") {
+		t.Fatalf("unexpected csv output:\n%s", buf.String())
+	}
+
+	buf.Reset()
+	if err := printResponse(&buf, &pb.WorkSpacesResponse{}); err != nil {
+		t.Fatal(err)
+	}
+	if lines = strings.Split(strings.TrimSpace(buf.String()), "\n"); len(lines) != 1 || !strings.HasPrefix(lines[0], "ordinal,") {
+		t.Fatalf("unexpected csv output of empty list:\n%s", buf.String())
+	}
+}
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		err  error
+		code int
+	}{
+		{errors.New("unknown flag"), ExitUsage},
+		{rpcError(status.Error(codes.Unavailable, "connection refused")), ExitUnavailable},
+		{rpcError(status.Error(codes.DeadlineExceeded, "timeout")), ExitUnavailable},
+		{rpcError(status.Error(1806, "Invalid miner payout address")), ExitRPCError},
+		{&cliError{code: ExitFailure, err: errors.New("broken pipe")}, ExitFailure},
+	}
+	for i, test := range tests {
+		if code := exitCode(test.err); code != test.code {
+			t.Errorf("%d: expected exit code %d, got %d", i, test.code, code)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package cmd
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	pb "github.com/Sukhavati-Labs/go-miner/rpc/proto"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+)
+
+// maxMsgSize equals to the limit of rpc server
+const maxMsgSize = 1e7
+
+// cliError carries the exit code of a failed command, errors of other
+// types come from parsing commands, flags and arguments.
+type cliError struct {
+	code int
+	err  error
+}
+
+func (e *cliError) Error() string {
+	return e.err.Error()
+}
+
+func exitCode(err error) int {
+	if e, ok := err.(*cliError); ok {
+		return e.code
+	}
+	return ExitUsage
+}
+
+// rpcError classifies errors returned by grpc.
+func rpcError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return &cliError{code: ExitFailure, err: err}
+	}
+	switch st.Code() {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Canceled:
+		return &cliError{code: ExitUnavailable, err: fmt.Errorf("%s: %s", rpcEndpoint, st.Message())}
+	default:
+		return &cliError{code: ExitRPCError, err: fmt.Errorf("%s (code %d)", st.Message(), st.Code())}
+	}
+}
+
+// rpcCall sends one request by the client.
+type rpcCall func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error)
+
+// runRPC connects to rpcEndpoint, runs call and prints the response in outputFormat.
+func runRPC(call rpcCall) error {
+	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+	defer cancel()
+
+	opts, err := dialOptions()
+	if err != nil {
+		return &cliError{code: ExitFailure, err: err}
+	}
+	conn, err := grpc.DialContext(ctx, rpcEndpoint, opts...)
+	if err != nil {
+		return &cliError{code: ExitUnavailable, err: fmt.Errorf("fail to connect %s: %v", rpcEndpoint, err)}
+	}
+	defer conn.Close()
+
+	resp, err := call(ctx, pb.NewApiServiceClient(conn))
+	if err != nil {
+		return rpcError(err)
+	}
+	if err = printResponse(os.Stdout, resp); err != nil {
+		return &cliError{code: ExitFailure, err: err}
+	}
+	return nil
+}
+
+// streamCall opens a stream by the client and receives messages from it.
+type streamCall func(ctx context.Context, client pb.ApiServiceClient) (recv func() (proto.Message, error), err error)
+
+// runStream connects to rpcEndpoint, runs call and prints each message until
+// the stream ends or the command is interrupted, rpcTimeout applies to
+// connecting only.
+func runStream(call streamCall) error {
+	dialCtx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+	defer cancel()
+
+	opts, err := dialOptions()
+	if err != nil {
+		return &cliError{code: ExitFailure, err: err}
+	}
+	conn, err := grpc.DialContext(dialCtx, rpcEndpoint, opts...)
+	if err != nil {
+		return &cliError{code: ExitUnavailable, err: fmt.Errorf("fail to connect %s: %v", rpcEndpoint, err)}
+	}
+	defer conn.Close()
+
+	ctx, stop := context.WithCancel(context.Background())
+	defer stop()
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(interrupt)
+	go func() {
+		select {
+		case <-interrupt:
+			stop()
+		case <-ctx.Done():
+		}
+	}()
+	recv, err := call(ctx, pb.NewApiServiceClient(conn))
+	if err != nil {
+		return rpcError(err)
+	}
+	w := newEventWriter(os.Stdout)
+	for {
+		msg, err := recv()
+		if err == io.EOF || ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return rpcError(err)
+		}
+		if err = w.write(msg); err != nil {
+			return &cliError{code: ExitFailure, err: err}
+		}
+	}
+}
+
+// apiKeyCredentials attaches the api key to every request, it is never sent
+// without TLS unless insecure is set.
+type apiKeyCredentials struct {
+	key      string
+	insecure bool
+}
+
+func (k apiKeyCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + k.key}, nil
+}
+
+func (k apiKeyCredentials) RequireTransportSecurity() bool {
+	return !k.insecure
+}
+
+func dialOptions() ([]grpc.DialOption, error) {
+	opts := []grpc.DialOption{grpc.WithBlock(), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxMsgSize))}
+
+	useTLS := rpcTLS || rpcTLSCert != ""
+	if useTLS {
+		tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+		if host, _, err := net.SplitHostPort(rpcEndpoint); err == nil {
+			tlsConfig.ServerName = host
+		}
+		if rpcTLSCert != "" {
+			pem, err := ioutil.ReadFile(rpcTLSCert)
+			if err != nil {
+				return nil, err
+			}
+			tlsConfig.RootCAs = x509.NewCertPool()
+			if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("no certificate found in %s", rpcTLSCert)
+			}
+		}
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+
+	if rpcAPIKey == "" {
+		rpcAPIKey = os.Getenv(envAPIKey)
+	}
+	if rpcAPIKey != "" {
+		if !useTLS && !rpcInsecure {
+			return nil, fmt.Errorf("api key is sent only with --tls, set --insecure to send it in plaintext")
+		}
+		opts = append(opts, grpc.WithPerRPCCredentials(apiKeyCredentials{key: rpcAPIKey, insecure: rpcInsecure}))
+	}
+	return opts, nil
+}
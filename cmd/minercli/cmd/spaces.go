@@ -0,0 +1,352 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	pb "github.com/Sukhavati-Labs/go-miner/rpc/proto"
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/spf13/cobra"
+)
+
+var (
+	errMissingPayoutAddress = errors.New("at least one --payout-address is required")
+
+	spaceByDirs        bool
+	spaceCapacity      uint64
+	spaceAllocations   []string
+	spacePayoutAddrs   []string
+	spacePassphrase    string
+	spaceCoinType      uint32
+	spaceAutoCreate    int32
+	spaceVerifySamples uint32
+	spacePlotThreads   uint32
+	spaceBitLength     uint32
+	spaceBinding       bool
+	spaceBlocks        uint32
+)
+
+var spaceCmd = &cobra.Command{
+	Use:   "space",
+	Short: "Configures and operates mining spaces.",
+}
+
+var spaceListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lists configured spaces.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			if spaceByDirs {
+				return client.GetCapacitySpacesByDirs(ctx, &empty.Empty{})
+			}
+			return client.GetCapacitySpaces(ctx, &empty.Empty{})
+		})
+	},
+}
+
+var spaceGetCmd = &cobra.Command{
+	Use:   "get <space_id>",
+	Short: "Shows a space.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.GetCapacitySpace(ctx, &pb.WorkSpaceRequest{SpaceId: args[0]})
+		})
+	},
+}
+
+var spaceConfigureCmd = &cobra.Command{
+	Use:   "configure",
+	Short: "Configures spaces by overall capacity, spaces must be stopped.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(spacePayoutAddrs) == 0 {
+			return errMissingPayoutAddress
+		}
+		req := &pb.ConfigureSpaceKeeperRequest{
+			Capacity:        spaceCapacity,
+			PayoutAddresses: spacePayoutAddrs,
+			Passphrase:      spacePassphrase,
+			Cointype:        spaceCoinType,
+		}
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.ConfigureCapacity(ctx, req)
+		})
+	},
+}
+
+var spaceConfigureDirsCmd = &cobra.Command{
+	Use:   "configure-dirs",
+	Short: "Configures spaces by capacity of each directory, spaces must be stopped.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(spacePayoutAddrs) == 0 {
+			return errMissingPayoutAddress
+		}
+		if len(spaceAllocations) == 0 {
+			return errors.New("at least one --allocation is required")
+		}
+		req := &pb.ConfigureSpaceKeeperByDirsRequest{
+			PayoutAddresses: spacePayoutAddrs,
+			Passphrase:      spacePassphrase,
+			Cointype:        spaceCoinType,
+			AutoCreate:      spaceAutoCreate,
+		}
+		var err error
+		if req.Allocations, err = parseAllocations(spaceAllocations); err != nil {
+			return err
+		}
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.ConfigureCapacityByDirs(ctx, req)
+		})
+	},
+}
+
+var spacePlanCmd = &cobra.Command{
+	Use:   "plan",
+	Short: "Shows what configure or configure-dirs would do without configuring anything.",
+	Long: "Shows what configure (by --capacity) or configure-dirs (by --allocation) would do without configuring anything.\n" +
+		"The returned plan_id is given to apply, which configures exactly the reviewed plan.",
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if (spaceCapacity == 0) == (len(spaceAllocations) == 0) {
+			return errors.New("either --capacity or --allocation is required")
+		}
+		req := &pb.PlanCapacityRequest{
+			Capacity:   spaceCapacity,
+			Cointype:   spaceCoinType,
+			AutoCreate: spaceAutoCreate,
+		}
+		var err error
+		if req.Allocations, err = parseAllocations(spaceAllocations); err != nil {
+			return err
+		}
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.PlanCapacity(ctx, req)
+		})
+	},
+}
+
+var spaceApplyCmd = &cobra.Command{
+	Use:   "apply <plan_id>",
+	Short: "Configures spaces by a plan returned by plan, spaces must be stopped.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(spacePayoutAddrs) == 0 {
+			return errMissingPayoutAddress
+		}
+		req := &pb.ApplyCapacityPlanRequest{
+			PlanId:          args[0],
+			PayoutAddresses: spacePayoutAddrs,
+			Passphrase:      spacePassphrase,
+		}
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.ApplyCapacityPlan(ctx, req)
+		})
+	},
+}
+
+// parseAllocations parses allocations given as <directory>=<capacity>.
+func parseAllocations(allocs []string) ([]*pb.ConfigureSpaceKeeperByDirsRequest_Allocation, error) {
+	var result []*pb.ConfigureSpaceKeeperByDirsRequest_Allocation
+	for _, alloc := range allocs {
+		idx := strings.LastIndex(alloc, "=")
+		if idx <= 0 {
+			return nil, fmt.Errorf("invalid allocation %q, should be <directory>=<capacity>", alloc)
+		}
+		capacity, err := parseUint64(alloc[idx+1:], "capacity")
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, &pb.ConfigureSpaceKeeperByDirsRequest_Allocation{
+			Directory: alloc[:idx],
+			Capacity:  capacity,
+		})
+	}
+	return result, nil
+}
+
+// newSpaceActionCmd creates command acting on the space given by argument,
+// or on all spaces without argument.
+func newSpaceActionCmd(use, short string,
+	all func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error),
+	one func(ctx context.Context, client pb.ApiServiceClient, req *pb.WorkSpaceRequest) (proto.Message, error)) *cobra.Command {
+	return &cobra.Command{
+		Use:   use + " [space_id]",
+		Short: short,
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+				if len(args) == 0 {
+					return all(ctx, client)
+				}
+				return one(ctx, client, &pb.WorkSpaceRequest{SpaceId: args[0]})
+			})
+		},
+	}
+}
+
+var spacePlotCmd = newSpaceActionCmd("plot", "Plots all spaces, or the given one.",
+	func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+		return client.PlotCapacitySpaces(ctx, &empty.Empty{})
+	},
+	func(ctx context.Context, client pb.ApiServiceClient, req *pb.WorkSpaceRequest) (proto.Message, error) {
+		return client.PlotCapacitySpace(ctx, &pb.PlotWorkSpaceRequest{SpaceId: req.SpaceId, Threads: spacePlotThreads})
+	})
+
+var spaceMineCmd = newSpaceActionCmd("mine", "Mines on all spaces, or on the given one.",
+	func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+		return client.MineCapacitySpaces(ctx, &empty.Empty{})
+	},
+	func(ctx context.Context, client pb.ApiServiceClient, req *pb.WorkSpaceRequest) (proto.Message, error) {
+		return client.MineCapacitySpace(ctx, req)
+	})
+
+var spaceStopCmd = newSpaceActionCmd("stop", "Stops plotting or mining on all spaces, or on the given one.",
+	func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+		return client.StopCapacitySpaces(ctx, &empty.Empty{})
+	},
+	func(ctx context.Context, client pb.ApiServiceClient, req *pb.WorkSpaceRequest) (proto.Message, error) {
+		return client.StopCapacitySpace(ctx, req)
+	})
+
+var spaceVerifyCmd = &cobra.Command{
+	Use:   "verify <space_id>",
+	Short: "Verifies integrity of a plotted space.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.VerifyCapacitySpace(ctx, &pb.VerifyWorkSpaceRequest{SpaceId: args[0], Samples: spaceVerifySamples})
+		})
+	},
+}
+
+var spaceQueueCmd = &cobra.Command{
+	Use:   "queue",
+	Short: "Shows running and waiting plots with their ETA and throughput.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.GetPlotQueue(ctx, &empty.Empty{})
+		})
+	},
+}
+
+var spacePauseCmd = &cobra.Command{
+	Use:   "pause <space_id>",
+	Short: "Pauses plotting of a queued space, it keeps its position in queue.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.PausePlotCapacitySpace(ctx, &pb.WorkSpaceRequest{SpaceId: args[0]})
+		})
+	},
+}
+
+var spaceResumeCmd = &cobra.Command{
+	Use:   "resume <space_id>",
+	Short: "Resumes plotting of a paused space.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.ResumePlotCapacitySpace(ctx, &pb.WorkSpaceRequest{SpaceId: args[0]})
+		})
+	},
+}
+
+var spaceMoveCmd = &cobra.Command{
+	Use:   "move <space_id> <target_dir>",
+	Short: "Moves a space into another directory of proof_dir without replotting.",
+	Long: "Moves a space into another directory of proof_dir without replotting.\n" +
+		"Files are copied and verified while the space keeps mining, the space is switched to copies once done.",
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.MoveCapacitySpace(ctx, &pb.MoveCapacitySpaceRequest{SpaceId: args[0], TargetDir: args[1]})
+		})
+	},
+}
+
+var spaceRebalanceCmd = &cobra.Command{
+	Use:   "rebalance [dir...]",
+	Short: "Moves plotted spaces among directories of proof_dir to even out free space of disks.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.RebalanceCapacitySpaces(ctx, &pb.RebalanceCapacitySpacesRequest{Dirs: args})
+		})
+	},
+}
+
+var spaceMovesCmd = &cobra.Command{
+	Use:   "moves",
+	Short: "Shows waiting, running and recently finished moves of spaces.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.GetCapacitySpaceMoves(ctx, &empty.Empty{})
+		})
+	},
+}
+
+var spaceRevenueCmd = &cobra.Command{
+	Use:   "revenue",
+	Short: "Estimates blocks and SKT per day earned by plotted spaces, or by the given capacity.",
+	Long: "Estimates blocks and SKT per day earned by plotted spaces, or by the given capacity.\n" +
+		"Network space is estimated from targets of recent blocks, bounds make the 95% confidence interval.",
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.EstimateMiningRevenue(ctx, &pb.EstimateMiningRevenueRequest{
+				Capacity:  spaceCapacity,
+				BitLength: spaceBitLength,
+				Binding:   spaceBinding,
+				Blocks:    spaceBlocks,
+			})
+		})
+	},
+}
+
+var spaceLatencyCmd = &cobra.Command{
+	Use:   "latency",
+	Short: "Shows percentiles of recent proof lookups by space and by directory, and spaces regularly exceeding budget.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.GetProofLatencies(ctx, &empty.Empty{})
+		})
+	},
+}
+
+func init() {
+	spaceListCmd.Flags().BoolVar(&spaceByDirs, "by-dirs", false, "group spaces by directory")
+
+	for _, cmd := range []*cobra.Command{spaceConfigureCmd, spaceConfigureDirsCmd, spaceApplyCmd} {
+		cmd.Flags().StringSliceVar(&spacePayoutAddrs, "payout-address", nil, "payout address of mining reward, can be repeated")
+		cmd.Flags().StringVar(&spacePassphrase, "passphrase", "", "private passphrase of wallet")
+	}
+	for _, cmd := range []*cobra.Command{spaceConfigureCmd, spaceConfigureDirsCmd, spacePlanCmd} {
+		cmd.Flags().Uint32Var(&spaceCoinType, "cointype", 0, "coin type of wallet")
+	}
+	spaceConfigureCmd.Flags().Uint64Var(&spaceCapacity, "capacity", 0, "overall capacity in MiB")
+	spaceConfigureCmd.MarkFlagRequired("capacity")
+	spaceConfigureDirsCmd.Flags().StringArrayVar(&spaceAllocations, "allocation", nil, "<directory>=<capacity in MiB>, can be repeated")
+	spaceConfigureDirsCmd.Flags().Int32Var(&spaceAutoCreate, "auto-create", 0, "positive to create missing directories, negative to never create")
+	spacePlanCmd.Flags().Uint64Var(&spaceCapacity, "capacity", 0, "overall capacity in MiB")
+	spacePlanCmd.Flags().StringArrayVar(&spaceAllocations, "allocation", nil, "<directory>=<capacity in MiB>, can be repeated")
+	spacePlanCmd.Flags().Int32Var(&spaceAutoCreate, "auto-create", 0, "negative to never create new spaces in directories")
+
+	spacePlotCmd.Flags().Uint32Var(&spacePlotThreads, "threads", 0, "plotting threads of the given space, 0 for the count of CPUs")
+	spaceVerifyCmd.Flags().Uint32VarP(&spaceVerifySamples, "samples", "n", 0, "number of sampled challenges, 0 for default")
+	spaceRevenueCmd.Flags().Uint64Var(&spaceCapacity, "capacity", 0, "capacity in MiB to estimate instead of plotted spaces")
+	spaceRevenueCmd.Flags().Uint32Var(&spaceBitLength, "bit-length", 0, "bit length of spaces plotting the capacity, 0 for 32")
+	spaceRevenueCmd.Flags().BoolVar(&spaceBinding, "binding", false, "assume the capacity has enough binding for full subsidy")
+	spaceRevenueCmd.Flags().Uint32Var(&spaceBlocks, "blocks", 0, "recent blocks to estimate network space from, 0 for 1000")
+
+	spaceCmd.AddCommand(spaceListCmd, spaceGetCmd, spaceConfigureCmd, spaceConfigureDirsCmd, spacePlanCmd, spaceApplyCmd,
+		spacePlotCmd, spaceMineCmd, spaceStopCmd, spaceVerifyCmd, spaceQueueCmd, spacePauseCmd, spaceResumeCmd,
+		spaceMoveCmd, spaceRebalanceCmd, spaceMovesCmd, spaceRevenueCmd, spaceLatencyCmd)
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"context"
+
+	pb "github.com/Sukhavati-Labs/go-miner/rpc/proto"
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/spf13/cobra"
+)
+
+var subscribeCmd = &cobra.Command{
+	Use:   "subscribe",
+	Short: "Streams events until interrupted.",
+	Long: "Streams events until interrupted, one event per line.\n" +
+		"The node closes a subscription which can not keep up with events, subscribe again and query the current state in that case.",
+}
+
+var subscribeBlocksCmd = &cobra.Command{
+	Use:   "blocks",
+	Short: "Streams blocks connected to and disconnected from the main chain.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runStream(func(ctx context.Context, client pb.ApiServiceClient) (func() (proto.Message, error), error) {
+			stream, err := client.SubscribeBlocks(ctx, &empty.Empty{})
+			return func() (proto.Message, error) { return stream.Recv() }, err
+		})
+	},
+}
+
+var subscribeTxPoolCmd = &cobra.Command{
+	Use:   "txpool",
+	Short: "Streams transactions accepted by txpool.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runStream(func(ctx context.Context, client pb.ApiServiceClient) (func() (proto.Message, error), error) {
+			stream, err := client.SubscribeTxPool(ctx, &empty.Empty{})
+			return func() (proto.Message, error) { return stream.Recv() }, err
+		})
+	},
+}
+
+var subscribeSpacesCmd = &cobra.Command{
+	Use:   "spaces",
+	Short: "Streams state and progress changes of workspaces.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runStream(func(ctx context.Context, client pb.ApiServiceClient) (func() (proto.Message, error), error) {
+			stream, err := client.SubscribeWorkSpaces(ctx, &empty.Empty{})
+			return func() (proto.Message, error) { return stream.Recv() }, err
+		})
+	},
+}
+
+var subscribeMiningCmd = &cobra.Command{
+	Use:   "mining",
+	Short: "Streams proofs found and blocks submitted by the miner.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runStream(func(ctx context.Context, client pb.ApiServiceClient) (func() (proto.Message, error), error) {
+			stream, err := client.SubscribeMining(ctx, &empty.Empty{})
+			return func() (proto.Message, error) { return stream.Recv() }, err
+		})
+	},
+}
+
+func init() {
+	subscribeCmd.AddCommand(subscribeBlocksCmd, subscribeTxPoolCmd, subscribeSpacesCmd, subscribeMiningCmd)
+}
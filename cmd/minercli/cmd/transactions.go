@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	pb "github.com/Sukhavati-Labs/go-miner/rpc/proto"
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/spf13/cobra"
+)
+
+var txPoolVerbose int
+
+var txCmd = &cobra.Command{
+	Use:   "tx",
+	Short: "Queries and submits transactions.",
+}
+
+var txGetCmd = &cobra.Command{
+	Use:   "get <tx_id>",
+	Short: "Shows a transaction from the chain or the txpool.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.GetRawTransaction(ctx, &pb.GetRawTransactionRequest{TxId: args[0]})
+		})
+	},
+}
+
+var txCoinbaseCmd = &cobra.Command{
+	Use:   "coinbase <height>",
+	Short: "Shows the coinbase transaction of block at height.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		height, err := parseUint64(args[0], "height")
+		if err != nil {
+			return err
+		}
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.GetCoinbase(ctx, &pb.GetCoinbaseRequest{Height: height})
+		})
+	},
+}
+
+var txSendCmd = &cobra.Command{
+	Use:   "send <hex|->",
+	Short: "Submits a signed transaction to the txpool and relays it.",
+	Long:  "Submits a signed transaction to the txpool and relays it, the hex is read from stdin if the argument is \"-\".",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		txHex, err := readHexArg(args[0])
+		if err != nil {
+			return err
+		}
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.SendRawTransaction(ctx, &pb.SendRawTransactionRequest{Hex: txHex})
+		})
+	},
+}
+
+var txTestCmd = &cobra.Command{
+	Use:   "test <hex|->",
+	Short: "Checks whether the txpool accepts a signed transaction, without submitting it.",
+	Long:  "Checks whether the txpool accepts a signed transaction, without submitting it, the hex is read from stdin if the argument is \"-\".",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		txHex, err := readHexArg(args[0])
+		if err != nil {
+			return err
+		}
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.TestMempoolAccept(ctx, &pb.TestMempoolAcceptRequest{Hex: txHex})
+		})
+	},
+}
+
+var txStakingRewardCmd = &cobra.Command{
+	Use:   "staking-reward <timestamp>",
+	Short: "Shows staking reward records at unix timestamp.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		timestamp, err := parseUint64(args[0], "timestamp")
+		if err != nil {
+			return err
+		}
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.GetStakingRewardRecord(ctx, &pb.GetStakingRewardRecordRequest{Timestamp: timestamp})
+		})
+	},
+}
+
+var txPoolCmd = &cobra.Command{
+	Use:   "txpool",
+	Short: "Shows transactions in the txpool.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if txPoolVerbose < -1 || txPoolVerbose > 1 {
+			return errors.New("verbose should be -1, 0 or 1")
+		}
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			switch txPoolVerbose {
+			case 0:
+				return client.GetTxPoolVerbose0(ctx, &empty.Empty{})
+			case 1:
+				return client.GetTxPoolVerbose1(ctx, &empty.Empty{})
+			default:
+				return client.GetTxPool(ctx, &empty.Empty{})
+			}
+		})
+	},
+}
+
+var txPoolStakingCmd = &cobra.Command{
+	Use:   "staking",
+	Short: "Shows staking transactions in the txpool.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.GetStakingTxPoolInfo(ctx, &empty.Empty{})
+		})
+	},
+}
+
+func init() {
+	txCmd.AddCommand(txGetCmd, txCoinbaseCmd, txSendCmd, txTestCmd, txStakingRewardCmd)
+	txPoolCmd.Flags().IntVarP(&txPoolVerbose, "verbose", "v", -1, "-1 for summary, 0 for descriptions, 1 for descriptions with priorities and dependencies")
+	txPoolCmd.AddCommand(txPoolStakingCmd)
+}
+
+// readHexArg reads hex from stdin if arg is "-".
+func readHexArg(arg string) (string, error) {
+	if arg != "-" {
+		return arg, nil
+	}
+	data, err := ioutil.ReadAll(os.Stdin)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
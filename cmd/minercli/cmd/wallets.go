@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"context"
+
+	pb "github.com/Sukhavati-Labs/go-miner/rpc/proto"
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/spf13/cobra"
+)
+
+var (
+	walletPassphrase    string
+	walletOldPassphrase string
+	walletNewPassphrase string
+	walletExportPath    string
+	walletDirPassphrase string
+	walletImportPubPass string
+)
+
+var walletCmd = &cobra.Command{
+	Use:     "wallet",
+	Aliases: []string{"keystore"},
+	Short:   "Manages keystores of the miner wallet.",
+}
+
+var walletListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lists keystores.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.GetKeystore(ctx, &empty.Empty{})
+		})
+	},
+}
+
+var walletDetailCmd = &cobra.Command{
+	Use:   "detail <wallet_id>",
+	Short: "Shows details of a keystore.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		req := &pb.GetKeystoreDetailRequest{WalletId: args[0], Passphrase: walletPassphrase}
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.GetKeystoreDetail(ctx, req)
+		})
+	},
+}
+
+var walletExportCmd = &cobra.Command{
+	Use:   "export <wallet_id>",
+	Short: "Exports a keystore.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		req := &pb.ExportKeystoreRequest{WalletId: args[0], Passphrase: walletPassphrase, ExportPath: walletExportPath}
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.ExportKeystore(ctx, req)
+		})
+	},
+}
+
+var walletExportDirCmd = &cobra.Command{
+	Use:   "export-dir <wallet_dir>",
+	Short: "Exports keystores of a wallet directory.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		req := &pb.ExportKeystoreByDirRequest{
+			WalletDir:        args[0],
+			Passphrase:       walletPassphrase,
+			WalletPassphrase: walletDirPassphrase,
+			ExportPath:       walletExportPath,
+		}
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.ExportKeystoreByDir(ctx, req)
+		})
+	},
+}
+
+var walletImportCmd = &cobra.Command{
+	Use:   "import <keystore_file>",
+	Short: "Imports a keystore.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		req := &pb.ImportKeystoreRequest{ImportPath: args[0], OldPassphrase: walletOldPassphrase, NewPassphrase: walletNewPassphrase}
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.ImportKeystore(ctx, req)
+		})
+	},
+}
+
+var walletImportDirCmd = &cobra.Command{
+	Use:   "import-dir <keystore_dir>",
+	Short: "Imports keystores of a wallet directory.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		req := &pb.ImportKeystoreByDirRequest{
+			ImportKeystoreDir: args[0],
+			ImportPubpass:     walletImportPubPass,
+			ImportPrivpass:    walletOldPassphrase,
+			CurrentPrivpass:   walletPassphrase,
+		}
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.ImportKeystoreByDir(ctx, req)
+		})
+	},
+}
+
+var walletUnlockCmd = &cobra.Command{
+	Use:   "unlock",
+	Short: "Unlocks the wallet.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.UnlockWallet(ctx, &pb.UnlockWalletRequest{Passphrase: walletPassphrase})
+		})
+	},
+}
+
+var walletLockCmd = &cobra.Command{
+	Use:   "lock",
+	Short: "Locks the wallet.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.LockWallet(ctx, &empty.Empty{})
+		})
+	},
+}
+
+var walletChangePrivPassCmd = &cobra.Command{
+	Use:   "change-privpass",
+	Short: "Changes private passphrase of the wallet.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		req := &pb.ChangePrivatePassRequest{OldPrivpass: walletOldPassphrase, NewPrivpass: walletNewPassphrase}
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.ChangePrivatePass(ctx, req)
+		})
+	},
+}
+
+var walletChangePubPassCmd = &cobra.Command{
+	Use:   "change-pubpass",
+	Short: "Changes public passphrase of the wallet.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		req := &pb.ChangePublicPassRequest{OldPubpass: walletOldPassphrase, NewPubpass: walletNewPassphrase}
+		return runRPC(func(ctx context.Context, client pb.ApiServiceClient) (proto.Message, error) {
+			return client.ChangePublicPass(ctx, req)
+		})
+	},
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{walletDetailCmd, walletExportCmd, walletExportDirCmd, walletUnlockCmd} {
+		cmd.Flags().StringVarP(&walletPassphrase, "passphrase", "p", "", "private passphrase of wallet")
+	}
+	walletImportDirCmd.Flags().StringVarP(&walletPassphrase, "passphrase", "p", "", "current private passphrase of wallet")
+	for _, cmd := range []*cobra.Command{walletExportCmd, walletExportDirCmd} {
+		cmd.Flags().StringVar(&walletExportPath, "export-path", "", "directory to write keystore files")
+	}
+	walletExportDirCmd.Flags().StringVar(&walletDirPassphrase, "wallet-passphrase", "", "private passphrase of the wallet directory")
+
+	walletImportCmd.Flags().StringVar(&walletOldPassphrase, "old-passphrase", "", "passphrase of the keystore file")
+	walletImportCmd.Flags().StringVar(&walletNewPassphrase, "new-passphrase", "", "passphrase of the imported keystore")
+	walletImportDirCmd.Flags().StringVar(&walletImportPubPass, "import-pubpass", "", "public passphrase of the imported wallet")
+	walletImportDirCmd.Flags().StringVar(&walletOldPassphrase, "import-privpass", "", "private passphrase of the imported wallet")
+	for _, cmd := range []*cobra.Command{walletChangePrivPassCmd, walletChangePubPassCmd} {
+		cmd.Flags().StringVar(&walletOldPassphrase, "old", "", "current passphrase")
+		cmd.Flags().StringVar(&walletNewPassphrase, "new", "", "new passphrase")
+	}
+
+	walletCmd.AddCommand(walletListCmd, walletDetailCmd, walletExportCmd, walletExportDirCmd, walletImportCmd,
+		walletImportDirCmd, walletUnlockCmd, walletLockCmd, walletChangePrivPassCmd, walletChangePubPassCmd)
+}
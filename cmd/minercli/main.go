@@ -1 +1,9 @@
-package minercli
+package main
+
+import (
+	"github.com/Sukhavati-Labs/go-miner/cmd/minercli/cmd"
+)
+
+func main() {
+	cmd.Execute()
+}
@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Sukhavati-Labs/go-miner/poc/wallet/keystore"
+	"github.com/Sukhavati-Labs/go-miner/poc/wallet/keystore/hdkeychain"
+	"github.com/Sukhavati-Labs/go-miner/pocec"
+)
+
+const (
+	// depth of account extended key, m/purpose'/coin'/account'
+	accountKeyDepth = 3
+	// depth of external branch extended key, m/purpose'/coin'/account'/0
+	branchKeyDepth = 4
+)
+
+var (
+	ErrPrivateExtendedKey = errors.New("private extended key is not accepted, use the neutered one")
+	ErrExtendedKeyDepth   = errors.New("extended key should be of an account or its external branch")
+	ErrInvalidOrdinals    = errors.New("invalid ordinal range")
+)
+
+// plotKey is a public key to be plotted with its ordinal in wallet.
+type plotKey struct {
+	ordinal int64
+	pubKey  *pocec.PublicKey
+}
+
+func parsePubKey(pkStr string) (*pocec.PublicKey, error) {
+	pkBytes, err := hex.DecodeString(pkStr)
+	if err != nil {
+		return nil, err
+	}
+	return pocec.ParsePubKey(pkBytes, pocec.S256())
+}
+
+// parseOrdinals parses range formatted as `first-last` or a single ordinal.
+func parseOrdinals(s string) (first, last uint32, err error) {
+	parts := strings.Split(s, "-")
+	if len(parts) > 2 {
+		return 0, 0, ErrInvalidOrdinals
+	}
+	first64, err := strconv.ParseUint(strings.TrimSpace(parts[0]), 10, 31)
+	if err != nil {
+		return 0, 0, ErrInvalidOrdinals
+	}
+	last64 := first64
+	if len(parts) == 2 {
+		if last64, err = strconv.ParseUint(strings.TrimSpace(parts[1]), 10, 31); err != nil {
+			return 0, 0, ErrInvalidOrdinals
+		}
+	}
+	if last64 < first64 {
+		return 0, 0, ErrInvalidOrdinals
+	}
+	return uint32(first64), uint32(last64), nil
+}
+
+// deriveKeys derives public keys of ordinals in [first, last] the same way
+// as wallet does, ordinals are indexes on the external branch of account.
+func deriveKeys(xpub string, first, last uint32) ([]plotKey, error) {
+	key, err := hdkeychain.NewKeyFromString(xpub)
+	if err != nil {
+		return nil, err
+	}
+	if key.IsPrivate() {
+		return nil, ErrPrivateExtendedKey
+	}
+	switch key.Depth() {
+	case accountKeyDepth:
+		if key, err = key.Child(keystore.ExternalBranch); err != nil {
+			return nil, err
+		}
+	case branchKeyDepth:
+	default:
+		return nil, ErrExtendedKeyDepth
+	}
+
+	keys := make([]plotKey, 0, last-first+1)
+	for i := first; ; i++ {
+		child, err := key.Child(i)
+		if err != nil {
+			return nil, fmt.Errorf("ordinal %d: %v", i, err)
+		}
+		pubKey, err := child.ECPubKey()
+		if err != nil {
+			return nil, fmt.Errorf("ordinal %d: %v", i, err)
+		}
+		keys = append(keys, plotKey{ordinal: int64(i), pubKey: pubKey})
+		if i == last {
+			break
+		}
+	}
+	return keys, nil
+}
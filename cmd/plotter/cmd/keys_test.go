@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/Sukhavati-Labs/go-miner/config"
+	"github.com/Sukhavati-Labs/go-miner/poc/wallet/keystore/hdkeychain"
+)
+
+func TestParseOrdinals(t *testing.T) {
+	tests := []struct {
+		s           string
+		first, last uint32
+		err         error
+	}{
+		{"3", 3, 3, nil},
+		{"0-9", 0, 9, nil},
+		{" 5 - 6 ", 5, 6, nil},
+		{"9-0", 0, 0, ErrInvalidOrdinals},
+		{"1-2-3", 0, 0, ErrInvalidOrdinals},
+		{"-1", 0, 0, ErrInvalidOrdinals},
+		{"2147483648", 0, 0, ErrInvalidOrdinals},
+	}
+	for _, test := range tests {
+		first, last, err := parseOrdinals(test.s)
+		if err != test.err || first != test.first || last != test.last {
+			t.Errorf("%q: got (%d, %d, %v), expected (%d, %d, %v)", test.s, first, last, err, test.first, test.last, test.err)
+		}
+	}
+}
+
+func TestDeriveKeys(t *testing.T) {
+	seed := bytes.Repeat([]byte{0x5a}, hdkeychain.RecommendedSeedLen)
+	master, err := hdkeychain.NewMaster(seed, &config.ChainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// m/44'/coin'/0'
+	account := master
+	for _, i := range []uint32{44, 1, 0} {
+		if account, err = account.Child(i + hdkeychain.HardenedKeyStart); err != nil {
+			t.Fatal(err)
+		}
+	}
+	branch, err := account.Child(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	accountPub, err := account.Neuter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	branchPub, err := branch.Neuter()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, xpub := range []string{accountPub.String(), branchPub.String()} {
+		keys, err := deriveKeys(xpub, 2, 4)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(keys) != 3 {
+			t.Fatalf("derived %d keys, expected 3", len(keys))
+		}
+		for _, key := range keys {
+			child, err := branch.Child(uint32(key.ordinal))
+			if err != nil {
+				t.Fatal(err)
+			}
+			expected, err := child.ECPubKey()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !key.pubKey.IsEqual(expected) {
+				t.Errorf("ordinal %d: public key not matched", key.ordinal)
+			}
+		}
+	}
+
+	if _, err := deriveKeys(account.String(), 0, 0); err != ErrPrivateExtendedKey {
+		t.Errorf("private key, got %v", err)
+	}
+	masterPub, err := master.Neuter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := deriveKeys(masterPub.String(), 0, 0); err != ErrExtendedKeyDepth {
+		t.Errorf("master key, got %v", err)
+	}
+}
@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/Sukhavati-Labs/go-miner/logging"
+	"github.com/Sukhavati-Labs/go-miner/poc"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/sktdb/sktdb.v1"
+	"github.com/spf13/cobra"
+)
+
+const (
+	loggingFilename  = "plotter"
+	progressInterval = 10 * time.Second
+)
+
+var (
+	ErrMissingKey     = errors.New("either --pubkey or --xpub is required")
+	ErrConflictingKey = errors.New("--pubkey and --xpub are mutually exclusive")
+	ErrInterrupted    = errors.New("plotting interrupted, run again to resume")
+
+	pubKeyStr string
+	ordinal   int64
+	xpub      string
+	ordinals  string
+	bitLength int
+	plotDir   string
+	threads   int
+	memory    uint64
+	logDir    string
+)
+
+var rootCmd = &cobra.Command{
+	Use:   filepath.Base(os.Args[0]),
+	Short: "Offline plotter for SKT plot files",
+	Long: "The plotter writes plot files without a running node or a wallet, plotted files can be moved\n" +
+		"into miner.proof_dir, or plotted in it directly, to be loaded by the node unchanged.\n" +
+		"Ordinals must match the wallet owning the keys, so that the node could sign mined blocks.\n" +
+		"\nPublic keys are given by either:\n" +
+		"  --pubkey <hex> --ordinal <n>           a single public key with its ordinal in wallet.\n" +
+		"  --xpub <key> --ordinals <first-last>   extended public key of wallet account or its external branch,\n" +
+		"                                         keys are derived by ordinals the same way as wallet does.\n" +
+		"\nPlots are resumed from their last checkpoints, interrupted plotting could be continued by running again.\n" +
+		"Progress advances as checkpoints are written, which is more frequent with less --memory.",
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.Init(logDir, loggingFilename, "info", 1, true)
+
+		keys, err := collectPlotKeys()
+		if err != nil {
+			return err
+		}
+		if !poc.EnsureBitLength(bitLength) {
+			return poc.ErrProofInvalidBitLength
+		}
+		dir, err := filepath.Abs(plotDir)
+		if err != nil {
+			return err
+		}
+		if err = os.MkdirAll(dir, 0700); err != nil {
+			return err
+		}
+
+		interrupt := make(chan os.Signal, 1)
+		signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
+		defer signal.Stop(interrupt)
+
+		for i, key := range keys {
+			fmt.Printf("[%d/%d] ", i+1, len(keys))
+			if err = plot(dir, key, interrupt); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.Flags().StringVar(&pubKeyStr, "pubkey", "", "hex encoded compressed public key")
+	rootCmd.Flags().Int64Var(&ordinal, "ordinal", 0, "ordinal of --pubkey in wallet")
+	rootCmd.Flags().StringVar(&xpub, "xpub", "", "extended public key of wallet account or its external branch")
+	rootCmd.Flags().StringVar(&ordinals, "ordinals", "0", "ordinals of keys derived from --xpub, formatted as first-last")
+	rootCmd.Flags().IntVarP(&bitLength, "bit-length", "b", 0, "bit length of plots")
+	rootCmd.Flags().StringVarP(&plotDir, "dir", "d", ".", "directory to write plot files")
+	rootCmd.Flags().IntVarP(&threads, "threads", "t", 0, "count of plotting threads, 0 for the count of CPUs")
+	rootCmd.Flags().Uint64VarP(&memory, "memory", "m", 0, "max memory used by plotting in MiB, 0 for available memory")
+	rootCmd.Flags().StringVar(&logDir, "log-dir", ".", "directory to write log files")
+	rootCmd.MarkFlagRequired("bit-length")
+}
+
+func Execute() {
+	if err := rootCmd.Execute(); err != nil {
+		logging.VPrint(logging.FATAL, "Command failed", logging.LogFormat{"err": err})
+	}
+}
+
+func collectPlotKeys() ([]plotKey, error) {
+	switch {
+	case pubKeyStr != "" && xpub != "":
+		return nil, ErrConflictingKey
+	case pubKeyStr != "":
+		pubKey, err := parsePubKey(pubKeyStr)
+		if err != nil {
+			return nil, err
+		}
+		return []plotKey{{ordinal: ordinal, pubKey: pubKey}}, nil
+	case xpub != "":
+		first, last, err := parseOrdinals(ordinals)
+		if err != nil {
+			return nil, err
+		}
+		return deriveKeys(xpub, first, last)
+	default:
+		return nil, ErrMissingKey
+	}
+}
+
+// plot plots a single file, it returns ErrInterrupted if interrupted by signal.
+func plot(dir string, key plotKey, interrupt chan os.Signal) error {
+	pkStr := hex.EncodeToString(key.pubKey.SerializeCompressed())
+	mdb, err := sktdb_v1.NewSktDBV1(dir, key.ordinal, key.pubKey, bitLength)
+	if err != nil {
+		return err
+	}
+	defer mdb.Close()
+
+	fmt.Printf("ordinal %d, pubkey %s, bit_length %d\n", key.ordinal, pkStr, bitLength)
+	if mdb.Ready() {
+		fmt.Println("    already plotted")
+		return nil
+	}
+	if _, _, progress := mdb.Progress(); progress > 0 {
+		fmt.Printf("    resume from checkpoint, progress %.2f%%\n", progress)
+	}
+
+	mdb.SetPlotThreads(threads)
+	mdb.SetPlotMemory(memory * poc.MiB)
+	start := time.Now()
+	result := mdb.Plot()
+	ticker := time.NewTicker(progressInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case err = <-result:
+			if err != nil {
+				return err
+			}
+			fmt.Printf("    plotted in %s\n", time.Since(start).Round(time.Second))
+			return nil
+
+		case <-ticker.C:
+			prePlotted, _, progress := mdb.Progress()
+			stage := "pre-plotting"
+			if prePlotted {
+				stage = "plotting"
+			}
+			fmt.Printf("    %s, progress %.2f%%, elapsed %s\n", stage, progress, time.Since(start).Round(time.Second))
+
+		case <-interrupt:
+			fmt.Println("    stopping, progress is kept by checkpoint")
+			<-mdb.StopPlot()
+			<-result
+			return ErrInterrupted
+		}
+	}
+}
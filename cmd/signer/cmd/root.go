@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"errors"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/Sukhavati-Labs/go-miner/chainutil"
+	"github.com/Sukhavati-Labs/go-miner/config"
+	configpb "github.com/Sukhavati-Labs/go-miner/config/pb"
+	"github.com/Sukhavati-Labs/go-miner/logging"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/spacekeeper/signer"
+	"github.com/Sukhavati-Labs/go-miner/poc/wallet"
+	_ "github.com/Sukhavati-Labs/go-miner/poc/wallet/db/ldb"
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultListenAddress = "0.0.0.0:9791"
+	defaultDataDirname   = "signer"
+	loggingFilename      = "signer"
+)
+
+var (
+	errMissingPubPass  = errors.New("missing app.pub_password in config")
+	errMissingPrivPass = errors.New("missing miner.private_password in config")
+
+	configFile    string
+	listenAddress string
+	token         string
+	dataDir       string
+	maxHeightGap  uint64
+)
+
+var rootCmd = &cobra.Command{
+	Use:   filepath.Base(os.Args[0]),
+	Short: "Signer signing blocks for SKT nodes keeping no private keys",
+	Long: "The signer unlocks the wallet in miner.miner_dir, and signs block headers for nodes configured with\n" +
+		"miner.remote_signer. A header is signed only if it carries a valid proof, and its height is above\n" +
+		"any height signed before for the public key, but at most --max-height-gap above it. Proofs do not\n" +
+		"prove heights, so raise the gap only for a known jump, e.g. after the signer is offline for long.\n" +
+		"Signed heights and an audit log of all requests are kept in the data directory, which defaults to\n" +
+		"\"" + defaultDataDirname + "\" under miner.miner_dir.\n" +
+		"Requests are rejected unless they carry the token, which defaults to miner.remote_signer_token in config.\n" +
+		"Nodes are served over TLS with the certificate pair of miner.remote_signer_tls_cert and miner.remote_signer_tls_key,\n" +
+		"a self-signed pair is generated if neither file exists, whose certificate should be trusted by nodes.",
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := loadConfig(configFile)
+		if err != nil {
+			return err
+		}
+		logging.Init(cfg.Log.LogDir, loggingFilename, cfg.Log.LogLevel, 1, cfg.Log.DisableCprint)
+		if token == "" {
+			token = cfg.Miner.RemoteSignerToken
+		}
+		if dataDir == "" {
+			dataDir = filepath.Join(cfg.Miner.MinerDir, defaultDataDirname)
+		}
+		return runSigner(cfg)
+	},
+}
+
+func init() {
+	rootCmd.Flags().StringVarP(&configFile, "config", "c", config.DefaultConfigFilename, "path to configuration file")
+	rootCmd.Flags().StringVarP(&listenAddress, "listen", "l", defaultListenAddress, "address for nodes to connect")
+	rootCmd.Flags().StringVarP(&token, "token", "t", "", "token shared with nodes")
+	rootCmd.Flags().StringVarP(&dataDir, "data", "d", "", "directory of signed heights and audit log")
+	rootCmd.Flags().Uint64Var(&maxHeightGap, "max-height-gap", signer.DefaultMaxHeightGap, "max height signed above the signed one, 0 for no limit")
+}
+
+func Execute() {
+	if err := rootCmd.Execute(); err != nil {
+		logging.VPrint(logging.FATAL, "Command failed", logging.LogFormat{"err": err})
+	}
+}
+
+func loadConfig(path string) (*config.Config, error) {
+	cfg, err := config.LoadConfig(&config.Config{ConfigFile: path, Config: configpb.NewConfig()})
+	if err != nil {
+		return nil, err
+	}
+	// signer never builds blocks, so payout addresses are not required
+	cfg.Miner.Generate = false
+	cfg.Miner.RemoteSigner = ""
+	return config.CheckConfig(cfg)
+}
+
+func runSigner(cfg *config.Config) error {
+	if cfg.App.PubPassword == "" {
+		return errMissingPubPass
+	}
+	if cfg.Miner.PrivatePassword == "" {
+		return errMissingPrivPass
+	}
+	pocWallet, err := wallet.NewPoCWallet(wallet.NewPocWalletConfig(cfg.Miner.MinerDir, cfg.Db.DbType), []byte(cfg.App.PubPassword))
+	if err != nil {
+		logging.CPrint(logging.ERROR, "unable to open wallet", logging.LogFormat{"err": err, "path": cfg.Miner.MinerDir})
+		return err
+	}
+	defer pocWallet.Close()
+	if err = pocWallet.Unlock([]byte(cfg.Miner.PrivatePassword)); err != nil {
+		return err
+	}
+
+	tlsConfig, generated, err := chainutil.LoadTLSConfig(cfg.Miner.RemoteSignerTlsCert, cfg.Miner.RemoteSignerTlsKey, cfg.Miner.RemoteSignerTlsHosts)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to load signer certificate pair",
+			logging.LogFormat{"cert": cfg.Miner.RemoteSignerTlsCert, "key": cfg.Miner.RemoteSignerTlsKey, "err": err})
+		return err
+	}
+	if generated {
+		logging.CPrint(logging.INFO, "generated signer certificate pair, copy the certificate to nodes",
+			logging.LogFormat{"cert": cfg.Miner.RemoteSignerTlsCert, "key": cfg.Miner.RemoteSignerTlsKey})
+	}
+	srv, err := signer.NewServer(pocWallet, dataDir, token, tlsConfig, maxHeightGap)
+	if err != nil {
+		return err
+	}
+	lis, err := net.Listen("tcp", listenAddress)
+	if err != nil {
+		return err
+	}
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		sig := <-interrupt
+		logging.CPrint(logging.INFO, "received signal, stopping signer", logging.LogFormat{"signal": sig})
+		srv.Stop()
+	}()
+	return srv.Serve(lis)
+}
@@ -0,0 +1,9 @@
+package main
+
+import (
+	"github.com/Sukhavati-Labs/go-miner/cmd/signer/cmd"
+)
+
+func main() {
+	cmd.Execute()
+}
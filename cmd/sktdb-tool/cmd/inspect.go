@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/Sukhavati-Labs/go-miner/logging"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/sktdb/sktdb.v1"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// `ordinal_pubKey_bitLength.massdb` and `ordinal_pubKey_bitLength_a.massdb`
+	regAnyPlotFile = `^\d+_[A-F0-9]{66}_\d{2}(_A)?\.MASSDB$`
+)
+
+var ErrPlotDamaged = errors.New("damaged plot found")
+
+var inspectCmd = &cobra.Command{
+	Use:   "inspect <path>...",
+	Short: "Dumps headers of plot files.",
+	Long: "Dumps headers of plot files, and checks them against file sizes and file names.\n" +
+		"Damaged headers are dumped as they are, which are refused by nodes on loading.\n" +
+		"\nArguments:\n" +
+		"  <path>   plot file or directory containing plot files.\n",
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		files, err := collectFiles(args, regAnyPlotFile)
+		if err != nil {
+			return err
+		}
+
+		var damaged int
+		for _, file := range files {
+			h, err := sktdb_v1.InspectFile(file)
+			if err != nil {
+				logging.CPrint(logging.ERROR, "fail to inspect plot", logging.LogFormat{"file": file, "err": err})
+				fmt.Printf("%s: error: %v\n", file, err)
+				damaged++
+				continue
+			}
+			printHeader(h)
+			if len(h.Problems) != 0 {
+				damaged++
+			}
+		}
+		fmt.Printf("inspected %d plots, %d damaged\n", len(files), damaged)
+
+		if damaged != 0 {
+			return ErrPlotDamaged
+		}
+		return nil
+	},
+}
+
+func mapTypeName(typ sktdb_v1.MapType) string {
+	switch typ {
+	case sktdb_v1.MapTypeHashMapA:
+		return "A"
+	case sktdb_v1.MapTypeHashMapB:
+		return "B"
+	default:
+		return fmt.Sprintf("unknown(%d)", typ)
+	}
+}
+
+func printHeader(h *sktdb_v1.FileHeader) {
+	var pubKey = "invalid"
+	if h.PubKey != nil {
+		pubKey = hex.EncodeToString(h.PubKey.SerializeCompressed())
+	}
+	var progress float64
+	if final := h.FinalCheckpoint(); final != 0 {
+		progress = float64(h.Checkpoint) * 100 / float64(final)
+	}
+	fmt.Printf("%s:\n", h.FilePath)
+	fmt.Printf("    type         %s\n", mapTypeName(h.Type))
+	fmt.Printf("    version      %d\n", h.Version)
+	fmt.Printf("    bit_length   %d\n", h.BitLength)
+	fmt.Printf("    pub_key      %s\n", pubKey)
+	fmt.Printf("    pub_key_hash %s\n", h.PubKeyHash)
+	fmt.Printf("    checkpoint   %d/%d (%.2f%%)\n", h.Checkpoint, h.FinalCheckpoint(), progress)
+	fmt.Printf("    size         %d/%d\n", h.FileSize, h.ExpectedSize)
+	for _, problem := range h.Problems {
+		fmt.Printf("    problem      %v\n", problem)
+	}
+}
@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/Sukhavati-Labs/go-miner/logging"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/sktdb/sktdb.v1"
+	"github.com/Sukhavati-Labs/go-miner/pocec"
+	"github.com/spf13/cobra"
+)
+
+var (
+	repairPubKey string
+	repairForce  bool
+)
+
+var repairCmd = &cobra.Command{
+	Use:   "repair <file>...",
+	Short: "Rebuilds damaged headers of plot files.",
+	Long: "Rebuilds damaged headers of plot files from file names, and resumes them from the last valid checkpoint.\n" +
+		"Files with healthy headers are skipped unless forced. Stop nodes using the files before repairing.\n" +
+		"\nArguments:\n" +
+		"  <file>   plot file named `ordinal_pubKey_bitLength.massdb` or `ordinal_pubKey_bitLength_a.massdb`.\n",
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var pubKey *pocec.PublicKey
+		if repairPubKey != "" {
+			pubKeyBytes, err := hex.DecodeString(repairPubKey)
+			if err != nil {
+				return err
+			}
+			if pubKey, err = pocec.ParsePubKey(pubKeyBytes, pocec.S256()); err != nil {
+				return err
+			}
+		}
+
+		var failed int
+		for _, file := range args {
+			h, err := sktdb_v1.InspectFile(file)
+			if err == nil && len(h.Problems) == 0 && !repairForce {
+				fmt.Printf("%s: header is healthy, skipped\n", file)
+				continue
+			}
+			if h, err = sktdb_v1.RepairHeader(file, pubKey); err != nil {
+				logging.CPrint(logging.ERROR, "fail to repair plot", logging.LogFormat{"file": file, "err": err})
+				fmt.Printf("%s: error: %v\n", file, err)
+				failed++
+				continue
+			}
+			printHeader(h)
+			if len(h.Problems) != 0 {
+				failed++
+			}
+		}
+
+		if failed != 0 {
+			return ErrPlotDamaged
+		}
+		return nil
+	},
+}
+
+var resumeCmd = &cobra.Command{
+	Use:   "resume <file>...",
+	Short: "Resumes half-plotted files from the last valid checkpoint.",
+	Long: "Steps back checkpoints of plot files until records before them are valid, and truncates data after them,\n" +
+		"so that plotting continues from there instead of from zero. Stop nodes using the files before resuming.\n" +
+		"\nArguments:\n" +
+		"  <file>   plot file with a healthy header, see `inspect` and `repair`.\n",
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var failed int
+		for _, file := range args {
+			from, to, err := sktdb_v1.ResumeFile(file)
+			if err != nil {
+				logging.CPrint(logging.ERROR, "fail to resume plot", logging.LogFormat{"file": file, "err": err})
+				fmt.Printf("%s: error: %v\n", file, err)
+				failed++
+				continue
+			}
+			fi, err := os.Stat(file)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("%s: checkpoint %d -> %d, size %d\n", file, from, to, fi.Size())
+		}
+
+		if failed != 0 {
+			return ErrPlotDamaged
+		}
+		return nil
+	},
+}
+
+func init() {
+	repairCmd.Flags().StringVarP(&repairPubKey, "pubkey", "k", "", "known public key in hex, checked against file names")
+	repairCmd.Flags().BoolVarP(&repairForce, "force", "f", false, "rebuild healthy headers as well")
+}
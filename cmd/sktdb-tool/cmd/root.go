@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/Sukhavati-Labs/go-miner/logging"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	logging.Init(".", "sktdb-tool", "info", 1, false)
+	rootCmd.AddCommand(verifyCmd, inspectCmd, repairCmd, resumeCmd)
+}
+
+var rootCmd = &cobra.Command{
+	Use:   filepath.Base(os.Args[0]),
+	Short: "Maintenance Tool for SKT plot files",
+	Long:  "The tool works on plot files directly, it does not need a running node or an unlocked wallet.",
+}
+
+func Execute() {
+	if err := rootCmd.Execute(); err != nil {
+		logging.VPrint(logging.FATAL, "Command failed", logging.LogFormat{"err": err})
+	}
+}
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/Sukhavati-Labs/go-miner/logging"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/sktdb"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/sktdb/sktdb.v1"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// `ordinal_pubKey_bitLength.massdb`, HashMapA files are skipped
+	regPlotFile = `^\d+_[A-F0-9]{66}_\d{2}\.MASSDB$`
+)
+
+var (
+	ErrPlotCorrupted = errors.New("corrupted plot found")
+
+	verifySamples int
+)
+
+var verifyCmd = &cobra.Command{
+	Use:   "verify <path>...",
+	Short: "Verifies integrity of plot files.",
+	Long: "Verifies integrity of plot files by sampling random challenges and recomputing proofs.\n" +
+		"Files are only read, so plots can be audited while a node is mining on them.\n" +
+		"\nArguments:\n" +
+		"  <path>   plot file or directory containing plot files.\n",
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		files, err := collectFiles(args, regPlotFile)
+		if err != nil {
+			return err
+		}
+
+		var corrupted int
+		for _, file := range files {
+			report, err := sktdb_v1.VerifyFile(file, verifySamples)
+			if err != nil {
+				logging.CPrint(logging.ERROR, "fail to verify plot", logging.LogFormat{"file": file, "err": err})
+				fmt.Printf("%s: error: %v\n", file, err)
+				corrupted++
+				continue
+			}
+			printReport(report)
+			if report.Corrupted != 0 || len(report.Ranges) != 0 {
+				corrupted++
+			}
+		}
+		fmt.Printf("verified %d plots, %d unhealthy\n", len(files), corrupted)
+
+		if corrupted != 0 {
+			return ErrPlotCorrupted
+		}
+		return nil
+	},
+}
+
+func init() {
+	verifyCmd.Flags().IntVarP(&verifySamples, "samples", "n", 10000, "number of sampled challenges per plot, 0 for checking all records")
+}
+
+// collectFiles returns files in paths, and files matching pattern in directories of paths.
+func collectFiles(paths []string, pattern string) ([]string, error) {
+	regExp, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, path := range paths {
+		fi, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !fi.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		fis, err := ioutil.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, fi := range fis {
+			if !fi.IsDir() && regExp.MatchString(strings.ToUpper(fi.Name())) {
+				files = append(files, filepath.Join(path, fi.Name()))
+			}
+		}
+	}
+	return files, nil
+}
+
+func printReport(report *sktdb.VerifyReport) {
+	fmt.Printf("%s: bit_length %d, sampled %d, valid %d, empty %d, corrupted %d, health %.4f\n",
+		report.FilePath, report.BitLength, report.Sampled, report.Valid, report.Empty, report.Corrupted, report.Health())
+	for _, r := range report.Ranges {
+		fmt.Printf("    corrupted bytes [%d, %d)\n", r.Offset, r.Offset+r.Length)
+	}
+}
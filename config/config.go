@@ -22,6 +22,7 @@ import (
 
 const (
 	defaultChainTag            = "mainnet"
+	regTestChainTag            = "regtest"
 	defaultConfigDir           = "config"
 	DefaultConfigFilename      = "config.json"
 	defaultGenesisJsonFilename = "genesis.json"
@@ -35,6 +36,25 @@ const (
 	defaultDbType              = "leveldb"
 	defaultPoCMinerBackend     = "sync"
 	defaultSpaceKeeperBackend  = "spacekeeper.v1"
+	remoteSpaceKeeperBackend   = "spacekeeper.remote"
+	defaultHarvesterTimeout    = 1500 // milliseconds
+	defaultHarvesterTLSCert    = "harvester.cert"
+	defaultHarvesterTLSKey     = "harvester.key"
+	defaultSignerTLSCert       = "signer.cert"
+	defaultSignerTLSKey        = "signer.key"
+	PoolPoCMinerBackend        = "pool"
+	PoolServerPoCMinerBackend  = "pool.server"
+	defaultPoolListen          = "127.0.0.1:9789"
+	defaultPoolTLSCertFilename = "pool.cert"
+	defaultPoolTLSKeyFilename  = "pool.key"
+	defaultPlotMaxConcurrent   = 1
+	defaultPlotDiskWrites      = 1
+	defaultProofReadMode       = "file"
+	mmapProofReadMode          = "mmap"
+	PayoutRoundRobin           = "round-robin"
+	PayoutWeighted             = "weighted"
+	PayoutPublicKey            = "public-key"
+	defaultPayoutStrategy      = PayoutRoundRobin
 	defaultBlockMinSize        = 0
 	defaultBlockMaxSize        = wire.MaxBlockPayload
 	defaultBlockPrioritySize   = consensus.DefaultBlockPrioritySize
@@ -44,6 +64,9 @@ const (
 	MaxMiningPayoutAddresses   = 6000
 	defaultAPIPortGRPC         = "9787"
 	defaultAPIPortHttp         = "9788"
+	defaultAPIHostGRPC         = "127.0.0.1"
+	defaultAPITLSCertFilename  = "rpc.cert"
+	defaultAPITLSKeyFilename   = "rpc.key"
 )
 
 var (
@@ -67,6 +90,7 @@ var (
 	defaultProofDir            = defaultProofDirname
 	defaultLogDir              = defaultLogDirname
 	HDCoinTypeMainNet   uint32 = 2021
+	HDCoinTypeTestNet   uint32 = 1
 )
 
 // RunServiceCommand is only set to a real function on Windows.  It is used
@@ -84,6 +108,7 @@ type Config struct {
 	ConfigFile  string `short:"C" long:"configfile" description:"Path to configuration file"`
 	ShowVersion bool   `short:"V" long:"version" description:"Display Version information and exit"`
 	Generate    bool   `long:"generate" description:"Generate (mine) coins when start"`
+	RegTest     bool   `long:"regtest" description:"Use the regression test network"`
 	Init        bool   `long:"init" description:"Init miner keystore"`
 	PrivatePass string `short:"P" long:"privpass" description:"Private passphrase for miner"`
 }
@@ -199,12 +224,18 @@ func CheckConfig(cfg *Config) (*Config, error) {
 	if cfg.Miner == nil {
 		cfg.Miner = new(configpb.MinerConfig)
 	}
+	if cfg.Metrics == nil {
+		cfg.Metrics = new(configpb.MetricsConfig)
+	}
 	if cfg.Miner.MiningAddr == nil {
 		cfg.Miner.MiningAddr = make([]string, 0)
 	}
 	if cfg.Miner.ProofDir == nil {
 		cfg.Miner.ProofDir = make([]string, 0)
 	}
+	if cfg.Miner.Harvester == nil {
+		cfg.Miner.Harvester = make([]string, 0)
+	}
 
 	// Checks for AppConfig
 	if cfg.App.Hostname == "" {
@@ -224,6 +255,46 @@ func CheckConfig(cfg *Config) (*Config, error) {
 	if cfg.Network.Rpc.ApiAllowedLan == nil {
 		cfg.Network.Rpc.ApiAllowedLan = make([]string, 0)
 	}
+	if cfg.Network.Rpc.ApiHostGrpc == "" {
+		cfg.Network.Rpc.ApiHostGrpc = defaultAPIHostGRPC
+	}
+	if cfg.Network.Rpc.ApiTlsHosts == nil {
+		cfg.Network.Rpc.ApiTlsHosts = make([]string, 0)
+	}
+	if cfg.Network.Rpc.ApiKeys == nil {
+		cfg.Network.Rpc.ApiKeys = make([]string, 0)
+	}
+	if cfg.Network.Rpc.ApiTls {
+		if cfg.Network.Rpc.ApiTlsCert == "" {
+			cfg.Network.Rpc.ApiTlsCert = defaultAPITLSCertFilename
+		}
+		if cfg.Network.Rpc.ApiTlsKey == "" {
+			cfg.Network.Rpc.ApiTlsKey = defaultAPITLSKeyFilename
+		}
+		cfg.Network.Rpc.ApiTlsCert = cleanAndExpandPath(cfg.Network.Rpc.ApiTlsCert)
+		cfg.Network.Rpc.ApiTlsKey = cleanAndExpandPath(cfg.Network.Rpc.ApiTlsKey)
+	}
+	if cfg.Network.Rpc.ApiCredentials == nil {
+		cfg.Network.Rpc.ApiCredentials = make([]*configpb.RPCCredential, 0)
+	}
+	if cfg.Network.Rpc.ApiAddressRoles == nil {
+		cfg.Network.Rpc.ApiAddressRoles = make([]*configpb.RPCAddressRoles, 0)
+	}
+	for i, key := range cfg.Network.Rpc.ApiKeys {
+		if key == "" {
+			return cfg, errors.New(fmt.Sprintf("invalid rpc api key, %d, empty key", i))
+		}
+	}
+	for i, cred := range cfg.Network.Rpc.ApiCredentials {
+		if cred.ApiKey == "" {
+			return cfg, errors.New(fmt.Sprintf("invalid rpc api credential, %d, empty key", i))
+		}
+	}
+	for i, entry := range cfg.Network.Rpc.ApiAddressRoles {
+		if entry.Address != "*" && net.ParseIP(entry.Address) == nil {
+			return cfg, errors.New(fmt.Sprintf("invalid rpc address roles, %d, %s", i, entry.Address))
+		}
+	}
 	// TODO: remove duplicate items
 	for i, addr := range cfg.Network.Rpc.ApiWhitelist {
 		if addr == "*" {
@@ -233,6 +304,22 @@ func CheckConfig(cfg *Config) (*Config, error) {
 			return cfg, errors.New(fmt.Sprintf("invalid rpc whitelist, %d, %s", i, addr))
 		}
 	}
+	if cfg.Metrics.Listen != "" {
+		if _, _, err := net.SplitHostPort(cfg.Metrics.Listen); err != nil {
+			return cfg, errors.New(fmt.Sprintf("invalid metrics listen address, %s, %v", cfg.Metrics.Listen, err))
+		}
+	}
+	// Checks for chain, it should be selected before other components read ChainParams
+	if cfg.RegTest {
+		cfg.Network.ChainTag = regTestChainTag
+	}
+	if cfg.Network.ChainTag == "" {
+		cfg.Network.ChainTag = defaultChainTag
+	}
+	if err := SelectChainParams(cfg.Network.ChainTag); err != nil {
+		return cfg, errors.New(fmt.Sprintf("invalid chain_tag %s", cfg.Network.ChainTag))
+	}
+
 	// TODO: add ip:port match
 	// Checks for P2PConfig
 	cfg.Network.P2P.Seeds = NormalizeSeeds(cfg.Network.P2P.Seeds, ChainParams.DefaultPort)
@@ -261,6 +348,10 @@ func CheckConfig(cfg *Config) (*Config, error) {
 		cfg.Db.DataDir = defaultDataDir
 	}
 	cfg.Db.DataDir = dealWithDir(cfg.Db.DataDir)
+	// keep chains of other networks apart from main network
+	if cfg.Network.ChainTag != defaultChainTag {
+		cfg.Db.DataDir = filepath.Join(cfg.Db.DataDir, cfg.Network.ChainTag)
+	}
 
 	// Checks for LogConfig
 	if cfg.Log.LogDir == "" {
@@ -288,10 +379,106 @@ func CheckConfig(cfg *Config) (*Config, error) {
 		if len(cfg.Miner.MiningAddr) > MaxMiningPayoutAddresses {
 			return cfg, errors.New(fmt.Sprintln("mining addr cannot be more than", MaxMiningPayoutAddresses, "current", len(cfg.Miner.MiningAddr)))
 		}
-		if cfg.Miner.PocminerBackend == defaultPoCMinerBackend && cfg.Miner.PrivatePassword == "" {
+		// blocks are signed by pool miners, remote harvesters or remote signers with their own wallets,
+		// and never signed in shadow mode
+		localSigning := (cfg.Miner.PocminerBackend == defaultPoCMinerBackend && cfg.Miner.RemoteSigner == "" && !cfg.Miner.Shadow) || cfg.Miner.PocminerBackend == PoolPoCMinerBackend
+		if localSigning && cfg.Miner.SpacekeeperBackend != remoteSpaceKeeperBackend && cfg.Miner.PrivatePassword == "" {
 			return cfg, errors.New("private password cannot be empty when generate set true")
 		}
 	}
+	if cfg.Miner.SpacekeeperBackend == remoteSpaceKeeperBackend {
+		if len(cfg.Miner.Harvester) == 0 {
+			return cfg, errors.New("harvester cannot be empty when spacekeeper_backend is " + remoteSpaceKeeperBackend)
+		}
+		if cfg.Miner.HarvesterToken == "" {
+			return cfg, errors.New("harvester_token cannot be empty when spacekeeper_backend is " + remoteSpaceKeeperBackend)
+		}
+	}
+	if cfg.Miner.RemoteSigner != "" {
+		if cfg.Miner.PocminerBackend != defaultPoCMinerBackend {
+			return cfg, errors.New("remote_signer is only supported when pocminer_backend is " + defaultPoCMinerBackend)
+		}
+		if cfg.Miner.RemoteSignerToken == "" {
+			return cfg, errors.New("remote_signer_token cannot be empty when remote_signer is set")
+		}
+	}
+	if cfg.Miner.Shadow && cfg.Miner.PocminerBackend != defaultPoCMinerBackend {
+		return cfg, errors.New("shadow is only supported when pocminer_backend is " + defaultPoCMinerBackend)
+	}
+	if cfg.Miner.PocminerBackend == PoolPoCMinerBackend {
+		if cfg.Miner.PoolAddress == "" {
+			return cfg, errors.New("pool_address cannot be empty when pocminer_backend is " + PoolPoCMinerBackend)
+		}
+		if cfg.Miner.PoolToken == "" {
+			return cfg, errors.New("pool_token cannot be empty when pocminer_backend is " + PoolPoCMinerBackend)
+		}
+		if cfg.Miner.PoolTlsCert == "" {
+			return cfg, errors.New("pool_tls_cert cannot be empty when pocminer_backend is " + PoolPoCMinerBackend)
+		}
+		cfg.Miner.PoolTlsCert = cleanAndExpandPath(cfg.Miner.PoolTlsCert)
+	}
+	if cfg.Miner.PocminerBackend == PoolServerPoCMinerBackend {
+		if cfg.Miner.PoolListen == "" {
+			cfg.Miner.PoolListen = defaultPoolListen
+		}
+		if cfg.Miner.PoolTlsCert == "" {
+			cfg.Miner.PoolTlsCert = defaultPoolTLSCertFilename
+		}
+		if cfg.Miner.PoolTlsKey == "" {
+			cfg.Miner.PoolTlsKey = defaultPoolTLSKeyFilename
+		}
+		cfg.Miner.PoolTlsCert = cleanAndExpandPath(cfg.Miner.PoolTlsCert)
+		cfg.Miner.PoolTlsKey = cleanAndExpandPath(cfg.Miner.PoolTlsKey)
+		if len(cfg.Miner.PoolCredentials) == 0 {
+			return cfg, errors.New("pool_credentials cannot be empty when pocminer_backend is " + PoolServerPoCMinerBackend)
+		}
+		for i, cred := range cfg.Miner.PoolCredentials {
+			if cred.Account == "" || cred.Token == "" {
+				return cfg, errors.New(fmt.Sprintf("invalid pool credential, %d, empty account or token", i))
+			}
+		}
+	}
+	if cfg.Miner.HarvesterTimeout == 0 {
+		cfg.Miner.HarvesterTimeout = defaultHarvesterTimeout
+	}
+	// harvesters serve with the pair, and nodes trust the certificate
+	if cfg.Miner.HarvesterTlsCert == "" {
+		cfg.Miner.HarvesterTlsCert = defaultHarvesterTLSCert
+	}
+	if cfg.Miner.HarvesterTlsKey == "" {
+		cfg.Miner.HarvesterTlsKey = defaultHarvesterTLSKey
+	}
+	cfg.Miner.HarvesterTlsCert = cleanAndExpandPath(cfg.Miner.HarvesterTlsCert)
+	cfg.Miner.HarvesterTlsKey = cleanAndExpandPath(cfg.Miner.HarvesterTlsKey)
+	// signers serve with the pair, and nodes trust the certificate
+	if cfg.Miner.RemoteSignerTlsCert == "" {
+		cfg.Miner.RemoteSignerTlsCert = defaultSignerTLSCert
+	}
+	if cfg.Miner.RemoteSignerTlsKey == "" {
+		cfg.Miner.RemoteSignerTlsKey = defaultSignerTLSKey
+	}
+	cfg.Miner.RemoteSignerTlsCert = cleanAndExpandPath(cfg.Miner.RemoteSignerTlsCert)
+	cfg.Miner.RemoteSignerTlsKey = cleanAndExpandPath(cfg.Miner.RemoteSignerTlsKey)
+	if cfg.Miner.PlotMaxConcurrent == 0 {
+		cfg.Miner.PlotMaxConcurrent = defaultPlotMaxConcurrent
+	}
+	if cfg.Miner.PlotDiskWrites == 0 {
+		cfg.Miner.PlotDiskWrites = defaultPlotDiskWrites
+	}
+	switch cfg.Miner.ProofReadMode {
+	case "":
+		cfg.Miner.ProofReadMode = defaultProofReadMode
+	case defaultProofReadMode, mmapProofReadMode:
+	default:
+		return cfg, errors.New("proof_read_mode should be " + defaultProofReadMode + " or " + mmapProofReadMode)
+	}
+	switch cfg.Miner.PayoutStrategy {
+	case "":
+		cfg.Miner.PayoutStrategy = defaultPayoutStrategy
+	case PayoutRoundRobin, PayoutWeighted, PayoutPublicKey:
+	default:
+		return cfg, errors.New("payout_strategy should be " + PayoutRoundRobin + ", " + PayoutWeighted + " or " + PayoutPublicKey)
+	}
 	if cfg.Miner.MinerDir == "" {
 		cfg.Miner.MinerDir = defaultMinerFileDir
 	}
@@ -302,6 +489,9 @@ func CheckConfig(cfg *Config) (*Config, error) {
 	for i, dir := range cfg.Miner.ProofDir {
 		cfg.Miner.ProofDir[i] = dealWithDir(dir)
 	}
+	if cfg.Miner.SignLockDir != "" {
+		cfg.Miner.SignLockDir = dealWithDir(cfg.Miner.SignLockDir)
+	}
 	if cfg.Miner.ProofList != "" {
 		_, err := DecodeProofList(cfg.Miner.ProofList)
 		if err != nil {
@@ -71,6 +71,57 @@ var genesisBlock = wire.MsgBlock{
 	Transactions: []*wire.MsgTx{&genesisCoinbaseTx},
 }
 
+// regTestGenesisCoinbaseTx is the coinbase transaction for genesis block
+// of regression test network.
+var regTestGenesisCoinbaseTx = wire.MsgTx{
+	Version: 1,
+	TxIn: []*wire.TxIn{
+		{
+			PreviousOutPoint: wire.OutPoint{
+				Hash:  wire.Hash{},
+				Index: wire.MaxPrevOutIndex,
+			},
+			Sequence: wire.MaxTxInSequenceNum,
+			Witness:  wire.TxWitness{},
+		},
+	},
+	TxOut: []*wire.TxOut{
+		{
+			Value:    0x2FAF08000,
+			PkScript: mustDecodeString("002018ab40cde6f4f6087e27d118717bc80c176c5c4d16d2308423e893689b8d1823"),
+		},
+	},
+	LockTime: 0,
+	Payload:  []byte("sukhavati regtest"),
+}
+
+// regTestGenesisBlock defines the genesis block of regression test network,
+// ChainID of its header is filled on init.
+// Timestamp is far enough in the past so that blocks could be generated
+// one slot after another without waiting for wall clock.
+var regTestGenesisBlock = wire.MsgBlock{
+	Header: wire.BlockHeader{
+		Version:         1,
+		Height:          0,
+		Timestamp:       time.Unix(0x5FEE6600, 0), // 2021-01-01 00:00:00 +0000 UTC
+		Previous:        wire.Hash{},
+		TransactionRoot: regTestGenesisCoinbaseTx.TxHash(),
+		WitnessRoot:     regTestGenesisCoinbaseTx.WitnessHash(),
+		ProposalRoot:    genesisHeader.ProposalRoot,
+		Target:          regTestPocLimit,
+		Challenge:       genesisHeader.Challenge,
+		PubKey:          genesisHeader.PubKey,
+		Proof:           genesisHeader.Proof,
+		Signature:       genesisHeader.Signature,
+		BanList:         make([]*pocec.PublicKey, 0),
+	},
+	Proposals: wire.ProposalArea{
+		PunishmentArea: make([]*wire.FaultPubKey, 0),
+		OtherArea:      make([]*wire.NormalProposal, 0),
+	},
+	Transactions: []*wire.MsgTx{&regTestGenesisCoinbaseTx},
+}
+
 var genesisHash = mustDecodeHash("7f56dee203d1798c2e34180ed8f763ea62e01758a3cfa91373999a1a1a7b53fb")
 
 var genesisChainID = mustDecodeHash("3e5c9bbb72a812303dd01b99ffe7fba755a7272a3a42abf2e983fdc2c0ec34b8")
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/Sukhavati-Labs/go-miner/consensus"
+	"github.com/Sukhavati-Labs/go-miner/poc"
 	"github.com/Sukhavati-Labs/go-miner/wire"
 )
 
@@ -16,6 +17,10 @@ var (
 
 	// mainPocLimit is the smallest proof of capacity target.
 	mainPocLimit = new(big.Int).Sub(new(big.Int).Lsh(bigOne, 20), bigOne)
+
+	// regTestPocLimit is the smallest proof of capacity target on regression
+	// test network, any proof with positive quality meets it.
+	regTestPocLimit = new(big.Int).Set(bigOne)
 )
 
 var (
@@ -23,6 +28,10 @@ var (
 	// is intended to identify the network for a hierarchical deterministic
 	// private extended key is not registered.
 	ErrUnknownHDKeyID = errors.New("unknown hd private extended key bytes")
+
+	// ErrUnknownChain describes an error where the provided chain tag does
+	// not match any registered network.
+	ErrUnknownChain = errors.New("unknown chain tag")
 )
 
 var (
@@ -30,6 +39,7 @@ var (
 	scriptHashAddrIDs    = make(map[byte]struct{})
 	bech32SegwitPrefixes = make(map[string]struct{})
 	hdPrivToPubKeyIDs    = make(map[[4]byte][]byte)
+	registeredNets       = make(map[string]*Params)
 )
 
 // Register registers the network parameters for a Skt network.  This may
@@ -42,6 +52,9 @@ var (
 // parameters based on inputs and work regardless of the network being standard
 // or not.
 func Register(params *Params) error {
+	// keep a copy, since ChainParams is replaced on selecting network
+	registered := *params
+	registeredNets[params.Name] = &registered
 	pubKeyHashAddrIDs[params.PubKeyHashAddrID] = struct{}{}
 	scriptHashAddrIDs[params.ScriptHashAddrID] = struct{}{}
 	hdPrivToPubKeyIDs[params.HDPrivateKeyID] = params.HDPublicKeyID[:]
@@ -79,6 +92,7 @@ type Params struct {
 	PocLimit               *big.Int
 	SubsidyHalvingInterval uint64
 	ResetMinDifficulty     bool
+	MinBitLength           int
 
 	// Checkpoints ordered from oldest to newest.
 	Checkpoints []Checkpoint
@@ -120,6 +134,7 @@ var ChainParams = Params{
 	PocLimit:               mainPocLimit,
 	SubsidyHalvingInterval: consensus.SubsidyHalvingInterval,
 	ResetMinDifficulty:     false,
+	MinBitLength:           poc.MinValidBitLength,
 
 	// Checkpoints ordered from oldest to newest.
 	Checkpoints: []Checkpoint{},
@@ -149,6 +164,80 @@ var ChainParams = Params{
 	CompatibleHDCoinTypes: []uint32{297},
 }
 
+// RegressionNetParams defines the network parameters for the regression test
+// skt network.  Not to be confused with the main network, it has its own
+// genesis block and address encodings, allows minimum difficulty and tiny
+// bitLength, so that blocks could be generated on demand.
+var RegressionNetParams = Params{
+	Name:        regTestChainTag,
+	DefaultPort: "43459",
+	DNSSeeds:    []string{},
+
+	// Chain parameters
+	GenesisBlock:           &regTestGenesisBlock,
+	PocLimit:               regTestPocLimit,
+	SubsidyHalvingInterval: consensus.SubsidyHalvingInterval,
+	ResetMinDifficulty:     true,
+	MinBitLength:           poc.RegTestBitLength,
+
+	// Checkpoints ordered from oldest to newest.
+	Checkpoints: []Checkpoint{},
+
+	// Mempool parameters
+	RelayNonStdTxs: true,
+
+	// Human-readable part for Bech32 encoded segwit addresses, as defined in
+	// BIP 173.
+	Bech32HRPSegwit: "skrt", // always skrt for reg test net
+
+	// Address encoding magics
+	PubKeyHashAddrID:        0x6f, // starts with m or n
+	ScriptHashAddrID:        0xc4, // starts with 2
+	PrivateKeyID:            0xef, // starts with 9 (uncompressed) or c (compressed)
+	WitnessPubKeyHashAddrID: 0x03, // starts with QW
+	WitnessScriptHashAddrID: 0x28, // starts with T7n
+
+	// BIP32 hierarchical deterministic extended key magics
+	HDPrivateKeyID: [4]byte{0x04, 0x35, 0x83, 0x94}, // starts with tprv
+	HDPublicKeyID:  [4]byte{0x04, 0x35, 0x87, 0xcf}, // starts with tpub
+
+	// BIP44 coin type used in the hierarchical deterministic path for
+	// address generation.
+	HDCoinType:            HDCoinTypeTestNet,
+	CompatibleHDCoinTypes: []uint32{},
+}
+
+// SelectChainParams replaces ChainParams with the registered network named by
+// chainTag, it must be called before any component reads ChainParams.
+func SelectChainParams(chainTag string) error {
+	params, ok := registeredNets[chainTag]
+	if !ok {
+		return ErrUnknownChain
+	}
+	if err := poc.SetMinBitLength(params.MinBitLength); err != nil {
+		return err
+	}
+	// ChainParams might have been updated since registered, e.g. by UpdateGenesisBlock
+	if ChainParams.Name != params.Name {
+		ChainParams = *params
+	}
+	ChainTag = params.Name
+	return nil
+}
+
+// fillGenesisIdentity calculates ChainID and GenesisHash from GenesisBlock.
+func fillGenesisIdentity(params *Params) {
+	header := &params.GenesisBlock.Header
+	chainID, err := header.GetChainID()
+	if err != nil {
+		panic(err) // should not happen
+	}
+	header.ChainID = chainID
+	hash := header.BlockHash()
+	params.ChainID = &chainID
+	params.GenesisHash = &hash
+}
+
 // IsPubKeyHashAddrID returns whether the id is an identifier known to prefix a
 // pay-to-pubkey-hash address on any default or registered network.  This is
 // used when decoding an wallet string into a specific wallet type.  It is up
@@ -221,4 +310,7 @@ func init() {
 	UpdateGenesisBlock(ChainParams.GenesisBlock)
 	// register chainParams
 	Register(&ChainParams)
+	// register regression test network
+	fillGenesisIdentity(&RegressionNetParams)
+	Register(&RegressionNetParams)
 }
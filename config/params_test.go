@@ -0,0 +1,59 @@
+package config_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Sukhavati-Labs/go-miner/chainutil"
+	"github.com/Sukhavati-Labs/go-miner/config"
+	"github.com/Sukhavati-Labs/go-miner/poc"
+)
+
+func TestRegressionNetParams(t *testing.T) {
+	main, regTest := &config.ChainParams, &config.RegressionNetParams
+	if regTest.GenesisHash.IsEqual(main.GenesisHash) || regTest.ChainID.IsEqual(main.ChainID) {
+		t.Fatal("regtest should have its own genesis and chain ID")
+	}
+	if regTest.Bech32HRPSegwit == main.Bech32HRPSegwit {
+		t.Fatal("regtest should have its own bech32 HRP")
+	}
+	if *regTest.ChainID != regTest.GenesisBlock.Header.ChainID || *regTest.GenesisHash != regTest.GenesisBlock.BlockHash() {
+		t.Fatal("regtest genesis identity mismatched")
+	}
+	if !config.IsBech32SegwitPrefix(regTest.Bech32HRPSegwit + "1") {
+		t.Fatal("regtest is not registered")
+	}
+
+	addr, err := chainutil.NewAddressWitnessScriptHash(make([]byte, 32), regTest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encoded := addr.EncodeAddress()
+	if !strings.HasPrefix(encoded, "skrt1") {
+		t.Fatalf("unexpected regtest address %s", encoded)
+	}
+
+	if err := config.SelectChainParams("unknown"); err != config.ErrUnknownChain {
+		t.Fatalf("expected %v, got %v", config.ErrUnknownChain, err)
+	}
+	if err := config.SelectChainParams(regTest.Name); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := config.SelectChainParams("mainnet"); err != nil {
+			t.Fatal(err)
+		}
+		if poc.EnsureBitLength(poc.RegTestBitLength) {
+			t.Fatal("tiny bitLength should be invalid on mainnet")
+		}
+	}()
+	if !config.ChainParams.GenesisHash.IsEqual(regTest.GenesisHash) || !config.ChainParams.ResetMinDifficulty {
+		t.Fatal("regtest is not selected")
+	}
+	if !poc.EnsureBitLength(poc.RegTestBitLength) {
+		t.Fatal("tiny bitLength should be valid on regtest")
+	}
+	if _, err = chainutil.NewAddressesFromStringList([]string{encoded}, &config.ChainParams); err != nil {
+		t.Fatalf("fail to decode regtest address, %v", err)
+	}
+}
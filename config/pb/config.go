@@ -8,15 +8,25 @@ func NewConfig() *Config {
 				AddPeer: make([]string, 0),
 			},
 			Rpc: &RPCConfig{
-				ApiWhitelist:  make([]string, 0),
-				ApiAllowedLan: make([]string, 0),
+				ApiWhitelist:    make([]string, 0),
+				ApiAllowedLan:   make([]string, 0),
+				ApiTlsHosts:     make([]string, 0),
+				ApiKeys:         make([]string, 0),
+				ApiCredentials:  make([]*RPCCredential, 0),
+				ApiAddressRoles: make([]*RPCAddressRoles, 0),
 			},
 		},
 		Db:  &DataConfig{},
 		Log: &LogConfig{},
 		Miner: &MinerConfig{
-			MiningAddr: make([]string, 0),
-			ProofDir:   make([]string, 0),
+			MiningAddr:           make([]string, 0),
+			ProofDir:             make([]string, 0),
+			Harvester:            make([]string, 0),
+			PoolTlsHosts:         make([]string, 0),
+			PoolCredentials:      make([]*PoolCredential, 0),
+			HarvesterTlsHosts:    make([]string, 0),
+			RemoteSignerTlsHosts: make([]string, 0),
 		},
+		Metrics: &MetricsConfig{},
 	}
 }
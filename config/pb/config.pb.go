@@ -26,6 +26,7 @@ type Config struct {
 	Db                   *DataConfig    `protobuf:"bytes,3,opt,name=db,proto3" json:"db,omitempty"`
 	Log                  *LogConfig     `protobuf:"bytes,4,opt,name=log,proto3" json:"log,omitempty"`
 	Miner                *MinerConfig   `protobuf:"bytes,5,opt,name=miner,proto3" json:"miner,omitempty"`
+	Metrics              *MetricsConfig `protobuf:"bytes,6,opt,name=metrics,proto3" json:"metrics,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -90,6 +91,13 @@ func (m *Config) GetMiner() *MinerConfig {
 	return nil
 }
 
+func (m *Config) GetMetrics() *MetricsConfig {
+	if m != nil {
+		return m.Metrics
+	}
+	return nil
+}
+
 type AppConfig struct {
 	Profile              string   `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
 	CpuProfile           string   `protobuf:"bytes,2,opt,name=cpu_profile,json=cpuProfile,proto3" json:"cpu_profile,omitempty"`
@@ -155,6 +163,7 @@ func (m *AppConfig) GetHostname() string {
 type NetworkConfig struct {
 	P2P                  *P2PConfig `protobuf:"bytes,1,opt,name=p2p,proto3" json:"p2p,omitempty"`
 	Rpc                  *RPCConfig `protobuf:"bytes,2,opt,name=rpc,proto3" json:"rpc,omitempty"`
+	ChainTag             string     `protobuf:"bytes,3,opt,name=chain_tag,json=chainTag,proto3" json:"chain_tag,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
 	XXX_unrecognized     []byte     `json:"-"`
 	XXX_sizecache        int32      `json:"-"`
@@ -198,6 +207,13 @@ func (m *NetworkConfig) GetRpc() *RPCConfig {
 	return nil
 }
 
+func (m *NetworkConfig) GetChainTag() string {
+	if m != nil {
+		return m.ChainTag
+	}
+	return ""
+}
+
 type DataConfig struct {
 	DataDir              string   `protobuf:"bytes,1,opt,name=data_dir,json=dataDir,proto3" json:"data_dir,omitempty"`
 	DbType               string   `protobuf:"bytes,2,opt,name=db_type,json=dbType,proto3" json:"db_type,omitempty"`
@@ -298,27 +314,93 @@ func (m *LogConfig) GetDisableCprint() bool {
 	return false
 }
 
-type MinerConfig struct {
-	PocminerBackend      string   `protobuf:"bytes,1,opt,name=pocminer_backend,json=pocminerBackend,proto3" json:"pocminer_backend,omitempty"`
-	SpacekeeperBackend   string   `protobuf:"bytes,2,opt,name=spacekeeper_backend,json=spacekeeperBackend,proto3" json:"spacekeeper_backend,omitempty"`
-	MinerDir             string   `protobuf:"bytes,3,opt,name=miner_dir,json=minerDir,proto3" json:"miner_dir,omitempty"`
-	MiningAddr           []string `protobuf:"bytes,4,rep,name=mining_addr,json=miningAddr,proto3" json:"mining_addr,omitempty"`
-	Generate             bool     `protobuf:"varint,5,opt,name=generate,proto3" json:"generate,omitempty"`
-	AllowSolo            bool     `protobuf:"varint,6,opt,name=allow_solo,json=allowSolo,proto3" json:"allow_solo,omitempty"`
-	ProofDir             []string `protobuf:"bytes,7,rep,name=proof_dir,json=proofDir,proto3" json:"proof_dir,omitempty"`
-	ProofList            string   `protobuf:"bytes,8,opt,name=proof_list,json=proofList,proto3" json:"proof_list,omitempty"`
-	Plot                 bool     `protobuf:"varint,9,opt,name=plot,proto3" json:"plot,omitempty"`
-	PrivatePassword      string   `protobuf:"bytes,10,opt,name=private_password,json=privatePassword,proto3" json:"private_password,omitempty"`
+type MetricsConfig struct {
+	Listen               string   `protobuf:"bytes,1,opt,name=listen,proto3" json:"listen,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
+func (m *MetricsConfig) Reset()         { *m = MetricsConfig{} }
+func (m *MetricsConfig) String() string { return proto.CompactTextString(m) }
+func (*MetricsConfig) ProtoMessage()    {}
+func (*MetricsConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{5}
+}
+func (m *MetricsConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MetricsConfig.Unmarshal(m, b)
+}
+func (m *MetricsConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MetricsConfig.Marshal(b, m, deterministic)
+}
+func (m *MetricsConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetricsConfig.Merge(m, src)
+}
+func (m *MetricsConfig) XXX_Size() int {
+	return xxx_messageInfo_MetricsConfig.Size(m)
+}
+func (m *MetricsConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetricsConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetricsConfig proto.InternalMessageInfo
+
+func (m *MetricsConfig) GetListen() string {
+	if m != nil {
+		return m.Listen
+	}
+	return ""
+}
+
+type MinerConfig struct {
+	PocminerBackend      string            `protobuf:"bytes,1,opt,name=pocminer_backend,json=pocminerBackend,proto3" json:"pocminer_backend,omitempty"`
+	SpacekeeperBackend   string            `protobuf:"bytes,2,opt,name=spacekeeper_backend,json=spacekeeperBackend,proto3" json:"spacekeeper_backend,omitempty"`
+	MinerDir             string            `protobuf:"bytes,3,opt,name=miner_dir,json=minerDir,proto3" json:"miner_dir,omitempty"`
+	MiningAddr           []string          `protobuf:"bytes,4,rep,name=mining_addr,json=miningAddr,proto3" json:"mining_addr,omitempty"`
+	Generate             bool              `protobuf:"varint,5,opt,name=generate,proto3" json:"generate,omitempty"`
+	AllowSolo            bool              `protobuf:"varint,6,opt,name=allow_solo,json=allowSolo,proto3" json:"allow_solo,omitempty"`
+	ProofDir             []string          `protobuf:"bytes,7,rep,name=proof_dir,json=proofDir,proto3" json:"proof_dir,omitempty"`
+	ProofList            string            `protobuf:"bytes,8,opt,name=proof_list,json=proofList,proto3" json:"proof_list,omitempty"`
+	Plot                 bool              `protobuf:"varint,9,opt,name=plot,proto3" json:"plot,omitempty"`
+	PrivatePassword      string            `protobuf:"bytes,10,opt,name=private_password,json=privatePassword,proto3" json:"private_password,omitempty"`
+	Harvester            []string          `protobuf:"bytes,11,rep,name=harvester,proto3" json:"harvester,omitempty"`
+	HarvesterToken       string            `protobuf:"bytes,12,opt,name=harvester_token,json=harvesterToken,proto3" json:"harvester_token,omitempty"`
+	HarvesterTimeout     uint32            `protobuf:"varint,13,opt,name=harvester_timeout,json=harvesterTimeout,proto3" json:"harvester_timeout,omitempty"`
+	PoolAddress          string            `protobuf:"bytes,14,opt,name=pool_address,json=poolAddress,proto3" json:"pool_address,omitempty"`
+	PoolListen           string            `protobuf:"bytes,15,opt,name=pool_listen,json=poolListen,proto3" json:"pool_listen,omitempty"`
+	PlotMaxConcurrent    uint32            `protobuf:"varint,16,opt,name=plot_max_concurrent,json=plotMaxConcurrent,proto3" json:"plot_max_concurrent,omitempty"`
+	PlotMemoryBudget     uint64            `protobuf:"varint,17,opt,name=plot_memory_budget,json=plotMemoryBudget,proto3" json:"plot_memory_budget,omitempty"`
+	PlotDiskWrites       uint32            `protobuf:"varint,18,opt,name=plot_disk_writes,json=plotDiskWrites,proto3" json:"plot_disk_writes,omitempty"`
+	ProofLatencyBudget   uint32            `protobuf:"varint,19,opt,name=proof_latency_budget,json=proofLatencyBudget,proto3" json:"proof_latency_budget,omitempty"`
+	DemoteSlowSpaces     bool              `protobuf:"varint,20,opt,name=demote_slow_spaces,json=demoteSlowSpaces,proto3" json:"demote_slow_spaces,omitempty"`
+	ProofReadMode        string            `protobuf:"bytes,21,opt,name=proof_read_mode,json=proofReadMode,proto3" json:"proof_read_mode,omitempty"`
+	ProofCachePages      uint32            `protobuf:"varint,22,opt,name=proof_cache_pages,json=proofCachePages,proto3" json:"proof_cache_pages,omitempty"`
+	RemoteSigner         string            `protobuf:"bytes,23,opt,name=remote_signer,json=remoteSigner,proto3" json:"remote_signer,omitempty"`
+	RemoteSignerToken    string            `protobuf:"bytes,24,opt,name=remote_signer_token,json=remoteSignerToken,proto3" json:"remote_signer_token,omitempty"`
+	SignLockDir          string            `protobuf:"bytes,25,opt,name=sign_lock_dir,json=signLockDir,proto3" json:"sign_lock_dir,omitempty"`
+	Shadow               bool              `protobuf:"varint,26,opt,name=shadow,proto3" json:"shadow,omitempty"`
+	PayoutStrategy       string            `protobuf:"bytes,27,opt,name=payout_strategy,json=payoutStrategy,proto3" json:"payout_strategy,omitempty"`
+	PoolToken            string            `protobuf:"bytes,28,opt,name=pool_token,json=poolToken,proto3" json:"pool_token,omitempty"`
+	PoolTlsCert          string            `protobuf:"bytes,29,opt,name=pool_tls_cert,json=poolTlsCert,proto3" json:"pool_tls_cert,omitempty"`
+	PoolTlsKey           string            `protobuf:"bytes,30,opt,name=pool_tls_key,json=poolTlsKey,proto3" json:"pool_tls_key,omitempty"`
+	PoolTlsHosts         []string          `protobuf:"bytes,31,rep,name=pool_tls_hosts,json=poolTlsHosts,proto3" json:"pool_tls_hosts,omitempty"`
+	PoolCredentials      []*PoolCredential `protobuf:"bytes,32,rep,name=pool_credentials,json=poolCredentials,proto3" json:"pool_credentials,omitempty"`
+	HarvesterTlsCert     string            `protobuf:"bytes,33,opt,name=harvester_tls_cert,json=harvesterTlsCert,proto3" json:"harvester_tls_cert,omitempty"`
+	HarvesterTlsKey      string            `protobuf:"bytes,34,opt,name=harvester_tls_key,json=harvesterTlsKey,proto3" json:"harvester_tls_key,omitempty"`
+	HarvesterTlsHosts    []string          `protobuf:"bytes,35,rep,name=harvester_tls_hosts,json=harvesterTlsHosts,proto3" json:"harvester_tls_hosts,omitempty"`
+	RemoteSignerTlsCert  string            `protobuf:"bytes,36,opt,name=remote_signer_tls_cert,json=remoteSignerTlsCert,proto3" json:"remote_signer_tls_cert,omitempty"`
+	RemoteSignerTlsKey   string            `protobuf:"bytes,37,opt,name=remote_signer_tls_key,json=remoteSignerTlsKey,proto3" json:"remote_signer_tls_key,omitempty"`
+	RemoteSignerTlsHosts []string          `protobuf:"bytes,38,rep,name=remote_signer_tls_hosts,json=remoteSignerTlsHosts,proto3" json:"remote_signer_tls_hosts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
 func (m *MinerConfig) Reset()         { *m = MinerConfig{} }
 func (m *MinerConfig) String() string { return proto.CompactTextString(m) }
 func (*MinerConfig) ProtoMessage()    {}
 func (*MinerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3eaf2c85e69e9ea4, []int{5}
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{6}
 }
 func (m *MinerConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MinerConfig.Unmarshal(m, b)
@@ -408,6 +490,248 @@ func (m *MinerConfig) GetPrivatePassword() string {
 	return ""
 }
 
+func (m *MinerConfig) GetHarvester() []string {
+	if m != nil {
+		return m.Harvester
+	}
+	return nil
+}
+
+func (m *MinerConfig) GetHarvesterToken() string {
+	if m != nil {
+		return m.HarvesterToken
+	}
+	return ""
+}
+
+func (m *MinerConfig) GetHarvesterTimeout() uint32 {
+	if m != nil {
+		return m.HarvesterTimeout
+	}
+	return 0
+}
+
+func (m *MinerConfig) GetPoolAddress() string {
+	if m != nil {
+		return m.PoolAddress
+	}
+	return ""
+}
+
+func (m *MinerConfig) GetPoolListen() string {
+	if m != nil {
+		return m.PoolListen
+	}
+	return ""
+}
+
+func (m *MinerConfig) GetPlotMaxConcurrent() uint32 {
+	if m != nil {
+		return m.PlotMaxConcurrent
+	}
+	return 0
+}
+
+func (m *MinerConfig) GetPlotMemoryBudget() uint64 {
+	if m != nil {
+		return m.PlotMemoryBudget
+	}
+	return 0
+}
+
+func (m *MinerConfig) GetPlotDiskWrites() uint32 {
+	if m != nil {
+		return m.PlotDiskWrites
+	}
+	return 0
+}
+
+func (m *MinerConfig) GetProofLatencyBudget() uint32 {
+	if m != nil {
+		return m.ProofLatencyBudget
+	}
+	return 0
+}
+
+func (m *MinerConfig) GetDemoteSlowSpaces() bool {
+	if m != nil {
+		return m.DemoteSlowSpaces
+	}
+	return false
+}
+
+func (m *MinerConfig) GetProofReadMode() string {
+	if m != nil {
+		return m.ProofReadMode
+	}
+	return ""
+}
+
+func (m *MinerConfig) GetProofCachePages() uint32 {
+	if m != nil {
+		return m.ProofCachePages
+	}
+	return 0
+}
+
+func (m *MinerConfig) GetRemoteSigner() string {
+	if m != nil {
+		return m.RemoteSigner
+	}
+	return ""
+}
+
+func (m *MinerConfig) GetRemoteSignerToken() string {
+	if m != nil {
+		return m.RemoteSignerToken
+	}
+	return ""
+}
+
+func (m *MinerConfig) GetSignLockDir() string {
+	if m != nil {
+		return m.SignLockDir
+	}
+	return ""
+}
+
+func (m *MinerConfig) GetShadow() bool {
+	if m != nil {
+		return m.Shadow
+	}
+	return false
+}
+
+func (m *MinerConfig) GetPayoutStrategy() string {
+	if m != nil {
+		return m.PayoutStrategy
+	}
+	return ""
+}
+
+func (m *MinerConfig) GetPoolToken() string {
+	if m != nil {
+		return m.PoolToken
+	}
+	return ""
+}
+
+func (m *MinerConfig) GetPoolTlsCert() string {
+	if m != nil {
+		return m.PoolTlsCert
+	}
+	return ""
+}
+
+func (m *MinerConfig) GetPoolTlsKey() string {
+	if m != nil {
+		return m.PoolTlsKey
+	}
+	return ""
+}
+
+func (m *MinerConfig) GetPoolTlsHosts() []string {
+	if m != nil {
+		return m.PoolTlsHosts
+	}
+	return nil
+}
+
+func (m *MinerConfig) GetPoolCredentials() []*PoolCredential {
+	if m != nil {
+		return m.PoolCredentials
+	}
+	return nil
+}
+
+func (m *MinerConfig) GetHarvesterTlsCert() string {
+	if m != nil {
+		return m.HarvesterTlsCert
+	}
+	return ""
+}
+
+func (m *MinerConfig) GetHarvesterTlsKey() string {
+	if m != nil {
+		return m.HarvesterTlsKey
+	}
+	return ""
+}
+
+func (m *MinerConfig) GetHarvesterTlsHosts() []string {
+	if m != nil {
+		return m.HarvesterTlsHosts
+	}
+	return nil
+}
+
+func (m *MinerConfig) GetRemoteSignerTlsCert() string {
+	if m != nil {
+		return m.RemoteSignerTlsCert
+	}
+	return ""
+}
+
+func (m *MinerConfig) GetRemoteSignerTlsKey() string {
+	if m != nil {
+		return m.RemoteSignerTlsKey
+	}
+	return ""
+}
+
+func (m *MinerConfig) GetRemoteSignerTlsHosts() []string {
+	if m != nil {
+		return m.RemoteSignerTlsHosts
+	}
+	return nil
+}
+
+type PoolCredential struct {
+	Account              string   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Token                string   `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PoolCredential) Reset()         { *m = PoolCredential{} }
+func (m *PoolCredential) String() string { return proto.CompactTextString(m) }
+func (*PoolCredential) ProtoMessage()    {}
+func (*PoolCredential) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{7}
+}
+func (m *PoolCredential) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PoolCredential.Unmarshal(m, b)
+}
+func (m *PoolCredential) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PoolCredential.Marshal(b, m, deterministic)
+}
+func (m *PoolCredential) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolCredential.Merge(m, src)
+}
+func (m *PoolCredential) XXX_Size() int {
+	return xxx_messageInfo_PoolCredential.Size(m)
+}
+func (m *PoolCredential) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolCredential.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolCredential proto.InternalMessageInfo
+
+func (m *PoolCredential) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *PoolCredential) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
 type P2PConfig struct {
 	Seeds                string   `protobuf:"bytes,1,opt,name=seeds,proto3" json:"seeds,omitempty"`
 	AddPeer              []string `protobuf:"bytes,2,rep,name=add_peer,json=addPeer,proto3" json:"add_peer,omitempty"`
@@ -425,7 +749,7 @@ func (m *P2PConfig) Reset()         { *m = P2PConfig{} }
 func (m *P2PConfig) String() string { return proto.CompactTextString(m) }
 func (*P2PConfig) ProtoMessage()    {}
 func (*P2PConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3eaf2c85e69e9ea4, []int{6}
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{8}
 }
 func (m *P2PConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_P2PConfig.Unmarshal(m, b)
@@ -495,20 +819,28 @@ func (m *P2PConfig) GetListenAddress() string {
 }
 
 type RPCConfig struct {
-	ApiPortGrpc          string   `protobuf:"bytes,1,opt,name=api_port_grpc,json=apiPortGrpc,proto3" json:"api_port_grpc,omitempty"`
-	ApiPortHttp          string   `protobuf:"bytes,2,opt,name=api_port_http,json=apiPortHttp,proto3" json:"api_port_http,omitempty"`
-	ApiWhitelist         []string `protobuf:"bytes,3,rep,name=api_whitelist,json=apiWhitelist,proto3" json:"api_whitelist,omitempty"`
-	ApiAllowedLan        []string `protobuf:"bytes,4,rep,name=api_allowed_lan,json=apiAllowedLan,proto3" json:"api_allowed_lan,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ApiPortGrpc          string             `protobuf:"bytes,1,opt,name=api_port_grpc,json=apiPortGrpc,proto3" json:"api_port_grpc,omitempty"`
+	ApiPortHttp          string             `protobuf:"bytes,2,opt,name=api_port_http,json=apiPortHttp,proto3" json:"api_port_http,omitempty"`
+	ApiWhitelist         []string           `protobuf:"bytes,3,rep,name=api_whitelist,json=apiWhitelist,proto3" json:"api_whitelist,omitempty"`
+	ApiAllowedLan        []string           `protobuf:"bytes,4,rep,name=api_allowed_lan,json=apiAllowedLan,proto3" json:"api_allowed_lan,omitempty"`
+	ApiHostGrpc          string             `protobuf:"bytes,5,opt,name=api_host_grpc,json=apiHostGrpc,proto3" json:"api_host_grpc,omitempty"`
+	ApiTls               bool               `protobuf:"varint,6,opt,name=api_tls,json=apiTls,proto3" json:"api_tls,omitempty"`
+	ApiTlsCert           string             `protobuf:"bytes,7,opt,name=api_tls_cert,json=apiTlsCert,proto3" json:"api_tls_cert,omitempty"`
+	ApiTlsKey            string             `protobuf:"bytes,8,opt,name=api_tls_key,json=apiTlsKey,proto3" json:"api_tls_key,omitempty"`
+	ApiTlsHosts          []string           `protobuf:"bytes,9,rep,name=api_tls_hosts,json=apiTlsHosts,proto3" json:"api_tls_hosts,omitempty"`
+	ApiKeys              []string           `protobuf:"bytes,10,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+	ApiCredentials       []*RPCCredential   `protobuf:"bytes,11,rep,name=api_credentials,json=apiCredentials,proto3" json:"api_credentials,omitempty"`
+	ApiAddressRoles      []*RPCAddressRoles `protobuf:"bytes,12,rep,name=api_address_roles,json=apiAddressRoles,proto3" json:"api_address_roles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *RPCConfig) Reset()         { *m = RPCConfig{} }
 func (m *RPCConfig) String() string { return proto.CompactTextString(m) }
 func (*RPCConfig) ProtoMessage()    {}
 func (*RPCConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3eaf2c85e69e9ea4, []int{7}
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{9}
 }
 func (m *RPCConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RPCConfig.Unmarshal(m, b)
@@ -556,66 +888,263 @@ func (m *RPCConfig) GetApiAllowedLan() []string {
 	return nil
 }
 
+func (m *RPCConfig) GetApiHostGrpc() string {
+	if m != nil {
+		return m.ApiHostGrpc
+	}
+	return ""
+}
+
+func (m *RPCConfig) GetApiTls() bool {
+	if m != nil {
+		return m.ApiTls
+	}
+	return false
+}
+
+func (m *RPCConfig) GetApiTlsCert() string {
+	if m != nil {
+		return m.ApiTlsCert
+	}
+	return ""
+}
+
+func (m *RPCConfig) GetApiTlsKey() string {
+	if m != nil {
+		return m.ApiTlsKey
+	}
+	return ""
+}
+
+func (m *RPCConfig) GetApiTlsHosts() []string {
+	if m != nil {
+		return m.ApiTlsHosts
+	}
+	return nil
+}
+
+func (m *RPCConfig) GetApiKeys() []string {
+	if m != nil {
+		return m.ApiKeys
+	}
+	return nil
+}
+
+func (m *RPCConfig) GetApiCredentials() []*RPCCredential {
+	if m != nil {
+		return m.ApiCredentials
+	}
+	return nil
+}
+
+func (m *RPCConfig) GetApiAddressRoles() []*RPCAddressRoles {
+	if m != nil {
+		return m.ApiAddressRoles
+	}
+	return nil
+}
+
+type RPCCredential struct {
+	ApiKey               string   `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Roles                []string `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RPCCredential) Reset()         { *m = RPCCredential{} }
+func (m *RPCCredential) String() string { return proto.CompactTextString(m) }
+func (*RPCCredential) ProtoMessage()    {}
+func (*RPCCredential) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{10}
+}
+func (m *RPCCredential) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RPCCredential.Unmarshal(m, b)
+}
+func (m *RPCCredential) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RPCCredential.Marshal(b, m, deterministic)
+}
+func (m *RPCCredential) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RPCCredential.Merge(m, src)
+}
+func (m *RPCCredential) XXX_Size() int {
+	return xxx_messageInfo_RPCCredential.Size(m)
+}
+func (m *RPCCredential) XXX_DiscardUnknown() {
+	xxx_messageInfo_RPCCredential.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RPCCredential proto.InternalMessageInfo
+
+func (m *RPCCredential) GetApiKey() string {
+	if m != nil {
+		return m.ApiKey
+	}
+	return ""
+}
+
+func (m *RPCCredential) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+type RPCAddressRoles struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Roles                []string `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RPCAddressRoles) Reset()         { *m = RPCAddressRoles{} }
+func (m *RPCAddressRoles) String() string { return proto.CompactTextString(m) }
+func (*RPCAddressRoles) ProtoMessage()    {}
+func (*RPCAddressRoles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{11}
+}
+func (m *RPCAddressRoles) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RPCAddressRoles.Unmarshal(m, b)
+}
+func (m *RPCAddressRoles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RPCAddressRoles.Marshal(b, m, deterministic)
+}
+func (m *RPCAddressRoles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RPCAddressRoles.Merge(m, src)
+}
+func (m *RPCAddressRoles) XXX_Size() int {
+	return xxx_messageInfo_RPCAddressRoles.Size(m)
+}
+func (m *RPCAddressRoles) XXX_DiscardUnknown() {
+	xxx_messageInfo_RPCAddressRoles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RPCAddressRoles proto.InternalMessageInfo
+
+func (m *RPCAddressRoles) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RPCAddressRoles) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Config)(nil), "configpb.Config")
 	proto.RegisterType((*AppConfig)(nil), "configpb.AppConfig")
 	proto.RegisterType((*NetworkConfig)(nil), "configpb.NetworkConfig")
 	proto.RegisterType((*DataConfig)(nil), "configpb.DataConfig")
 	proto.RegisterType((*LogConfig)(nil), "configpb.LogConfig")
+	proto.RegisterType((*MetricsConfig)(nil), "configpb.MetricsConfig")
 	proto.RegisterType((*MinerConfig)(nil), "configpb.MinerConfig")
+	proto.RegisterType((*PoolCredential)(nil), "configpb.PoolCredential")
 	proto.RegisterType((*P2PConfig)(nil), "configpb.P2PConfig")
 	proto.RegisterType((*RPCConfig)(nil), "configpb.RPCConfig")
+	proto.RegisterType((*RPCCredential)(nil), "configpb.RPCCredential")
+	proto.RegisterType((*RPCAddressRoles)(nil), "configpb.RPCAddressRoles")
 }
 
 func init() { proto.RegisterFile("config.proto", fileDescriptor_3eaf2c85e69e9ea4) }
 
 var fileDescriptor_3eaf2c85e69e9ea4 = []byte{
-	// 743 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x94, 0xe1, 0x8e, 0x1b, 0x35,
-	0x10, 0xc7, 0x95, 0xdc, 0x25, 0xd9, 0x9d, 0x5c, 0x68, 0x71, 0x8b, 0xba, 0x50, 0x9d, 0x5a, 0x16,
-	0x8a, 0x40, 0x95, 0x0e, 0x11, 0x5e, 0x80, 0x70, 0x95, 0xe0, 0x43, 0x8a, 0xa2, 0xa5, 0x88, 0x4f,
-	0x68, 0xe5, 0x5d, 0xfb, 0x36, 0x56, 0x9c, 0xf5, 0xc8, 0xeb, 0x5c, 0xd4, 0x07, 0xe0, 0x3d, 0x78,
-	0x30, 0x78, 0x06, 0x5e, 0x01, 0x79, 0xbc, 0xde, 0xe4, 0x50, 0xbf, 0x65, 0xfe, 0xf3, 0xb3, 0xc7,
-	0xf3, 0x9f, 0xd9, 0xc0, 0x55, 0x6d, 0xda, 0x3b, 0xd5, 0xdc, 0xa0, 0x35, 0xce, 0xb0, 0x24, 0x44,
-	0x58, 0xe5, 0x7f, 0x8f, 0x60, 0x7a, 0x4b, 0x01, 0x7b, 0x05, 0x17, 0x1c, 0x31, 0x1b, 0xbd, 0x1c,
-	0x7d, 0x3d, 0x5f, 0x3e, 0xb9, 0x89, 0xc8, 0xcd, 0x0a, 0x31, 0x10, 0x85, 0xcf, 0xb3, 0xef, 0x60,
-	0xd6, 0x4a, 0x77, 0x34, 0x76, 0x97, 0x8d, 0x09, 0x7d, 0x76, 0x42, 0x7f, 0x09, 0x89, 0x1e, 0x8f,
-	0x1c, 0xfb, 0x12, 0xc6, 0xa2, 0xca, 0x2e, 0x88, 0x7e, 0x7a, 0xa2, 0xdf, 0x70, 0xc7, 0x7b, 0x74,
-	0x2c, 0x2a, 0x5f, 0x5f, 0x9b, 0x26, 0xbb, 0xfc, 0x7f, 0xfd, 0xb5, 0x69, 0x62, 0x7d, 0x6d, 0x1a,
-	0xf6, 0x1a, 0x26, 0x7b, 0xd5, 0x4a, 0x9b, 0x4d, 0x08, 0xfc, 0xe4, 0x04, 0xbe, 0xf5, 0x72, 0x8f,
-	0x06, 0x26, 0xff, 0x73, 0x04, 0xe9, 0xf0, 0x7e, 0x96, 0xc1, 0x0c, 0xad, 0xb9, 0x53, 0x5a, 0x52,
-	0x97, 0x69, 0x11, 0x43, 0xf6, 0x02, 0xe6, 0x35, 0x1e, 0xca, 0x98, 0x1d, 0x53, 0x16, 0x6a, 0x3c,
-	0x6c, 0x7a, 0xe0, 0x73, 0xb8, 0xc2, 0x43, 0x55, 0x22, 0xef, 0xba, 0xa3, 0xb1, 0x82, 0x9a, 0x49,
-	0x8b, 0x39, 0x1e, 0xaa, 0x4d, 0x2f, 0xb1, 0xcf, 0x20, 0xd9, 0x9a, 0xce, 0xb5, 0x7c, 0x2f, 0xa9,
-	0x89, 0xb4, 0x18, 0xe2, 0xfc, 0x0f, 0x58, 0x3c, 0xf0, 0xc6, 0x37, 0x8b, 0xcb, 0x0f, 0x98, 0xbd,
-	0x59, 0x6e, 0x62, 0xb3, 0xb8, 0x44, 0x8f, 0x59, 0xac, 0x7b, 0xa3, 0xcf, 0xb0, 0x62, 0x73, 0x1b,
-	0x31, 0x8b, 0x75, 0xfe, 0x03, 0xc0, 0xc9, 0x4c, 0xf6, 0x29, 0x24, 0x82, 0x3b, 0x5e, 0x0a, 0x65,
-	0x63, 0x9f, 0x3e, 0x7e, 0xa3, 0x2c, 0x7b, 0x06, 0x33, 0x51, 0x95, 0xee, 0x3d, 0xc6, 0x1e, 0xa7,
-	0xa2, 0x7a, 0xf7, 0x1e, 0x65, 0xbe, 0x85, 0x74, 0xf0, 0xd9, 0x53, 0xda, 0x34, 0x67, 0xe7, 0xa7,
-	0xda, 0x34, 0xfe, 0xf8, 0x73, 0x48, 0x7d, 0x42, 0xcb, 0x7b, 0xa9, 0xfb, 0x0b, 0x12, 0x6d, 0x9a,
-	0xb5, 0x8f, 0xd9, 0x2b, 0xf8, 0x48, 0xa8, 0x8e, 0x57, 0x5a, 0x96, 0x35, 0x5a, 0xd5, 0x3a, 0x32,
-	0x29, 0x29, 0x16, 0xbd, 0x7a, 0x4b, 0x62, 0xfe, 0xcf, 0x18, 0xe6, 0x67, 0x93, 0x62, 0xdf, 0xc0,
-	0x63, 0x34, 0x35, 0x8d, 0xab, 0xac, 0x78, 0xbd, 0x93, 0xad, 0xe8, 0xab, 0x3e, 0x8a, 0xfa, 0x8f,
-	0x41, 0x66, 0xdf, 0xc2, 0x93, 0x0e, 0x79, 0x2d, 0x77, 0x52, 0xe2, 0x19, 0x1d, 0x1e, 0xc2, 0xce,
-	0x52, 0xf1, 0xc0, 0x73, 0x48, 0xc3, 0xc5, 0xbe, 0x95, 0x30, 0xb2, 0x84, 0x04, 0xdf, 0xcc, 0x0b,
-	0x98, 0xef, 0x55, 0xab, 0xda, 0xa6, 0xe4, 0x42, 0xd8, 0xec, 0xf2, 0xe5, 0x85, 0x9f, 0x79, 0x90,
-	0x56, 0x42, 0x58, 0x3f, 0xd0, 0x46, 0xb6, 0xd2, 0x72, 0x27, 0x69, 0xd9, 0x92, 0x62, 0x88, 0xd9,
-	0x35, 0x00, 0xd7, 0xda, 0x1c, 0xcb, 0xce, 0x68, 0x93, 0x4d, 0x29, 0x9b, 0x92, 0xf2, 0xab, 0xd1,
-	0xc6, 0x17, 0x46, 0x6b, 0xcc, 0x1d, 0x15, 0x9e, 0xd1, 0xcd, 0x09, 0x09, 0xbe, 0xf0, 0x35, 0x40,
-	0x48, 0x6a, 0xd5, 0xb9, 0x2c, 0xa1, 0x67, 0x05, 0x7c, 0xad, 0x3a, 0xc7, 0x18, 0x5c, 0xa2, 0x36,
-	0x2e, 0x4b, 0xe9, 0x52, 0xfa, 0x4d, 0x26, 0x59, 0x75, 0xcf, 0x9d, 0x3c, 0xad, 0x20, 0xf4, 0x26,
-	0x05, 0x3d, 0xae, 0x61, 0xfe, 0xef, 0x08, 0xd2, 0x61, 0x8b, 0xd8, 0x53, 0x98, 0x74, 0x52, 0x8a,
-	0xae, 0xb7, 0x34, 0x04, 0x7e, 0x43, 0xb8, 0x10, 0x25, 0x4a, 0x69, 0xb3, 0x31, 0xbd, 0x6e, 0xc6,
-	0x85, 0xd8, 0x48, 0x49, 0x23, 0xee, 0x76, 0x0a, 0xcb, 0x03, 0xb6, 0xd8, 0x0f, 0x30, 0xf1, 0xc2,
-	0x6f, 0xd8, 0x22, 0x7b, 0x0d, 0x1f, 0x6f, 0x79, 0x2b, 0xba, 0x2d, 0xdf, 0xc9, 0xd2, 0xa9, 0xbd,
-	0x34, 0x07, 0x47, 0xbb, 0xbe, 0x28, 0x1e, 0x0f, 0x89, 0x77, 0x41, 0xf7, 0x9f, 0x8c, 0x50, 0x5c,
-	0x0f, 0xdc, 0x84, 0xb8, 0xb9, 0xd7, 0x22, 0x72, 0x0d, 0x70, 0xcf, 0x0f, 0xda, 0x95, 0x7b, 0x23,
-	0x64, 0x74, 0x91, 0x94, 0xb7, 0x46, 0x48, 0xbf, 0x51, 0xde, 0x22, 0xd9, 0xd2, 0x84, 0x64, 0xd7,
-	0x65, 0x33, 0xea, 0x62, 0x11, 0xd4, 0x55, 0x10, 0xf3, 0xbf, 0x46, 0x90, 0x0e, 0x1f, 0x04, 0xcb,
-	0x61, 0xc1, 0x51, 0x95, 0x68, 0xac, 0x2b, 0x1b, 0xff, 0xf1, 0x84, 0xce, 0xe7, 0x1c, 0xd5, 0xc6,
-	0x58, 0xf7, 0x93, 0xc5, 0xfa, 0x01, 0xb3, 0x75, 0x0e, 0xfb, 0x15, 0x8a, 0xcc, 0xcf, 0xce, 0x21,
-	0xfb, 0x22, 0x30, 0xc7, 0xad, 0x72, 0x92, 0x06, 0x75, 0x41, 0x46, 0x5d, 0x71, 0x54, 0xbf, 0x47,
-	0x8d, 0x7d, 0x05, 0x8f, 0x3c, 0x44, 0x83, 0x97, 0xa2, 0xd4, 0xbc, 0xed, 0xf7, 0xc8, 0x9f, 0x5d,
-	0x05, 0x75, 0xcd, 0xdb, 0x6a, 0x4a, 0xff, 0xbb, 0xdf, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0x90,
-	0x19, 0x5c, 0x83, 0x87, 0x05, 0x00, 0x00,
+	// 1469 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x57, 0xdf, 0x73, 0x13, 0x39,
+	0x12, 0xae, 0x38, 0x89, 0x63, 0xb7, 0x7f, 0x24, 0x51, 0x42, 0x22, 0x08, 0x01, 0x63, 0x08, 0xe4,
+	0x80, 0xca, 0x1d, 0xa1, 0xee, 0xf5, 0x8a, 0x60, 0xae, 0x8e, 0x2a, 0x92, 0x2b, 0x97, 0x93, 0x2d,
+	0x1e, 0xa7, 0xe4, 0x19, 0x31, 0x9e, 0xf2, 0x78, 0xa4, 0x1a, 0xc9, 0x09, 0xde, 0xe7, 0xdd, 0xe7,
+	0xfd, 0x33, 0xf7, 0x71, 0xff, 0x85, 0xad, 0x6e, 0x69, 0xc6, 0xe3, 0xc0, 0x9b, 0xfb, 0xeb, 0x4f,
+	0xea, 0x56, 0xf7, 0xd7, 0xd2, 0x18, 0xda, 0xa1, 0xca, 0xbe, 0x25, 0xf1, 0x99, 0xce, 0x95, 0x55,
+	0xac, 0xe1, 0x2c, 0x3d, 0xee, 0xff, 0x51, 0x83, 0xfa, 0x80, 0x0c, 0x76, 0x02, 0xeb, 0x42, 0x6b,
+	0xbe, 0xd6, 0x5b, 0x3b, 0x6d, 0x9d, 0xef, 0x9d, 0x15, 0x94, 0xb3, 0x0b, 0xad, 0x1d, 0x63, 0x84,
+	0x7e, 0xf6, 0x0e, 0xb6, 0x32, 0x69, 0xef, 0x54, 0x3e, 0xe5, 0x35, 0xa2, 0x1e, 0x2e, 0xa9, 0xff,
+	0x77, 0x0e, 0x4f, 0x2f, 0x78, 0xec, 0x05, 0xd4, 0xa2, 0x31, 0x5f, 0x27, 0xf6, 0xfe, 0x92, 0xfd,
+	0x49, 0x58, 0xe1, 0xa9, 0xb5, 0x68, 0x8c, 0xf1, 0x53, 0x15, 0xf3, 0x8d, 0xfb, 0xf1, 0x2f, 0x55,
+	0x5c, 0xc4, 0x4f, 0x55, 0xcc, 0xde, 0xc0, 0xe6, 0x2c, 0xc9, 0x64, 0xce, 0x37, 0x89, 0xf8, 0x60,
+	0x49, 0xbc, 0x42, 0xd8, 0x53, 0x1d, 0x07, 0x93, 0x9d, 0x49, 0x9b, 0x27, 0xa1, 0xe1, 0xf5, 0xfb,
+	0xc9, 0x5e, 0x39, 0x47, 0x91, 0xac, 0xe7, 0xf5, 0x7f, 0x5f, 0x83, 0x66, 0x79, 0x64, 0xc6, 0x61,
+	0x4b, 0xe7, 0xea, 0x5b, 0x92, 0x4a, 0x2a, 0x4c, 0x73, 0x54, 0x98, 0xec, 0x29, 0xb4, 0x42, 0x3d,
+	0x0f, 0x0a, 0x6f, 0x8d, 0xbc, 0x10, 0xea, 0xf9, 0xd0, 0x13, 0x9e, 0x41, 0x5b, 0xcf, 0xc7, 0x81,
+	0x16, 0xc6, 0xdc, 0xa9, 0x3c, 0xa2, 0xf3, 0x37, 0x47, 0x2d, 0x3d, 0x1f, 0x0f, 0x3d, 0xc4, 0x1e,
+	0x41, 0x63, 0xa2, 0x8c, 0xcd, 0xc4, 0x4c, 0xd2, 0xb9, 0x9b, 0xa3, 0xd2, 0xee, 0xff, 0x0a, 0x9d,
+	0x95, 0x72, 0x62, 0x7d, 0xf4, 0xf9, 0x4f, 0xfa, 0x33, 0x3c, 0x1f, 0x16, 0xf5, 0xd1, 0xe7, 0x1a,
+	0x69, 0xb9, 0x0e, 0x79, 0xed, 0x3e, 0x6d, 0x34, 0x1c, 0x14, 0xb4, 0x5c, 0x87, 0xec, 0x08, 0x9a,
+	0xe1, 0x44, 0x24, 0x59, 0x60, 0x45, 0xec, 0x53, 0x6b, 0x10, 0x70, 0x23, 0xe2, 0xfe, 0x07, 0x80,
+	0x65, 0x73, 0xd8, 0x43, 0x68, 0x44, 0xc2, 0x8a, 0x20, 0x4a, 0xf2, 0xa2, 0x08, 0x68, 0x7f, 0x4a,
+	0x72, 0x76, 0x08, 0x5b, 0xd1, 0x38, 0xb0, 0x0b, 0x5d, 0x14, 0xa0, 0x1e, 0x8d, 0x6f, 0x16, 0x5a,
+	0xf6, 0x27, 0xd0, 0x2c, 0xfb, 0x86, 0xac, 0x54, 0xc5, 0x95, 0xf5, 0xf5, 0x54, 0xc5, 0xb8, 0xfc,
+	0x08, 0x9a, 0xe8, 0x48, 0xe5, 0xad, 0x4c, 0xfd, 0x06, 0x8d, 0x54, 0xc5, 0x97, 0x68, 0xb3, 0x13,
+	0xe8, 0x46, 0x89, 0x11, 0xe3, 0x54, 0x06, 0xa1, 0xce, 0x93, 0xcc, 0x52, 0x9a, 0x8d, 0x51, 0xc7,
+	0xa3, 0x03, 0x02, 0xfb, 0xaf, 0xa0, 0xb3, 0xd2, 0x49, 0x76, 0x00, 0xf5, 0x34, 0x31, 0x56, 0x66,
+	0x65, 0x30, 0xb2, 0xfa, 0xbf, 0xb5, 0xa1, 0x55, 0x91, 0x08, 0xfb, 0x07, 0xec, 0x68, 0x15, 0x92,
+	0x4e, 0x82, 0xb1, 0x08, 0xa7, 0x32, 0x8b, 0xfc, 0x8a, 0xed, 0x02, 0xff, 0xe8, 0x60, 0xf6, 0x4f,
+	0xd8, 0x33, 0x5a, 0x84, 0x72, 0x2a, 0xa5, 0xae, 0xb0, 0x5d, 0xc6, 0xac, 0xe2, 0x2a, 0x16, 0x1c,
+	0x41, 0xd3, 0x6d, 0x8c, 0x67, 0xf6, 0xd5, 0x25, 0x00, 0x4f, 0xfd, 0x14, 0x5a, 0xb3, 0x24, 0x4b,
+	0xb2, 0x38, 0x10, 0x51, 0x94, 0xf3, 0x8d, 0xde, 0x3a, 0x2a, 0xc7, 0x41, 0x17, 0x51, 0x94, 0xa3,
+	0x2c, 0x62, 0x99, 0xc9, 0x5c, 0x58, 0x49, 0x2a, 0x6f, 0x8c, 0x4a, 0x9b, 0x1d, 0x03, 0x88, 0x34,
+	0x55, 0x77, 0x81, 0x51, 0xa9, 0x22, 0x51, 0x37, 0x46, 0x4d, 0x42, 0xae, 0x55, 0xaa, 0x30, 0xb0,
+	0xce, 0x95, 0xfa, 0x46, 0x81, 0xb7, 0x68, 0xe7, 0x06, 0x01, 0x18, 0xf8, 0x18, 0xc0, 0x39, 0xb1,
+	0x22, 0xbc, 0x41, 0x69, 0x39, 0xfa, 0x65, 0x62, 0x2c, 0x63, 0xb0, 0xa1, 0x53, 0x65, 0x79, 0x93,
+	0x36, 0xa5, 0xdf, 0x54, 0xa4, 0x3c, 0xb9, 0x15, 0x56, 0x2e, 0x85, 0x0c, 0xbe, 0x48, 0x0e, 0x2f,
+	0xc5, 0xfc, 0x18, 0x9a, 0x13, 0x91, 0xdf, 0x4a, 0x63, 0x65, 0xce, 0x5b, 0x14, 0x7a, 0x09, 0xb0,
+	0x57, 0xb0, 0x5d, 0x1a, 0x81, 0x55, 0x53, 0x99, 0xf1, 0x36, 0xed, 0xd3, 0x2d, 0xe1, 0x1b, 0x44,
+	0xd9, 0x1b, 0xd8, 0xad, 0x10, 0x93, 0x99, 0x54, 0x73, 0xcb, 0x3b, 0xbd, 0xb5, 0xd3, 0xce, 0x68,
+	0x67, 0x49, 0x75, 0x38, 0xcd, 0x98, 0x52, 0x29, 0x15, 0x52, 0x1a, 0xc3, 0xbb, 0x7e, 0xc6, 0x94,
+	0x4a, 0x2f, 0x1c, 0x84, 0xd5, 0x26, 0x8a, 0xd7, 0xc4, 0xb6, 0x9b, 0x53, 0x84, 0x2e, 0x09, 0x61,
+	0x67, 0xb0, 0x87, 0x47, 0x0d, 0x66, 0xe2, 0x7b, 0x10, 0xaa, 0x2c, 0x9c, 0xe7, 0xb9, 0xcc, 0x2c,
+	0xdf, 0xa1, 0x90, 0xbb, 0xe8, 0xba, 0x12, 0xdf, 0x07, 0xa5, 0x83, 0xbd, 0x05, 0xe6, 0xf8, 0x72,
+	0xa6, 0xf2, 0x45, 0x30, 0x9e, 0x47, 0xb1, 0xb4, 0x7c, 0xb7, 0xb7, 0x76, 0xba, 0x31, 0xda, 0x21,
+	0x3a, 0x39, 0x3e, 0x12, 0xce, 0x4e, 0x81, 0xb0, 0x20, 0x4a, 0xcc, 0x34, 0xb8, 0xcb, 0x13, 0x2b,
+	0x0d, 0x67, 0xb4, 0x75, 0x17, 0xf1, 0x4f, 0x89, 0x99, 0x7e, 0x25, 0x94, 0xfd, 0x0b, 0xf6, 0x7d,
+	0x77, 0x84, 0x95, 0x59, 0x58, 0xee, 0xbc, 0x47, 0x6c, 0xe6, 0xfa, 0xe4, 0x5c, 0x7e, 0xef, 0xb7,
+	0xc0, 0x22, 0x39, 0x53, 0x56, 0x06, 0x86, 0x14, 0x81, 0x3a, 0x34, 0x7c, 0x9f, 0xda, 0xb7, 0xe3,
+	0x3c, 0xd7, 0x28, 0x0c, 0xc2, 0xd9, 0x4b, 0xd8, 0x76, 0xfb, 0xe7, 0x52, 0x44, 0xc1, 0x4c, 0x45,
+	0x92, 0x3f, 0xa0, 0x62, 0x74, 0x08, 0x1e, 0x49, 0x11, 0x5d, 0xa9, 0x48, 0xb2, 0xd7, 0xb0, 0xeb,
+	0x78, 0xa1, 0x08, 0x27, 0xd8, 0xf6, 0x58, 0x1a, 0x7e, 0x40, 0x49, 0xb8, 0x0d, 0x06, 0x88, 0x0f,
+	0x11, 0x66, 0xcf, 0xa1, 0x93, 0xfb, 0x0c, 0x92, 0x18, 0x2f, 0xe5, 0x43, 0xda, 0xb1, 0xed, 0xc0,
+	0x6b, 0xc2, 0xb0, 0xc0, 0x2b, 0x24, 0xdf, 0x7e, 0x4e, 0xd4, 0xdd, 0x2a, 0xd5, 0x29, 0xa0, 0x0f,
+	0x1d, 0x24, 0x06, 0xa9, 0x0a, 0xa7, 0xa4, 0xe3, 0x87, 0xae, 0xab, 0x08, 0x5e, 0xaa, 0x70, 0x8a,
+	0x52, 0x3e, 0x80, 0xba, 0x99, 0x88, 0x48, 0xdd, 0xf1, 0x47, 0x74, 0x5c, 0x6f, 0xa1, 0xcc, 0xb4,
+	0x58, 0xa8, 0xb9, 0x0d, 0x8c, 0xc5, 0x79, 0x89, 0x17, 0xfc, 0xc8, 0xc9, 0xcc, 0xc1, 0xd7, 0x1e,
+	0xa5, 0x59, 0x40, 0x59, 0xb8, 0x5c, 0x1e, 0xfb, 0x59, 0x50, 0x2a, 0x2d, 0x73, 0x70, 0xee, 0xd4,
+	0x04, 0xa1, 0xcc, 0x2d, 0x3f, 0x5e, 0x2a, 0xeb, 0x26, 0x35, 0x03, 0x99, 0x5b, 0xd6, 0x83, 0x76,
+	0xc9, 0x99, 0xca, 0x05, 0x7f, 0xb2, 0x94, 0xd6, 0x4d, 0x6a, 0xbe, 0xc8, 0x05, 0x7b, 0x01, 0xdd,
+	0x92, 0x81, 0x17, 0xbb, 0xe1, 0x4f, 0x69, 0x2e, 0xda, 0x9e, 0xf3, 0x19, 0x31, 0x36, 0xc0, 0x8b,
+	0x48, 0xa5, 0x41, 0x98, 0xcb, 0x48, 0x66, 0x36, 0x11, 0xa9, 0xe1, 0xbd, 0xde, 0xfa, 0x69, 0xeb,
+	0x9c, 0x57, 0x6e, 0x79, 0xa5, 0xd2, 0x41, 0x49, 0xc0, 0x2b, 0xaa, 0x6a, 0x1b, 0xd4, 0x42, 0x65,
+	0x6c, 0x8a, 0xac, 0x9f, 0x51, 0x4a, 0x95, 0xb9, 0xf1, 0xa9, 0xbf, 0x5e, 0x19, 0x32, 0x9f, 0x7f,
+	0xdf, 0xcd, 0x75, 0x95, 0x8c, 0x87, 0x38, 0x83, 0xbd, 0x55, 0xae, 0x3b, 0xc9, 0x73, 0x3a, 0xc9,
+	0x6e, 0x95, 0xed, 0x8e, 0xf3, 0x1e, 0x0e, 0xee, 0xb5, 0xbb, 0xc8, 0xe6, 0x05, 0x05, 0xd8, 0x5b,
+	0xe9, 0xb8, 0x4f, 0xe8, 0x1d, 0x3c, 0xf8, 0x71, 0x11, 0x26, 0x75, 0x42, 0x6b, 0xd8, 0xbd, 0x35,
+	0x98, 0xd7, 0xbf, 0xe1, 0xf0, 0xc7, 0x25, 0x2e, 0xb7, 0x97, 0x94, 0xdb, 0xfe, 0xbd, 0x45, 0x94,
+	0x5e, 0xff, 0x03, 0x74, 0x57, 0x6b, 0x89, 0x6f, 0xbc, 0x08, 0x43, 0x35, 0xcf, 0x6c, 0xf1, 0xbc,
+	0x79, 0x93, 0xed, 0xc3, 0xa6, 0xd3, 0x87, 0xbb, 0xe9, 0x9d, 0xd1, 0xff, 0x6b, 0x0d, 0x9a, 0xe5,
+	0xa3, 0x8b, 0x1c, 0x23, 0x65, 0x64, 0xfc, 0x5a, 0x67, 0xe0, 0x9b, 0x29, 0xa2, 0x28, 0xd0, 0x52,
+	0xe6, 0xbc, 0x46, 0xd9, 0x6c, 0x89, 0x28, 0x1a, 0x4a, 0x49, 0x8f, 0x9e, 0x99, 0x26, 0x3a, 0x98,
+	0xeb, 0x4c, 0xfb, 0x27, 0xad, 0x81, 0xc0, 0x2f, 0x3a, 0xd3, 0xee, 0xf6, 0xcb, 0x22, 0x33, 0x11,
+	0x53, 0x59, 0xde, 0x7e, 0x1b, 0xc5, 0xed, 0xe7, 0x1d, 0x95, 0xdb, 0x2f, 0x4a, 0x44, 0x5a, 0xf2,
+	0x36, 0x89, 0xd7, 0x42, 0xac, 0xa0, 0x1c, 0x03, 0xdc, 0x8a, 0x79, 0x6a, 0xdd, 0xbc, 0xfb, 0xe7,
+	0x82, 0x10, 0x9a, 0xf5, 0x13, 0xe8, 0xba, 0x7b, 0xb1, 0xbc, 0x41, 0xb7, 0xdc, 0x95, 0xe0, 0x50,
+	0x7f, 0x87, 0xf6, 0xff, 0x5c, 0x87, 0x66, 0xf9, 0xfd, 0x80, 0xb3, 0x21, 0x74, 0x12, 0x68, 0x95,
+	0xdb, 0x20, 0xc6, 0x6f, 0x0d, 0x77, 0xf2, 0x96, 0xd0, 0xc9, 0x50, 0xe5, 0xf6, 0x7f, 0xf8, 0x79,
+	0x51, 0xe5, 0x4c, 0xac, 0xd5, 0xbc, 0xb6, 0xc2, 0xf9, 0x6c, 0xad, 0x66, 0xcf, 0x1d, 0xe7, 0x6e,
+	0x92, 0x58, 0x49, 0x2f, 0xd2, 0xba, 0x1b, 0x0e, 0xa1, 0x93, 0xaf, 0x05, 0x86, 0xb7, 0x16, 0x92,
+	0xe8, 0x85, 0x93, 0x51, 0x90, 0x8a, 0xcc, 0x3f, 0x98, 0xb8, 0xf6, 0xc2, 0xa1, 0x97, 0x22, 0x2b,
+	0x02, 0x62, 0xff, 0x5d, 0x52, 0x9b, 0x65, 0x40, 0xec, 0x3b, 0x25, 0x75, 0x08, 0x5b, 0xc8, 0xb1,
+	0xa9, 0xf1, 0x95, 0xa8, 0x0b, 0x9d, 0xdc, 0xa4, 0x06, 0x27, 0xd9, 0x3b, 0x9c, 0x50, 0x5d, 0x11,
+	0xc0, 0x79, 0x49, 0x9f, 0x4f, 0xa0, 0x55, 0x30, 0x50, 0x95, 0xfe, 0xed, 0x74, 0x04, 0x14, 0xa3,
+	0x0f, 0xbf, 0x94, 0x60, 0x93, 0x92, 0x6c, 0x39, 0x86, 0x1b, 0x0c, 0xd4, 0x84, 0x4e, 0x70, 0xbd,
+	0xe1, 0xe0, 0x35, 0xa1, 0x93, 0x2f, 0x72, 0x61, 0xd8, 0x07, 0x77, 0xca, 0xea, 0x0d, 0xd0, 0xea,
+	0xad, 0xaf, 0x7e, 0xaf, 0x62, 0x03, 0x4a, 0xff, 0xa8, 0x2b, 0x74, 0x52, 0x9d, 0xff, 0xff, 0xc2,
+	0x2e, 0xd5, 0xc9, 0x75, 0x2c, 0xc8, 0x55, 0x2a, 0x0d, 0x6f, 0xd3, 0x1e, 0x0f, 0x57, 0xf6, 0xf0,
+	0x3d, 0x1d, 0x21, 0x61, 0x84, 0x51, 0xab, 0x40, 0xff, 0x3f, 0xd0, 0x59, 0x89, 0x53, 0xd4, 0x0c,
+	0x0f, 0xed, 0x3f, 0xa7, 0x5c, 0xce, 0xa8, 0x7b, 0x17, 0xc4, 0xc9, 0xdb, 0x19, 0xfd, 0x0b, 0xd8,
+	0xbe, 0x17, 0x83, 0xc6, 0xcb, 0xd9, 0xe5, 0x78, 0x39, 0xf3, 0xe7, 0x5b, 0x8c, 0xeb, 0xf4, 0x1f,
+	0xe5, 0xfd, 0xdf, 0x03, 0x00, 0x3d, 0x5b, 0x79, 0x29, 0xb3, 0x0c, 0x00, 0x00,
 }
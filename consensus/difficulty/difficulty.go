@@ -15,8 +15,8 @@ const (
 )
 
 func CalcNextRequiredDifficulty(lastHeader *wire.BlockHeader, newBlockTime time.Time) (*big.Int, error) {
-	// Genesis block.
-	if lastHeader == nil {
+	// Genesis block, or networks always allowing the minimum difficulty.
+	if lastHeader == nil || config.ChainParams.ResetMinDifficulty {
 		return config.ChainParams.PocLimit, nil
 	}
 
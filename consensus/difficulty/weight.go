@@ -0,0 +1,105 @@
+package difficulty
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/Sukhavati-Labs/go-miner/poc"
+	"github.com/Sukhavati-Labs/go-miner/wire"
+)
+
+// maxAdjustedSlots is the slot gap from which the adjustment reaches its
+// bound -199, the required target stays the same for any later slot.
+const maxAdjustedSlots = 200 * toleranceSlot
+
+var ErrNotEnoughHeaders = errors.New("not enough headers to estimate network weight")
+
+// EstimateNetworkWeight estimates the total weight of proofs answering each
+// challenge on the network, from headers in ascending order of height.
+//
+// No proof of total weight W = sum(SIZE * BitLength) reaches target T in a slot
+// with probability 2^(-W/T). Every block fails the slots between its parent
+// and itself, and succeeds in its own slot, with the target of each slot
+// following CalcNextRequiredDifficulty. The network weight is the maximum
+// likelihood estimation of W over all these slots.
+//
+// It returns the estimated weight and the number of block intervals it is
+// estimated from.
+func EstimateNetworkWeight(headers []*wire.BlockHeader) (float64, int, error) {
+	if len(headers) < 2 {
+		return 0, 0, ErrNotEnoughHeaders
+	}
+	// failed is sum(1/T) of failed slots, succeeded is 1/T of each block slot
+	var failed float64
+	var succeeded []float64
+	for i := 1; i < len(headers); i++ {
+		parent, header := headers[i-1], headers[i]
+		parentSlot := parent.Timestamp.Unix() / poc.PoCSlot
+		gap := header.Timestamp.Unix()/poc.PoCSlot - parentSlot
+		if gap <= 0 {
+			continue
+		}
+		var inverse float64
+		for s := int64(1); s <= gap && s <= maxAdjustedSlots; s++ {
+			target, err := CalcNextRequiredDifficulty(parent, time.Unix((parentSlot+s)*poc.PoCSlot, 0))
+			if err != nil {
+				return 0, 0, err
+			}
+			if s > 1 {
+				failed += inverse
+			}
+			inverse = 1 / bigToFloat(target)
+		}
+		if gap > maxAdjustedSlots {
+			failed += float64(gap-maxAdjustedSlots) * inverse
+		}
+		succeeded = append(succeeded, inverse)
+	}
+	if len(succeeded) == 0 {
+		return 0, 0, ErrNotEnoughHeaders
+	}
+	return maxLikelihoodWeight(failed, succeeded), len(succeeded), nil
+}
+
+// maxLikelihoodWeight solves the score function of W, which is decreasing:
+//
+//	sum(b / (2^(W*b) - 1)) = a
+//
+// where a is sum(1/T) of failed slots and b is 1/T of each succeeded slot.
+// The root lies in [n / (a + sum(b)), n / a] for n succeeded slots.
+func maxLikelihoodWeight(failed float64, succeeded []float64) float64 {
+	var total float64
+	for _, b := range succeeded {
+		total += b
+	}
+	n := float64(len(succeeded))
+	lo := n / (failed + total) / math.Ln2
+	if failed == 0 {
+		// every block is found at the first slot, W is unbounded
+		return lo
+	}
+	hi := n / failed / math.Ln2
+	for i := 0; i < 64 && hi-lo > lo*1e-9; i++ {
+		mid := (lo + hi) / 2
+		var score float64
+		for _, b := range succeeded {
+			score += b / math.Expm1(mid*b*math.Ln2)
+		}
+		if score > failed {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return (lo + hi) / 2
+}
+
+func bigToFloat(n *big.Int) float64 {
+	f, _ := new(big.Float).SetInt(n).Float64()
+	if f < 1 {
+		return 1
+	}
+	return f
+}
@@ -0,0 +1,63 @@
+package difficulty_test
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/Sukhavati-Labs/go-miner/consensus/difficulty"
+	"github.com/Sukhavati-Labs/go-miner/poc"
+	"github.com/Sukhavati-Labs/go-miner/wire"
+)
+
+// simulateHeaders mines blocks of a network with the given weight, slot by slot.
+func simulateHeaders(weight float64, target int64, blocks int) []*wire.BlockHeader {
+	rnd := rand.New(rand.NewSource(1))
+	headers := []*wire.BlockHeader{{
+		Timestamp: time.Unix(10000*poc.PoCSlot, 0),
+		Target:    big.NewInt(target),
+	}}
+	for len(headers) <= blocks {
+		parent := headers[len(headers)-1]
+		for slot := parent.Timestamp.Unix()/poc.PoCSlot + 1; ; slot++ {
+			timestamp := time.Unix(slot*poc.PoCSlot, 0)
+			next, _ := difficulty.CalcNextRequiredDifficulty(parent, timestamp)
+			f, _ := new(big.Float).SetInt(next).Float64()
+			if rnd.Float64() < 1-math.Exp2(-weight/f) {
+				headers = append(headers, &wire.BlockHeader{Timestamp: timestamp, Target: next})
+				break
+			}
+		}
+	}
+	return headers
+}
+
+func TestEstimateNetworkWeight(t *testing.T) {
+	if _, _, err := difficulty.EstimateNetworkWeight(nil); err != difficulty.ErrNotEnoughHeaders {
+		t.Fatalf("estimate without headers, got %v", err)
+	}
+
+	tests := []struct {
+		weight float64
+		target int64
+	}{
+		{1 << 30, 1 << 32},
+		{1 << 30, 1 << 36}, // targets keep decreasing
+		{1 << 36, 1 << 36}, // targets keep increasing
+	}
+	for i, test := range tests {
+		headers := simulateHeaders(test.weight, test.target, 2000)
+		weight, blocks, err := difficulty.EstimateNetworkWeight(headers)
+		if err != nil {
+			t.Fatal(i, err)
+		}
+		if blocks != 2000 {
+			t.Errorf("%d: estimated from %d blocks", i, blocks)
+		}
+		if ratio := weight / test.weight; ratio < 0.9 || ratio > 1.1 {
+			t.Errorf("%d: estimated weight %g, actual %g", i, weight, test.weight)
+		}
+	}
+}
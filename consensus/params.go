@@ -109,6 +109,15 @@ var (
 		38: 152,
 		40: 640,
 	}
+	// RegTestBindingRequiredSkt is the binding required for bitLengths below
+	// those of BindingRequiredSkt, only valid on networks allowing them by
+	// MinBitLength, i.e. regression test networks.
+	RegTestBindingRequiredSkt = map[int]float64{
+		16: 0.000016,
+		18: 0.000072,
+		20: 0.00032,
+		22: 0.001408,
+	}
 	// StakingFrozenPeriodWeight day --> weight * 10000
 	StakingFrozenPeriodWeight = map[uint64]uint64{
 		55:  10000,
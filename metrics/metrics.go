@@ -0,0 +1,66 @@
+// Package metrics serves metrics of node subsystems in Prometheus format.
+// Subsystems register their collectors to Registry, which is exported only
+// if a listen address is configured.
+package metrics
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/Sukhavati-Labs/go-miner/logging"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Namespace prefixes names of all metrics.
+const Namespace = "skt"
+
+const (
+	metricsPath     = "/metrics"
+	shutdownTimeout = 5 * time.Second
+)
+
+// Registry holds collectors of all subsystems, Go runtime and process metrics included.
+var Registry = prometheus.NewRegistry()
+
+// Server exports Registry over HTTP.
+type Server struct {
+	listen string
+	srv    *http.Server
+}
+
+func NewServer(listen string) *Server {
+	mux := http.NewServeMux()
+	mux.Handle(metricsPath, promhttp.HandlerFor(Registry, promhttp.HandlerOpts{}))
+	return &Server{
+		listen: listen,
+		srv:    &http.Server{Addr: listen, Handler: mux},
+	}
+}
+
+func (s *Server) Start() error {
+	listener, err := net.Listen("tcp", s.listen)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to start metrics listener", logging.LogFormat{"address": s.listen, "err": err})
+		return err
+	}
+	go func() {
+		if err := s.srv.Serve(listener); err != nil && err != http.ErrServerClosed {
+			logging.CPrint(logging.ERROR, "metrics server stopped", logging.LogFormat{"err": err})
+		}
+	}()
+	logging.CPrint(logging.INFO, "metrics server start", logging.LogFormat{"address": s.listen, "path": metricsPath})
+	return nil
+}
+
+func (s *Server) Stop() error {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	return s.srv.Shutdown(ctx)
+}
+
+func init() {
+	Registry.MustRegister(prometheus.NewGoCollector(), prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}))
+}
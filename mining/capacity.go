@@ -2,12 +2,16 @@ package mining
 
 import (
 	"reflect"
+	"time"
 
 	"github.com/Sukhavati-Labs/go-miner/logging"
 	"github.com/Sukhavati-Labs/go-miner/poc/engine"
 	_ "github.com/Sukhavati-Labs/go-miner/poc/engine/pocminer/miner"
+	_ "github.com/Sukhavati-Labs/go-miner/poc/engine/pocminer/pool"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/sktdb"
 	"github.com/Sukhavati-Labs/go-miner/poc/engine/spacekeeper"
 	"github.com/Sukhavati-Labs/go-miner/poc/engine/spacekeeper/capacity"
+	_ "github.com/Sukhavati-Labs/go-miner/poc/engine/spacekeeper/remote"
 )
 
 type SpaceKeeper interface {
@@ -19,6 +23,18 @@ type SpaceKeeper interface {
 	AvailableDiskSize() (uint64, error)
 	IsCapacityAvailable(path string, capacity uint64) error
 	WorkSpaceInfosByDirs() (dirs []string, results [][]engine.WorkSpaceInfo, err error)
+	VerifyWorkSpace(sid string, samples int) (*sktdb.VerifyReport, error)
+	PlotWorkSpace(sid string, threads int) error
+	PausePlotWorkSpace(sid string) error
+	ResumePlotWorkSpace(sid string) error
+	PlotQueue() ([]capacity.QueuedPlot, error)
+	MoveWorkSpace(sid, targetDir string) (capacity.SpaceMove, error)
+	RebalanceWorkSpaces(dirs []string) ([]capacity.SpaceMove, error)
+	WorkSpaceMoves() ([]capacity.SpaceMove, error)
+	PlanBySize(targetSize uint64, cointype uint32) (*capacity.CapacityPlan, error)
+	PlanByPath(paths []string, sizes []uint64, autoCreate bool, cointype uint32) (*capacity.CapacityPlan, error)
+	ApplyPlan(id string, execPlot, execMine bool) ([]engine.WorkSpaceInfo, error)
+	ProofLatencies() ([]capacity.SpaceLatency, []capacity.DirLatency, time.Duration, error)
 }
 
 type ConfigurableSpaceKeeper struct {
@@ -95,6 +111,133 @@ func (csk *ConfigurableSpaceKeeper) WorkSpaceInfosByDirs() (dirs []string, resul
 	return sk.WorkSpaceInfosByDirs()
 }
 
+func (csk *ConfigurableSpaceKeeper) VerifyWorkSpace(sid string, samples int) (*sktdb.VerifyReport, error) {
+	sk, err := getInstance(csk.SpaceKeeper)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to assert SpaceKeeper type", logging.LogFormat{"actual": reflect.TypeOf(sk)})
+		return nil, err
+	}
+	return sk.VerifyWS(sid, samples)
+}
+
+func (csk *ConfigurableSpaceKeeper) PlotWorkSpace(sid string, threads int) error {
+	sk, err := getInstance(csk.SpaceKeeper)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to assert SpaceKeeper type", logging.LogFormat{"actual": reflect.TypeOf(sk)})
+		return err
+	}
+	return sk.PlotWSWithThreads(sid, threads)
+}
+
+func (csk *ConfigurableSpaceKeeper) PausePlotWorkSpace(sid string) error {
+	sk, err := getInstance(csk.SpaceKeeper)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to assert SpaceKeeper type", logging.LogFormat{"actual": reflect.TypeOf(sk)})
+		return err
+	}
+	return sk.PausePlotWS(sid)
+}
+
+func (csk *ConfigurableSpaceKeeper) ResumePlotWorkSpace(sid string) error {
+	sk, err := getInstance(csk.SpaceKeeper)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to assert SpaceKeeper type", logging.LogFormat{"actual": reflect.TypeOf(sk)})
+		return err
+	}
+	return sk.ResumePlotWS(sid)
+}
+
+func (csk *ConfigurableSpaceKeeper) PlotQueue() ([]capacity.QueuedPlot, error) {
+	sk, err := getInstance(csk.SpaceKeeper)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to assert SpaceKeeper type", logging.LogFormat{"actual": reflect.TypeOf(sk)})
+		return nil, err
+	}
+	return sk.PlotQueue(), nil
+}
+
+func (csk *ConfigurableSpaceKeeper) MoveWorkSpace(sid, targetDir string) (capacity.SpaceMove, error) {
+	sk, err := getInstance(csk.SpaceKeeper)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to assert SpaceKeeper type", logging.LogFormat{"actual": reflect.TypeOf(sk)})
+		return capacity.SpaceMove{}, err
+	}
+	return sk.MoveWS(sid, targetDir)
+}
+
+func (csk *ConfigurableSpaceKeeper) RebalanceWorkSpaces(dirs []string) ([]capacity.SpaceMove, error) {
+	sk, err := getInstance(csk.SpaceKeeper)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to assert SpaceKeeper type", logging.LogFormat{"actual": reflect.TypeOf(sk)})
+		return nil, err
+	}
+	return sk.RebalanceWS(dirs)
+}
+
+func (csk *ConfigurableSpaceKeeper) WorkSpaceMoves() ([]capacity.SpaceMove, error) {
+	sk, err := getInstance(csk.SpaceKeeper)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to assert SpaceKeeper type", logging.LogFormat{"actual": reflect.TypeOf(sk)})
+		return nil, err
+	}
+	return sk.SpaceMoves(), nil
+}
+
+func (csk *ConfigurableSpaceKeeper) PlanBySize(targetSize uint64, cointype uint32) (*capacity.CapacityPlan, error) {
+	sk, err := getInstance(csk.SpaceKeeper)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to assert SpaceKeeper type", logging.LogFormat{"actual": reflect.TypeOf(sk)})
+		return nil, err
+	}
+	return sk.PlanBySize(targetSize, cointype)
+}
+
+func (csk *ConfigurableSpaceKeeper) PlanByPath(paths []string, sizes []uint64, autoCreate bool, cointype uint32) (*capacity.CapacityPlan, error) {
+	sk, err := getInstance(csk.SpaceKeeper)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to assert SpaceKeeper type", logging.LogFormat{"actual": reflect.TypeOf(sk)})
+		return nil, err
+	}
+	sizesInt := make([]int, len(sizes))
+	for i := range sizes {
+		sizesInt[i] = int(sizes[i])
+	}
+	return sk.PlanByPath(paths, sizesInt, autoCreate, cointype)
+}
+
+func (csk *ConfigurableSpaceKeeper) ApplyPlan(id string, execPlot, execMine bool) ([]engine.WorkSpaceInfo, error) {
+	sk, err := getInstance(csk.SpaceKeeper)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to assert SpaceKeeper type", logging.LogFormat{"actual": reflect.TypeOf(sk)})
+		return nil, err
+	}
+	return sk.ApplyPlan(id, execPlot, execMine)
+}
+
+func (csk *ConfigurableSpaceKeeper) ProofLatencies() ([]capacity.SpaceLatency, []capacity.DirLatency, time.Duration, error) {
+	sk, err := getInstance(csk.SpaceKeeper)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to assert SpaceKeeper type", logging.LogFormat{"actual": reflect.TypeOf(sk)})
+		return nil, nil, 0, err
+	}
+	spaces, dirs, budget := sk.ProofLatencies()
+	return spaces, dirs, budget, nil
+}
+
+// RegisterListener makes listener notified of workSpace changes in plot dirs,
+// it takes no effect if the underlying SpaceKeeper does not watch plot dirs.
+func (csk *ConfigurableSpaceKeeper) RegisterListener(listener spacekeeper.Listener) {
+	if notifier, ok := csk.SpaceKeeper.(spacekeeper.Notifier); ok {
+		notifier.RegisterListener(listener)
+	}
+}
+
+func (csk *ConfigurableSpaceKeeper) UnregisterListener(listener spacekeeper.Listener) {
+	if notifier, ok := csk.SpaceKeeper.(spacekeeper.Notifier); ok {
+		notifier.UnregisterListener(listener)
+	}
+}
+
 func getInstance(sk spacekeeper.SpaceKeeper) (*capacity.SpaceKeeper, error) {
 	ins, ok := sk.(*capacity.SpaceKeeper)
 	if !ok {
@@ -0,0 +1,317 @@
+package mining
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Sukhavati-Labs/go-miner/blockchain"
+	"github.com/Sukhavati-Labs/go-miner/chainutil"
+	"github.com/Sukhavati-Labs/go-miner/config"
+	"github.com/Sukhavati-Labs/go-miner/logging"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/pocminer"
+	"github.com/Sukhavati-Labs/go-miner/pocec"
+	"github.com/Sukhavati-Labs/go-miner/txscript"
+	"github.com/Sukhavati-Labs/go-miner/wire"
+)
+
+// MinedBlocksFilename is the name of ledger file kept in chain data directory.
+const MinedBlocksFilename = "mined_blocks.json"
+
+// MinedBlock is a block mined by this node, with the split of its subsidy.
+type MinedBlock struct {
+	Height         uint64
+	Hash           wire.Hash
+	Time           time.Time // timestamp of the block
+	PublicKey      *pocec.PublicKey
+	BitLength      int
+	PayoutAddress  string
+	Reward         chainutil.Amount // miner output of coinbase, fees included
+	MinerSubsidy   chainutil.Amount
+	BindingSubsidy chainutil.Amount // part of MinerSubsidy earned by enough binding
+	StakingSubsidy chainutil.Amount // paid to staking pool
+	SenateSubsidy  chainutil.Amount
+	Orphaned       bool // disconnected from best chain by reorganization
+}
+
+// MainChain tells whether a block is in main chain, which is implemented by Blockchain.
+type MainChain interface {
+	InMainChain(hash wire.Hash) bool
+}
+
+// Ledger records blocks mined by this node. It listens to PoCMiner for
+// accepted blocks, and to Blockchain for blocks disconnected by reorganization.
+// Blocks are saved in a file replaced on each change.
+type Ledger struct {
+	mu     sync.RWMutex
+	path   string
+	chain  MainChain
+	blocks map[wire.Hash]*MinedBlock
+}
+
+// ledgerEntry is a block saved in ledger file.
+type ledgerEntry struct {
+	Height         uint64    `json:"height"`
+	Hash           string    `json:"hash"`
+	Time           time.Time `json:"time"`
+	PublicKey      string    `json:"public_key"`
+	BitLength      int       `json:"bit_length"`
+	PayoutAddress  string    `json:"payout_address"`
+	Reward         int64     `json:"reward"`
+	MinerSubsidy   int64     `json:"miner_subsidy"`
+	BindingSubsidy int64     `json:"binding_subsidy"`
+	StakingSubsidy int64     `json:"staking_subsidy"`
+	SenateSubsidy  int64     `json:"senate_subsidy"`
+	Orphaned       bool      `json:"orphaned"`
+}
+
+// NewLedger loads the ledger saved in path, blocks not in main chain are
+// marked orphaned in case reorganization happened while stopped.
+func NewLedger(path string, chain MainChain) (*Ledger, error) {
+	l := &Ledger{
+		path:   path,
+		chain:  chain,
+		blocks: make(map[wire.Hash]*MinedBlock),
+	}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return l, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var entries []*ledgerEntry
+	if err = json.Unmarshal(data, &entries); err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		block, err := entry.toBlock()
+		if err != nil {
+			return nil, err
+		}
+		l.blocks[block.Hash] = block
+	}
+
+	var changed bool
+	for hash, block := range l.blocks {
+		if orphaned := !l.chain.InMainChain(hash); orphaned != block.Orphaned {
+			block.Orphaned = orphaned
+			changed = true
+		}
+	}
+	if changed {
+		if err = l.save(); err != nil {
+			return nil, err
+		}
+	}
+	return l, nil
+}
+
+// Blocks returns blocks mined within [start, end) by pubKey in order of height,
+// zero start or end is unbounded, and nil pubKey matches all.
+func (l *Ledger) Blocks(start, end time.Time, pubKey *pocec.PublicKey) []*MinedBlock {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	result := make([]*MinedBlock, 0)
+	for _, block := range l.blocks {
+		if !start.IsZero() && block.Time.Before(start) {
+			continue
+		}
+		if !end.IsZero() && !block.Time.Before(end) {
+			continue
+		}
+		if pubKey != nil && !pubKey.IsEqual(block.PublicKey) {
+			continue
+		}
+		copied := *block
+		result = append(result, &copied)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Height != result[j].Height {
+			return result[i].Height < result[j].Height
+		}
+		return result[i].Time.Before(result[j].Time)
+	})
+	return result
+}
+
+func (l *Ledger) OnProofFound(*pocminer.ProofFound) {}
+
+// OnBlockSubmitted records accepted blocks. A block accepted to a side chain
+// is never connected, so it is recorded orphaned until connected by reorganization.
+func (l *Ledger) OnBlockSubmitted(event *pocminer.BlockSubmitted) {
+	if !event.Accepted {
+		return
+	}
+	block, err := newMinedBlock(event.Block.MsgBlock(), event.Reward)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to record mined block", logging.LogFormat{"height": event.Block.Height(), "err": err})
+		return
+	}
+	block.Orphaned = !l.chain.InMainChain(block.Hash)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.blocks[block.Hash]; ok {
+		return
+	}
+	l.blocks[block.Hash] = block
+	if err = l.save(); err != nil {
+		logging.CPrint(logging.ERROR, "fail to save mined blocks", logging.LogFormat{"path": l.path, "err": err})
+		return
+	}
+	logging.CPrint(logging.INFO, "mined block recorded", logging.LogFormat{"height": block.Height, "hash": block.Hash, "reward": block.Reward, "orphaned": block.Orphaned})
+}
+
+func (l *Ledger) OnBlockConnected(block *wire.MsgBlock) error {
+	return l.setOrphaned(block.BlockHash(), false)
+}
+
+func (l *Ledger) OnBlockDisconnected(block *wire.MsgBlock) error {
+	return l.setOrphaned(block.BlockHash(), true)
+}
+
+func (l *Ledger) OnTransactionReceived(tx *wire.MsgTx) error {
+	return nil
+}
+
+// setOrphaned updates the orphaned state of hash if it is mined by this node,
+// failures are logged without failing chain processing.
+func (l *Ledger) setOrphaned(hash wire.Hash, orphaned bool) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	block, ok := l.blocks[hash]
+	if !ok || block.Orphaned == orphaned {
+		return nil
+	}
+	block.Orphaned = orphaned
+	if err := l.save(); err != nil {
+		logging.CPrint(logging.ERROR, "fail to save mined blocks", logging.LogFormat{"path": l.path, "err": err})
+		return nil
+	}
+	logging.CPrint(logging.INFO, "mined block state changed", logging.LogFormat{"height": block.Height, "hash": hash, "orphaned": orphaned})
+	return nil
+}
+
+// save writes blocks durably by replacing the old file.
+func (l *Ledger) save() error {
+	entries := make([]*ledgerEntry, 0, len(l.blocks))
+	for _, block := range l.blocks {
+		entries = append(entries, newLedgerEntry(block))
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Height < entries[j].Height })
+	data, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	return chainutil.WriteFileAtomic(l.path, data, 0600)
+}
+
+// newMinedBlock splits the subsidy of block in the same way as block templates,
+// the coinbase spends binding of the public key only if binding is enough.
+func newMinedBlock(block *wire.MsgBlock, reward chainutil.Amount) (*MinedBlock, error) {
+	header := &block.Header
+	coinbase := block.Transactions[0]
+	bitLength := header.Proof.BitLength
+
+	minerTxOut, err := blockchain.GetMinerRewardTxOutFromCoinbase(coinbase)
+	if err != nil {
+		return nil, err
+	}
+	var payoutAddress string
+	if _, addrs, _, _, err := txscript.ExtractPkScriptAddrs(minerTxOut.PkScript, &config.ChainParams); err == nil && len(addrs) > 0 {
+		payoutAddress = addrs[0].EncodeAddress()
+	}
+
+	binding := chainutil.ZeroAmount()
+	if required, ok := blockchain.BindingRequiredAmount(bitLength); ok && len(coinbase.TxIn) > 1 {
+		binding = required
+	}
+	miner, staking, senate, err := blockchain.CalcBlockSubsidy(header.Height, &config.ChainParams, binding, bitLength)
+	if err != nil {
+		return nil, err
+	}
+	base, _, _, err := blockchain.CalcBlockSubsidy(header.Height, &config.ChainParams, chainutil.ZeroAmount(), bitLength)
+	if err != nil {
+		return nil, err
+	}
+	bindingSubsidy, err := miner.Sub(base)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MinedBlock{
+		Height:         header.Height,
+		Hash:           block.BlockHash(),
+		Time:           header.Timestamp,
+		PublicKey:      header.PubKey,
+		BitLength:      bitLength,
+		PayoutAddress:  payoutAddress,
+		Reward:         reward,
+		MinerSubsidy:   miner,
+		BindingSubsidy: bindingSubsidy,
+		StakingSubsidy: staking,
+		SenateSubsidy:  senate,
+	}, nil
+}
+
+func newLedgerEntry(block *MinedBlock) *ledgerEntry {
+	return &ledgerEntry{
+		Height:         block.Height,
+		Hash:           block.Hash.String(),
+		Time:           block.Time,
+		PublicKey:      hex.EncodeToString(block.PublicKey.SerializeCompressed()),
+		BitLength:      block.BitLength,
+		PayoutAddress:  block.PayoutAddress,
+		Reward:         block.Reward.IntValue(),
+		MinerSubsidy:   block.MinerSubsidy.IntValue(),
+		BindingSubsidy: block.BindingSubsidy.IntValue(),
+		StakingSubsidy: block.StakingSubsidy.IntValue(),
+		SenateSubsidy:  block.SenateSubsidy.IntValue(),
+		Orphaned:       block.Orphaned,
+	}
+}
+
+func (entry *ledgerEntry) toBlock() (*MinedBlock, error) {
+	hash, err := wire.NewHashFromStr(entry.Hash)
+	if err != nil {
+		return nil, err
+	}
+	pkBytes, err := hex.DecodeString(entry.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := pocec.ParsePubKey(pkBytes, pocec.S256())
+	if err != nil {
+		return nil, err
+	}
+	block := &MinedBlock{
+		Height:        entry.Height,
+		Hash:          *hash,
+		Time:          entry.Time,
+		PublicKey:     pubKey,
+		BitLength:     entry.BitLength,
+		PayoutAddress: entry.PayoutAddress,
+		Orphaned:      entry.Orphaned,
+	}
+	amounts := []struct {
+		value int64
+		dst   *chainutil.Amount
+	}{
+		{entry.Reward, &block.Reward},
+		{entry.MinerSubsidy, &block.MinerSubsidy},
+		{entry.BindingSubsidy, &block.BindingSubsidy},
+		{entry.StakingSubsidy, &block.StakingSubsidy},
+		{entry.SenateSubsidy, &block.SenateSubsidy},
+	}
+	for _, amount := range amounts {
+		if *amount.dst, err = chainutil.NewAmountFromInt(amount.value); err != nil {
+			return nil, err
+		}
+	}
+	return block, nil
+}
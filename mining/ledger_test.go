@@ -0,0 +1,142 @@
+package mining
+
+import (
+	"math"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Sukhavati-Labs/go-miner/blockchain"
+	"github.com/Sukhavati-Labs/go-miner/chainutil"
+	"github.com/Sukhavati-Labs/go-miner/config"
+	"github.com/Sukhavati-Labs/go-miner/poc"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/pocminer"
+	"github.com/Sukhavati-Labs/go-miner/pocec"
+	"github.com/Sukhavati-Labs/go-miner/txscript"
+	"github.com/Sukhavati-Labs/go-miner/wire"
+)
+
+type mockMainChain map[wire.Hash]bool
+
+func (c mockMainChain) InMainChain(hash wire.Hash) bool {
+	return c[hash]
+}
+
+func newTestMinedBlock(t *testing.T, pubKey *pocec.PublicKey, height uint64, bound bool) *chainutil.Block {
+	addr, err := chainutil.NewAddressWitnessScriptHash(make([]byte, 32), &config.ChainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	coinbase := wire.NewMsgTx()
+	coinbase.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&wire.Hash{}, math.MaxUint32), nil))
+	if bound {
+		coinbase.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&wire.Hash{1}, 0), nil))
+	}
+	coinbase.AddTxOut(&wire.TxOut{Value: 1e8, PkScript: pkScript})
+
+	return chainutil.NewBlock(&wire.MsgBlock{
+		Header: wire.BlockHeader{
+			Height:    height,
+			Timestamp: time.Unix(int64(height)*poc.PoCSlot, 0),
+			Target:    big.NewInt(1),
+			PubKey:    pubKey,
+			Proof:     &poc.Proof{BitLength: 32},
+			Signature: &pocec.Signature{R: new(big.Int), S: new(big.Int)},
+		},
+		Transactions: []*wire.MsgTx{coinbase},
+	})
+}
+
+func TestLedger(t *testing.T) {
+	privKey1, err := pocec.NewPrivateKey(pocec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	privKey2, err := pocec.NewPrivateKey(pocec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKey1, pubKey2 := privKey1.PubKey(), privKey2.PubKey()
+
+	reward, _ := chainutil.NewAmountFromInt(1e8)
+	blocks := []*chainutil.Block{
+		newTestMinedBlock(t, pubKey1, 10, false),
+		newTestMinedBlock(t, pubKey1, 11, true),
+		newTestMinedBlock(t, pubKey2, 12, true),
+	}
+	// block 12 is accepted to a side chain
+	path := filepath.Join(t.TempDir(), MinedBlocksFilename)
+	ledger, err := NewLedger(path, mockMainChain{*blocks[0].Hash(): true, *blocks[1].Hash(): true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, block := range blocks {
+		ledger.OnBlockSubmitted(&pocminer.BlockSubmitted{Block: block, Accepted: true, Reward: reward})
+	}
+	ledger.OnBlockSubmitted(&pocminer.BlockSubmitted{Block: newTestMinedBlock(t, pubKey2, 13, false), Reward: reward})
+
+	all := ledger.Blocks(time.Time{}, time.Time{}, nil)
+	if len(all) != 3 {
+		t.Fatalf("expect 3 accepted blocks recorded, got %d", len(all))
+	}
+	unbound, bound := all[0], all[1]
+	if unbound.Orphaned || bound.Orphaned || !all[2].Orphaned {
+		t.Errorf("unexpected orphaned state %v %v %v", unbound.Orphaned, bound.Orphaned, all[2].Orphaned)
+	}
+	if unbound.PayoutAddress == "" || unbound.BitLength != 32 || unbound.Reward.Cmp(reward) != 0 {
+		t.Errorf("unexpected mined block %+v", unbound)
+	}
+	if !unbound.BindingSubsidy.IsZero() || bound.BindingSubsidy.IsZero() {
+		t.Errorf("binding subsidy, expect only bound block earns, got %v and %v", unbound.BindingSubsidy, bound.BindingSubsidy)
+	}
+	if miner, _, _, err := blockchain.CalcBlockSubsidy(11, &config.ChainParams, chainutil.ZeroAmount(), 32); err != nil || bound.MinerSubsidy.Cmp(mustAdd(t, miner, bound.BindingSubsidy)) != 0 {
+		t.Errorf("miner subsidy of bound block %v, expect %v plus binding %v", bound.MinerSubsidy, miner, bound.BindingSubsidy)
+	}
+	if unbound.StakingSubsidy.Cmp(mustAdd(t, bound.StakingSubsidy, bound.BindingSubsidy)) != 0 {
+		t.Errorf("binding subsidy %v should be moved from staking subsidy %v to miner", bound.BindingSubsidy, unbound.StakingSubsidy)
+	}
+
+	if got := ledger.Blocks(time.Time{}, time.Time{}, pubKey2); len(got) != 1 || got[0].Height != 12 {
+		t.Errorf("expect block 12 mined by pubKey2, got %d blocks", len(got))
+	}
+	if got := ledger.Blocks(blocks[1].MsgBlock().Header.Timestamp, blocks[2].MsgBlock().Header.Timestamp, nil); len(got) != 1 || got[0].Height != 11 {
+		t.Errorf("expect block 11 within time range, got %d blocks", len(got))
+	}
+
+	// orphaned by reorganization, and connected back
+	ledger.OnBlockDisconnected(blocks[0].MsgBlock())
+	ledger.OnBlockDisconnected(blocks[1].MsgBlock())
+	ledger.OnBlockConnected(blocks[1].MsgBlock())
+	if all = ledger.Blocks(time.Time{}, time.Time{}, nil); !all[0].Orphaned || all[1].Orphaned || !all[2].Orphaned {
+		t.Errorf("unexpected orphaned state %v %v %v", all[0].Orphaned, all[1].Orphaned, all[2].Orphaned)
+	}
+
+	// reloaded blocks are reconciled with main chain
+	ledger, err = NewLedger(path, mockMainChain{*blocks[0].Hash(): true, *blocks[1].Hash(): true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	reloaded := ledger.Blocks(time.Time{}, time.Time{}, nil)
+	if len(reloaded) != 3 {
+		t.Fatalf("expect 3 blocks after reload, got %d", len(reloaded))
+	}
+	if reloaded[0].Orphaned || reloaded[1].Orphaned || !reloaded[2].Orphaned {
+		t.Errorf("unexpected orphaned state after reload %v %v %v", reloaded[0].Orphaned, reloaded[1].Orphaned, reloaded[2].Orphaned)
+	}
+	if !reloaded[1].PublicKey.IsEqual(pubKey1) || reloaded[1].StakingSubsidy.Cmp(bound.StakingSubsidy) != 0 || !reloaded[1].Time.Equal(bound.Time) {
+		t.Errorf("block changed after reload %+v", reloaded[1])
+	}
+}
+
+func mustAdd(t *testing.T, a, b chainutil.Amount) chainutil.Amount {
+	sum, err := a.Add(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sum
+}
@@ -0,0 +1,172 @@
+package mining
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/Sukhavati-Labs/go-miner/blockchain"
+	"github.com/Sukhavati-Labs/go-miner/chainutil"
+	"github.com/Sukhavati-Labs/go-miner/consensus/difficulty"
+	"github.com/Sukhavati-Labs/go-miner/poc"
+)
+
+const (
+	// ProofHitRatio is the fraction of challenges a plotted space has a proof for,
+	// measured to be about one third for all bit lengths.
+	ProofHitRatio = 0.33
+
+	// confidenceZ is the two-sided z-score of 95% confidence.
+	confidenceZ = 1.96
+)
+
+// RevenueSpace is a plotted space counted in revenue estimation.
+type RevenueSpace struct {
+	BitLength int
+	Mining    bool             // whether the space is mining and counted in network weight
+	Bound     bool             // whether the space has enough binding for full subsidy
+	Subsidy   chainutil.Amount // miner subsidy of each block
+}
+
+// RevenueGroup is the estimated revenue of spaces of the same bit length.
+type RevenueGroup struct {
+	BitLength    int
+	Count        int
+	Bound        int
+	Bytes        uint64
+	Share        float64 // share of blocks on the network
+	BlocksPerDay float64
+	SktPerDay    chainutil.Amount
+}
+
+// RevenueEstimate is the estimated revenue of spaces per day, the lower and
+// upper bounds make the 95% confidence interval of both the network weight
+// and the number of blocks mined in a day.
+type RevenueEstimate struct {
+	Network           *blockchain.NetworkWeight
+	NetworkBytes      float64
+	NetworkBytesLower float64
+	NetworkBytesUpper float64
+	Bytes             uint64
+	Share             float64
+	BlocksPerDay      float64
+	BlocksPerDayLower uint64
+	BlocksPerDayUpper uint64
+	SktPerDay         chainutil.Amount
+	SktPerDayLower    chainutil.Amount
+	SktPerDayUpper    chainutil.Amount
+	Groups            []RevenueGroup
+}
+
+// EstimateRevenue estimates blocks and miner subsidy per day earned by spaces
+// against network, fees are not counted.
+func EstimateRevenue(network *blockchain.NetworkWeight, spaces []RevenueSpace) (*RevenueEstimate, error) {
+	if network.Blocks == 0 || network.Interval <= 0 {
+		return nil, difficulty.ErrNotEnoughHeaders
+	}
+	spread := math.Exp(confidenceZ / math.Sqrt(float64(network.Blocks)))
+	networkBytes := network.Weight / ProofHitRatio / weightPerByte()
+	blocksPerDay := float64(24*time.Hour) / float64(network.Interval)
+
+	var ours, mining float64
+	for _, space := range spaces {
+		ours += spaceWeight(space.BitLength)
+		if space.Mining {
+			mining += spaceWeight(space.BitLength)
+		}
+	}
+	// share of ours in network of weight w, which includes mining spaces
+	share := func(w float64) float64 {
+		if ours == 0 {
+			return 0
+		}
+		return ours / (math.Max(w, mining) + ours - mining)
+	}
+
+	est := &RevenueEstimate{
+		Network:           network,
+		NetworkBytes:      networkBytes,
+		NetworkBytesLower: networkBytes / spread,
+		NetworkBytesUpper: networkBytes * spread,
+		Share:             share(network.Weight),
+		SktPerDay:         chainutil.ZeroAmount(),
+		SktPerDayLower:    chainutil.ZeroAmount(),
+		SktPerDayUpper:    chainutil.ZeroAmount(),
+	}
+	est.BlocksPerDay = est.Share * blocksPerDay
+
+	groups := make(map[int]*RevenueGroup)
+	for _, space := range spaces {
+		group, ok := groups[space.BitLength]
+		if !ok {
+			group = &RevenueGroup{BitLength: space.BitLength, SktPerDay: chainutil.ZeroAmount()}
+			groups[space.BitLength] = group
+		}
+		weight := spaceWeight(space.BitLength)
+		blocks := est.BlocksPerDay * weight / ours
+		skt, err := space.Subsidy.MulF64(blocks)
+		if err != nil {
+			return nil, err
+		}
+		if group.SktPerDay, err = group.SktPerDay.Add(skt); err != nil {
+			return nil, err
+		}
+		if est.SktPerDay, err = est.SktPerDay.Add(skt); err != nil {
+			return nil, err
+		}
+		group.Count++
+		if space.Bound {
+			group.Bound++
+		}
+		group.Bytes += uint64(poc.BitLengthDiskSize[space.BitLength])
+		group.Share += est.Share * weight / ours
+		group.BlocksPerDay += blocks
+		est.Bytes += uint64(poc.BitLengthDiskSize[space.BitLength])
+	}
+	for _, group := range groups {
+		est.Groups = append(est.Groups, *group)
+	}
+	sort.Slice(est.Groups, func(i, j int) bool { return est.Groups[i].BitLength < est.Groups[j].BitLength })
+
+	if est.BlocksPerDay == 0 {
+		return est, nil
+	}
+	est.BlocksPerDayLower = poissonQuantile(share(network.Weight*spread)*blocksPerDay, 0.025)
+	est.BlocksPerDayUpper = poissonQuantile(share(network.Weight/spread)*blocksPerDay, 0.975)
+	var err error
+	if est.SktPerDayLower, err = est.SktPerDay.MulF64(float64(est.BlocksPerDayLower) / est.BlocksPerDay); err != nil {
+		return nil, err
+	}
+	if est.SktPerDayUpper, err = est.SktPerDay.MulF64(float64(est.BlocksPerDayUpper) / est.BlocksPerDay); err != nil {
+		return nil, err
+	}
+	return est, nil
+}
+
+// spaceWeight returns SIZE * BitLength of proofs, counting only challenges
+// the space has proofs for.
+func spaceWeight(bitLength int) float64 {
+	return ProofHitRatio * math.Ldexp(float64(bitLength), bitLength)
+}
+
+// weightPerByte returns SIZE * BitLength per byte of plotted space, which is
+// the same for bit lengths of byte-aligned records.
+func weightPerByte() float64 {
+	return math.Ldexp(32, 32) / float64(poc.BitLengthDiskSize[32])
+}
+
+// poissonQuantile returns the smallest k with P(X <= k) >= p for X ~ Poisson(lambda).
+func poissonQuantile(lambda, p float64) uint64 {
+	if lambda <= 0 {
+		return 0
+	}
+	var cdf float64
+	logLambda := math.Log(lambda)
+	for k := uint64(0); ; k++ {
+		logFact, _ := math.Lgamma(float64(k) + 1)
+		cdf += math.Exp(float64(k)*logLambda - lambda - logFact)
+		if cdf >= p || float64(k) > lambda+10*math.Sqrt(lambda)+10 {
+			return k
+		}
+	}
+}
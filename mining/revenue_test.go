@@ -0,0 +1,84 @@
+package mining
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/Sukhavati-Labs/go-miner/blockchain"
+	"github.com/Sukhavati-Labs/go-miner/chainutil"
+	"github.com/Sukhavati-Labs/go-miner/poc"
+)
+
+func TestPoissonQuantile(t *testing.T) {
+	tests := []struct {
+		lambda, p float64
+		k         uint64
+	}{
+		{0, 0.975, 0},
+		{1, 0.025, 0},
+		{1, 0.975, 3},
+		{10, 0.025, 4},
+		{10, 0.975, 17},
+		{2880, 0.5, 2880},
+	}
+	for i, test := range tests {
+		if k := poissonQuantile(test.lambda, test.p); k != test.k {
+			t.Errorf("%d: quantile %v of Poisson(%v), expect %d, got %d", i, test.p, test.lambda, test.k, k)
+		}
+	}
+}
+
+func TestEstimateRevenue(t *testing.T) {
+	subsidy, err := chainutil.NewAmountFromSkt(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 99 spaces of bit length 32 on the network, a block per 30 seconds
+	network := &blockchain.NetworkWeight{
+		Weight:   99 * spaceWeight(32),
+		Blocks:   1000,
+		Interval: 30 * time.Second,
+	}
+	spaces := []RevenueSpace{{BitLength: 32, Bound: true, Subsidy: subsidy}}
+
+	est, err := EstimateRevenue(network, spaces)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(est.Share-0.01) > 1e-9 || math.Abs(est.BlocksPerDay-28.8) > 1e-9 {
+		t.Errorf("unexpected share %v and blocks per day %v", est.Share, est.BlocksPerDay)
+	}
+	if est.NetworkBytes != 99*float64(poc.BitLengthDiskSize[32]) || est.Bytes != uint64(poc.BitLengthDiskSize[32]) {
+		t.Errorf("unexpected network bytes %v and bytes %v", est.NetworkBytes, est.Bytes)
+	}
+	if est.NetworkBytesLower >= est.NetworkBytes || est.NetworkBytesUpper <= est.NetworkBytes {
+		t.Errorf("unexpected network bytes interval [%v, %v]", est.NetworkBytesLower, est.NetworkBytesUpper)
+	}
+	if est.BlocksPerDayLower >= 28 || est.BlocksPerDayUpper <= 29 {
+		t.Errorf("unexpected blocks interval [%d, %d]", est.BlocksPerDayLower, est.BlocksPerDayUpper)
+	}
+	if expected, _ := subsidy.MulF64(28.8); est.SktPerDay.Cmp(expected) != 0 {
+		t.Errorf("unexpected skt per day %s", est.SktPerDay)
+	}
+	if est.SktPerDayLower.Cmp(est.SktPerDay) >= 0 || est.SktPerDayUpper.Cmp(est.SktPerDay) <= 0 {
+		t.Errorf("unexpected skt interval [%s, %s]", est.SktPerDayLower, est.SktPerDayUpper)
+	}
+
+	// mining spaces are counted in network weight, others are added to it
+	network.Weight = 100 * spaceWeight(32)
+	spaces[0].Mining = true
+	spaces = append(spaces, RevenueSpace{BitLength: 24, Subsidy: chainutil.ZeroAmount()})
+	if est, err = EstimateRevenue(network, spaces); err != nil {
+		t.Fatal(err)
+	}
+	if len(est.Groups) != 2 || est.Groups[0].BitLength != 24 || est.Groups[1].BitLength != 32 {
+		t.Fatalf("unexpected groups %+v", est.Groups)
+	}
+	if group := est.Groups[0]; group.Bound != 0 || !group.SktPerDay.IsZero() || group.BlocksPerDay*256 > est.Groups[1].BlocksPerDay {
+		t.Errorf("unexpected group %+v", group)
+	}
+	if math.Abs(est.Groups[1].Share-0.01) > 1e-4 || est.Groups[1].Share >= 0.01 {
+		t.Errorf("unexpected share %v of bit length 32", est.Groups[1].Share)
+	}
+}
@@ -0,0 +1,37 @@
+package netsync
+
+import (
+	"github.com/Sukhavati-Labs/go-miner/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// syncCollector reads SyncManager when metrics are scraped.
+type syncCollector struct {
+	sm       *SyncManager
+	peers    *prometheus.Desc
+	caughtUp *prometheus.Desc
+}
+
+// NewCollector returns a collector reporting peers and sync state of sm,
+// bytes in and out are reported by package p2p/connection.
+func NewCollector(sm *SyncManager) prometheus.Collector {
+	return &syncCollector{
+		sm:       sm,
+		peers:    prometheus.NewDesc(prometheus.BuildFQName(metrics.Namespace, "netsync", "peers"), "Count of connected peers.", nil, nil),
+		caughtUp: prometheus.NewDesc(prometheus.BuildFQName(metrics.Namespace, "netsync", "caught_up"), "1 if the best chain is caught up with peers, 0 if syncing.", nil, nil),
+	}
+}
+
+func (c *syncCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.peers
+	ch <- c.caughtUp
+}
+
+func (c *syncCollector) Collect(ch chan<- prometheus.Metric) {
+	var caughtUp float64
+	if c.sm.IsCaughtUp() {
+		caughtUp = 1
+	}
+	ch <- prometheus.MustNewConstMetric(c.peers, prometheus.GaugeValue, float64(c.sm.PeerCount()))
+	ch <- prometheus.MustNewConstMetric(c.caughtUp, prometheus.GaugeValue, caughtUp)
+}
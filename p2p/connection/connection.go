@@ -255,6 +255,7 @@ func (c *MConnection) recvRoutine() {
 		var err error
 		pktType := wire.ReadByte(c.bufReader, &n, &err)
 		c.recvMonitor.Update(int(n))
+		receivedBytesCounter.Add(float64(n))
 		if err != nil {
 			if c.IsRunning() {
 				logging.CPrint(logging.ERROR, "connection failed @ recvRoutine (reading byte)", logging.LogFormat{"conn": c, "err": err})
@@ -280,6 +281,7 @@ func (c *MConnection) recvRoutine() {
 			pkt, n, err := msgPacket{}, int(0), error(nil)
 			wire.ReadBinaryPtr(&pkt, c.bufReader, maxMsgPacketTotalSize, &n, &err)
 			c.recvMonitor.Update(int(n))
+			receivedBytesCounter.Add(float64(n))
 			if err != nil {
 				if c.IsRunning() {
 					logging.CPrint(logging.ERROR, "failed on recvRoutine", logging.LogFormat{"conn": c, "err": err})
@@ -336,6 +338,7 @@ func (c *MConnection) sendMsgPacket() bool {
 		return true
 	}
 	c.sendMonitor.Update(int(n))
+	sentBytesCounter.Add(float64(n))
 	c.flushTimer.Set()
 	return false
 }
@@ -358,11 +361,13 @@ func (c *MConnection) sendRoutine() {
 			logging.CPrint(logging.DEBUG, "send Ping")
 			wire.WriteByte(packetTypePing, c.bufWriter, &n, &err)
 			c.sendMonitor.Update(int(n))
+			sentBytesCounter.Add(float64(n))
 			c.flush()
 		case <-c.pong:
 			logging.CPrint(logging.DEBUG, "send Pong")
 			wire.WriteByte(packetTypePong, c.bufWriter, &n, &err)
 			c.sendMonitor.Update(int(n))
+			sentBytesCounter.Add(float64(n))
 			c.flush()
 		case <-c.quit:
 			return
@@ -0,0 +1,25 @@
+package connection
+
+import (
+	"github.com/Sukhavati-Labs/go-miner/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	receivedBytesCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "p2p",
+		Name:      "received_bytes_total",
+		Help:      "Bytes received from all peers.",
+	})
+	sentBytesCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "p2p",
+		Name:      "sent_bytes_total",
+		Help:      "Bytes sent to all peers.",
+	})
+)
+
+func init() {
+	metrics.Registry.MustRegister(receivedBytesCounter, sentBytesCounter)
+}
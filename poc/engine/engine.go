@@ -257,6 +257,10 @@ type WorkSpaceInfo struct {
 	BitLength int
 	Progress  float64
 	State     WorkSpaceState
+	// Unavailable is set while plot file is inaccessible, the workSpace is skipped on mining
+	Unavailable bool
+	// Slow is set while proof lookups regularly exceed the latency budget
+	Slow bool
 }
 
 // ProofReader is the interface that wraps the basic Read-Proof method.
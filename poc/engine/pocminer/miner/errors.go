@@ -8,5 +8,16 @@ var (
 	errWrongTemplateCh   = errors.New("unexpected element received from template channel")
 	errAvoidDoubleMining = errors.New("sleep mining for 1 second to avoid double mining")
 	errBestChainSwitched = errors.New("best chain has been switched")
+	errOrphanBlock       = errors.New("block is an orphan")
+	errBlockNotFound     = errors.New("block is not found on best chain")
 	ErrNoPayoutAddresses = errors.New("can not mine without payout addresses")
+
+	ErrInvalidPayoutStrategy  = errors.New("invalid payout strategy")
+	ErrPayoutAddressExists    = errors.New("payout address already exists")
+	ErrPayoutAddressNotFound  = errors.New("payout address not found")
+	ErrTooManyPayoutAddresses = errors.New("payout addresses are more than allowed")
+
+	ErrGenerateNotAllowed = errors.New("generating blocks is only allowed on networks with minimum difficulty")
+	ErrInvalidBlockCount  = errors.New("count of blocks to generate should be positive")
+	ErrBlockRejected      = errors.New("generated block is rejected")
 )
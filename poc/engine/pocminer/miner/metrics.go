@@ -0,0 +1,49 @@
+package miner
+
+import (
+	"github.com/Sukhavati-Labs/go-miner/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	submitResultAccepted = "accepted"
+	submitResultRejected = "rejected"
+	submitResultOrphan   = "orphan"
+)
+
+var (
+	slotProofsGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "miner",
+		Name:      "slot_proofs_evaluated",
+		Help:      "Count of proofs evaluated in the last slot.",
+	})
+	proofsCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "miner",
+		Name:      "proofs_evaluated_total",
+		Help:      "Count of proofs evaluated in all slots.",
+	})
+	bestQualityGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "miner",
+		Name:      "best_quality",
+		Help:      "Best quality of proofs in the last slot.",
+	})
+	blocksSubmittedCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "miner",
+		Name:      "blocks_submitted_total",
+		Help:      "Count of mined blocks submitted to chain, by result.",
+	}, []string{"result"})
+	shadowSlotsCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "miner",
+		Name:      "shadow_slots_total",
+		Help:      "Count of heights settled in shadow mode, by result.",
+	}, []string{"result"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(slotProofsGauge, proofsCounter, bestQualityGauge, blocksSubmittedCounter, shadowSlotsCounter)
+}
@@ -1,10 +1,10 @@
 package miner
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
 	"math/big"
-	"math/rand"
 	"reflect"
 	"sync"
 	"sync/atomic"
@@ -16,7 +16,10 @@ import (
 	"github.com/Sukhavati-Labs/go-miner/logging"
 	"github.com/Sukhavati-Labs/go-miner/poc"
 	"github.com/Sukhavati-Labs/go-miner/poc/engine"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/pocminer"
 	"github.com/Sukhavati-Labs/go-miner/poc/engine/spacekeeper"
+	"github.com/Sukhavati-Labs/go-miner/pocec"
+	"github.com/Sukhavati-Labs/go-miner/txscript"
 	"github.com/Sukhavati-Labs/go-miner/wire"
 )
 
@@ -48,34 +51,43 @@ type ProofTemplate struct {
 
 type PoCMiner struct {
 	*service.BaseService
-	quit            chan struct{}
-	wg              sync.WaitGroup
-	allowSolo       bool
-	chain           Chain
-	syncManager     SyncManager
-	SpaceKeeper     spacekeeper.SpaceKeeper
-	minedHeight     map[uint64]struct{}
-	newBlockCh      chan *wire.Hash
-	payoutAddresses []chainutil.Address
-	getBestProof    func(pocTemplate *blockchain.PoCTemplate, quit chan struct{}) (*ProofTemplate, error)
+	quit          chan struct{}
+	wg            sync.WaitGroup
+	allowSolo     bool
+	chain         Chain
+	syncManager   SyncManager
+	SpaceKeeper   spacekeeper.SpaceKeeper
+	minedHeight   map[uint64]struct{}
+	newBlockCh    chan *wire.Hash
+	payouts       *payoutBook
+	getBestProof  func(pocTemplate *blockchain.PoCTemplate, quit chan struct{}) (*ProofTemplate, error)
+	signHeader    func(sid string, header *wire.BlockHeader) (*pocec.Signature, error)
+	signGuard     *pocminer.SignGuard // guards signing against double mining if set
+	shadow        *shadowRecorder     // solves blocks without submitting them if set
+	blockAccepted func(block *chainutil.Block, minerReward chainutil.Amount)
+	generateMu    sync.Mutex
+	regTestSpaces *regTestSpaces
+	listeners     *pocminer.Listeners
 }
 
 func NewPoCMiner(name string, allowSolo bool, chain Chain, syncManager SyncManager, sk spacekeeper.SpaceKeeper, newBlockCh chan *wire.Hash, payoutAddresses []chainutil.Address) *PoCMiner {
 	m := &PoCMiner{
-		allowSolo:       allowSolo,
-		chain:           chain,
-		syncManager:     syncManager,
-		SpaceKeeper:     sk,
-		minedHeight:     make(map[uint64]struct{}),
-		newBlockCh:      newBlockCh,
-		payoutAddresses: payoutAddresses,
+		allowSolo:   allowSolo,
+		chain:       chain,
+		syncManager: syncManager,
+		SpaceKeeper: sk,
+		minedHeight: make(map[uint64]struct{}),
+		newBlockCh:  newBlockCh,
+		listeners:   new(pocminer.Listeners),
 	}
+	m.payouts, _ = newPayoutBook("", pocminer.PayoutRoundRobin, payoutAddresses)
 	m.BaseService = service.NewBaseService(m, name)
+	m.signHeader = m.signHeaderBySpaceKeeper
 	return m
 }
 
 func (m *PoCMiner) OnStart() error {
-	if len(m.payoutAddresses) == 0 {
+	if m.payouts.Len() == 0 {
 		logging.CPrint(logging.ERROR, "can not start mining", logging.LogFormat{"err": ErrNoPayoutAddresses})
 		return ErrNoPayoutAddresses
 	}
@@ -106,11 +118,15 @@ func (m *PoCMiner) Type() string {
 }
 
 func (m *PoCMiner) SetPayoutAddresses(addresses []chainutil.Address) error {
-	if len(addresses) == 0 {
-		return ErrNoPayoutAddresses
-	}
-	m.payoutAddresses = addresses
-	return nil
+	return m.payouts.SetAddresses(addresses)
+}
+
+func (m *PoCMiner) RegisterListener(listener pocminer.Listener) {
+	m.listeners.Register(listener)
+}
+
+func (m *PoCMiner) UnregisterListener(listener pocminer.Listener) {
+	m.listeners.Unregister(listener)
 }
 
 func (m *PoCMiner) generateBlocks(quit chan struct{}) {
@@ -137,13 +153,17 @@ out:
 			continue out
 		}
 
-		// Choose a payment address randomly.
-		payoutAddresses := m.payoutAddresses
-		if len(payoutAddresses) == 0 {
+		// Templates are paid to any address, the payout address is chosen
+		// after proof found, since it may depend on the public key.
+		payoutAddress := m.payouts.First()
+		if payoutAddress == nil {
 			logging.CPrint(logging.ERROR, "no valid mining payout addresses", logging.LogFormat{"err": ErrNoPayoutAddresses})
 			break out
 		}
-		payoutAddress := payoutAddresses[rand.Intn(len(payoutAddresses))]
+		if m.shadow != nil {
+			m.shadowSolveBlock(payoutAddress, quit)
+			continue out
+		}
 		// start solve block
 		if newBlock, minerReward, err := m.solveBlock(payoutAddress, quit); err == nil {
 			block := chainutil.NewBlock(newBlock)
@@ -180,13 +200,19 @@ func (m *PoCMiner) submitBlock(block *chainutil.Block, minerReward chainutil.Amo
 	if err != nil {
 		logging.CPrint(logging.ERROR, "block submitted via PoC miner rejected",
 			logging.LogFormat{"err": err, "hash": block.Hash(), "height": block.Height()})
+		blocksSubmittedCounter.WithLabelValues(submitResultRejected).Inc()
+		m.listeners.NotifyBlockSubmitted(&pocminer.BlockSubmitted{Block: block, Reward: minerReward, Err: err})
 		return false
 	}
 	if isOrphan {
 		logging.CPrint(logging.ERROR, "block submitted via PoC miner is an orphan",
 			logging.LogFormat{"hash": block.Hash(), "height": block.Height()})
+		blocksSubmittedCounter.WithLabelValues(submitResultOrphan).Inc()
+		m.listeners.NotifyBlockSubmitted(&pocminer.BlockSubmitted{Block: block, Reward: minerReward, Err: errOrphanBlock})
 		return false
 	}
+	blocksSubmittedCounter.WithLabelValues(submitResultAccepted).Inc()
+	m.listeners.NotifyBlockSubmitted(&pocminer.BlockSubmitted{Block: block, Accepted: true, Reward: minerReward})
 
 	// The block was accepted.
 	logging.CPrint(logging.INFO, "block submitted via PoC miner accepted",
@@ -198,6 +224,12 @@ func (m *PoCMiner) submitBlock(block *chainutil.Block, minerReward chainutil.Amo
 	// prevent double-mining
 	m.minedHeight[block.Height()] = struct{}{}
 
+	// pay next block to the next address
+	m.payouts.Advance(block.MsgBlock().Header.PubKey)
+
+	if m.blockAccepted != nil {
+		m.blockAccepted(block, minerReward)
+	}
 	return true
 }
 
@@ -221,6 +253,9 @@ func (m *PoCMiner) solveBlock(payoutAddress chainutil.Address, quit chan struct{
 		return failure(err)
 	}
 	pocTemplate := pocTemplateI.(*blockchain.PoCTemplate)
+	if m.shadow != nil {
+		m.shadow.begin(pocTemplate.Height)
+	}
 
 	// Step 3: check for double mining
 	logging.CPrint(logging.INFO, "Step 3: check for double mining")
@@ -245,6 +280,14 @@ func (m *PoCMiner) solveBlock(payoutAddress chainutil.Address, quit chan struct{
 		}
 		return failure(err)
 	}
+	m.listeners.NotifyProofFound(&pocminer.ProofFound{
+		Height:    pocTemplate.Height,
+		SpaceID:   tProof.proof.SpaceID,
+		PublicKey: tProof.proof.PublicKey,
+		BitLength: tProof.proof.Proof.BitLength,
+		Quality:   tProof.quality,
+		Time:      tProof.time,
+	})
 
 	// Step 5: wait for chain template
 	logging.CPrint(logging.INFO, "Step 5: wait for chain template")
@@ -256,18 +299,24 @@ func (m *PoCMiner) solveBlock(payoutAddress chainutil.Address, quit chan struct{
 
 	// Step 6: assemble full block
 	logging.CPrint(logging.INFO, "Step 6: assemble full block")
-	block, minerReward, err := assembleFullBlock(blockTemplate, pocTemplate, tProof)
+	block, minerReward, err := assembleFullBlock(blockTemplate, pocTemplate, tProof, m.payouts)
 	if err != nil {
 		return failure(err)
 	}
 
-	// Step 7: get signature for poc hash
+	// Step 7: get signature for poc hash, blocks solved in shadow mode are never signed
+	if m.shadow != nil {
+		logging.CPrint(logging.INFO, "Step 7: skip signature in shadow mode")
+		logging.CPrint(logging.INFO, "Step 8: return")
+		return block, minerReward, nil
+	}
 	logging.CPrint(logging.INFO, "Step 7: get signature for poc hash")
-	pocHash, err := block.Header.PoCHash()
-	if err != nil {
-		return failure(err)
+	if m.signGuard != nil {
+		if err = m.signGuard.Claim(block.Header.PubKey, block.Header.Height); err != nil {
+			return failure(err)
+		}
 	}
-	block.Header.Signature, err = m.SpaceKeeper.SignHash(tProof.proof.SpaceID, pocHash)
+	block.Header.Signature, err = m.signHeader(tProof.proof.SpaceID, &block.Header)
 	if err != nil {
 		return failure(err)
 	}
@@ -276,6 +325,17 @@ func (m *PoCMiner) solveBlock(payoutAddress chainutil.Address, quit chan struct{
 	return block, minerReward, nil
 }
 
+func (m *PoCMiner) signHeaderBySpaceKeeper(sid string, header *wire.BlockHeader) (*pocec.Signature, error) {
+	if hs, ok := m.SpaceKeeper.(spacekeeper.HeaderSigner); ok {
+		return hs.SignHeader(sid, header)
+	}
+	pocHash, err := header.PoCHash()
+	if err != nil {
+		return nil, err
+	}
+	return m.SpaceKeeper.SignHash(sid, pocHash)
+}
+
 func getTemplate(quit chan struct{}, ch chan interface{}, typ reflect.Type) (interface{}, error) {
 	select {
 	case <-quit:
@@ -307,7 +367,7 @@ func getTemplate(quit chan struct{}, ch chan interface{}, typ reflect.Type) (int
 	}
 }
 
-func assembleFullBlock(blockTemplate *blockchain.BlockTemplate, pocTemplate *blockchain.PoCTemplate, tProof *ProofTemplate) (*wire.MsgBlock, chainutil.Amount, error) {
+func assembleFullBlock(blockTemplate *blockchain.BlockTemplate, pocTemplate *blockchain.PoCTemplate, tProof *ProofTemplate, payouts *payoutBook) (*wire.MsgBlock, chainutil.Amount, error) {
 	var block = blockTemplate.Block
 	var workProof, pocProof = tProof.proof, tProof.proof.Proof
 
@@ -323,6 +383,11 @@ func assembleFullBlock(blockTemplate *blockchain.BlockTemplate, pocTemplate *blo
 			logging.LogFormat{"pubkey": workProof.PublicKey, "err": err})
 		return nil, chainutil.Amount{}, err
 	}
+	if coinbaseTx, err = payCoinbase(coinbaseTx, workProof.PublicKey, payouts); err != nil {
+		logging.CPrint(logging.ERROR, "failed to pay coinbase to payout address",
+			logging.LogFormat{"pubkey": workProof.PublicKey, "err": err})
+		return nil, chainutil.Amount{}, err
+	}
 
 	if coinbaseTx.MsgTx().TxHash() != *blockTemplate.MerkleCache[0] {
 		block.Transactions[0] = coinbaseTx.MsgTx()
@@ -343,6 +408,28 @@ func assembleFullBlock(blockTemplate *blockchain.BlockTemplate, pocTemplate *blo
 	return block, minerReward, nil
 }
 
+// payCoinbase pays miner output of coinbase to the address chosen for pubKey.
+func payCoinbase(coinbaseTx *chainutil.Tx, pubKey *pocec.PublicKey, payouts *payoutBook) (*chainutil.Tx, error) {
+	payoutAddress, err := payouts.Select(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	pkScript, err := txscript.PayToAddrScript(payoutAddress)
+	if err != nil {
+		return nil, err
+	}
+	minerTxOut, err := blockchain.GetMinerRewardTxOutFromCoinbase(coinbaseTx.MsgTx())
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(minerTxOut.PkScript, pkScript) {
+		return coinbaseTx, nil
+	}
+	minerTxOut.PkScript = pkScript
+	// hashes are cached by chainutil.Tx
+	return chainutil.NewTx(coinbaseTx.MsgTx()), nil
+}
+
 func runStaleMonitor(chain Chain, workSlot *uint64, previousHash *wire.Hash) (cancelFunc func(), staleFunc func() bool, err error) {
 	node := chain.BestBlockNode()
 	if !node.Hash.IsEqual(previousHash) {
@@ -422,7 +509,3 @@ func runStaleMonitor(chain Chain, workSlot *uint64, previousHash *wire.Hash) (ca
 	}
 	return cancel, staleFunc, nil
 }
-
-func init() {
-	rand.Seed(time.Now().UnixNano())
-}
@@ -0,0 +1,400 @@
+package miner
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/Sukhavati-Labs/go-miner/chainutil"
+	"github.com/Sukhavati-Labs/go-miner/config"
+	"github.com/Sukhavati-Labs/go-miner/logging"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/pocminer"
+	"github.com/Sukhavati-Labs/go-miner/pocec"
+)
+
+const payoutAddressesFilename = "payout_addresses.json"
+
+// payoutBook keeps payout addresses and chooses one for each mined block
+// according to strategy.
+//
+// If path is set, addresses and strategy are saved in path on each change, and
+// the saved ones take precedence over configured ones on restart, a warning is
+// logged if they differ.
+//
+// Turns of strategies advance only once a block paid by Select is accepted, so
+// blocks refused or never submitted leave the next address unchanged.
+type payoutBook struct {
+	mu       sync.Mutex
+	path     string
+	strategy string
+	payouts  []*pocminer.PayoutAddress
+	next     uint64  // turn of round-robin
+	current  []int64 // current weights of smooth weighted round-robin
+}
+
+// payoutFile is the content of payout addresses file.
+type payoutFile struct {
+	Strategy  string         `json:"strategy"`
+	Addresses []*payoutEntry `json:"addresses"`
+}
+
+type payoutEntry struct {
+	Address   string `json:"address"`
+	Weight    uint32 `json:"weight"`
+	PublicKey string `json:"public_key,omitempty"`
+}
+
+func newPayoutBook(path, strategy string, addresses []chainutil.Address) (*payoutBook, error) {
+	if strategy == "" {
+		strategy = pocminer.PayoutRoundRobin
+	}
+	if !validPayoutStrategy(strategy) {
+		return nil, ErrInvalidPayoutStrategy
+	}
+	b := &payoutBook{path: path, strategy: strategy}
+	b.setPayouts(newPayouts(addresses, nil))
+	if path == "" {
+		return b, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return b, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var file payoutFile
+	if err = json.Unmarshal(data, &file); err != nil {
+		return nil, err
+	}
+	if !validPayoutStrategy(file.Strategy) {
+		return nil, ErrInvalidPayoutStrategy
+	}
+	if file.Strategy != strategy {
+		logging.CPrint(logging.WARN, "saved payout strategy overrides configured one",
+			logging.LogFormat{"path": path, "saved": file.Strategy, "configured": strategy})
+	}
+	b.strategy = file.Strategy
+	if len(file.Addresses) == 0 {
+		return b, nil
+	}
+	payouts := make([]*pocminer.PayoutAddress, len(file.Addresses))
+	for i, entry := range file.Addresses {
+		if payouts[i], err = entry.toPayout(); err != nil {
+			return nil, err
+		}
+	}
+	if !samePayouts(payouts, b.payouts) {
+		logging.CPrint(logging.WARN, "saved payout addresses override configured ones",
+			logging.LogFormat{"path": path, "saved": len(payouts), "configured": len(b.payouts)})
+	}
+	b.setPayouts(payouts)
+	logging.CPrint(logging.INFO, "payout addresses restored", logging.LogFormat{"path": path, "strategy": b.strategy, "count": len(payouts)})
+	return b, nil
+}
+
+// newPayoutBookByConfig saves payout addresses in chain data dir, with strategy
+// of miner.payout_strategy.
+func newPayoutBookByConfig(cfg *config.Config, addresses []chainutil.Address) (*payoutBook, error) {
+	return newPayoutBook(filepath.Join(cfg.Db.DataDir, payoutAddressesFilename), cfg.Miner.PayoutStrategy, addresses)
+}
+
+func validPayoutStrategy(strategy string) bool {
+	switch strategy {
+	case pocminer.PayoutRoundRobin, pocminer.PayoutWeighted, pocminer.PayoutPublicKey:
+		return true
+	default:
+		return false
+	}
+}
+
+// newPayouts makes payout addresses of default weight, keeping weights and
+// public keys of addresses found in old.
+func newPayouts(addresses []chainutil.Address, old []*pocminer.PayoutAddress) []*pocminer.PayoutAddress {
+	payouts := make([]*pocminer.PayoutAddress, len(addresses))
+	for i, addr := range addresses {
+		payouts[i] = &pocminer.PayoutAddress{Address: addr, Weight: 1}
+		if j := indexOfPayout(old, addr); j >= 0 {
+			payouts[i].Weight, payouts[i].PublicKey = old[j].Weight, old[j].PublicKey
+		}
+	}
+	return payouts
+}
+
+func indexOfPayout(payouts []*pocminer.PayoutAddress, addr chainutil.Address) int {
+	for i, payout := range payouts {
+		if payout.Address.EncodeAddress() == addr.EncodeAddress() {
+			return i
+		}
+	}
+	return -1
+}
+
+// samePayouts tells whether a and b have the same addresses, regardless of
+// order, weights and public keys.
+func samePayouts(a, b []*pocminer.PayoutAddress) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, payout := range a {
+		if indexOfPayout(b, payout.Address) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// setPayouts replaces payouts and resets turns of strategies.
+func (b *payoutBook) setPayouts(payouts []*pocminer.PayoutAddress) {
+	b.payouts = payouts
+	b.current = make([]int64, len(payouts))
+}
+
+func (b *payoutBook) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.payouts)
+}
+
+// First returns the first payout address, or nil if there is none.
+func (b *payoutBook) First() chainutil.Address {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.payouts) == 0 {
+		return nil
+	}
+	return b.payouts[0].Address
+}
+
+// Select chooses payout address for a block mined by pubKey, the turn of
+// strategy is kept until Advance.
+func (b *payoutBook) Select(pubKey *pocec.PublicKey) (chainutil.Address, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.choose(pubKey, false)
+}
+
+// Advance moves the turn of strategy past the address chosen by Select for
+// pubKey, it is called once the block paid to the address is accepted.
+func (b *payoutBook) Advance(pubKey *pocec.PublicKey) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.choose(pubKey, true)
+}
+
+func (b *payoutBook) choose(pubKey *pocec.PublicKey, advance bool) (chainutil.Address, error) {
+	if len(b.payouts) == 0 {
+		return nil, ErrNoPayoutAddresses
+	}
+
+	switch b.strategy {
+	case pocminer.PayoutWeighted:
+		var total int64
+		best := 0
+		for i, payout := range b.payouts {
+			total += int64(payout.Weight)
+			if b.current[i]+int64(payout.Weight) > b.current[best]+int64(b.payouts[best].Weight) {
+				best = i
+			}
+		}
+		if advance {
+			for i, payout := range b.payouts {
+				b.current[i] += int64(payout.Weight)
+			}
+			b.current[best] -= total
+		}
+		return b.payouts[best].Address, nil
+
+	case pocminer.PayoutPublicKey:
+		var owned, shared []chainutil.Address
+		for _, payout := range b.payouts {
+			switch {
+			case payout.PublicKey == nil:
+				shared = append(shared, payout.Address)
+			case pubKey != nil && payout.PublicKey.IsEqual(pubKey):
+				owned = append(owned, payout.Address)
+			}
+		}
+		if len(owned) != 0 {
+			return b.turn(owned, advance), nil
+		}
+		if len(shared) != 0 {
+			return b.turn(shared, advance), nil
+		}
+		fallthrough
+
+	default:
+		addresses := make([]chainutil.Address, len(b.payouts))
+		for i, payout := range b.payouts {
+			addresses[i] = payout.Address
+		}
+		return b.turn(addresses, advance), nil
+	}
+}
+
+func (b *payoutBook) turn(addresses []chainutil.Address, advance bool) chainutil.Address {
+	addr := addresses[b.next%uint64(len(addresses))]
+	if advance {
+		b.next++
+	}
+	return addr
+}
+
+func (b *payoutBook) PayoutStrategy() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.strategy
+}
+
+func (b *payoutBook) SetPayoutStrategy(strategy string) error {
+	if !validPayoutStrategy(strategy) {
+		return ErrInvalidPayoutStrategy
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err := b.save(strategy, b.payouts); err != nil {
+		return err
+	}
+	b.strategy = strategy
+	b.setPayouts(b.payouts)
+	return nil
+}
+
+// PayoutAddresses returns copies of payout addresses in order of adding.
+func (b *payoutBook) PayoutAddresses() []*pocminer.PayoutAddress {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	payouts := make([]*pocminer.PayoutAddress, len(b.payouts))
+	for i, payout := range b.payouts {
+		copied := *payout
+		payouts[i] = &copied
+	}
+	return payouts
+}
+
+// AddPayoutAddress adds payout, weight 0 is taken as 1.
+func (b *payoutBook) AddPayoutAddress(payout *pocminer.PayoutAddress) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if indexOfPayout(b.payouts, payout.Address) >= 0 {
+		return ErrPayoutAddressExists
+	}
+	if len(b.payouts) >= config.MaxMiningPayoutAddresses {
+		return ErrTooManyPayoutAddresses
+	}
+	added := *payout
+	if added.Weight == 0 {
+		added.Weight = 1
+	}
+	payouts := append(append(make([]*pocminer.PayoutAddress, 0, len(b.payouts)+1), b.payouts...), &added)
+	if err := b.save(b.strategy, payouts); err != nil {
+		return err
+	}
+	b.setPayouts(payouts)
+	return nil
+}
+
+// RemovePayoutAddress removes address, the last address is never removed.
+func (b *payoutBook) RemovePayoutAddress(address chainutil.Address) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	i := indexOfPayout(b.payouts, address)
+	if i < 0 {
+		return ErrPayoutAddressNotFound
+	}
+	if len(b.payouts) == 1 {
+		return ErrNoPayoutAddresses
+	}
+	payouts := append(append(make([]*pocminer.PayoutAddress, 0, len(b.payouts)-1), b.payouts[:i]...), b.payouts[i+1:]...)
+	if err := b.save(b.strategy, payouts); err != nil {
+		return err
+	}
+	b.setPayouts(payouts)
+	return nil
+}
+
+// SetAddresses replaces payout addresses, addresses kept have their weights
+// and public keys unchanged.
+func (b *payoutBook) SetAddresses(addresses []chainutil.Address) error {
+	if len(addresses) == 0 {
+		return ErrNoPayoutAddresses
+	}
+	if len(addresses) > config.MaxMiningPayoutAddresses {
+		return ErrTooManyPayoutAddresses
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	payouts := newPayouts(addresses, b.payouts)
+	if err := b.save(b.strategy, payouts); err != nil {
+		return err
+	}
+	b.setPayouts(payouts)
+	return nil
+}
+
+// save writes strategy and payouts durably by replacing the old file,
+// it does nothing if path is not set.
+func (b *payoutBook) save(strategy string, payouts []*pocminer.PayoutAddress) error {
+	if b.path == "" {
+		return nil
+	}
+	file := payoutFile{Strategy: strategy, Addresses: make([]*payoutEntry, len(payouts))}
+	for i, payout := range payouts {
+		file.Addresses[i] = newPayoutEntry(payout)
+	}
+	data, err := json.Marshal(&file)
+	if err != nil {
+		return err
+	}
+	return chainutil.WriteFileAtomic(b.path, data, 0600)
+}
+
+func newPayoutEntry(payout *pocminer.PayoutAddress) *payoutEntry {
+	entry := &payoutEntry{Address: payout.Address.EncodeAddress(), Weight: payout.Weight}
+	if payout.PublicKey != nil {
+		entry.PublicKey = hex.EncodeToString(payout.PublicKey.SerializeCompressed())
+	}
+	return entry
+}
+
+func (entry *payoutEntry) toPayout() (*pocminer.PayoutAddress, error) {
+	addresses, err := chainutil.NewAddressesFromStringList([]string{entry.Address}, &config.ChainParams)
+	if err != nil {
+		return nil, err
+	}
+	payout := &pocminer.PayoutAddress{Address: addresses[0], Weight: entry.Weight}
+	if entry.PublicKey != "" {
+		pkBytes, err := hex.DecodeString(entry.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		if payout.PublicKey, err = pocec.ParsePubKey(pkBytes, pocec.S256()); err != nil {
+			return nil, err
+		}
+	}
+	return payout, nil
+}
+
+func (m *PoCMiner) PayoutStrategy() string {
+	return m.payouts.PayoutStrategy()
+}
+
+func (m *PoCMiner) SetPayoutStrategy(strategy string) error {
+	return m.payouts.SetPayoutStrategy(strategy)
+}
+
+func (m *PoCMiner) PayoutAddresses() []*pocminer.PayoutAddress {
+	return m.payouts.PayoutAddresses()
+}
+
+func (m *PoCMiner) AddPayoutAddress(payout *pocminer.PayoutAddress) error {
+	return m.payouts.AddPayoutAddress(payout)
+}
+
+func (m *PoCMiner) RemovePayoutAddress(address chainutil.Address) error {
+	return m.payouts.RemovePayoutAddress(address)
+}
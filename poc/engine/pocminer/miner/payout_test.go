@@ -0,0 +1,111 @@
+package miner
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/Sukhavati-Labs/go-miner/chainutil"
+	"github.com/Sukhavati-Labs/go-miner/config"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/pocminer"
+	"github.com/Sukhavati-Labs/go-miner/pocec"
+)
+
+func TestPayoutBook(t *testing.T) {
+	addrs := make([]chainutil.Address, 3)
+	for i := range addrs {
+		addr, err := chainutil.NewAddressWitnessScriptHash(append(make([]byte, 31), byte(i)), &config.ChainParams)
+		if err != nil {
+			t.Fatal(err)
+		}
+		addrs[i] = addr
+	}
+	privKey, err := pocec.NewPrivateKey(pocec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKey := privKey.PubKey()
+
+	var selectN = func(b *payoutBook, pubKey *pocec.PublicKey, n int) map[string]int {
+		counts := make(map[string]int)
+		for i := 0; i < n; i++ {
+			addr, err := b.Select(pubKey)
+			if err != nil {
+				t.Fatal(err)
+			}
+			counts[addr.EncodeAddress()]++
+			b.Advance(pubKey)
+		}
+		return counts
+	}
+
+	path := filepath.Join(t.TempDir(), payoutAddressesFilename)
+	b, err := newPayoutBook(path, "", addrs[:2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if counts := selectN(b, nil, 10); counts[addrs[0].EncodeAddress()] != 5 || counts[addrs[1].EncodeAddress()] != 5 {
+		t.Errorf("round-robin, expect 5 blocks for each address, got %v", counts)
+	}
+
+	// turns are kept until the block is accepted
+	for i := 0; i < 3; i++ {
+		if addr, err := b.Select(nil); err != nil || addr.EncodeAddress() != addrs[0].EncodeAddress() {
+			t.Fatalf("expect %s before advance, got %v %v", addrs[0].EncodeAddress(), addr, err)
+		}
+	}
+
+	if err = b.AddPayoutAddress(&pocminer.PayoutAddress{Address: addrs[2], Weight: 3, PublicKey: pubKey}); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.AddPayoutAddress(&pocminer.PayoutAddress{Address: addrs[2]}); err != ErrPayoutAddressExists {
+		t.Errorf("expect %v, got %v", ErrPayoutAddressExists, err)
+	}
+	if err = b.SetPayoutStrategy("random"); err != ErrInvalidPayoutStrategy {
+		t.Errorf("expect %v, got %v", ErrInvalidPayoutStrategy, err)
+	}
+	if err = b.SetPayoutStrategy(pocminer.PayoutWeighted); err != nil {
+		t.Fatal(err)
+	}
+	if counts := selectN(b, nil, 10); counts[addrs[0].EncodeAddress()] != 2 || counts[addrs[2].EncodeAddress()] != 6 {
+		t.Errorf("weighted, expect 2, 2 and 6 blocks, got %v", counts)
+	}
+
+	// saved payouts take precedence over configured ones
+	b, err = newPayoutBook(path, pocminer.PayoutRoundRobin, addrs[:1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.PayoutStrategy() != pocminer.PayoutWeighted || b.Len() != 3 {
+		t.Fatalf("expect 3 weighted addresses restored, got %d %s", b.Len(), b.PayoutStrategy())
+	}
+	if payouts := b.PayoutAddresses(); payouts[2].Weight != 3 || !payouts[2].PublicKey.IsEqual(pubKey) {
+		t.Errorf("payout changed after restart %+v", payouts[2])
+	}
+
+	if err = b.SetPayoutStrategy(pocminer.PayoutPublicKey); err != nil {
+		t.Fatal(err)
+	}
+	if counts := selectN(b, pubKey, 4); counts[addrs[2].EncodeAddress()] != 4 {
+		t.Errorf("public-key, expect blocks of public key paid to its address, got %v", counts)
+	}
+	if counts := selectN(b, nil, 4); counts[addrs[0].EncodeAddress()] != 2 || counts[addrs[1].EncodeAddress()] != 2 {
+		t.Errorf("public-key, expect other blocks paid to addresses without public key, got %v", counts)
+	}
+
+	// addresses kept by SetAddresses have weights and public keys unchanged
+	if err = b.SetAddresses([]chainutil.Address{addrs[2]}); err != nil {
+		t.Fatal(err)
+	}
+	if payouts := b.PayoutAddresses(); len(payouts) != 1 || payouts[0].Weight != 3 || payouts[0].PublicKey == nil {
+		t.Errorf("unexpected payouts after set addresses %+v", payouts)
+	}
+	if counts := selectN(b, nil, 2); counts[addrs[2].EncodeAddress()] != 2 {
+		t.Errorf("public-key, expect all addresses used without address for any public key, got %v", counts)
+	}
+	if err = b.RemovePayoutAddress(addrs[0]); err != ErrPayoutAddressNotFound {
+		t.Errorf("expect %v, got %v", ErrPayoutAddressNotFound, err)
+	}
+	if err = b.RemovePayoutAddress(addrs[2]); err != ErrNoPayoutAddresses {
+		t.Errorf("expect %v, got %v", ErrNoPayoutAddresses, err)
+	}
+}
@@ -0,0 +1,75 @@
+package miner
+
+import (
+	"github.com/Sukhavati-Labs/go-miner/blockchain"
+	"github.com/Sukhavati-Labs/go-miner/chainutil"
+	"github.com/Sukhavati-Labs/go-miner/config"
+	"github.com/Sukhavati-Labs/go-miner/logging"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/pocminer"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/pocminer/pool"
+)
+
+const (
+	TypePoolServer = pool.TypePoolServer
+)
+
+// NewPoolServer returns a PoCMiner running the reference pool, it works like
+// sync miner except that proofs are shares submitted by pool miners, and
+// blocks are signed by the pool miner who found the best proof.
+//
+// Pool miners connect over TLS with the certificate pair of miner.pool_tls_cert
+// and miner.pool_tls_key, and are authenticated by miner.pool_credentials.
+func NewPoolServer(args ...interface{}) (pocminer.PoCMiner, error) {
+	if len(args) != 7 {
+		return nil, pocminer.ErrInvalidMinerArgs
+	}
+	cfg, ok := args[6].(*config.Config)
+	if !ok {
+		logging.CPrint(logging.ERROR, "invalid pool server config", logging.LogFormat{"err": pocminer.ErrInvalidMinerArgs})
+		return nil, pocminer.ErrInvalidMinerArgs
+	}
+	allowSolo, chain, syncManager, _, newBlockCh, payoutAddresses, err := parsArgs(args[:6]...)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig, generated, err := chainutil.LoadTLSConfig(cfg.Miner.PoolTlsCert, cfg.Miner.PoolTlsKey, cfg.Miner.PoolTlsHosts)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to load pool certificate pair",
+			logging.LogFormat{"cert": cfg.Miner.PoolTlsCert, "key": cfg.Miner.PoolTlsKey, "err": err})
+		return nil, err
+	}
+	if generated {
+		logging.CPrint(logging.INFO, "generated pool certificate pair, copy the certificate to pool miners",
+			logging.LogFormat{"cert": cfg.Miner.PoolTlsCert, "key": cfg.Miner.PoolTlsKey})
+	}
+	creds := make([]pool.Credential, len(cfg.Miner.PoolCredentials))
+	for i, cred := range cfg.Miner.PoolCredentials {
+		creds[i] = pool.Credential{Account: cred.Account, Token: cred.Token}
+	}
+	srv, err := pool.NewServer(cfg.Miner.PoolListen, tlsConfig, creds, pool.DefaultShareFactor, pool.DefaultShareWindow)
+	if err != nil {
+		return nil, err
+	}
+	m := NewPoCMiner(TypePoolServer, allowSolo, chain, syncManager, srv, newBlockCh, payoutAddresses)
+	m.getBestProof = func(pocTemplate *blockchain.PoCTemplate, quit chan struct{}) (*ProofTemplate, error) {
+		srv.NewJob(pocTemplate.Height, pocTemplate.Previous, pocTemplate.Challenge, pocTemplate.Timestamp, pocTemplate.GetTarget(pocTemplate.Timestamp))
+		return m.syncGetBestProof(pocTemplate, quit)
+	}
+	m.signHeader = srv.SignHeader
+	if m.signGuard, err = newSignGuardByConfig(cfg); err != nil {
+		return nil, err
+	}
+	if m.payouts, err = newPayoutBookByConfig(cfg, payoutAddresses); err != nil {
+		return nil, err
+	}
+	m.blockAccepted = srv.BlockAccepted
+	return m, nil
+}
+
+func init() {
+	pocminer.AddPoCMinerBackend(pocminer.Backend{
+		Typ:         TypePoolServer,
+		NewPoCMiner: NewPoolServer,
+	})
+}
@@ -0,0 +1,193 @@
+package miner
+
+import (
+	"encoding/hex"
+	"strconv"
+	"sync"
+
+	"github.com/Sukhavati-Labs/go-miner/blockchain"
+	"github.com/Sukhavati-Labs/go-miner/chainutil"
+	"github.com/Sukhavati-Labs/go-miner/config"
+	"github.com/Sukhavati-Labs/go-miner/logging"
+	"github.com/Sukhavati-Labs/go-miner/poc"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine"
+	"github.com/Sukhavati-Labs/go-miner/poc/pocutil"
+	"github.com/Sukhavati-Labs/go-miner/pocec"
+	"github.com/Sukhavati-Labs/go-miner/wire"
+)
+
+const (
+	// maxRegTestSpaces limits the count of in-memory workspaces,
+	// each one takes about 2^RegTestBitLength records.
+	maxRegTestSpaces = 64
+)
+
+// regTestSpace is an in-memory workspace of poc.RegTestBitLength,
+// it holds its own private key so that no wallet is required.
+type regTestSpace struct {
+	sid     string
+	privKey *pocec.PrivateKey
+	proofs  map[pocutil.PoCValue]*poc.Proof
+}
+
+// newRegTestSpace generates a random key and plots all (x, x') pairs in memory.
+func newRegTestSpace() (*regTestSpace, error) {
+	privKey, err := pocec.NewPrivateKey(pocec.S256())
+	if err != nil {
+		return nil, err
+	}
+	var bl = poc.RegTestBitLength
+	var pkHash = pocutil.PubKeyHash(privKey.PubKey())
+
+	ys := make(map[pocutil.PoCValue][]pocutil.PoCValue)
+	for x := pocutil.PoCValue(0); x < 1<<uint(bl); x++ {
+		y := pocutil.P(x, bl, pkHash)
+		ys[y] = append(ys[y], x)
+	}
+	proofs := make(map[pocutil.PoCValue]*poc.Proof)
+	for y, xs := range ys {
+		for _, xp := range ys[pocutil.FlipValue(y, bl)] {
+			for _, x := range xs {
+				z := pocutil.F(x, xp, bl, pkHash)
+				if _, ok := proofs[z]; ok {
+					continue
+				}
+				proofs[z] = &poc.Proof{
+					X:         pocutil.PoCValue2Bytes(x, bl),
+					XPrime:    pocutil.PoCValue2Bytes(xp, bl),
+					BitLength: bl,
+				}
+			}
+		}
+	}
+
+	return &regTestSpace{
+		sid:     hex.EncodeToString(privKey.PubKey().SerializeCompressed()) + "-" + strconv.Itoa(bl),
+		privKey: privKey,
+		proofs:  proofs,
+	}, nil
+}
+
+func (ws *regTestSpace) getProof(challenge pocutil.Hash) (*engine.WorkSpaceProof, bool) {
+	proof, ok := ws.proofs[pocutil.CutHash(challenge, poc.RegTestBitLength)]
+	if !ok {
+		return nil, false
+	}
+	return &engine.WorkSpaceProof{
+		SpaceID:   ws.sid,
+		Proof:     proof,
+		PublicKey: ws.privKey.PubKey(),
+	}, true
+}
+
+// regTestSpaces provides proofs and signatures for GenerateBlocks, new
+// workspace is plotted whenever existing ones have no proof for a challenge.
+type regTestSpaces struct {
+	mu     sync.Mutex
+	spaces []*regTestSpace
+	index  map[string]*regTestSpace
+}
+
+func newRegTestSpaces() *regTestSpaces {
+	return &regTestSpaces{index: make(map[string]*regTestSpace)}
+}
+
+func (rs *regTestSpaces) getBestProof(pocTemplate *blockchain.PoCTemplate, quit chan struct{}) (*ProofTemplate, error) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	var challenge = pocutil.Hash(pocTemplate.Challenge)
+	var slot = uint64(pocTemplate.Timestamp.Unix()) / pocSlot
+	var target = pocTemplate.GetTarget(pocTemplate.Timestamp)
+
+	for {
+		select {
+		case <-quit:
+			return nil, errQuitSolveBlock
+		default:
+		}
+
+		var best *ProofTemplate
+		for _, ws := range rs.spaces {
+			proof, ok := ws.getProof(challenge)
+			if !ok {
+				continue
+			}
+			quality := proof.Proof.GetQuality(slot, pocTemplate.Height)
+			if quality.Cmp(target) > 0 && (best == nil || quality.Cmp(best.quality) > 0) {
+				best = &ProofTemplate{proof: proof, time: pocTemplate.Timestamp, quality: quality}
+			}
+		}
+		if best != nil {
+			return best, nil
+		}
+
+		if len(rs.spaces) >= maxRegTestSpaces {
+			return nil, errNoValidProof
+		}
+		ws, err := newRegTestSpace()
+		if err != nil {
+			return nil, err
+		}
+		rs.spaces = append(rs.spaces, ws)
+		rs.index[ws.sid] = ws
+		logging.CPrint(logging.DEBUG, "new regtest workspace plotted",
+			logging.LogFormat{"sid": ws.sid, "count": len(rs.spaces)})
+	}
+}
+
+func (rs *regTestSpaces) signHeader(sid string, header *wire.BlockHeader) (*pocec.Signature, error) {
+	rs.mu.Lock()
+	ws, ok := rs.index[sid]
+	rs.mu.Unlock()
+	if !ok {
+		return nil, errNoValidProof
+	}
+	pocHash, err := header.PoCHash()
+	if err != nil {
+		return nil, err
+	}
+	return ws.privKey.Sign(wire.HashB(pocHash[:]))
+}
+
+// GenerateBlocks solves and submits n blocks on top of the best chain right
+// away, paying to payoutAddress.
+// It is only allowed on networks with minimum difficulty, where proofs come from
+// in-memory workspaces of poc.RegTestBitLength instead of the SpaceKeeper.
+func (m *PoCMiner) GenerateBlocks(n int, payoutAddress chainutil.Address) ([]*wire.Hash, error) {
+	if !config.ChainParams.ResetMinDifficulty {
+		return nil, ErrGenerateNotAllowed
+	}
+	if n <= 0 {
+		return nil, ErrInvalidBlockCount
+	}
+
+	m.generateMu.Lock()
+	defer m.generateMu.Unlock()
+	if m.regTestSpaces == nil {
+		m.regTestSpaces = newRegTestSpaces()
+	}
+
+	gen := NewPoCMiner(m.Name(), true, m.chain, m.syncManager, m.SpaceKeeper, m.newBlockCh, []chainutil.Address{payoutAddress})
+	gen.getBestProof = m.regTestSpaces.getBestProof
+	gen.signHeader = m.regTestSpaces.signHeader
+	gen.listeners = m.listeners
+
+	quit := make(chan struct{})
+	hashes := make([]*wire.Hash, 0, n)
+	for len(hashes) < n {
+		newBlock, minerReward, err := gen.solveBlock(payoutAddress, quit)
+		if err != nil {
+			return hashes, err
+		}
+		block := chainutil.NewBlock(newBlock)
+		if !gen.submitBlock(block, minerReward) {
+			return hashes, ErrBlockRejected
+		}
+		hashes = append(hashes, block.Hash())
+	}
+
+	logging.CPrint(logging.INFO, "generated blocks",
+		logging.LogFormat{"count": len(hashes), "height": m.chain.BestBlockHeight(), "payout_address": payoutAddress.EncodeAddress()})
+	return hashes, nil
+}
@@ -0,0 +1,43 @@
+package miner
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/Sukhavati-Labs/go-miner/poc"
+	"github.com/Sukhavati-Labs/go-miner/poc/pocutil"
+)
+
+func TestRegTestSpace(t *testing.T) {
+	if err := poc.SetMinBitLength(poc.RegTestBitLength); err != nil {
+		t.Fatal(err)
+	}
+	defer poc.SetMinBitLength(poc.MinValidBitLength)
+
+	ws, err := newRegTestSpace()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ws.proofs) == 0 {
+		t.Fatal("no proof plotted")
+	}
+
+	var found int
+	for i := 0; i < 100; i++ {
+		var challenge pocutil.Hash
+		if _, err := rand.Read(challenge[:]); err != nil {
+			t.Fatal(err)
+		}
+		proof, ok := ws.getProof(challenge)
+		if !ok {
+			continue
+		}
+		found++
+		if err := poc.VerifyProof(proof.Proof, pocutil.PubKeyHash(proof.PublicKey), challenge); err != nil {
+			t.Fatalf("invalid proof for challenge %s, %v", challenge, err)
+		}
+	}
+	if found == 0 {
+		t.Fatal("no proof found for 100 challenges")
+	}
+}
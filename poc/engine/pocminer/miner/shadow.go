@@ -0,0 +1,293 @@
+package miner
+
+import (
+	"bufio"
+	"encoding/json"
+	"math/big"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/Sukhavati-Labs/go-miner/blockchain"
+	"github.com/Sukhavati-Labs/go-miner/chainutil"
+	"github.com/Sukhavati-Labs/go-miner/logging"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/pocminer"
+	"github.com/Sukhavati-Labs/go-miner/wire"
+)
+
+const (
+	shadowSlotsFilename = "shadow_slots.log"
+
+	shadowResultWon  = "won"
+	shadowResultLost = "lost"
+)
+
+// shadowRecorder collects best qualities of slots evaluated by a shadow miner,
+// and settles each height against the block winning it on chain. Settled slots
+// are appended to a file as json lines, so that reports survive restarts.
+type shadowRecorder struct {
+	mu        sync.RWMutex
+	path      string
+	slots     []*pocminer.ShadowSlot
+	height    uint64              // height being solved, 0 if none
+	qualities map[uint64]*big.Int // slot -> best quality evaluated at height
+}
+
+// shadowEntry is a line of the shadow slots file.
+type shadowEntry struct {
+	Height        uint64    `json:"height"`
+	Slot          uint64    `json:"slot"`
+	Quality       *big.Int  `json:"quality"`
+	Found         bool      `json:"found"`
+	WinnerHash    string    `json:"winner_hash"`
+	WinnerSlot    uint64    `json:"winner_slot"`
+	WinnerQuality *big.Int  `json:"winner_quality"`
+	Won           bool      `json:"won"`
+	Time          time.Time `json:"time"`
+}
+
+func newShadowRecorder(path string) (*shadowRecorder, error) {
+	r := &shadowRecorder{
+		path:      path,
+		qualities: make(map[uint64]*big.Int),
+	}
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return r, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var entry shadowEntry
+		if err = json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			// the last line may be partially written on crash
+			logging.CPrint(logging.WARN, "skip broken shadow slot", logging.LogFormat{"path": path, "err": err})
+			continue
+		}
+		slot, err := entry.toSlot()
+		if err != nil {
+			logging.CPrint(logging.WARN, "skip broken shadow slot", logging.LogFormat{"path": path, "err": err})
+			continue
+		}
+		r.slots = append(r.slots, slot)
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// begin starts collecting qualities evaluated at height.
+func (r *shadowRecorder) begin(height uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.height = height
+	r.qualities = make(map[uint64]*big.Int)
+}
+
+// pending returns the height being solved.
+func (r *shadowRecorder) pending() (uint64, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.height, r.height != 0
+}
+
+// observe records quality if it is the best one evaluated in slot.
+func (r *shadowRecorder) observe(height, slot uint64, quality *big.Int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if height != r.height {
+		return
+	}
+	if best, ok := r.qualities[slot]; !ok || quality.Cmp(best) > 0 {
+		r.qualities[slot] = new(big.Int).Set(quality)
+	}
+}
+
+// settle compares the pending height with winner, found tells whether our
+// proof reached target in proofSlot.
+func (r *shadowRecorder) settle(winner *blockchain.BlockNode, proofSlot uint64, found bool) (*pocminer.ShadowSlot, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	winnerSlot := uint64(winner.Timestamp.Unix()) / pocSlot
+	slot := &pocminer.ShadowSlot{
+		Height:        r.height,
+		Slot:          winnerSlot,
+		Found:         found,
+		WinnerHash:    *winner.Hash,
+		WinnerSlot:    winnerSlot,
+		WinnerQuality: winner.Quality,
+		Time:          winner.Timestamp,
+	}
+	if found {
+		slot.Slot = proofSlot
+	}
+	slot.Quality = r.qualities[slot.Slot]
+	slot.Won = found && slot.Quality != nil &&
+		(slot.Slot < winnerSlot || (slot.Slot == winnerSlot && slot.Quality.Cmp(winner.Quality) > 0))
+
+	if err := r.append(slot); err != nil {
+		return nil, err
+	}
+	r.slots = append(r.slots, slot)
+	r.height = 0
+	r.qualities = make(map[uint64]*big.Int)
+	return slot, nil
+}
+
+// append writes slot to the end of file durably.
+func (r *shadowRecorder) append(slot *pocminer.ShadowSlot) error {
+	data, err := json.Marshal(newShadowEntry(slot))
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(r.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(append(data, '\n')); err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// report returns slots settled within [start, end), zero start or end is unbounded.
+func (r *shadowRecorder) report(start, end time.Time) []*pocminer.ShadowSlot {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	result := make([]*pocminer.ShadowSlot, 0)
+	for _, slot := range r.slots {
+		if !start.IsZero() && slot.Time.Before(start) {
+			continue
+		}
+		if !end.IsZero() && !slot.Time.Before(end) {
+			continue
+		}
+		result = append(result, slot)
+	}
+	return result
+}
+
+func newShadowEntry(slot *pocminer.ShadowSlot) *shadowEntry {
+	return &shadowEntry{
+		Height:        slot.Height,
+		Slot:          slot.Slot,
+		Quality:       slot.Quality,
+		Found:         slot.Found,
+		WinnerHash:    slot.WinnerHash.String(),
+		WinnerSlot:    slot.WinnerSlot,
+		WinnerQuality: slot.WinnerQuality,
+		Won:           slot.Won,
+		Time:          slot.Time,
+	}
+}
+
+func (entry *shadowEntry) toSlot() (*pocminer.ShadowSlot, error) {
+	hash, err := wire.NewHashFromStr(entry.WinnerHash)
+	if err != nil {
+		return nil, err
+	}
+	return &pocminer.ShadowSlot{
+		Height:        entry.Height,
+		Slot:          entry.Slot,
+		Quality:       entry.Quality,
+		Found:         entry.Found,
+		WinnerHash:    *hash,
+		WinnerSlot:    entry.WinnerSlot,
+		WinnerQuality: entry.WinnerQuality,
+		Won:           entry.Won,
+		Time:          entry.Time,
+	}, nil
+}
+
+func (m *PoCMiner) ShadowEnabled() bool {
+	return m.shadow != nil
+}
+
+func (m *PoCMiner) ShadowReport(start, end time.Time) []*pocminer.ShadowSlot {
+	if m.shadow == nil {
+		return nil
+	}
+	return m.shadow.report(start, end)
+}
+
+// shadowSolveBlock solves a block the same as normal mining, but settles it
+// against the block winning the height on chain instead of submitting it.
+func (m *PoCMiner) shadowSolveBlock(payoutAddress chainutil.Address, quit chan struct{}) {
+	block, _, err := m.solveBlock(payoutAddress, quit)
+	if err != nil && err != errQuitSolveBlock && err != errNoValidProof {
+		logging.CPrint(logging.WARN, "fail to solve block in shadow mode", logging.LogFormat{"err": err})
+		return
+	}
+	height, ok := m.shadow.pending()
+	if !ok {
+		return
+	}
+	winner, err := m.waitForBlock(height, quit)
+	if err != nil {
+		if err != errQuitSolveBlock {
+			logging.CPrint(logging.WARN, "fail to wait for block in shadow mode", logging.LogFormat{"height": height, "err": err})
+		}
+		return
+	}
+
+	var proofSlot uint64
+	if block != nil {
+		proofSlot = uint64(block.Header.Timestamp.Unix()) / pocSlot
+	}
+	slot, err := m.shadow.settle(winner, proofSlot, block != nil)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to record shadow slot", logging.LogFormat{"height": height, "err": err})
+		return
+	}
+	result := shadowResultLost
+	if slot.Won {
+		result = shadowResultWon
+	}
+	shadowSlotsCounter.WithLabelValues(result).Inc()
+	logging.CPrint(logging.INFO, "settle shadow slot",
+		logging.LogFormat{
+			"height":         slot.Height,
+			"slot":           slot.Slot,
+			"quality":        slot.Quality,
+			"found":          slot.Found,
+			"winner_slot":    slot.WinnerSlot,
+			"winner_quality": slot.WinnerQuality,
+			"result":         result,
+		})
+}
+
+// waitForBlock returns the block at height on best chain, waiting for it if
+// best chain is not that high.
+func (m *PoCMiner) waitForBlock(height uint64, quit chan struct{}) (*blockchain.BlockNode, error) {
+	for {
+		node := m.chain.BestBlockNode()
+		if node.Height >= height {
+			for node.Height > height && node.Parent != nil {
+				node = node.Parent
+			}
+			if node.Height != height {
+				return nil, errBlockNotFound
+			}
+			return node, nil
+		}
+		ch, err := m.chain.BlockWaiter(node.Height)
+		if err != nil {
+			// best chain moves on in the meantime
+			continue
+		}
+		select {
+		case <-quit:
+			return nil, errQuitSolveBlock
+		case <-ch:
+		}
+	}
+}
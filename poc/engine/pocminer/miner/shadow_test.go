@@ -0,0 +1,86 @@
+package miner
+
+import (
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Sukhavati-Labs/go-miner/blockchain"
+	"github.com/Sukhavati-Labs/go-miner/wire"
+)
+
+func TestShadowRecorder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), shadowSlotsFilename)
+	r, err := newShadowRecorder(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var slotTime = func(slot uint64) time.Time {
+		return time.Unix(int64(slot*pocSlot), 0)
+	}
+	var winner = func(slot uint64, quality int64) *blockchain.BlockNode {
+		hash := wire.DoubleHashH(big.NewInt(int64(slot)).Bytes())
+		return &blockchain.BlockNode{Hash: &hash, Timestamp: slotTime(slot), Quality: big.NewInt(quality)}
+	}
+
+	tests := []struct {
+		name      string
+		qualities map[uint64]int64
+		proofSlot uint64
+		found     bool
+		winner    *blockchain.BlockNode
+		slot      uint64
+		quality   *big.Int
+		won       bool
+	}{
+		{"earlier slot", map[uint64]int64{100: 5, 101: 80}, 101, true, winner(102, 90), 101, big.NewInt(80), true},
+		{"same slot better", map[uint64]int64{200: 95}, 200, true, winner(200, 90), 200, big.NewInt(95), true},
+		{"same slot worse", map[uint64]int64{300: 85}, 300, true, winner(300, 90), 300, big.NewInt(85), false},
+		{"later slot", map[uint64]int64{400: 5, 401: 99}, 401, true, winner(400, 90), 401, big.NewInt(99), false},
+		{"not found", map[uint64]int64{500: 5, 501: 7}, 0, false, winner(501, 90), 501, big.NewInt(7), false},
+		{"no proof", nil, 0, false, winner(600, 90), 600, nil, false},
+	}
+	for i, test := range tests {
+		height := uint64(i + 1)
+		r.begin(height)
+		for slot, quality := range test.qualities {
+			r.observe(height, slot, big.NewInt(quality))
+			r.observe(height, slot, big.NewInt(quality-1))
+			r.observe(height+1, slot, big.NewInt(quality+1))
+		}
+		slot, err := r.settle(test.winner, test.proofSlot, test.found)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if slot.Height != height || slot.Slot != test.slot || slot.Won != test.won {
+			t.Errorf("%s: got height %d, slot %d, won %v", test.name, slot.Height, slot.Slot, slot.Won)
+		}
+		if (slot.Quality == nil) != (test.quality == nil) || (slot.Quality != nil && slot.Quality.Cmp(test.quality) != 0) {
+			t.Errorf("%s: expect quality %v, got %v", test.name, test.quality, slot.Quality)
+		}
+		if _, ok := r.pending(); ok {
+			t.Errorf("%s: height is still pending after settled", test.name)
+		}
+	}
+
+	// settled slots survive restart
+	r, err = newShadowRecorder(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slots := r.report(time.Time{}, time.Time{})
+	if len(slots) != len(tests) {
+		t.Fatalf("expect %d slots after restart, got %d", len(tests), len(slots))
+	}
+	for i, slot := range slots {
+		if !slot.WinnerHash.IsEqual(tests[i].winner.Hash) || slot.Won != tests[i].won || !slot.Time.Equal(tests[i].winner.Timestamp) {
+			t.Errorf("%s: slot changed after restart", tests[i].name)
+		}
+	}
+
+	if slots = r.report(slotTime(200), slotTime(401)); len(slots) != 3 || slots[0].Height != 2 || slots[2].Height != 4 {
+		t.Errorf("unexpected slots reported within time range: %d", len(slots))
+	}
+}
@@ -3,12 +3,14 @@ package miner
 import (
 	"context"
 	"math/big"
+	"path/filepath"
 	"reflect"
 	"sync/atomic"
 	"time"
 
 	"github.com/Sukhavati-Labs/go-miner/blockchain"
 	"github.com/Sukhavati-Labs/go-miner/chainutil"
+	"github.com/Sukhavati-Labs/go-miner/config"
 	"github.com/Sukhavati-Labs/go-miner/logging"
 	"github.com/Sukhavati-Labs/go-miner/poc/engine"
 	"github.com/Sukhavati-Labs/go-miner/poc/engine/pocminer"
@@ -21,16 +23,46 @@ const (
 	TypeSyncMiner = "sync"
 )
 
+// NewSyncMiner returns a PoCMiner signing blocks by SpaceKeeper, an optional
+// *config.Config following other args enables the persistent signGuard and
+// payoutBook, and shadow mode if miner.shadow is set.
 func NewSyncMiner(args ...interface{}) (pocminer.PoCMiner, error) {
-	allowSolo, chain, syncManager, sk, newBlockCh, payoutAddresses, err := parsArgs(args...)
+	if len(args) != 6 && len(args) != 7 {
+		return nil, pocminer.ErrInvalidMinerArgs
+	}
+	allowSolo, chain, syncManager, sk, newBlockCh, payoutAddresses, err := parsArgs(args[:6]...)
 	if err != nil {
 		return nil, err
 	}
 	m := NewPoCMiner(TypeSyncMiner, allowSolo, chain, syncManager, sk, newBlockCh, payoutAddresses)
 	m.getBestProof = m.syncGetBestProof
+	if len(args) == 7 {
+		cfg, ok := args[6].(*config.Config)
+		if !ok {
+			logging.CPrint(logging.ERROR, "invalid sync miner config", logging.LogFormat{"err": pocminer.ErrInvalidMinerArgs})
+			return nil, pocminer.ErrInvalidMinerArgs
+		}
+		if m.signGuard, err = newSignGuardByConfig(cfg); err != nil {
+			return nil, err
+		}
+		if m.payouts, err = newPayoutBookByConfig(cfg, payoutAddresses); err != nil {
+			return nil, err
+		}
+		if cfg.Miner.Shadow {
+			if m.shadow, err = newShadowRecorder(filepath.Join(cfg.Db.DataDir, shadowSlotsFilename)); err != nil {
+				return nil, err
+			}
+		}
+	}
 	return m, nil
 }
 
+// newSignGuardByConfig saves signed heights in chain data dir, and claims
+// heights in miner.sign_lock_dir if configured.
+func newSignGuardByConfig(cfg *config.Config) (*pocminer.SignGuard, error) {
+	return pocminer.NewSignGuard(filepath.Join(cfg.Db.DataDir, pocminer.MinedHeightsFilename), cfg.Miner.SignLockDir)
+}
+
 func parsArgs(args ...interface{}) (allowSolo bool, chain Chain, syncManager SyncManager, sk spacekeeper.SpaceKeeper, newBlockCh chan *wire.Hash, payoutAddresses []chainutil.Address, err error) {
 	var failure = func() (bool, Chain, SyncManager, spacekeeper.SpaceKeeper, chan *wire.Hash, []chainutil.Address, error) {
 		expectedTypes := []reflect.Type{reflect.TypeOf(chain), reflect.TypeOf(syncManager), reflect.TypeOf(sk), reflect.TypeOf(newBlockCh), reflect.TypeOf(payoutAddresses)}
@@ -151,6 +183,13 @@ try:
 						bestProofIndex = i
 					}
 				}
+				slotProofsGauge.Set(float64(len(qualities)))
+				proofsCounter.Add(float64(len(qualities)))
+				bestQualityFloat, _ := new(big.Float).SetInt(bestQuality).Float64()
+				bestQualityGauge.Set(bestQualityFloat)
+				if m.shadow != nil {
+					m.shadow.observe(pocTemplate.Height, workSlot, bestQuality)
+				}
 
 				// compare with target
 				if bestQuality.Cmp(pocTemplate.GetTarget(pocTemplate.Timestamp)) > 0 {
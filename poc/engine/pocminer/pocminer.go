@@ -2,8 +2,14 @@ package pocminer
 
 import (
 	"errors"
+	"math/big"
+	"sync"
+	"time"
 
 	"github.com/Sukhavati-Labs/go-miner/chainutil"
+	"github.com/Sukhavati-Labs/go-miner/config"
+	"github.com/Sukhavati-Labs/go-miner/pocec"
+	"github.com/Sukhavati-Labs/go-miner/wire"
 )
 
 type PoCMiner interface {
@@ -14,10 +20,139 @@ type PoCMiner interface {
 	SetPayoutAddresses(addresses []chainutil.Address) error
 }
 
+// BlockGenerator is implemented by PoCMiners able to generate blocks on demand,
+// which is only allowed on regression test networks.
+type BlockGenerator interface {
+	GenerateBlocks(n int, payoutAddress chainutil.Address) ([]*wire.Hash, error)
+}
+
+// ShadowReporter is implemented by PoCMiners able to run in shadow mode, in
+// which blocks are solved but never signed or submitted.
+type ShadowReporter interface {
+	// ShadowEnabled tells whether the PoCMiner runs in shadow mode.
+	ShadowEnabled() bool
+	// ShadowReport returns slots settled within [start, end), in order of height.
+	ShadowReport(start, end time.Time) []*ShadowSlot
+}
+
+// ShadowSlot compares the best proof of a shadow miner for a block height with
+// the block winning that height on chain.
+type ShadowSlot struct {
+	Height        uint64
+	Slot          uint64   // slot our proof reached target in, or slot of the winning block
+	Quality       *big.Int // our best quality in Slot, nil if no proof is evaluated in Slot
+	Found         bool     // our proof reached target in Slot
+	WinnerHash    wire.Hash
+	WinnerSlot    uint64
+	WinnerQuality *big.Int
+	Won           bool // our block would have beaten the winning block
+	Time          time.Time
+}
+
+// Strategies of PayoutManager choosing payout address for each mined block,
+// the same as accepted by miner.payout_strategy.
+const (
+	// PayoutRoundRobin pays to addresses in turn.
+	PayoutRoundRobin = config.PayoutRoundRobin
+	// PayoutWeighted splits blocks among addresses in proportion to their weights.
+	PayoutWeighted = config.PayoutWeighted
+	// PayoutPublicKey pays to the address of public key which the block is mined by,
+	// addresses without public key are paid in turn for other public keys, or
+	// all addresses if there is none.
+	PayoutPublicKey = config.PayoutPublicKey
+)
+
+// PayoutAddress is an address receiving mining rewards.
+type PayoutAddress struct {
+	Address   chainutil.Address
+	Weight    uint32           // used by PayoutWeighted
+	PublicKey *pocec.PublicKey // used by PayoutPublicKey, nil for any public key
+}
+
+// PayoutManager is implemented by PoCMiners able to manage payout addresses at
+// runtime, changes are persisted across restarts.
+type PayoutManager interface {
+	PayoutStrategy() string
+	SetPayoutStrategy(strategy string) error
+	PayoutAddresses() []*PayoutAddress
+	AddPayoutAddress(payout *PayoutAddress) error
+	RemovePayoutAddress(address chainutil.Address) error
+}
+
+// ProofFound reports the best proof found for a block template.
+type ProofFound struct {
+	Height    uint64
+	SpaceID   string
+	PublicKey *pocec.PublicKey
+	BitLength int
+	Quality   *big.Int
+	Time      time.Time
+}
+
+// BlockSubmitted reports a mined block submitted to chain, Err is the reason
+// if it is not accepted.
+type BlockSubmitted struct {
+	Block    *chainutil.Block
+	Accepted bool
+	Reward   chainutil.Amount
+	Err      error
+}
+
+// Listener is notified of mining events, it should return quickly.
+type Listener interface {
+	OnProofFound(*ProofFound)
+	OnBlockSubmitted(*BlockSubmitted)
+}
+
+// Notifier is implemented by PoCMiners able to report mining events.
+type Notifier interface {
+	RegisterListener(listener Listener)
+	UnregisterListener(listener Listener)
+}
+
+// Listeners is a set of Listener safe for concurrent access.
+type Listeners struct {
+	mu        sync.RWMutex
+	listeners map[Listener]struct{}
+}
+
+func (ls *Listeners) Register(listener Listener) {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	if ls.listeners == nil {
+		ls.listeners = make(map[Listener]struct{})
+	}
+	ls.listeners[listener] = struct{}{}
+}
+
+func (ls *Listeners) Unregister(listener Listener) {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	delete(ls.listeners, listener)
+}
+
+func (ls *Listeners) NotifyProofFound(event *ProofFound) {
+	ls.mu.RLock()
+	defer ls.mu.RUnlock()
+	for listener := range ls.listeners {
+		listener.OnProofFound(event)
+	}
+}
+
+func (ls *Listeners) NotifyBlockSubmitted(event *BlockSubmitted) {
+	ls.mu.RLock()
+	defer ls.mu.RUnlock()
+	for listener := range ls.listeners {
+		listener.OnBlockSubmitted(event)
+	}
+}
+
 var (
 	ErrInvalidMinerType = errors.New("invalid Miner type")
 	ErrInvalidMinerArgs = errors.New("invalid Miner args")
 	ErrUnimplemented    = errors.New("unimplemented Miner interface")
+	ErrHeightSigned     = errors.New("height is not above the highest signed one of public key")
+	ErrHeightClaimed    = errors.New("height is claimed by another node for public key")
 )
 
 var (
@@ -0,0 +1,85 @@
+package pool
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const metadataTokenKey = "pool-token"
+
+// Credential authenticates a pool miner by token, shares of the miner
+// are credited to account.
+type Credential struct {
+	Account string
+	Token   string
+}
+
+// tokenCredentials attaches the token of pool miner to every request,
+// it is never sent without TLS.
+type tokenCredentials string
+
+func (t tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{metadataTokenKey: string(t)}, nil
+}
+
+func (t tokenCredentials) RequireTransportSecurity() bool {
+	return true
+}
+
+type accountKey struct{}
+
+// authenticatedAccount returns the account authenticated by tokenAuthenticator.
+func authenticatedAccount(ctx context.Context) string {
+	account, _ := ctx.Value(accountKey{}).(string)
+	return account
+}
+
+// tokenAuthenticator rejects requests not carrying the token of a pool miner,
+// and binds accepted requests to the account of the token.
+type tokenAuthenticator []Credential
+
+func (t tokenAuthenticator) authenticate(ctx context.Context) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, ErrUnauthenticated.Error())
+	}
+	for _, token := range md.Get(metadataTokenKey) {
+		for _, cred := range t {
+			if subtle.ConstantTimeCompare([]byte(token), []byte(cred.Token)) == 1 {
+				return context.WithValue(ctx, accountKey{}, cred.Account), nil
+			}
+		}
+	}
+	return nil, status.Error(codes.Unauthenticated, ErrUnauthenticated.Error())
+}
+
+func (t tokenAuthenticator) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := t.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (t tokenAuthenticator) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := t.authenticate(ss.Context())
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+}
+
+// authenticatedStream carries the authenticated account in its context.
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,22 @@
+package pool
+
+import "errors"
+
+var (
+	ErrNoPayoutAddresses = errors.New("can not mine without payout addresses")
+	ErrNoJob             = errors.New("no job for the challenge")
+	ErrStaleJob          = errors.New("share for stale job")
+	ErrDuplicateShare    = errors.New("duplicate share")
+	ErrLowQualityShare   = errors.New("share quality is lower than share target")
+	ErrUnknownShare      = errors.New("no share for the workspace")
+	ErrMinerOffline      = errors.New("pool miner is offline")
+	ErrSignTimeout       = errors.New("pool miner does not sign in time")
+	ErrInvalidSignature  = errors.New("invalid signature from pool miner")
+	ErrHeaderMismatch    = errors.New("block header does not match submitted share")
+	ErrEmptyRound        = errors.New("no share in current round")
+	ErrHeaderSigned      = errors.New("another header is signed at the height")
+	ErrNoCredentials     = errors.New("no pool miner credentials")
+	ErrEmptyToken        = errors.New("pool token is empty")
+	ErrUnauthenticated   = errors.New("invalid pool token")
+	ErrAccountMismatch   = errors.New("account is not the one of pool token")
+)
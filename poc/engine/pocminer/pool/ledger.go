@@ -0,0 +1,91 @@
+package pool
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/Sukhavati-Labs/go-miner/chainutil"
+)
+
+// Ledger counts shares of the current round, and credits block rewards to
+// accounts in proportion to their share counts when a round is closed.
+type Ledger struct {
+	mu       sync.Mutex
+	shares   map[string]uint64
+	total    uint64
+	balances map[string]chainutil.Amount
+}
+
+func NewLedger() *Ledger {
+	return &Ledger{
+		shares:   make(map[string]uint64),
+		balances: make(map[string]chainutil.Amount),
+	}
+}
+
+func (l *Ledger) AddShare(account string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.shares[account]++
+	l.total++
+}
+
+// Shares returns share counts of the current round.
+func (l *Ledger) Shares() map[string]uint64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	result := make(map[string]uint64, len(l.shares))
+	for account, count := range l.shares {
+		result[account] = count
+	}
+	return result
+}
+
+// Balances returns rewards credited to accounts in all closed rounds.
+func (l *Ledger) Balances() map[string]chainutil.Amount {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	result := make(map[string]chainutil.Amount, len(l.balances))
+	for account, amount := range l.balances {
+		result[account] = amount
+	}
+	return result
+}
+
+// CloseRound splits reward by share counts and starts a new round,
+// the remainder of integer division is kept by pool.
+func (l *Ledger) CloseRound(reward chainutil.Amount) (map[string]chainutil.Amount, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.total == 0 {
+		return nil, ErrEmptyRound
+	}
+
+	payouts := make(map[string]chainutil.Amount, len(l.shares))
+	balances := make(map[string]chainutil.Amount, len(l.shares))
+	total := new(big.Int).SetUint64(l.total)
+	for account, count := range l.shares {
+		value := new(big.Int).SetUint64(reward.UintValue())
+		value.Mul(value, new(big.Int).SetUint64(count))
+		value.Div(value, total)
+		payout, err := chainutil.NewAmountFromUint(value.Uint64())
+		if err != nil {
+			return nil, err
+		}
+		balance, ok := l.balances[account]
+		if !ok {
+			balance = chainutil.ZeroAmount()
+		}
+		if balances[account], err = balance.Add(payout); err != nil {
+			return nil, err
+		}
+		payouts[account] = payout
+	}
+
+	for account, balance := range balances {
+		l.balances[account] = balance
+	}
+	l.shares = make(map[string]uint64)
+	l.total = 0
+	return payouts, nil
+}
@@ -0,0 +1,376 @@
+package pool
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/hex"
+	"math/big"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/Sukhavati-Labs/go-miner/chainutil"
+	"github.com/Sukhavati-Labs/go-miner/chainutil/service"
+	"github.com/Sukhavati-Labs/go-miner/config"
+	"github.com/Sukhavati-Labs/go-miner/logging"
+	"github.com/Sukhavati-Labs/go-miner/poc"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/pocminer"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/pocminer/pool/pb"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/spacekeeper"
+	"github.com/Sukhavati-Labs/go-miner/poc/pocutil"
+	"github.com/Sukhavati-Labs/go-miner/wire"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	TypePoolMiner = config.PoolPoCMinerBackend
+
+	reconnectInterval = time.Second * poc.PoCSlot
+	// recentJobs is the count of jobs whose shares are kept for signing
+	recentJobs = 8
+)
+
+type submittedShare struct {
+	job   *poolpb.Job
+	share *poolpb.Share
+}
+
+// signedHeader is the header last signed by a public key, kept for answering
+// retried requests of pool.
+type signedHeader struct {
+	height    uint64
+	pocHash   wire.Hash
+	signature []byte
+}
+
+// Miner is a PoCMiner mining for a pool, it answers jobs of pool with the
+// best proof of local SpaceKeeper, and signs blocks assembled by pool
+// only if they are built on its own share.
+//
+// Heights are claimed by signGuard before signing, so that a public key never
+// signs two distinct headers at the same height even if pool asks for it.
+type Miner struct {
+	*service.BaseService
+	quit            chan struct{}
+	wg              sync.WaitGroup
+	SpaceKeeper     spacekeeper.SpaceKeeper
+	poolAddress     string
+	poolTLS         *tls.Config
+	poolToken       string
+	conn            *grpc.ClientConn
+	client          poolpb.PoolClient
+	mu              sync.Mutex
+	payoutAddresses []chainutil.Address
+	shares          map[uint64]*submittedShare // job id -> share
+	cancelJob       context.CancelFunc
+	signGuard       *pocminer.SignGuard
+	signLock        sync.Mutex
+	signed          map[string]*signedHeader // compressed public key in hex -> last signed header
+}
+
+func NewPoolMiner(args ...interface{}) (pocminer.PoCMiner, error) {
+	sk, payoutAddresses, cfg, err := parseArgs(args...)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Miner.PoolToken == "" {
+		return nil, ErrEmptyToken
+	}
+	poolTLS, err := chainutil.NewClientTLSConfig(cfg.Miner.PoolTlsCert)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to load certificate of pool", logging.LogFormat{"cert": cfg.Miner.PoolTlsCert, "err": err})
+		return nil, err
+	}
+	signGuard, err := pocminer.NewSignGuard(filepath.Join(cfg.Db.DataDir, pocminer.MinedHeightsFilename), cfg.Miner.SignLockDir)
+	if err != nil {
+		return nil, err
+	}
+	m := &Miner{
+		SpaceKeeper:     sk,
+		poolAddress:     cfg.Miner.PoolAddress,
+		poolTLS:         poolTLS,
+		poolToken:       cfg.Miner.PoolToken,
+		payoutAddresses: payoutAddresses,
+		shares:          make(map[uint64]*submittedShare),
+		signGuard:       signGuard,
+		signed:          make(map[string]*signedHeader),
+	}
+	m.BaseService = service.NewBaseService(m, TypePoolMiner)
+	return m, nil
+}
+
+// parseArgs accepts args of solo miners followed by *config.Config.
+func parseArgs(args ...interface{}) (spacekeeper.SpaceKeeper, []chainutil.Address, *config.Config, error) {
+	if len(args) != 7 {
+		return nil, nil, nil, pocminer.ErrInvalidMinerArgs
+	}
+	sk, ok := args[3].(spacekeeper.SpaceKeeper)
+	if !ok {
+		return nil, nil, nil, pocminer.ErrInvalidMinerArgs
+	}
+	payoutAddresses, ok := args[5].([]chainutil.Address)
+	if !ok {
+		return nil, nil, nil, pocminer.ErrInvalidMinerArgs
+	}
+	cfg, ok := args[6].(*config.Config)
+	if !ok {
+		return nil, nil, nil, pocminer.ErrInvalidMinerArgs
+	}
+	return sk, payoutAddresses, cfg, nil
+}
+
+func (m *Miner) OnStart() error {
+	if len(m.payoutAddresses) == 0 {
+		logging.CPrint(logging.ERROR, "can not start mining", logging.LogFormat{"err": ErrNoPayoutAddresses})
+		return ErrNoPayoutAddresses
+	}
+	if !m.SpaceKeeper.Started() {
+		if err := m.SpaceKeeper.Start(); err != nil {
+			return err
+		}
+	}
+
+	conn, err := grpc.Dial(m.poolAddress,
+		grpc.WithTransportCredentials(credentials.NewTLS(m.poolTLS)),
+		grpc.WithPerRPCCredentials(tokenCredentials(m.poolToken)))
+	if err != nil {
+		return err
+	}
+	m.conn = conn
+	m.client = poolpb.NewPoolClient(conn)
+	m.quit = make(chan struct{})
+	m.wg.Add(1)
+	go m.subscribe(m.quit)
+
+	logging.CPrint(logging.INFO, "pool miner started", logging.LogFormat{"pool": m.poolAddress})
+	return nil
+}
+
+func (m *Miner) OnStop() error {
+	close(m.quit)
+	m.wg.Wait()
+	m.conn.Close()
+
+	logging.CPrint(logging.INFO, "pool miner stopped")
+	return nil
+}
+
+func (m *Miner) Type() string {
+	return m.Name()
+}
+
+func (m *Miner) SetPayoutAddresses(addresses []chainutil.Address) error {
+	if len(addresses) == 0 {
+		return ErrNoPayoutAddresses
+	}
+	m.mu.Lock()
+	m.payoutAddresses = addresses
+	m.mu.Unlock()
+	return nil
+}
+
+// account is the first payout address, it should be the account of pool token,
+// which pool credits rewards to.
+func (m *Miner) account() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.payoutAddresses[0].EncodeAddress()
+}
+
+// subscribe receives works from pool until quit, and reconnects on failure.
+func (m *Miner) subscribe(quit chan struct{}) {
+	defer m.wg.Done()
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-quit
+		cancel()
+	}()
+
+	for {
+		stream, err := m.client.Subscribe(ctx, &poolpb.SubscribeRequest{Account: m.account()})
+		if err == nil {
+			err = m.receive(stream)
+		}
+		select {
+		case <-quit:
+			logging.CPrint(logging.TRACE, "pool subscription done")
+			return
+		default:
+		}
+		logging.CPrint(logging.WARN, "lost connection to pool, retrying", logging.LogFormat{"pool": m.poolAddress, "err": err})
+		select {
+		case <-quit:
+			return
+		case <-time.After(reconnectInterval):
+		}
+	}
+}
+
+func (m *Miner) receive(stream poolpb.Pool_SubscribeClient) error {
+	for {
+		work, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		switch w := work.Work.(type) {
+		case *poolpb.Work_Job:
+			m.handleJob(w.Job)
+		case *poolpb.Work_Sign:
+			go m.handleSign(w.Sign)
+		}
+	}
+}
+
+// handleJob abandons previous job, since pool only accepts shares for current job.
+func (m *Miner) handleJob(job *poolpb.Job) {
+	m.mu.Lock()
+	if m.cancelJob != nil {
+		m.cancelJob()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*poc.PoCSlot)
+	m.cancelJob = cancel
+	m.mu.Unlock()
+
+	go func() {
+		defer cancel()
+		if err := m.mineJob(ctx, job); err != nil {
+			logging.CPrint(logging.DEBUG, "no share for pool job", logging.LogFormat{"job": job.JobId, "height": job.Height, "err": err})
+		}
+	}()
+}
+
+func (m *Miner) mineJob(ctx context.Context, job *poolpb.Job) error {
+	var challenge pocutil.Hash
+	copy(challenge[:], job.Challenge)
+	slot := uint64(job.Timestamp) / poc.PoCSlot
+	shareTarget := new(big.Int).SetBytes(job.ShareTarget)
+
+	proofs, err := m.SpaceKeeper.GetProofs(ctx, engine.SFMining, challenge)
+	if err != nil {
+		return err
+	}
+	var best *engine.WorkSpaceProof
+	var bestQuality = big.NewInt(0)
+	for _, proof := range proofs {
+		if proof.Error != nil {
+			continue
+		}
+		quality, err := proof.Proof.GetVerifiedQuality(pocutil.PubKeyHash(proof.PublicKey), challenge, slot, job.Height)
+		if err != nil {
+			continue
+		}
+		if quality.Cmp(bestQuality) > 0 {
+			best, bestQuality = proof, quality
+		}
+	}
+	if best == nil || bestQuality.Cmp(shareTarget) <= 0 {
+		return ErrLowQualityShare
+	}
+
+	share := &poolpb.Share{
+		JobId:     job.JobId,
+		Account:   m.account(),
+		SpaceId:   best.SpaceID,
+		PublicKey: best.PublicKey.SerializeCompressed(),
+		Ordinal:   best.Ordinal,
+		X:         best.Proof.X,
+		XPrime:    best.Proof.XPrime,
+		BitLength: int32(best.Proof.BitLength),
+	}
+	m.mu.Lock()
+	m.shares[job.JobId] = &submittedShare{job: job, share: share}
+	for id := range m.shares {
+		if id+recentJobs <= job.JobId {
+			delete(m.shares, id)
+		}
+	}
+	m.mu.Unlock()
+
+	result, err := m.client.SubmitShare(ctx, share)
+	if err != nil {
+		return err
+	}
+	logging.CPrint(logging.INFO, "share submitted to pool", logging.LogFormat{
+		"job":      job.JobId,
+		"height":   job.Height,
+		"sid":      best.SpaceID,
+		"accepted": result.Accepted,
+		"reason":   result.Reason,
+	})
+	return nil
+}
+
+func (m *Miner) handleSign(req *poolpb.SignRequest) {
+	resp := &poolpb.Signature{RequestId: req.RequestId}
+	sig, err := m.signHeader(req)
+	if err != nil {
+		logging.CPrint(logging.WARN, "refuse to sign for pool", logging.LogFormat{"job": req.JobId, "sid": req.SpaceId, "err": err})
+		resp.Error = err.Error()
+	} else {
+		resp.Signature = sig
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), signTimeout)
+	defer cancel()
+	if _, err = m.client.SubmitSignature(ctx, resp); err != nil {
+		logging.CPrint(logging.WARN, "fail to submit signature to pool", logging.LogFormat{"job": req.JobId, "err": err})
+	}
+}
+
+// signHeader signs header only if it is built on the share submitted for the job.
+func (m *Miner) signHeader(req *poolpb.SignRequest) ([]byte, error) {
+	m.mu.Lock()
+	submitted, ok := m.shares[req.JobId]
+	m.mu.Unlock()
+	if !ok || submitted.share.SpaceId != req.SpaceId {
+		return nil, ErrUnknownShare
+	}
+
+	header, err := decodeHeader(req.Header)
+	if err != nil {
+		return nil, err
+	}
+	share, job := submitted.share, submitted.job
+	if header.height != job.Height ||
+		!bytes.Equal(header.previous[:], job.Previous) ||
+		header.slot != uint64(job.Timestamp)/poc.PoCSlot ||
+		!bytes.Equal(header.challenge[:], job.Challenge) ||
+		!bytes.Equal(header.pubKey.SerializeCompressed(), share.PublicKey) ||
+		header.proof.BitLength != int(share.BitLength) ||
+		!bytes.Equal(header.proof.X, share.X) || !bytes.Equal(header.proof.XPrime, share.XPrime) {
+		return nil, ErrHeaderMismatch
+	}
+
+	// claiming and signing are serialized, so that a retried request never
+	// races with the request being signed
+	m.signLock.Lock()
+	defer m.signLock.Unlock()
+	key := hex.EncodeToString(share.PublicKey)
+	if last, ok := m.signed[key]; ok && last.height == header.height {
+		if last.pocHash != header.pocHash {
+			return nil, ErrHeaderSigned
+		}
+		return last.signature, nil
+	}
+	if err = m.signGuard.Claim(header.pubKey, header.height); err != nil {
+		return nil, err
+	}
+
+	sig, err := m.SpaceKeeper.SignHash(req.SpaceId, header.pocHash)
+	if err != nil {
+		return nil, err
+	}
+	m.signed[key] = &signedHeader{height: header.height, pocHash: header.pocHash, signature: sig.Serialize()}
+	logging.CPrint(logging.INFO, "signed block for pool", logging.LogFormat{"height": header.height, "sid": req.SpaceId})
+	return m.signed[key].signature, nil
+}
+
+func init() {
+	pocminer.AddPoCMinerBackend(pocminer.Backend{
+		Typ:         TypePoolMiner,
+		NewPoCMiner: NewPoolMiner,
+	})
+}
@@ -0,0 +1,740 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pool.proto
+
+package poolpb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type SubscribeRequest struct {
+	// account is the payout address of the miner, it should be either empty
+	// or the account of pool token
+	Account              string   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
+func (m *SubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeRequest) ProtoMessage()    {}
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a14d8612184524f, []int{0}
+}
+func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeRequest.Unmarshal(m, b)
+}
+func (m *SubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeRequest.Marshal(b, m, deterministic)
+}
+func (m *SubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeRequest.Merge(m, src)
+}
+func (m *SubscribeRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeRequest.Size(m)
+}
+func (m *SubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeRequest proto.InternalMessageInfo
+
+func (m *SubscribeRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+type Job struct {
+	JobId     uint64 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Height    uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Previous  []byte `protobuf:"bytes,3,opt,name=previous,proto3" json:"previous,omitempty"`
+	Challenge []byte `protobuf:"bytes,4,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	// timestamp in unix seconds, shares are evaluated at its slot
+	Timestamp int64 `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// shares must have quality greater than share_target (big-endian)
+	ShareTarget          []byte   `protobuf:"bytes,6,opt,name=share_target,json=shareTarget,proto3" json:"share_target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Job) Reset()         { *m = Job{} }
+func (m *Job) String() string { return proto.CompactTextString(m) }
+func (*Job) ProtoMessage()    {}
+func (*Job) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a14d8612184524f, []int{1}
+}
+func (m *Job) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Job.Unmarshal(m, b)
+}
+func (m *Job) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Job.Marshal(b, m, deterministic)
+}
+func (m *Job) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Job.Merge(m, src)
+}
+func (m *Job) XXX_Size() int {
+	return xxx_messageInfo_Job.Size(m)
+}
+func (m *Job) XXX_DiscardUnknown() {
+	xxx_messageInfo_Job.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Job proto.InternalMessageInfo
+
+func (m *Job) GetJobId() uint64 {
+	if m != nil {
+		return m.JobId
+	}
+	return 0
+}
+
+func (m *Job) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Job) GetPrevious() []byte {
+	if m != nil {
+		return m.Previous
+	}
+	return nil
+}
+
+func (m *Job) GetChallenge() []byte {
+	if m != nil {
+		return m.Challenge
+	}
+	return nil
+}
+
+func (m *Job) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *Job) GetShareTarget() []byte {
+	if m != nil {
+		return m.ShareTarget
+	}
+	return nil
+}
+
+type SignRequest struct {
+	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	JobId     uint64 `protobuf:"varint,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	SpaceId   string `protobuf:"bytes,3,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	// header is the assembled block header in packet format
+	Header               []byte   `protobuf:"bytes,4,opt,name=header,proto3" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignRequest) Reset()         { *m = SignRequest{} }
+func (m *SignRequest) String() string { return proto.CompactTextString(m) }
+func (*SignRequest) ProtoMessage()    {}
+func (*SignRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a14d8612184524f, []int{2}
+}
+func (m *SignRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignRequest.Unmarshal(m, b)
+}
+func (m *SignRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignRequest.Marshal(b, m, deterministic)
+}
+func (m *SignRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignRequest.Merge(m, src)
+}
+func (m *SignRequest) XXX_Size() int {
+	return xxx_messageInfo_SignRequest.Size(m)
+}
+func (m *SignRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignRequest proto.InternalMessageInfo
+
+func (m *SignRequest) GetRequestId() uint64 {
+	if m != nil {
+		return m.RequestId
+	}
+	return 0
+}
+
+func (m *SignRequest) GetJobId() uint64 {
+	if m != nil {
+		return m.JobId
+	}
+	return 0
+}
+
+func (m *SignRequest) GetSpaceId() string {
+	if m != nil {
+		return m.SpaceId
+	}
+	return ""
+}
+
+func (m *SignRequest) GetHeader() []byte {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type Work struct {
+	// Types that are valid to be assigned to Work:
+	//	*Work_Job
+	//	*Work_Sign
+	Work                 isWork_Work `protobuf_oneof:"work"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *Work) Reset()         { *m = Work{} }
+func (m *Work) String() string { return proto.CompactTextString(m) }
+func (*Work) ProtoMessage()    {}
+func (*Work) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a14d8612184524f, []int{3}
+}
+func (m *Work) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Work.Unmarshal(m, b)
+}
+func (m *Work) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Work.Marshal(b, m, deterministic)
+}
+func (m *Work) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Work.Merge(m, src)
+}
+func (m *Work) XXX_Size() int {
+	return xxx_messageInfo_Work.Size(m)
+}
+func (m *Work) XXX_DiscardUnknown() {
+	xxx_messageInfo_Work.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Work proto.InternalMessageInfo
+
+type isWork_Work interface {
+	isWork_Work()
+}
+
+type Work_Job struct {
+	Job *Job `protobuf:"bytes,1,opt,name=job,proto3,oneof" json:"job,omitempty"`
+}
+type Work_Sign struct {
+	Sign *SignRequest `protobuf:"bytes,2,opt,name=sign,proto3,oneof" json:"sign,omitempty"`
+}
+
+func (*Work_Job) isWork_Work()  {}
+func (*Work_Sign) isWork_Work() {}
+
+func (m *Work) GetWork() isWork_Work {
+	if m != nil {
+		return m.Work
+	}
+	return nil
+}
+
+func (m *Work) GetJob() *Job {
+	if x, ok := m.GetWork().(*Work_Job); ok {
+		return x.Job
+	}
+	return nil
+}
+
+func (m *Work) GetSign() *SignRequest {
+	if x, ok := m.GetWork().(*Work_Sign); ok {
+		return x.Sign
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Work) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Work_Job)(nil),
+		(*Work_Sign)(nil),
+	}
+}
+
+type Share struct {
+	JobId                uint64   `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Account              string   `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	SpaceId              string   `protobuf:"bytes,3,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	PublicKey            []byte   `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Ordinal              int64    `protobuf:"varint,5,opt,name=ordinal,proto3" json:"ordinal,omitempty"`
+	X                    []byte   `protobuf:"bytes,6,opt,name=x,proto3" json:"x,omitempty"`
+	XPrime               []byte   `protobuf:"bytes,7,opt,name=x_prime,json=xPrime,proto3" json:"x_prime,omitempty"`
+	BitLength            int32    `protobuf:"varint,8,opt,name=bit_length,json=bitLength,proto3" json:"bit_length,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Share) Reset()         { *m = Share{} }
+func (m *Share) String() string { return proto.CompactTextString(m) }
+func (*Share) ProtoMessage()    {}
+func (*Share) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a14d8612184524f, []int{4}
+}
+func (m *Share) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Share.Unmarshal(m, b)
+}
+func (m *Share) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Share.Marshal(b, m, deterministic)
+}
+func (m *Share) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Share.Merge(m, src)
+}
+func (m *Share) XXX_Size() int {
+	return xxx_messageInfo_Share.Size(m)
+}
+func (m *Share) XXX_DiscardUnknown() {
+	xxx_messageInfo_Share.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Share proto.InternalMessageInfo
+
+func (m *Share) GetJobId() uint64 {
+	if m != nil {
+		return m.JobId
+	}
+	return 0
+}
+
+func (m *Share) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *Share) GetSpaceId() string {
+	if m != nil {
+		return m.SpaceId
+	}
+	return ""
+}
+
+func (m *Share) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *Share) GetOrdinal() int64 {
+	if m != nil {
+		return m.Ordinal
+	}
+	return 0
+}
+
+func (m *Share) GetX() []byte {
+	if m != nil {
+		return m.X
+	}
+	return nil
+}
+
+func (m *Share) GetXPrime() []byte {
+	if m != nil {
+		return m.XPrime
+	}
+	return nil
+}
+
+func (m *Share) GetBitLength() int32 {
+	if m != nil {
+		return m.BitLength
+	}
+	return 0
+}
+
+type ShareResult struct {
+	Accepted             bool     `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShareResult) Reset()         { *m = ShareResult{} }
+func (m *ShareResult) String() string { return proto.CompactTextString(m) }
+func (*ShareResult) ProtoMessage()    {}
+func (*ShareResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a14d8612184524f, []int{5}
+}
+func (m *ShareResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShareResult.Unmarshal(m, b)
+}
+func (m *ShareResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShareResult.Marshal(b, m, deterministic)
+}
+func (m *ShareResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShareResult.Merge(m, src)
+}
+func (m *ShareResult) XXX_Size() int {
+	return xxx_messageInfo_ShareResult.Size(m)
+}
+func (m *ShareResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShareResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShareResult proto.InternalMessageInfo
+
+func (m *ShareResult) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
+	}
+	return false
+}
+
+func (m *ShareResult) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type Signature struct {
+	RequestId            uint64   `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Signature            []byte   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Signature) Reset()         { *m = Signature{} }
+func (m *Signature) String() string { return proto.CompactTextString(m) }
+func (*Signature) ProtoMessage()    {}
+func (*Signature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a14d8612184524f, []int{6}
+}
+func (m *Signature) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Signature.Unmarshal(m, b)
+}
+func (m *Signature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Signature.Marshal(b, m, deterministic)
+}
+func (m *Signature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Signature.Merge(m, src)
+}
+func (m *Signature) XXX_Size() int {
+	return xxx_messageInfo_Signature.Size(m)
+}
+func (m *Signature) XXX_DiscardUnknown() {
+	xxx_messageInfo_Signature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Signature proto.InternalMessageInfo
+
+func (m *Signature) GetRequestId() uint64 {
+	if m != nil {
+		return m.RequestId
+	}
+	return 0
+}
+
+func (m *Signature) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *Signature) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type SignatureResult struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignatureResult) Reset()         { *m = SignatureResult{} }
+func (m *SignatureResult) String() string { return proto.CompactTextString(m) }
+func (*SignatureResult) ProtoMessage()    {}
+func (*SignatureResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a14d8612184524f, []int{7}
+}
+func (m *SignatureResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignatureResult.Unmarshal(m, b)
+}
+func (m *SignatureResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignatureResult.Marshal(b, m, deterministic)
+}
+func (m *SignatureResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignatureResult.Merge(m, src)
+}
+func (m *SignatureResult) XXX_Size() int {
+	return xxx_messageInfo_SignatureResult.Size(m)
+}
+func (m *SignatureResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignatureResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignatureResult proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*SubscribeRequest)(nil), "poolpb.SubscribeRequest")
+	proto.RegisterType((*Job)(nil), "poolpb.Job")
+	proto.RegisterType((*SignRequest)(nil), "poolpb.SignRequest")
+	proto.RegisterType((*Work)(nil), "poolpb.Work")
+	proto.RegisterType((*Share)(nil), "poolpb.Share")
+	proto.RegisterType((*ShareResult)(nil), "poolpb.ShareResult")
+	proto.RegisterType((*Signature)(nil), "poolpb.Signature")
+	proto.RegisterType((*SignatureResult)(nil), "poolpb.SignatureResult")
+}
+
+func init() { proto.RegisterFile("pool.proto", fileDescriptor_8a14d8612184524f) }
+
+var fileDescriptor_8a14d8612184524f = []byte{
+	// 535 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xd1, 0x6e, 0xd3, 0x30,
+	0x14, 0x9d, 0xd7, 0x34, 0x6d, 0x6e, 0x8a, 0xc6, 0x3c, 0x60, 0xa1, 0xda, 0x44, 0xc9, 0x53, 0x91,
+	0x50, 0x05, 0x45, 0x3c, 0xf2, 0x00, 0x4f, 0xdb, 0xe0, 0x61, 0x72, 0x91, 0x90, 0x78, 0xa0, 0xb2,
+	0x53, 0xab, 0x75, 0x9b, 0xc6, 0xc1, 0x71, 0x46, 0xf7, 0x55, 0xfb, 0x17, 0xbe, 0x08, 0xd9, 0x71,
+	0xd2, 0x0c, 0x04, 0xbc, 0xe5, 0x9c, 0xeb, 0x7b, 0x73, 0xcf, 0x39, 0x36, 0x40, 0x2e, 0x65, 0x3a,
+	0xc9, 0x95, 0xd4, 0x12, 0xfb, 0xe6, 0x3b, 0x67, 0xf1, 0x4b, 0x78, 0x38, 0x2b, 0x59, 0x91, 0x28,
+	0xc1, 0x38, 0xe1, 0xdf, 0x4b, 0x5e, 0x68, 0x1c, 0x41, 0x8f, 0x26, 0x89, 0x2c, 0x33, 0x1d, 0xa1,
+	0x11, 0x1a, 0x07, 0xa4, 0x86, 0xf1, 0x1d, 0x82, 0xce, 0x95, 0x64, 0xf8, 0x31, 0xf8, 0x6b, 0xc9,
+	0xe6, 0x62, 0x61, 0x0f, 0x78, 0xa4, 0xbb, 0x96, 0xec, 0x72, 0x81, 0x9f, 0x80, 0xbf, 0xe2, 0x62,
+	0xb9, 0xd2, 0xd1, 0xa1, 0xa5, 0x1d, 0xc2, 0x43, 0xe8, 0xe7, 0x8a, 0xdf, 0x08, 0x59, 0x16, 0x51,
+	0x67, 0x84, 0xc6, 0x03, 0xd2, 0x60, 0x7c, 0x06, 0x41, 0xb2, 0xa2, 0x69, 0xca, 0xb3, 0x25, 0x8f,
+	0x3c, 0x5b, 0xdc, 0x13, 0xa6, 0xaa, 0xc5, 0x96, 0x17, 0x9a, 0x6e, 0xf3, 0xa8, 0x3b, 0x42, 0xe3,
+	0x0e, 0xd9, 0x13, 0xf8, 0x39, 0x0c, 0x8a, 0x15, 0x55, 0x7c, 0xae, 0xa9, 0x5a, 0x72, 0x1d, 0xf9,
+	0xb6, 0x3d, 0xb4, 0xdc, 0x67, 0x4b, 0xc5, 0x37, 0x10, 0xce, 0xc4, 0x32, 0xab, 0xa5, 0x9d, 0x03,
+	0xa8, 0xea, 0x73, 0xbf, 0x7c, 0xe0, 0x98, 0xcb, 0x45, 0x4b, 0xd7, 0x61, 0x5b, 0xd7, 0x53, 0xe8,
+	0x17, 0x39, 0x4d, 0xb8, 0x29, 0x74, 0x2a, 0x47, 0x2c, 0xae, 0x25, 0xd3, 0x05, 0x57, 0x6e, 0x77,
+	0x87, 0xe2, 0xaf, 0xe0, 0x7d, 0x91, 0x6a, 0x83, 0x9f, 0x41, 0x67, 0x2d, 0x99, 0xfd, 0x53, 0x38,
+	0x0d, 0x27, 0x95, 0xeb, 0x93, 0x2b, 0xc9, 0x2e, 0x0e, 0x88, 0xa9, 0xe0, 0x17, 0xe0, 0x15, 0x62,
+	0x99, 0xd9, 0x1f, 0x86, 0xd3, 0x93, 0xfa, 0x44, 0x6b, 0xe9, 0x8b, 0x03, 0x62, 0x8f, 0x7c, 0xf0,
+	0xc1, 0xfb, 0x21, 0xd5, 0x26, 0xfe, 0x89, 0xa0, 0x3b, 0x33, 0x1a, 0xff, 0x96, 0x43, 0x2b, 0xc0,
+	0xc3, 0x7b, 0x01, 0xfe, 0x4b, 0xc9, 0x39, 0x40, 0x5e, 0xb2, 0x54, 0x24, 0xf3, 0x0d, 0xbf, 0xad,
+	0x93, 0xa8, 0x98, 0x8f, 0xfc, 0xd6, 0xcc, 0x94, 0x6a, 0x21, 0x32, 0x9a, 0xba, 0x1c, 0x6a, 0x88,
+	0x07, 0x80, 0x76, 0xce, 0x7a, 0xb4, 0xc3, 0xa7, 0xd0, 0xdb, 0xcd, 0x73, 0x25, 0xb6, 0x3c, 0xea,
+	0x55, 0x8e, 0xec, 0xae, 0x0d, 0x32, 0xf3, 0x99, 0xd0, 0x73, 0x93, 0xab, 0x5e, 0x45, 0xfd, 0x11,
+	0x1a, 0x77, 0x49, 0xc0, 0x84, 0xfe, 0x64, 0x89, 0xf8, 0x3d, 0x84, 0x56, 0x13, 0xe1, 0x45, 0x99,
+	0xda, 0x2b, 0x43, 0x93, 0x84, 0xe7, 0x9a, 0x57, 0xda, 0xfa, 0xa4, 0xc1, 0xc6, 0x73, 0xc5, 0x69,
+	0x21, 0x33, 0xa7, 0xce, 0xa1, 0xf8, 0x1b, 0x04, 0xc6, 0x36, 0xaa, 0x4b, 0xc5, 0xff, 0x97, 0xf4,
+	0x19, 0x04, 0x45, 0x7d, 0xd6, 0x8e, 0x19, 0x90, 0x3d, 0x81, 0x1f, 0x41, 0x97, 0x2b, 0x25, 0x95,
+	0xf3, 0xa8, 0x02, 0xf1, 0x31, 0x1c, 0x35, 0xf3, 0xab, 0x35, 0xa7, 0x77, 0x08, 0xbc, 0x6b, 0x29,
+	0x53, 0xfc, 0x16, 0x82, 0xe6, 0x1d, 0xe1, 0xa8, 0x49, 0xf1, 0xb7, 0xa7, 0x35, 0x1c, 0xd4, 0x15,
+	0x73, 0x39, 0x5e, 0x21, 0xfc, 0x1a, 0xc2, 0x59, 0xc9, 0xb6, 0x42, 0x57, 0x79, 0x3e, 0x68, 0x1a,
+	0x0d, 0x1c, 0x9e, 0xdc, 0x83, 0xce, 0x99, 0x77, 0x70, 0xe4, 0x5a, 0x9a, 0x75, 0x8f, 0xdb, 0xb7,
+	0xc6, 0x52, 0xc3, 0xd3, 0x3f, 0xa8, 0xaa, 0x9d, 0xf9, 0xf6, 0xfd, 0xbf, 0xf9, 0x35, 0x00, 0x63,
+	0x32, 0xef, 0x89, 0x0d, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// PoolClient is the client API for Pool service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PoolClient interface {
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Pool_SubscribeClient, error)
+	SubmitShare(ctx context.Context, in *Share, opts ...grpc.CallOption) (*ShareResult, error)
+	SubmitSignature(ctx context.Context, in *Signature, opts ...grpc.CallOption) (*SignatureResult, error)
+}
+
+type poolClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPoolClient(cc *grpc.ClientConn) PoolClient {
+	return &poolClient{cc}
+}
+
+func (c *poolClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Pool_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Pool_serviceDesc.Streams[0], "/poolpb.Pool/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &poolSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Pool_SubscribeClient interface {
+	Recv() (*Work, error)
+	grpc.ClientStream
+}
+
+type poolSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *poolSubscribeClient) Recv() (*Work, error) {
+	m := new(Work)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *poolClient) SubmitShare(ctx context.Context, in *Share, opts ...grpc.CallOption) (*ShareResult, error) {
+	out := new(ShareResult)
+	err := c.cc.Invoke(ctx, "/poolpb.Pool/SubmitShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *poolClient) SubmitSignature(ctx context.Context, in *Signature, opts ...grpc.CallOption) (*SignatureResult, error) {
+	out := new(SignatureResult)
+	err := c.cc.Invoke(ctx, "/poolpb.Pool/SubmitSignature", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PoolServer is the server API for Pool service.
+type PoolServer interface {
+	Subscribe(*SubscribeRequest, Pool_SubscribeServer) error
+	SubmitShare(context.Context, *Share) (*ShareResult, error)
+	SubmitSignature(context.Context, *Signature) (*SignatureResult, error)
+}
+
+// UnimplementedPoolServer can be embedded to have forward compatible implementations.
+type UnimplementedPoolServer struct {
+}
+
+func (*UnimplementedPoolServer) Subscribe(req *SubscribeRequest, srv Pool_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (*UnimplementedPoolServer) SubmitShare(ctx context.Context, req *Share) (*ShareResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitShare not implemented")
+}
+func (*UnimplementedPoolServer) SubmitSignature(ctx context.Context, req *Signature) (*SignatureResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitSignature not implemented")
+}
+
+func RegisterPoolServer(s *grpc.Server, srv PoolServer) {
+	s.RegisterService(&_Pool_serviceDesc, srv)
+}
+
+func _Pool_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PoolServer).Subscribe(m, &poolSubscribeServer{stream})
+}
+
+type Pool_SubscribeServer interface {
+	Send(*Work) error
+	grpc.ServerStream
+}
+
+type poolSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *poolSubscribeServer) Send(m *Work) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Pool_SubmitShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Share)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PoolServer).SubmitShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolpb.Pool/SubmitShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PoolServer).SubmitShare(ctx, req.(*Share))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pool_SubmitSignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Signature)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PoolServer).SubmitSignature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolpb.Pool/SubmitSignature",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PoolServer).SubmitSignature(ctx, req.(*Signature))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Pool_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "poolpb.Pool",
+	HandlerType: (*PoolServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitShare",
+			Handler:    _Pool_SubmitShare_Handler,
+		},
+		{
+			MethodName: "SubmitSignature",
+			Handler:    _Pool_SubmitSignature_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Pool_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "pool.proto",
+}
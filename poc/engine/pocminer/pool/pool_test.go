@@ -0,0 +1,350 @@
+package pool_test
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Sukhavati-Labs/go-miner/chainutil"
+	"github.com/Sukhavati-Labs/go-miner/chainutil/service"
+	"github.com/Sukhavati-Labs/go-miner/config"
+	configpb "github.com/Sukhavati-Labs/go-miner/config/pb"
+	"github.com/Sukhavati-Labs/go-miner/poc"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/pocminer/pool"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/pocminer/pool/pb"
+	"github.com/Sukhavati-Labs/go-miner/poc/pocutil"
+	"github.com/Sukhavati-Labs/go-miner/pocec"
+	"github.com/Sukhavati-Labs/go-miner/wire"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testAccountA = "sk1qqggu42p34335mwrutv88t7fqh6sp5eqlawglmx457dhn0w7ks2nzsm0rq7q"
+	testAccountB = "sk1qq75qqxpq9qcrssqgzqvzq2ps8pqqqyqcyq5rqwzqpqgpsgpgxp58qhsmr8d"
+	testTokenA   = "token-a"
+	testTokenB   = "token-b"
+)
+
+// newTestServer starts a pool server with a generated certificate pair,
+// and returns the certificate file for pool miners.
+func newTestServer(t *testing.T) (*pool.Server, string) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "pool.cert")
+	tlsConfig, _, err := chainutil.LoadTLSConfig(certFile, filepath.Join(dir, "pool.key"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	creds := []pool.Credential{{Account: testAccountA, Token: testTokenA}, {Account: testAccountB, Token: testTokenB}}
+	srv, err := pool.NewServer("127.0.0.1:0", tlsConfig, creds, pool.DefaultShareFactor, 500*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = srv.Start(); err != nil {
+		t.Fatal(err)
+	}
+	return srv, certFile
+}
+
+func TestLedger(t *testing.T) {
+	ledger := pool.NewLedger()
+	if _, err := ledger.CloseRound(chainutil.ZeroAmount()); err != pool.ErrEmptyRound {
+		t.Fatalf("expected %v, got %v", pool.ErrEmptyRound, err)
+	}
+	for i := 0; i < 3; i++ {
+		ledger.AddShare(testAccountA)
+	}
+	ledger.AddShare(testAccountB)
+	if shares := ledger.Shares(); shares[testAccountA] != 3 || shares[testAccountB] != 1 {
+		t.Fatalf("unexpected shares %v", shares)
+	}
+
+	reward, _ := chainutil.NewAmountFromUint(1001)
+	payouts, err := ledger.CloseRound(reward)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if payouts[testAccountA].UintValue() != 750 || payouts[testAccountB].UintValue() != 250 {
+		t.Fatalf("unexpected payouts %v", payouts)
+	}
+	if len(ledger.Shares()) != 0 {
+		t.Fatal("round not reset")
+	}
+
+	ledger.AddShare(testAccountB)
+	if _, err = ledger.CloseRound(reward); err != nil {
+		t.Fatal(err)
+	}
+	if balances := ledger.Balances(); balances[testAccountA].UintValue() != 750 || balances[testAccountB].UintValue() != 1251 {
+		t.Fatalf("unexpected balances %v", balances)
+	}
+}
+
+// mockKeeper holds a single valid proof, signatures are made by
+// a random key since the private key of the proof is unknown.
+type mockKeeper struct {
+	*service.BaseService
+	proof  *engine.WorkSpaceProof
+	signer *pocec.PrivateKey
+	signed chan string
+}
+
+func newMockKeeper(t *testing.T) (*mockKeeper, pocutil.Hash) {
+	challenge, err := pocutil.DecodeStringToHash("f17a8b5534fb1a9d34c831d0766fbc77b0b718500412c6647f48fda0dd8fa780")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkByte, _ := hex.DecodeString("02be7ff1bbbd42b808cb6b7de2d22cd53dea771c9c599fb034c7b15bae0ec53eb3")
+	pk, err := pocec.ParsePubKey(pkByte, pocec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := pocec.NewPrivateKey(pocec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &mockKeeper{
+		proof: &engine.WorkSpaceProof{
+			SpaceID:   "ws",
+			Proof:     &poc.Proof{X: []byte{0xeb, 0xd0, 0x8b, 0xeb}, XPrime: []byte{0x98, 0x87, 0x63, 0x0a}, BitLength: 32},
+			PublicKey: pk,
+			Ordinal:   1,
+		},
+		signer: signer,
+		signed: make(chan string, 1),
+	}
+	m.BaseService = service.NewBaseService(m, "mock")
+	return m, challenge
+}
+
+func (m *mockKeeper) Type() string { return m.Name() }
+func (m *mockKeeper) WorkSpaceIDs(engine.WorkSpaceStateFlags) ([]string, error) {
+	return []string{m.proof.SpaceID}, nil
+}
+func (m *mockKeeper) WorkSpaceInfos(engine.WorkSpaceStateFlags) ([]engine.WorkSpaceInfo, error) {
+	return nil, nil
+}
+func (m *mockKeeper) GetProof(context.Context, string, pocutil.Hash) (*engine.WorkSpaceProof, error) {
+	return m.proof, nil
+}
+func (m *mockKeeper) GetProofs(context.Context, engine.WorkSpaceStateFlags, pocutil.Hash) ([]*engine.WorkSpaceProof, error) {
+	return []*engine.WorkSpaceProof{m.proof}, nil
+}
+func (m *mockKeeper) GetProofReader(context.Context, string, pocutil.Hash) (engine.ProofReader, error) {
+	return nil, nil
+}
+func (m *mockKeeper) GetProofsReader(context.Context, engine.WorkSpaceStateFlags, pocutil.Hash) (engine.ProofReader, error) {
+	return nil, nil
+}
+func (m *mockKeeper) ActOnWorkSpace(string, engine.ActionType) error { return nil }
+func (m *mockKeeper) ActOnWorkSpaces(engine.WorkSpaceStateFlags, engine.ActionType) (map[string]error, error) {
+	return nil, nil
+}
+func (m *mockKeeper) SignHash(sid string, hash [32]byte) (*pocec.Signature, error) {
+	m.signed <- sid
+	return m.signer.Sign(hash[:])
+}
+
+func TestPool(t *testing.T) {
+	srv, certFile := newTestServer(t)
+	defer srv.Stop()
+
+	sk, challenge := newMockKeeper(t)
+	cfg := &config.Config{Config: configpb.NewConfig()}
+	cfg.Miner.PoolAddress = srv.Addr().String()
+	cfg.Miner.PoolToken = testTokenA
+	cfg.Miner.PoolTlsCert = certFile
+	cfg.Db.DataDir = t.TempDir()
+	account, err := chainutil.DecodeAddress(testAccountA, &config.ChainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := pool.NewPoolMiner(false, nil, nil, sk, nil, []chainutil.Address{account}, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = m.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer m.Stop()
+	// wait for subscription
+	time.Sleep(200 * time.Millisecond)
+
+	var height uint64 = 10
+	previous := wire.Hash{1}
+	// starts at a slot, so that the timestamp could be moved within the slot
+	timestamp := time.Unix(time.Now().Unix()/poc.PoCSlot*poc.PoCSlot, 0)
+	srv.NewJob(height, previous, wire.Hash(challenge), timestamp, big.NewInt(0))
+	proofs, err := srv.GetProofs(context.Background(), engine.SFMining, challenge)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(proofs) != 1 || proofs[0].SpaceID != sk.proof.SpaceID {
+		t.Fatalf("unexpected proofs %+v", proofs)
+	}
+	if shares := srv.Ledger().Shares(); shares[testAccountA] != 1 {
+		t.Fatalf("unexpected shares %v", shares)
+	}
+
+	header := wire.NewEmptyBlockHeader()
+	header.Height = height
+	header.Previous = previous
+	header.Timestamp = timestamp
+	header.Challenge = wire.Hash(challenge)
+	header.PubKey = sk.proof.PublicKey
+	header.Proof = sk.proof.Proof
+
+	// miner signs for its own share, and pool checks the signature
+	if _, err = srv.SignHeader(sk.proof.SpaceID, header); err != pool.ErrInvalidSignature {
+		t.Fatalf("expected %v, got %v", pool.ErrInvalidSignature, err)
+	}
+	if sid := <-sk.signed; sid != sk.proof.SpaceID {
+		t.Fatalf("unexpected signed workspace %s", sid)
+	}
+
+	// retried header gets the same signature without signing again
+	if _, err = srv.SignHeader(sk.proof.SpaceID, header); err != pool.ErrInvalidSignature {
+		t.Fatalf("expected %v, got %v", pool.ErrInvalidSignature, err)
+	}
+	if len(sk.signed) != 0 {
+		t.Fatalf("retried header is signed again")
+	}
+
+	// miner refuses another header at the signed height
+	header.Timestamp = timestamp.Add(time.Second)
+	if _, err = srv.SignHeader(sk.proof.SpaceID, header); err == nil || !strings.Contains(err.Error(), pool.ErrHeaderSigned.Error()) {
+		t.Fatalf("expected %v, got %v", pool.ErrHeaderSigned, err)
+	}
+	header.Timestamp = timestamp
+
+	// miner refuses headers not built on its share
+	for _, mutate := range []func(h *wire.BlockHeader){
+		func(h *wire.BlockHeader) { h.Height = height + 1 },
+		func(h *wire.BlockHeader) { h.Previous = wire.Hash{2} },
+		func(h *wire.BlockHeader) { h.Timestamp = timestamp.Add(time.Second * poc.PoCSlot) },
+	} {
+		mismatched := *header
+		mutate(&mismatched)
+		if _, err = srv.SignHeader(sk.proof.SpaceID, &mismatched); err == nil || !strings.Contains(err.Error(), pool.ErrHeaderMismatch.Error()) {
+			t.Fatalf("expected %v, got %v", pool.ErrHeaderMismatch, err)
+		}
+	}
+	header.Height = height + 1
+	if _, err = srv.SignHeader("unknown", header); err != pool.ErrUnknownShare {
+		t.Fatalf("expected %v, got %v", pool.ErrUnknownShare, err)
+	}
+}
+
+func TestPoolDuplicateShare(t *testing.T) {
+	srv, certFile := newTestServer(t)
+	defer srv.Stop()
+	tlsConfig, err := chainutil.NewClientTLSConfig(certFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn, err := grpc.Dial(srv.Addr().String(), grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+		grpc.WithPerRPCCredentials(testToken(testTokenA)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := poolpb.NewPoolClient(conn)
+
+	sk, challenge := newMockKeeper(t)
+	srv.NewJob(10, wire.Hash{}, wire.Hash(challenge), time.Now(), big.NewInt(0))
+	share := &poolpb.Share{
+		JobId:     1,
+		SpaceId:   sk.proof.SpaceID,
+		PublicKey: sk.proof.PublicKey.SerializeCompressed(),
+		Ordinal:   sk.proof.Ordinal,
+		X:         sk.proof.Proof.X,
+		XPrime:    sk.proof.Proof.XPrime,
+		BitLength: int32(sk.proof.Proof.BitLength),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	result, err := client.SubmitShare(ctx, share)
+	if err != nil || !result.Accepted {
+		t.Fatalf("expected share accepted, got %v %v", result, err)
+	}
+
+	// the same proof under another space id is not credited again
+	share.SpaceId = "another"
+	result, err = client.SubmitShare(ctx, share)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Accepted || result.Reason != pool.ErrDuplicateShare.Error() {
+		t.Fatalf("expected %v, got %v", pool.ErrDuplicateShare, result)
+	}
+	if shares := srv.Ledger().Shares(); shares[testAccountA] != 1 {
+		t.Fatalf("unexpected shares %v", shares)
+	}
+}
+
+func TestPoolAuth(t *testing.T) {
+	srv, certFile := newTestServer(t)
+	defer srv.Stop()
+	tlsConfig, err := chainutil.NewClientTLSConfig(certFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var subscribe = func(token, account string) error {
+		conn, err := grpc.Dial(srv.Addr().String(), grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+			grpc.WithPerRPCCredentials(testToken(token)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Close()
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		stream, err := poolpb.NewPoolClient(conn).Subscribe(ctx, &poolpb.SubscribeRequest{Account: account})
+		if err != nil {
+			return err
+		}
+		srv.NewJob(1, wire.Hash{}, wire.Hash{}, time.Now(), big.NewInt(1))
+		_, err = stream.Recv()
+		return err
+	}
+	if err = subscribe("invalid", testAccountA); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("invalid token, expected %v, got %v", codes.Unauthenticated, err)
+	}
+	// sessions are bound to the account of token
+	if err = subscribe(testTokenB, testAccountA); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("account of another token, expected %v, got %v", codes.PermissionDenied, err)
+	}
+	if err = subscribe(testTokenB, ""); err != nil {
+		t.Errorf("expected job for account of token, got %v", err)
+	}
+
+	// plaintext connections are refused
+	conn, err := grpc.Dial(srv.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if _, err = poolpb.NewPoolClient(conn).SubmitShare(ctx, &poolpb.Share{}); err == nil {
+		t.Error("expected plaintext request refused")
+	}
+}
+
+// testToken sends token of pool miners like the pool miner does.
+type testToken string
+
+func (t testToken) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"pool-token": string(t)}, nil
+}
+
+func (t testToken) RequireTransportSecurity() bool {
+	return true
+}
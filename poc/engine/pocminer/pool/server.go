@@ -0,0 +1,500 @@
+package pool
+
+import (
+	"context"
+	"crypto/tls"
+	"math/big"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/Sukhavati-Labs/go-miner/chainutil"
+	"github.com/Sukhavati-Labs/go-miner/chainutil/service"
+	"github.com/Sukhavati-Labs/go-miner/config"
+	"github.com/Sukhavati-Labs/go-miner/logging"
+	"github.com/Sukhavati-Labs/go-miner/poc"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/pocminer/pool/pb"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/spacekeeper"
+	"github.com/Sukhavati-Labs/go-miner/poc/pocutil"
+	"github.com/Sukhavati-Labs/go-miner/pocec"
+	"github.com/Sukhavati-Labs/go-miner/wire"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	TypePoolServer = config.PoolServerPoCMinerBackend
+
+	// DefaultShareFactor makes share target 64 times easier than block target
+	DefaultShareFactor = 64
+	// DefaultShareWindow is how long pool waits for shares of a new job
+	DefaultShareWindow = time.Second * poc.PoCSlot / 2
+
+	signTimeout    = time.Second * poc.PoCSlot
+	sessionBufSize = 16
+)
+
+type session struct {
+	account string
+	ch      chan *poolpb.Work
+}
+
+type pendingSign struct {
+	account string
+	ch      chan *poolpb.Signature
+}
+
+type share struct {
+	account string
+	proof   *engine.WorkSpaceProof
+}
+
+// shareKey identifies the plot proving a share, since space ids are made up
+// by pool miners.
+type shareKey struct {
+	pubKey    string // compressed public key
+	bitLength int
+}
+
+type job struct {
+	id          uint64
+	height      uint64
+	previous    wire.Hash
+	challenge   pocutil.Hash
+	timestamp   time.Time
+	slot        uint64
+	shareTarget *big.Int
+	shares      map[string]*share // space id -> share
+	proved      map[shareKey]struct{}
+}
+
+func (j *job) work() *poolpb.Work {
+	return &poolpb.Work{Work: &poolpb.Work_Job{Job: &poolpb.Job{
+		JobId:       j.id,
+		Height:      j.height,
+		Previous:    j.previous[:],
+		Challenge:   j.challenge[:],
+		Timestamp:   j.timestamp.Unix(),
+		ShareTarget: j.shareTarget.Bytes(),
+	}}}
+}
+
+// Server is a reference mining pool.
+//
+// Server implements spacekeeper.SpaceKeeper, so that a PoCMiner on the pool
+// node mines with shares submitted by pool miners: NewJob broadcasts the
+// template, GetProofs returns shares received in shareWindow, and SignHeader
+// asks the owner of the winning share to sign the block.
+//
+// Pool miners are served over TLS only, and each of them is authenticated by
+// its token, which binds the miner to the account its shares are credited to.
+type Server struct {
+	*service.BaseService
+	listen      string
+	tlsConfig   *tls.Config
+	auth        tokenAuthenticator
+	shareFactor int64
+	shareWindow time.Duration
+	ledger      *Ledger
+	grpcServer  *grpc.Server
+	addr        net.Addr
+
+	mu            sync.Mutex
+	sessions      map[string]*session // account -> session
+	job           *job
+	nextRequestID uint64
+	pending       map[uint64]*pendingSign
+}
+
+// NewServer returns a Server listening on listen with tlsConfig, accounts of
+// credentials should be valid addresses.
+func NewServer(listen string, tlsConfig *tls.Config, creds []Credential, shareFactor int64, shareWindow time.Duration) (*Server, error) {
+	if len(creds) == 0 {
+		return nil, ErrNoCredentials
+	}
+	for _, cred := range creds {
+		if cred.Token == "" {
+			return nil, ErrEmptyToken
+		}
+		if _, err := chainutil.DecodeAddress(cred.Account, &config.ChainParams); err != nil {
+			return nil, err
+		}
+	}
+	s := &Server{
+		listen:      listen,
+		tlsConfig:   tlsConfig,
+		auth:        tokenAuthenticator(creds),
+		shareFactor: shareFactor,
+		shareWindow: shareWindow,
+		ledger:      NewLedger(),
+		sessions:    make(map[string]*session),
+		pending:     make(map[uint64]*pendingSign),
+	}
+	s.BaseService = service.NewBaseService(s, TypePoolServer)
+	return s, nil
+}
+
+func (s *Server) OnStart() error {
+	lis, err := net.Listen("tcp", s.listen)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to listen for pool miners", logging.LogFormat{"addr": s.listen, "err": err})
+		return err
+	}
+	s.addr = lis.Addr()
+	s.grpcServer = grpc.NewServer(
+		grpc.Creds(credentials.NewTLS(s.tlsConfig)),
+		grpc.UnaryInterceptor(s.auth.unaryInterceptor),
+		grpc.StreamInterceptor(s.auth.streamInterceptor),
+	)
+	poolpb.RegisterPoolServer(s.grpcServer, s)
+	go func() {
+		if err := s.grpcServer.Serve(lis); err != nil {
+			logging.CPrint(logging.ERROR, "pool server stopped unexpectedly", logging.LogFormat{"err": err})
+		}
+	}()
+	logging.CPrint(logging.INFO, "pool server started", logging.LogFormat{"addr": lis.Addr().String()})
+	return nil
+}
+
+func (s *Server) OnStop() error {
+	s.grpcServer.Stop()
+	logging.CPrint(logging.INFO, "pool server stopped")
+	return nil
+}
+
+// Addr returns the address pool miners connect to, it is nil until started.
+func (s *Server) Addr() net.Addr {
+	return s.addr
+}
+
+func (s *Server) Ledger() *Ledger {
+	return s.ledger
+}
+
+// NewJob replaces current job and broadcasts it to all pool miners,
+// shares are evaluated at the slot of timestamp.
+func (s *Server) NewJob(height uint64, previous, challenge wire.Hash, timestamp time.Time, target *big.Int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var id uint64 = 1
+	if s.job != nil {
+		id = s.job.id + 1
+	}
+	s.job = &job{
+		id:          id,
+		height:      height,
+		previous:    previous,
+		challenge:   pocutil.Hash(challenge),
+		timestamp:   timestamp,
+		slot:        uint64(timestamp.Unix()) / poc.PoCSlot,
+		shareTarget: new(big.Int).Div(target, big.NewInt(s.shareFactor)),
+		shares:      make(map[string]*share),
+		proved:      make(map[shareKey]struct{}),
+	}
+	work := s.job.work()
+	for _, sess := range s.sessions {
+		s.send(sess, work)
+	}
+	logging.CPrint(logging.INFO, "new pool job", logging.LogFormat{"job": id, "height": height, "miners": len(s.sessions)})
+}
+
+// send never blocks, work is dropped for miners consuming too slowly.
+func (s *Server) send(sess *session, work *poolpb.Work) bool {
+	select {
+	case sess.ch <- work:
+		return true
+	default:
+		logging.CPrint(logging.WARN, "pool miner is too slow, work dropped", logging.LogFormat{"account": sess.account})
+		return false
+	}
+}
+
+// checkAccount returns the account authenticated for ctx, account claimed by
+// request should either be empty or the same.
+func checkAccount(ctx context.Context, claimed string) (string, error) {
+	account := authenticatedAccount(ctx)
+	if account == "" {
+		return "", status.Error(codes.Unauthenticated, ErrUnauthenticated.Error())
+	}
+	if claimed != "" && claimed != account {
+		return "", status.Error(codes.PermissionDenied, ErrAccountMismatch.Error())
+	}
+	return account, nil
+}
+
+// Subscribe implements poolpb.PoolServer, the session is bound to the
+// authenticated account.
+func (s *Server) Subscribe(in *poolpb.SubscribeRequest, stream poolpb.Pool_SubscribeServer) error {
+	account, err := checkAccount(stream.Context(), in.Account)
+	if err != nil {
+		return err
+	}
+	sess := &session{account: account, ch: make(chan *poolpb.Work, sessionBufSize)}
+
+	s.mu.Lock()
+	s.sessions[account] = sess
+	if s.job != nil {
+		s.send(sess, s.job.work())
+	}
+	s.mu.Unlock()
+	logging.CPrint(logging.INFO, "pool miner connected", logging.LogFormat{"account": account})
+
+	defer func() {
+		s.mu.Lock()
+		if s.sessions[account] == sess {
+			delete(s.sessions, account)
+		}
+		s.mu.Unlock()
+		logging.CPrint(logging.INFO, "pool miner disconnected", logging.LogFormat{"account": account})
+	}()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case work := <-sess.ch:
+			if err := stream.Send(work); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// SubmitShare implements poolpb.PoolServer, the share is credited to the
+// authenticated account.
+func (s *Server) SubmitShare(ctx context.Context, in *poolpb.Share) (*poolpb.ShareResult, error) {
+	account, err := checkAccount(ctx, in.Account)
+	if err != nil {
+		return nil, err
+	}
+	pk, err := pocec.ParsePubKey(in.PublicKey, pocec.S256())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	proof := &poc.Proof{X: in.X, XPrime: in.XPrime, BitLength: int(in.BitLength)}
+
+	reject := func(err error) (*poolpb.ShareResult, error) {
+		logging.CPrint(logging.DEBUG, "share rejected", logging.LogFormat{"account": account, "job": in.JobId, "err": err})
+		return &poolpb.ShareResult{Accepted: false, Reason: err.Error()}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.job == nil || s.job.id != in.JobId {
+		return reject(ErrStaleJob)
+	}
+	key := shareKey{pubKey: string(pk.SerializeCompressed()), bitLength: proof.BitLength}
+	if _, ok := s.job.shares[in.SpaceId]; ok {
+		return reject(ErrDuplicateShare)
+	}
+	if _, ok := s.job.proved[key]; ok {
+		return reject(ErrDuplicateShare)
+	}
+	quality, err := proof.GetVerifiedQuality(pocutil.PubKeyHash(pk), s.job.challenge, s.job.slot, s.job.height)
+	if err != nil {
+		return reject(err)
+	}
+	if quality.Cmp(s.job.shareTarget) <= 0 {
+		return reject(ErrLowQualityShare)
+	}
+
+	s.job.shares[in.SpaceId] = &share{
+		account: account,
+		proof: &engine.WorkSpaceProof{
+			SpaceID:   in.SpaceId,
+			Proof:     proof,
+			PublicKey: pk,
+			Ordinal:   in.Ordinal,
+		},
+	}
+	s.job.proved[key] = struct{}{}
+	s.ledger.AddShare(account)
+	return &poolpb.ShareResult{Accepted: true}, nil
+}
+
+// SubmitSignature implements poolpb.PoolServer, only the account asked to
+// sign may answer the request.
+func (s *Server) SubmitSignature(ctx context.Context, in *poolpb.Signature) (*poolpb.SignatureResult, error) {
+	account, err := checkAccount(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	pending, ok := s.pending[in.RequestId]
+	if ok && pending.account == account {
+		delete(s.pending, in.RequestId)
+	}
+	s.mu.Unlock()
+	if !ok || pending.account != account {
+		return nil, status.Error(codes.NotFound, "unknown sign request")
+	}
+	pending.ch <- in
+	return &poolpb.SignatureResult{}, nil
+}
+
+// SignHeader sends header to the pool miner who submitted the proof of sid,
+// and waits for its signature of header.PoCHash.
+func (s *Server) SignHeader(sid string, header *wire.BlockHeader) (*pocec.Signature, error) {
+	pocHash, err := header.PoCHash()
+	if err != nil {
+		return nil, err
+	}
+	headerBytes, err := encodeHeader(header)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	if s.job == nil || s.job.challenge != pocutil.Hash(header.Challenge) {
+		s.mu.Unlock()
+		return nil, ErrNoJob
+	}
+	sh, ok := s.job.shares[sid]
+	if !ok {
+		s.mu.Unlock()
+		return nil, ErrUnknownShare
+	}
+	sess, ok := s.sessions[sh.account]
+	if !ok {
+		s.mu.Unlock()
+		return nil, ErrMinerOffline
+	}
+	s.nextRequestID++
+	requestID := s.nextRequestID
+	ch := make(chan *poolpb.Signature, 1)
+	s.pending[requestID] = &pendingSign{account: sh.account, ch: ch}
+	sent := s.send(sess, &poolpb.Work{Work: &poolpb.Work_Sign{Sign: &poolpb.SignRequest{
+		RequestId: requestID,
+		JobId:     s.job.id,
+		SpaceId:   sid,
+		Header:    headerBytes,
+	}}})
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		delete(s.pending, requestID)
+		s.mu.Unlock()
+	}()
+	if !sent {
+		return nil, ErrMinerOffline
+	}
+
+	select {
+	case resp := <-ch:
+		if resp.Error != "" {
+			return nil, status.Error(codes.Unknown, resp.Error)
+		}
+		sig, err := pocec.ParseDERSignature(resp.Signature, pocec.S256())
+		if err != nil {
+			return nil, err
+		}
+		if !sig.Verify(pocHash[:], header.PubKey) {
+			return nil, ErrInvalidSignature
+		}
+		return sig, nil
+	case <-time.After(signTimeout):
+		return nil, ErrSignTimeout
+	}
+}
+
+// BlockAccepted closes the round and credits reward to pool miners.
+func (s *Server) BlockAccepted(block *chainutil.Block, reward chainutil.Amount) {
+	payouts, err := s.ledger.CloseRound(reward)
+	if err != nil {
+		logging.CPrint(logging.WARN, "fail to close pool round", logging.LogFormat{"height": block.Height(), "err": err})
+		return
+	}
+	for account, amount := range payouts {
+		logging.CPrint(logging.INFO, "credit pool reward", logging.LogFormat{"height": block.Height(), "account": account, "amount": amount})
+	}
+}
+
+func (s *Server) currentShares(challenge pocutil.Hash) ([]*engine.WorkSpaceProof, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.job == nil || s.job.challenge != challenge {
+		return nil, ErrNoJob
+	}
+	result := make([]*engine.WorkSpaceProof, 0, len(s.job.shares))
+	for _, sh := range s.job.shares {
+		result = append(result, sh.proof)
+	}
+	return result, nil
+}
+
+func (s *Server) Type() string {
+	return s.Name()
+}
+
+func (s *Server) WorkSpaceIDs(flags engine.WorkSpaceStateFlags) ([]string, error) {
+	return []string{}, nil
+}
+
+func (s *Server) WorkSpaceInfos(flags engine.WorkSpaceStateFlags) ([]engine.WorkSpaceInfo, error) {
+	return []engine.WorkSpaceInfo{}, nil
+}
+
+func (s *Server) GetProof(ctx context.Context, sid string, challenge pocutil.Hash) (*engine.WorkSpaceProof, error) {
+	proofs, err := s.currentShares(challenge)
+	if err != nil {
+		return nil, err
+	}
+	for _, proof := range proofs {
+		if proof.SpaceID == sid {
+			return proof, nil
+		}
+	}
+	return nil, ErrUnknownShare
+}
+
+// GetProofs waits shareWindow for pool miners, and returns their shares for challenge.
+func (s *Server) GetProofs(ctx context.Context, flags engine.WorkSpaceStateFlags, challenge pocutil.Hash) ([]*engine.WorkSpaceProof, error) {
+	select {
+	case <-ctx.Done():
+	case <-time.After(s.shareWindow):
+	}
+	return s.currentShares(challenge)
+}
+
+func (s *Server) GetProofReader(ctx context.Context, sid string, challenge pocutil.Hash) (engine.ProofReader, error) {
+	proof, err := s.GetProof(ctx, sid, challenge)
+	if err != nil {
+		return nil, err
+	}
+	prw := engine.NewProofRW(ctx, 1)
+	prw.Write(proof)
+	prw.Close()
+	return prw, nil
+}
+
+func (s *Server) GetProofsReader(ctx context.Context, flags engine.WorkSpaceStateFlags, challenge pocutil.Hash) (engine.ProofReader, error) {
+	proofs, err := s.GetProofs(ctx, flags, challenge)
+	if err != nil {
+		return nil, err
+	}
+	prw := engine.NewProofRW(ctx, len(proofs))
+	for _, proof := range proofs {
+		prw.Write(proof)
+	}
+	prw.Close()
+	return prw, nil
+}
+
+func (s *Server) ActOnWorkSpace(sid string, action engine.ActionType) error {
+	return spacekeeper.ErrUnimplemented
+}
+
+func (s *Server) ActOnWorkSpaces(flags engine.WorkSpaceStateFlags, action engine.ActionType) (map[string]error, error) {
+	return nil, spacekeeper.ErrUnimplemented
+}
+
+// SignHash is not supported since pool miners never sign a bare hash,
+// blocks are signed by SignHeader.
+func (s *Server) SignHash(sid string, hash [32]byte) (*pocec.Signature, error) {
+	return nil, spacekeeper.ErrUnimplemented
+}
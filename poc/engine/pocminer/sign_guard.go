@@ -0,0 +1,120 @@
+package pocminer
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Sukhavati-Labs/go-miner/chainutil"
+	"github.com/Sukhavati-Labs/go-miner/pocec"
+)
+
+const (
+	MinedHeightsFilename = "mined_heights.json"
+	heightClaimSuffix    = ".claim"
+)
+
+// SignGuard prevents signing two blocks at the same height with a public key,
+// which would get the public key banned by DoubleMiningDetector.
+//
+// The highest signed height of each public key is saved durably before signing,
+// so that it survives restarts. If lockDir is set, a height is also claimed by
+// creating a file exclusively in lockDir, so that nodes sharing lockDir never
+// sign at the same height even if they mine the same plots.
+type SignGuard struct {
+	mu      sync.Mutex
+	path    string
+	lockDir string
+	heights map[string]uint64 // compressed public key in hex -> highest signed height
+}
+
+func NewSignGuard(path, lockDir string) (*SignGuard, error) {
+	g := &SignGuard{
+		path:    path,
+		lockDir: lockDir,
+		heights: make(map[string]uint64),
+	}
+	if lockDir != "" {
+		if err := os.MkdirAll(lockDir, 0700); err != nil {
+			return nil, err
+		}
+	}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return g, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(data, &g.heights); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// Claim records height as signed for pubKey, it should be called right
+// before signing. Heights not above the highest signed one are refused,
+// and so are heights claimed by other nodes sharing lockDir.
+func (g *SignGuard) Claim(pubKey *pocec.PublicKey, height uint64) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	key := hex.EncodeToString(pubKey.SerializeCompressed())
+	if signed, ok := g.heights[key]; ok && height <= signed {
+		return ErrHeightSigned
+	}
+	if g.lockDir != "" {
+		if err := g.claimFile(key, height); err != nil {
+			return err
+		}
+	}
+
+	heights := make(map[string]uint64, len(g.heights)+1)
+	for k, v := range g.heights {
+		heights[k] = v
+	}
+	heights[key] = height
+	if err := g.save(heights); err != nil {
+		return err
+	}
+	g.heights = heights
+	return nil
+}
+
+// claimFile creates the claim file of height exclusively, a claim is never
+// released even if signing fails later.
+func (g *SignGuard) claimFile(key string, height uint64) error {
+	name := filepath.Join(g.lockDir, key+"_"+strconv.FormatUint(height, 10)+heightClaimSuffix)
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if os.IsExist(err) {
+		return ErrHeightClaimed
+	}
+	if err != nil {
+		return err
+	}
+	hostname, _ := os.Hostname()
+	_, err = fmt.Fprintf(f, "%s %d %s\n", hostname, os.Getpid(), time.Now().Format(time.RFC3339))
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// save writes heights durably by replacing the old file, the rename is
+// synced before Claim returns, so that a signed height survives power loss.
+func (g *SignGuard) save(heights map[string]uint64) error {
+	data, err := json.Marshal(heights)
+	if err != nil {
+		return err
+	}
+	return chainutil.WriteFileAtomic(g.path, data, 0600)
+}
@@ -0,0 +1,87 @@
+package pocminer
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/Sukhavati-Labs/go-miner/pocec"
+)
+
+func TestSignGuard(t *testing.T) {
+	dir := t.TempDir()
+	privKey, err := pocec.NewPrivateKey(pocec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKey := privKey.PubKey()
+
+	path := filepath.Join(dir, MinedHeightsFilename)
+	g, err := NewSignGuard(path, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = g.Claim(pubKey, 10); err != nil {
+		t.Fatal(err)
+	}
+	for _, height := range []uint64{9, 10} {
+		if err = g.Claim(pubKey, height); err != ErrHeightSigned {
+			t.Errorf("claim height %d, expect ErrHeightSigned, got %v", height, err)
+		}
+	}
+
+	// signed heights survive restart
+	g, err = NewSignGuard(path, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = g.Claim(pubKey, 10); err != ErrHeightSigned {
+		t.Errorf("expect ErrHeightSigned after restart, got %v", err)
+	}
+	if err = g.Claim(pubKey, 11); err != nil {
+		t.Fatal(err)
+	}
+
+	// other public keys are not affected
+	otherKey, err := pocec.NewPrivateKey(pocec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = g.Claim(otherKey.PubKey(), 5); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSignGuardSharedLockDir(t *testing.T) {
+	lockDir := filepath.Join(t.TempDir(), "lock")
+	privKey, err := pocec.NewPrivateKey(pocec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKey := privKey.PubKey()
+
+	// two nodes mining the same plots
+	g1, err := NewSignGuard(filepath.Join(t.TempDir(), MinedHeightsFilename), lockDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g2, err := NewSignGuard(filepath.Join(t.TempDir(), MinedHeightsFilename), lockDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = g1.Claim(pubKey, 10); err != nil {
+		t.Fatal(err)
+	}
+	if err = g2.Claim(pubKey, 10); err != ErrHeightClaimed {
+		t.Errorf("expect ErrHeightClaimed, got %v", err)
+	}
+	if err = g2.Claim(pubKey, 11); err != nil {
+		t.Fatal(err)
+	}
+	if err = g1.Claim(pubKey, 11); err != ErrHeightClaimed {
+		t.Errorf("expect ErrHeightClaimed, got %v", err)
+	}
+	// a refused claim does not take the height locally
+	if err = g1.Claim(pubKey, 12); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -44,6 +44,94 @@ type SktDB interface {
 	Delete() chan error
 }
 
+// Verifier is implemented by SktDB types which are able to check
+// the integrity of their plotted data.
+type Verifier interface {
+	// Verify samples plotted records and reports corrupted ones,
+	// all records are checked if samples is not less than the volume
+	Verify(samples int) (*VerifyReport, error)
+}
+
+// ParallelPlotter is implemented by SktDB types which are able to plot
+// with multiple threads.
+type ParallelPlotter interface {
+	// SetPlotThreads sets the count of threads used by following plots,
+	// a non-positive count means the count of CPUs
+	SetPlotThreads(threads int)
+}
+
+// PlotMemoryLimiter is implemented by SktDB types which are able to plot
+// within limited memory.
+type PlotMemoryLimiter interface {
+	// SetPlotMemory sets the max bytes of memory used by following plots,
+	// zero means no limit other than available memory
+	SetPlotMemory(bytes uint64)
+}
+
+// ReadMode is the way proofs are read from plotted data.
+type ReadMode string
+
+const (
+	// ReadModeFile reads each proof by a file read syscall
+	ReadModeFile ReadMode = "file"
+	// ReadModeMmap reads proofs from memory-mapped files where available,
+	// otherwise falls back to ReadModeFile
+	ReadModeMmap ReadMode = "mmap"
+)
+
+// ParseReadMode returns ReadMode named by s, empty s is ReadModeFile.
+func ParseReadMode(s string) (ReadMode, error) {
+	switch mode := ReadMode(s); mode {
+	case "":
+		return ReadModeFile, nil
+	case ReadModeFile, ReadModeMmap:
+		return mode, nil
+	default:
+		return "", ErrInvalidReadMode
+	}
+}
+
+// ProofReader is implemented by SktDB types which are able to read proofs
+// in ways other than plain file reads.
+type ProofReader interface {
+	// SetReadMode sets the way proofs are read and the count of hot pages
+	// cached, it takes effect once SktDB is plotted
+	SetReadMode(mode ReadMode, cachePages int) error
+}
+
+// FileHolder is implemented by SktDB types stored in files, so that they
+// could be moved to other directories by copying files.
+type FileHolder interface {
+	// Files returns paths of existing files holding data of SktDB
+	Files() []string
+}
+
+// CorruptRange represents a range of corrupted bytes in a db file.
+type CorruptRange struct {
+	Offset int64
+	Length int64
+}
+
+// VerifyReport represents the integrity check result of a SktDB.
+type VerifyReport struct {
+	FilePath  string
+	BitLength int
+	Sampled   int // number of records checked
+	Valid     int // number of records carrying a verified proof
+	Empty     int // number of records without proof, which is normal for plotted data
+	Corrupted int // number of records failed on verification
+	Ranges    []CorruptRange
+}
+
+// Health returns the ratio of valid records among non-empty records,
+// ranges from 0 (fully corrupted) to 1 (healthy).
+func (r *VerifyReport) Health() float64 {
+	if r.Valid+r.Corrupted == 0 {
+		return 0
+	}
+	return float64(r.Valid) / float64(r.Valid+r.Corrupted)
+}
+
 var (
 	ErrInvalidDBType        = errors.New("invalid sktdb type")
 	ErrInvalidDBArgs        = errors.New("invalid sktdb args")
@@ -52,6 +140,8 @@ var (
 	ErrDBCorrupted          = errors.New("sktdb corrupted")
 	ErrUnimplemented        = errors.New("unimplemented sktdb interface")
 	ErrUnsupportedBitLength = errors.New("unsupported bit length")
+	ErrDBNotPlotted         = errors.New("sktdb is not plotted")
+	ErrInvalidReadMode      = errors.New("invalid sktdb read mode")
 )
 
 // TODO compatible with multiple db prefix
@@ -11,6 +11,12 @@ var (
 	ErrDBWrongType       = errors.New("db type not allowed")
 	ErrDBWrongPubKeyHash = errors.New("db pubKey hash is not matched with pubKey")
 	ErrDBWrongMapType    = errors.New("db mapType is not valid")
+	ErrDBWrongBitLength  = errors.New("db bitLength is not valid")
+	ErrDBWrongPubKey     = errors.New("db pubKey is not valid")
+	ErrDBWrongCheckpoint = errors.New("db checkpoint is beyond volume")
+	ErrDBWrongFileName   = errors.New("db file name is not valid")
+	ErrDBNameNotMatched  = errors.New("db header is not matched with file name")
+	ErrMappedFault       = errors.New("fault reading mapped db file, the disk might be gone")
 
 	ErrAlreadyPlotting = errors.New("db already been plotting")
 	ErrStopPlotting    = errors.New("db stop plotting")
@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"os"
 	"path"
+	"sync/atomic"
 
 	"github.com/Sukhavati-Labs/go-miner/logging"
 	"github.com/Sukhavati-Labs/go-miner/poc/engine/sktdb"
@@ -69,6 +70,7 @@ type HashMapA struct {
 // | 2Ln+L  |         L       | Z(`n`) -> XP | Value XP for `Z = n` |
 type HashMapB struct {
 	HashMap
+	reader atomic.Value // *pageReader, proofs are read from data if not set
 }
 
 const (
@@ -124,6 +126,16 @@ func createMapFile(filePath string, typ MapType, bl int, pubKey *pocec.PublicKey
 		return failureReturn(err)
 	}
 
+	fileHeaderBytes := encodeFileHeader(typ, bl, 0, pubKey)
+	if _, err = f.WriteAt(fileHeaderBytes[:], 0); err != nil {
+		return failureReturn(err)
+	}
+
+	return f, nil
+}
+
+// encodeFileHeader returns meta info of a map file
+func encodeFileHeader(typ MapType, bl int, checkpoint pocutil.PoCValue, pubKey *pocec.PublicKey) [LenMetaInfo]byte {
 	var (
 		fileHeaderBytes [LenMetaInfo]byte
 		b1              [1]byte
@@ -143,7 +155,7 @@ func createMapFile(filePath string, typ MapType, bl int, pubKey *pocec.PublicKey
 	b1[0] = byte(typ)
 	copy(fileHeaderBytes[PosType:], b1[:])
 	// Write checkpoint
-	binary.LittleEndian.PutUint64(b8[:], 0)
+	binary.LittleEndian.PutUint64(b8[:], uint64(checkpoint))
 	copy(fileHeaderBytes[PosCheckpoint:], b8[:])
 	// Write PubKeyHash and PubKey
 	pubKeyBytes := pubKey.SerializeCompressed()
@@ -151,11 +163,7 @@ func createMapFile(filePath string, typ MapType, bl int, pubKey *pocec.PublicKey
 	copy(fileHeaderBytes[PosPubKeyHash:], b32[:])
 	copy(fileHeaderBytes[PosPubKey:], pubKeyBytes)
 
-	if _, err = f.WriteAt(fileHeaderBytes[:], 0); err != nil {
-		return failureReturn(err)
-	}
-
-	return f, nil
+	return fileHeaderBytes
 }
 
 func openMapFile(filePath string) (*os.File, error) {
@@ -370,8 +378,14 @@ func (hm *HashMapB) Progress() (bool, pocutil.PoCValue) {
 func (hm *HashMapB) Get(key pocutil.PoCValue) ([]byte, []byte, error) {
 	var recordSize = hm.recordSize
 	var proof [16]byte
+	var n int
 	target := hm.offset + int(key)*recordSize*2
-	if n, _ := hm.data.ReadAt(proof[:recordSize*2], int64(target)); n < recordSize*2 {
+	if r := hm.pageReader(); r != nil {
+		n, _ = r.ReadAt(proof[:recordSize*2], int64(target))
+	} else {
+		n, _ = hm.data.ReadAt(proof[:recordSize*2], int64(target))
+	}
+	if n < recordSize*2 {
 		return nil, nil, sktdb.ErrDBCorrupted
 	}
 	return proof[:recordSize], proof[recordSize : recordSize*2], nil
@@ -389,3 +403,30 @@ func (hm *HashMapB) Set(key pocutil.PoCValue, x, xp []byte) error {
 	}
 	return nil
 }
+
+func (hm *HashMapB) pageReader() *pageReader {
+	r, _ := hm.reader.Load().(*pageReader)
+	return r
+}
+
+// setReadMode replaces the way proofs are read by Get, it should only be
+// called on plotted HashMapB, as pages are neither remapped nor invalidated
+// on writes.
+func (hm *HashMapB) setReadMode(mode sktdb.ReadMode, cachePages int) {
+	var r *pageReader
+	if mode != sktdb.ReadModeFile || cachePages > 0 {
+		r = newPageReader(hm.data, mode, cachePages)
+	}
+	old := hm.pageReader()
+	hm.reader.Store(r)
+	if old != nil {
+		old.close()
+	}
+}
+
+func (hm *HashMapB) Close() error {
+	if r := hm.pageReader(); r != nil {
+		r.close()
+	}
+	return hm.HashMap.Close()
+}
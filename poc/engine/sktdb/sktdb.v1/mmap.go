@@ -0,0 +1,32 @@
+// +build !windows
+
+package sktdb_v1
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// mmapFile maps the whole file read-only, proofs are looked up at random
+// so that read-ahead is disabled.
+func mmapFile(f *os.File) ([]byte, error) {
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	size := info.Size()
+	if size == 0 || int64(int(size)) != size {
+		return nil, ErrDBWrongFileSize
+	}
+	data, err := unix.Mmap(int(f.Fd()), 0, int(size), unix.PROT_READ, unix.MAP_SHARED)
+	if err != nil {
+		return nil, err
+	}
+	unix.Madvise(data, unix.MADV_RANDOM)
+	return data, nil
+}
+
+func munmapFile(data []byte) error {
+	return unix.Munmap(data)
+}
@@ -0,0 +1,19 @@
+// +build windows
+
+package sktdb_v1
+
+import (
+	"errors"
+	"os"
+)
+
+var errMmapUnsupported = errors.New("mmap is not supported")
+
+// do not map file, reads fall back to file reads
+func mmapFile(f *os.File) ([]byte, error) {
+	return nil, errMmapUnsupported
+}
+
+func munmapFile(data []byte) error {
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"io"
 	"os"
 	"sync/atomic"
 
@@ -22,10 +23,11 @@ const (
 	minPrePlotMem = 256 * poc.MiB      // 256 MiB, min memory used when generating HashMapA
 	minPlotMem    = 256 * poc.MiB      // 256 MiB, min memory used when generating HashMapB
 	minMapABufMem = 64 * poc.MiB       // 64 MiB, min memory used for reading MapA buffer when generating HashMapB
-
-	waitQuitPlotInterval = 1 << 20 // wait interval on quit plot signal
 )
 
+// noLoad is used for batches calculated without input.
+func noLoad(*plotBatch) error { return nil }
+
 func makeAvailableMemory(cache *MemCache, requiredMem, maxMem, minMem uint64) error {
 	if requiredMem > maxMem {
 		requiredMem = maxMem
@@ -44,12 +46,28 @@ func makeAvailableMemory(cache *MemCache, requiredMem, maxMem, minMem uint64) er
 	return nil
 }
 
-func (hm *HashMapA) makeAvailableMemory(cache *MemCache, requiredMem uint64) error {
-	return makeAvailableMemory(cache, requiredMem, maxPrePlotMem, minPrePlotMem)
+// limitMemory returns the smaller one of maxMem and non-zero limit.
+func limitMemory(maxMem, limit uint64) uint64 {
+	if limit != 0 && limit < maxMem {
+		return limit
+	}
+	return maxMem
+}
+
+func (hm *HashMapA) makeAvailableMemory(cache *MemCache, requiredMem, limit uint64) error {
+	return makeAvailableMemory(cache, requiredMem, limitMemory(maxPrePlotMem, limit), minPrePlotMem)
+}
+
+func (hm *HashMapB) makeAvailableMemory(cache *MemCache, requiredMem, limit uint64) error {
+	return makeAvailableMemory(cache, requiredMem, limitMemory(maxPlotMem, limit), minPlotMem)
 }
 
-func (hm *HashMapB) makeAvailableMemory(cache *MemCache, requiredMem uint64) error {
-	return makeAvailableMemory(cache, requiredMem, maxPlotMem, minPlotMem)
+// PlotMemory returns the memory used by plotting of bitLength,
+// plotting is slower but still works with less memory down to minMem.
+func PlotMemory(bitLength int) (minMem, maxMem uint64) {
+	// HashMapB takes twice the memory of HashMapA
+	maxMem = limitMemory(maxPlotMem, uint64(pocutil.RecordSize(bitLength)*2)<<uint(bitLength))
+	return limitMemory(minPlotMem, maxMem), maxMem
 }
 
 func NewSktDBV1(rootPath string, ordinal int64, pubKey *pocec.PublicKey, bitLength int) (*SktDBV1, error) {
@@ -104,6 +122,7 @@ func (sdb *SktDBV1) executePlot(result chan error) {
 	sdb.HashMapA.Close()
 	os.Remove(sdb.filePathA)
 	sdb.HashMapA = nil
+	sdb.applyReadMode()
 	logging.CPrint(logging.INFO, "plot finished",
 		logging.LogFormat{"bit_length": sdb.bl, "pub_key": hex.EncodeToString(sdb.pubKey.SerializeCompressed())})
 }
@@ -113,16 +132,19 @@ func (sdb *SktDBV1) prePlotWork(cache *MemCache) error {
 	var recordSize = hmA.recordSize
 	var bl = hmA.bitLength
 	var pkHash = hmA.pkHash
-	var b8 [8]byte
 	var half = hmA.volume / 2
+	var threads = sdb.PlotThreads()
+	var memLimit = sdb.PlotMemory()
 
 	var logCheckpointInterval = hmA.volume / 50
-	var checkpoint = hmA.ReadCheckpoint()
+	// windows should start at even points, since x and x' are calculated in pairs,
+	// and checkpoints written by former versions were the start of last window plus one
+	var checkpoint = hmA.ReadCheckpoint() &^ 1
 	logging.CPrint(logging.INFO, fmt.Sprintf("load checkpoint for HashMapA: %d/%d (%d/%d)", checkpoint, hmA.volume, checkpoint/logCheckpointInterval, 50),
 		logging.LogFormat{"bit_length": sdb.bl, "pub_key": hex.EncodeToString(sdb.pubKey.SerializeCompressed())})
 
 	var ensureCacheMemory = func(startPoint pocutil.PoCValue) error {
-		return hmA.makeAvailableMemory(cache, uint64(hmA.volume-startPoint)*uint64(recordSize))
+		return hmA.makeAvailableMemory(cache, uint64(hmA.volume-startPoint)*uint64(recordSize), memLimit)
 	}
 	var calcWindowSize = func() pocutil.PoCValue {
 		rem := (cache.Len() / recordSize) & 1
@@ -133,36 +155,38 @@ func (sdb *SktDBV1) prePlotWork(cache *MemCache) error {
 			return err
 		}
 		endPoint := startPoint + calcWindowSize() // slide windows defined by [start, end)
-		logging.CPrint(logging.DEBUG, "assign hashMapA calculation work", logging.LogFormat{"start_point": startPoint, "end_point": endPoint})
-		for x := pocutil.PoCValue(0); x < hmA.volume; x++ {
-			// calc and write the cache
-			y := pocutil.P(x, bl, pkHash)
-			if y < half {
-				y = y * 2
-			} else {
-				y = pocutil.FlipValue(y, bl)*2 + 1
-			}
-			// write data within the window defined by [start, end)
-			if startPoint <= y && y < endPoint {
-				target := int(y-startPoint) * recordSize
-				binary.LittleEndian.PutUint64(b8[:], uint64(x))
-				cache.WriteAt(b8[:recordSize], int64(target))
-			}
-
-			// log and respond to quit signal
-			if x%waitQuitPlotInterval == 0 {
-				select {
-				case <-sdb.stopPlotCh:
-					logging.CPrint(logging.INFO, "pre plot aborted",
-						logging.LogFormat{"bit_length": sdb.bl, "pub_key": hex.EncodeToString(sdb.pubKey.SerializeCompressed())})
-					return ErrStopPlotting
-				default:
+		logging.CPrint(logging.DEBUG, "assign hashMapA calculation work", logging.LogFormat{"start_point": startPoint, "end_point": endPoint, "threads": threads})
+		var calc = func(b *plotBatch) {
+			var b8 [8]byte
+			for x := b.start; x < b.end; x++ {
+				y := pocutil.P(x, bl, pkHash)
+				if y < half {
+					y = y * 2
+				} else {
+					y = pocutil.FlipValue(y, bl)*2 + 1
+				}
+				// write data within the window defined by [start, end)
+				if startPoint <= y && y < endPoint {
+					binary.LittleEndian.PutUint64(b8[:], uint64(x))
+					b.add(int64(y-startPoint)*int64(recordSize), b8[:recordSize])
 				}
 			}
-			if x%logCheckpointInterval == 0 {
-				totalProgress := float64(startPoint)/float64(hmA.volume) + float64(endPoint-startPoint)/float64(hmA.volume)*(float64(x/logCheckpointInterval)/50)
-				logging.CPrint(logging.DEBUG, fmt.Sprintf("current round %d/%d (%d), total progress %f", x/logCheckpointInterval, 50, x, totalProgress))
+		}
+		var round pocutil.PoCValue
+		var apply = func(b *plotBatch) {
+			b.writeTo(cache, recordSize)
+			if r := b.end / logCheckpointInterval; r != round {
+				round = r
+				totalProgress := float64(startPoint)/float64(hmA.volume) + float64(endPoint-startPoint)/float64(hmA.volume)*(float64(r)/50)
+				logging.CPrint(logging.DEBUG, fmt.Sprintf("current round %d/%d (%d), total progress %f", r, 50, b.end, totalProgress))
+			}
+		}
+		if err := runPlotBatches(threads, hmA.volume, sdb.stopPlotCh, noLoad, calc, apply); err != nil {
+			if err == ErrStopPlotting {
+				logging.CPrint(logging.INFO, "pre plot aborted",
+					logging.LogFormat{"bit_length": sdb.bl, "pub_key": hex.EncodeToString(sdb.pubKey.SerializeCompressed())})
 			}
+			return err
 		}
 		if n, err := cache.WriteToWriter(sdb.stopPlotCh, hmA.data, 0, int64(hmA.offset)+int64(startPoint)*int64(recordSize), int64(cache.Len())); err != nil {
 			logging.CPrint(logging.ERROR, "fail on writing cache to file", logging.LogFormat{"err": err, "n": n})
@@ -170,7 +194,8 @@ func (sdb *SktDBV1) prePlotWork(cache *MemCache) error {
 		}
 		hmA.data.Sync() // write pre-plot data first
 
-		hmA.checkpoint = startPoint + 1
+		// resumed plotting starts from the end of plotted windows
+		hmA.checkpoint = endPoint
 		hmA.UpdateCheckpoint()
 		hmA.data.Sync() // then write new checkpoint
 		startPoint = endPoint
@@ -188,7 +213,9 @@ func (sdb *SktDBV1) plotWork(cache *MemCache) error {
 	var bl = hmA.bitLength
 	var pkHash = hmA.pkHash
 	var recordSize = pocutil.RecordSize(bl)
-	var bs = make([]byte, recordSize*2)
+	var pairSize = recordSize * 2
+	var threads = sdb.PlotThreads()
+	var memLimit = sdb.PlotMemory()
 
 	var logCheckpointInterval = hmB.volume / (50 * 2)
 	var checkpoint = hmB.ReadCheckpoint()
@@ -204,7 +231,7 @@ func (sdb *SktDBV1) plotWork(cache *MemCache) error {
 		return true
 	}
 	var ensureCacheMemory = func(startPoint pocutil.PoCValue) error {
-		return hmB.makeAvailableMemory(cache, uint64(half-startPoint)*uint64(recordSize)<<2)
+		return hmB.makeAvailableMemory(cache, uint64(half-startPoint)*uint64(recordSize)<<2, memLimit)
 	}
 	var calcWindowSize = func() pocutil.PoCValue {
 		return pocutil.PoCValue((cache.Len() / recordSize) >> 2)
@@ -222,37 +249,47 @@ func (sdb *SktDBV1) plotWork(cache *MemCache) error {
 		}
 		bufRdA := bufio.NewReaderSize(hmA.data, minMapABufMem)
 
-		for y := pocutil.PoCValue(0); y < half; y++ {
-			bufRdA.Read(bs)
-			x, xp := bs[:recordSize], bs[recordSize:]
-			if !bytesEqualZero(x) && !bytesEqualZero(xp) {
-				z := pocutil.FB(x, xp, bl, pkHash)
-				if doubleStartPoint <= z && z < doubleEndPoint {
-					target := int(z-doubleStartPoint) * recordSize * 2
-					cache.WriteAt(x, int64(target))
-					cache.WriteAt(xp, int64(target+recordSize))
-				}
-				zp := pocutil.FB(xp, x, bl, pkHash)
-				if doubleStartPoint <= zp && zp < doubleEndPoint {
-					target := int(zp-doubleStartPoint) * recordSize * 2
-					cache.WriteAt(xp, int64(target))
-					cache.WriteAt(x, int64(target+recordSize))
-				}
+		var load = func(b *plotBatch) error {
+			size := int(b.end-b.start) * pairSize
+			if cap(b.input) < size {
+				b.input = make([]byte, size)
 			}
-
-			if y%waitQuitPlotInterval == 0 {
-				select {
-				case <-sdb.stopPlotCh:
-					logging.CPrint(logging.INFO, "plot aborted",
-						logging.LogFormat{"bit_length": sdb.bl, "pub_key": hex.EncodeToString(sdb.pubKey.SerializeCompressed())})
-					return ErrStopPlotting
-				default:
+			b.input = b.input[:size]
+			// plain Read returns short at buffer boundaries, which misaligned
+			// records of bit lengths from 34 before, so such plots differ
+			_, err := io.ReadFull(bufRdA, b.input)
+			return err
+		}
+		var calc = func(b *plotBatch) {
+			for bs := b.input; len(bs) >= pairSize; bs = bs[pairSize:] {
+				x, xp := bs[:recordSize], bs[recordSize:pairSize]
+				if !bytesEqualZero(x) && !bytesEqualZero(xp) {
+					z := pocutil.FB(x, xp, bl, pkHash)
+					if doubleStartPoint <= z && z < doubleEndPoint {
+						b.add(int64(z-doubleStartPoint)*int64(pairSize), x, xp)
+					}
+					zp := pocutil.FB(xp, x, bl, pkHash)
+					if doubleStartPoint <= zp && zp < doubleEndPoint {
+						b.add(int64(zp-doubleStartPoint)*int64(pairSize), xp, x)
+					}
 				}
 			}
-			if y%logCheckpointInterval == 0 {
-				totalProgress := float64(startPoint*2)/float64(hmA.volume) + float64(endPoint-startPoint)*2.0/float64(hmA.volume)*(float64(y/logCheckpointInterval)/50)
-				logging.CPrint(logging.DEBUG, fmt.Sprintf("current round %d/%d (%d), total progress %f", y/logCheckpointInterval, 50, y, totalProgress))
+		}
+		var round pocutil.PoCValue
+		var apply = func(b *plotBatch) {
+			b.writeTo(cache, pairSize)
+			if r := b.end / logCheckpointInterval; r != round {
+				round = r
+				totalProgress := float64(startPoint*2)/float64(hmA.volume) + float64(endPoint-startPoint)*2.0/float64(hmA.volume)*(float64(r)/50)
+				logging.CPrint(logging.DEBUG, fmt.Sprintf("current round %d/%d (%d), total progress %f", r, 50, b.end, totalProgress))
+			}
+		}
+		if err := runPlotBatches(threads, half, sdb.stopPlotCh, load, calc, apply); err != nil {
+			if err == ErrStopPlotting {
+				logging.CPrint(logging.INFO, "plot aborted",
+					logging.LogFormat{"bit_length": sdb.bl, "pub_key": hex.EncodeToString(sdb.pubKey.SerializeCompressed())})
 			}
+			return err
 		}
 		if n, err := cache.WriteToWriter(sdb.stopPlotCh, hmB.data, 0, int64(hmB.offset)+int64(startPoint)*int64(recordSize)*4, int64(cache.Len())); err != nil {
 			logging.CPrint(logging.ERROR, "fail on writing cache to file", logging.LogFormat{"err": err, "n": n})
@@ -260,7 +297,8 @@ func (sdb *SktDBV1) plotWork(cache *MemCache) error {
 		}
 		hmB.data.Sync() // write plot data first
 
-		hmB.checkpoint = startPoint + 1
+		// resumed plotting starts from the end of plotted windows
+		hmB.checkpoint = endPoint
 		hmB.UpdateCheckpoint()
 		hmB.data.Sync() // then update checkpoint
 		startPoint = endPoint
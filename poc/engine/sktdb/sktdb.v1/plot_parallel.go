@@ -0,0 +1,131 @@
+package sktdb_v1
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/Sukhavati-Labs/go-miner/poc/pocutil"
+)
+
+// plotBatchSize is the count of values calculated by a worker at a time.
+const plotBatchSize = 1 << 16
+
+// plotBatch holds records calculated from values in [start, end),
+// each record would be written into cache at its offset.
+type plotBatch struct {
+	start   pocutil.PoCValue
+	end     pocutil.PoCValue
+	input   []byte
+	offsets []int64
+	records []byte
+	done    chan struct{}
+}
+
+func (b *plotBatch) add(offset int64, record ...[]byte) {
+	b.offsets = append(b.offsets, offset)
+	for _, r := range record {
+		b.records = append(b.records, r...)
+	}
+}
+
+// writeTo writes records into cache in the order they were added.
+func (b *plotBatch) writeTo(cache *MemCache, width int) {
+	for i, offset := range b.offsets {
+		cache.WriteAt(b.records[i*width:(i+1)*width], offset)
+	}
+}
+
+var plotBatchPool = sync.Pool{
+	New: func() interface{} { return &plotBatch{} },
+}
+
+func newPlotBatch(start, end pocutil.PoCValue) *plotBatch {
+	b := plotBatchPool.Get().(*plotBatch)
+	b.start, b.end = start, end
+	b.offsets = b.offsets[:0]
+	b.records = b.records[:0]
+	b.done = make(chan struct{})
+	return b
+}
+
+func normalizePlotThreads(threads int) int {
+	if threads <= 0 {
+		return runtime.NumCPU()
+	}
+	return threads
+}
+
+// runPlotBatches splits values in [0, total) into batches, batches are
+// loaded in order, calculated by threads in parallel and then applied
+// in order. Since records are applied in the same order as a single
+// thread would do, the plotted data do not depend on the count of threads.
+func runPlotBatches(threads int, total pocutil.PoCValue, stop chan struct{},
+	load func(b *plotBatch) error, calc func(b *plotBatch), apply func(b *plotBatch)) error {
+	var (
+		wg      sync.WaitGroup
+		jobs    = make(chan *plotBatch, threads)
+		pending = make(chan *plotBatch, threads*2)
+		quit    = make(chan struct{})
+		loadErr = make(chan error, 1)
+	)
+
+	for i := 0; i < threads; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for b := range jobs {
+				calc(b)
+				close(b.done)
+			}
+		}()
+	}
+
+	go func() {
+		defer close(jobs)
+		defer close(pending)
+		for start := pocutil.PoCValue(0); start < total; start += plotBatchSize {
+			end := start + plotBatchSize
+			if end > total {
+				end = total
+			}
+			b := newPlotBatch(start, end)
+			if err := load(b); err != nil {
+				loadErr <- err
+				return
+			}
+			select {
+			case pending <- b:
+			case <-quit:
+				return
+			}
+			jobs <- b
+		}
+	}()
+
+	defer func() {
+		close(quit)
+		for b := range pending {
+			<-b.done
+		}
+		wg.Wait()
+	}()
+
+	for b := range pending {
+		<-b.done
+		apply(b)
+		plotBatchPool.Put(b)
+
+		select {
+		case <-stop:
+			return ErrStopPlotting
+		default:
+		}
+	}
+
+	select {
+	case err := <-loadErr:
+		return err
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,216 @@
+package sktdb_v1_test
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/sktdb/sktdb.v1"
+	"github.com/Sukhavati-Labs/go-miner/poc/pocutil"
+	"github.com/Sukhavati-Labs/go-miner/pocec"
+)
+
+// baselineDigests are sha256 digests of proof data of HashMapB, plotted for
+// testPlotKey by the single-threaded plotter before plotting in parallel.
+var baselineDigests = map[int]string{
+	18: "8c9a9f0459539ed9ff18097f7854d3c9447b8e94c8b0b1b72bc6e1f6376e2279",
+	20: "439e8f04eeb1068d7c25d02ef9bef7f05d5db3cfe99e979880f96494b26e082d",
+}
+
+// testPlotKey returns the private key plotted for baselineDigests.
+func testPlotKey() *pocec.PrivateKey {
+	seed := sha256.Sum256([]byte("sktdb plot reference"))
+	sk, _ := pocec.PrivKeyFromBytes(pocec.S256(), seed[:])
+	return sk
+}
+
+// checkBaseline compares proof data of file with the baseline plot.
+func checkBaseline(t *testing.T, file string, bl int) bool {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	digest := sha256.Sum256(data[sktdb_v1.PosProofData:])
+	return hex.EncodeToString(digest[:]) == baselineDigests[bl]
+}
+
+func plotWithThreads(dir string, pk *pocec.PublicKey, bl, threads int) (string, error) {
+	mdb, err := sktdb_v1.NewSktDBV1(dir, 0, pk, bl)
+	if err != nil {
+		return "", err
+	}
+	defer mdb.Close()
+	mdb.SetPlotThreads(threads)
+	if err = <-mdb.Plot(); err != nil {
+		return "", err
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*"+sktdb_v1.SktDBV1Suffix))
+	if err != nil {
+		return "", err
+	}
+	for _, file := range files {
+		if !strings.HasSuffix(file, "_a"+sktdb_v1.SktDBV1Suffix) {
+			return file, nil
+		}
+	}
+	return "", os.ErrNotExist
+}
+
+func TestParallelPlot(t *testing.T) {
+	// large enough to be split into several batches
+	var bl = 20
+	sk := testPlotKey()
+
+	for _, threads := range []int{1, 3, 8} {
+		dir, err := ioutil.TempDir("", "sktdb-parallel")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+
+		file, err := plotWithThreads(dir, sk.PubKey(), bl, threads)
+		if err != nil {
+			t.Fatalf("threads %d: %v", threads, err)
+		}
+		if !checkBaseline(t, file, bl) {
+			t.Errorf("threads %d: plotted data not matched with single-threaded plotter", threads)
+		}
+	}
+}
+
+func BenchmarkPlot(b *testing.B) {
+	var bl = 22
+	sk, err := pocec.NewPrivateKey(pocec.S256())
+	if err != nil {
+		b.Fatal(err)
+	}
+	for threads := 1; threads <= runtime.NumCPU()*2; threads *= 2 {
+		b.Run(fmt.Sprintf("threads-%d", threads), func(b *testing.B) {
+			b.SetBytes(int64(pocutil.RecordSize(bl)*2) << uint(bl))
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				dir, err := ioutil.TempDir("", "sktdb-bench")
+				if err != nil {
+					b.Fatal(err)
+				}
+				b.StartTimer()
+				if _, err := plotWithThreads(dir, sk.PubKey(), bl, threads); err != nil {
+					b.Fatal(err)
+				}
+				b.StopTimer()
+				os.RemoveAll(dir)
+				b.StartTimer()
+			}
+		})
+	}
+}
+
+func TestResumePlot(t *testing.T) {
+	// small memory makes plotting work in several windows
+	var bl = 18
+	var memory uint64 = 64 * 1024
+	sk := testPlotKey()
+
+	dir, err := ioutil.TempDir("", "sktdb-resume")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// stop plotting once checkpoints have been written, for each of HashMapA and HashMapB
+	for _, stopAt := range []float64{0, 50} {
+		mdb, err := sktdb_v1.NewSktDBV1(dir, 0, sk.PubKey(), bl)
+		if err != nil {
+			t.Fatal(err)
+		}
+		mdb.SetPlotMemory(memory)
+		result := mdb.Plot()
+		for {
+			if _, _, progress := mdb.Progress(); progress > stopAt {
+				break
+			}
+			time.Sleep(time.Millisecond)
+		}
+		<-mdb.StopPlot()
+		if err = <-result; err != nil {
+			t.Fatal(err)
+		}
+		if _, _, progress := mdb.Progress(); progress >= 100 {
+			t.Fatalf("plotting not stopped before finished")
+		}
+		mdb.Close()
+	}
+
+	file, err := plotWithThreads(dir, sk.PubKey(), bl, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !checkBaseline(t, file, bl) {
+		t.Errorf("resumed plot data not matched with single-threaded plotter")
+	}
+}
+
+func TestResumeFormerCheckpoint(t *testing.T) {
+	var bl = 18
+	var memory uint64 = 64 * 1024
+	sk := testPlotKey()
+	dir, err := ioutil.TempDir("", "sktdb-resume")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// former versions wrote the start of the last plotted window plus one as
+	// checkpoint, so a file with its first window plotted was checkpointed at 1
+	var formerCheckpoint [sktdb_v1.LenCheckpoint]byte
+	binary.LittleEndian.PutUint64(formerCheckpoint[:], 1)
+
+	// stop plotting in each of HashMapA and HashMapB
+	for i, stopAt := range []float64{0, 50} {
+		mdb, err := sktdb_v1.NewSktDBV1(dir, 0, sk.PubKey(), bl)
+		if err != nil {
+			t.Fatal(err)
+		}
+		mdb.SetPlotMemory(memory)
+		result := mdb.Plot()
+		for {
+			if _, _, progress := mdb.Progress(); progress > stopAt {
+				break
+			}
+			time.Sleep(time.Millisecond)
+		}
+		<-mdb.StopPlot()
+		if err = <-result; err != nil {
+			t.Fatal(err)
+		}
+		files := mdb.Files()
+		mdb.Close()
+
+		// Files returns HashMapB followed by HashMapA
+		f, err := os.OpenFile(files[len(files)-1-i], os.O_RDWR, 0666)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = f.WriteAt(formerCheckpoint[:], sktdb_v1.PosCheckpoint)
+		f.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	file, err := plotWithThreads(dir, sk.PubKey(), bl, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !checkBaseline(t, file, bl) {
+		t.Errorf("plot resumed from former checkpoint not matched with single-threaded plotter")
+	}
+}
@@ -0,0 +1,140 @@
+package sktdb_v1
+
+import (
+	"io"
+	"os"
+	"runtime"
+	"runtime/debug"
+	"sync"
+
+	"github.com/Sukhavati-Labs/go-miner/chainutil/ccache"
+	"github.com/Sukhavati-Labs/go-miner/logging"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/sktdb"
+)
+
+// readerPageSize is the size of pages cached by pageReader
+const readerPageSize = 4096
+
+// pageReader reads plotted data through memory map where available,
+// and keeps hot pages in a small LRU cache.
+type pageReader struct {
+	mu     sync.RWMutex // held for writing on close, so that no read touches unmapped memory
+	file   *os.File
+	mapped []byte         // nil if not mapped
+	pages  *ccache.CCache // nil if not cached
+	closed bool
+}
+
+// newPageReader never fails, it reads from f directly if f could not be mapped.
+func newPageReader(f *os.File, mode sktdb.ReadMode, cachePages int) *pageReader {
+	r := &pageReader{file: f}
+	if mode == sktdb.ReadModeMmap {
+		mapped, err := mmapFile(f)
+		if err != nil {
+			logging.CPrint(logging.WARN, "fail to mmap sktdb, fall back to file reads",
+				logging.LogFormat{"file": f.Name(), "err": err})
+		} else {
+			r.mapped = mapped
+		}
+	}
+	if cachePages > 0 {
+		r.pages = ccache.NewCCache(cachePages)
+	}
+	return r
+}
+
+func (r *pageReader) ReadAt(buf []byte, offset int64) (n int, err error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.closed {
+		return 0, os.ErrClosed
+	}
+	if r.pages == nil {
+		return r.readAt(buf, offset)
+	}
+
+	for n < len(buf) {
+		index := (offset + int64(n)) / readerPageSize
+		page, err := r.page(index)
+		if err != nil {
+			return n, err
+		}
+		start := int(offset + int64(n) - index*readerPageSize)
+		if start >= len(page) {
+			return n, io.EOF
+		}
+		n += copy(buf[n:], page[start:])
+	}
+	return n, nil
+}
+
+// page returns cached page of index, or loads it into cache,
+// the last page of file might be shorter than readerPageSize.
+// Pages are never modified once cached, as they are shared by readers.
+func (r *pageReader) page(index int64) ([]byte, error) {
+	if page, ok := r.pages.Get(index); ok {
+		return page.([]byte), nil
+	}
+	page := make([]byte, readerPageSize)
+	n, err := r.readAt(page, index*readerPageSize)
+	if n == 0 {
+		return nil, err
+	}
+	page = page[:n]
+	r.pages.Add(index, page)
+	return page, nil
+}
+
+// readAt is not thread safe, should use lock in upper functions
+func (r *pageReader) readAt(buf []byte, offset int64) (int, error) {
+	if r.mapped == nil {
+		return r.file.ReadAt(buf, offset)
+	}
+	if offset >= int64(len(r.mapped)) {
+		return 0, io.EOF
+	}
+	n, err := copyMapped(buf, r.mapped[offset:])
+	if err != nil {
+		return 0, err
+	}
+	if n < len(buf) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// copyMapped copies mapped memory into buf. Memory of a file on a disk gone
+// away raises SIGBUS on access, the fault is returned as ErrMappedFault
+// instead of crashing the node, as file reads would fail with EIO.
+func copyMapped(buf, mapped []byte) (n int, err error) {
+	defer debug.SetPanicOnFault(debug.SetPanicOnFault(true))
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(runtime.Error); !ok {
+				panic(r)
+			}
+			err = ErrMappedFault
+		}
+	}()
+	return copy(buf, mapped), nil
+}
+
+// close releases mapped memory and cached pages, it waits for reads in
+// progress. The file is left open for its owner.
+func (r *pageReader) close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+	if r.pages != nil {
+		r.pages.Clear()
+	}
+	if r.mapped == nil {
+		return nil
+	}
+	err := munmapFile(r.mapped)
+	r.mapped = nil
+	return err
+}
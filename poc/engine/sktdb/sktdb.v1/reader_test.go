@@ -0,0 +1,260 @@
+package sktdb_v1_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/sktdb"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/sktdb/sktdb.v1"
+	"github.com/Sukhavati-Labs/go-miner/poc/pocutil"
+	"github.com/Sukhavati-Labs/go-miner/pocec"
+)
+
+var readModeTests = []struct {
+	mode       sktdb.ReadMode
+	cachePages int
+}{
+	{sktdb.ReadModeFile, 0},
+	{sktdb.ReadModeFile, 64},
+	{sktdb.ReadModeMmap, 0},
+	{sktdb.ReadModeMmap, 64},
+}
+
+// plotForRead plots a HashMapB of bl in dir and returns its path.
+func plotForRead(dir string, bl int) (string, error) {
+	sk, err := pocec.NewPrivateKey(pocec.S256())
+	if err != nil {
+		return "", err
+	}
+	mdb, err := sktdb_v1.NewSktDBV1(dir, 0, sk.PubKey(), bl)
+	if err != nil {
+		return "", err
+	}
+	defer mdb.Close()
+	if err = <-mdb.Plot(); err != nil {
+		return "", err
+	}
+	return mdb.Files()[0], nil
+}
+
+func readAll(mdb *sktdb_v1.SktDBV1, bl int) ([]byte, error) {
+	var buf bytes.Buffer
+	for z := pocutil.PoCValue(0); z < 1<<uint(bl); z++ {
+		x, xp, err := mdb.HashMapB.Get(z)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(x)
+		buf.Write(xp)
+	}
+	return buf.Bytes(), nil
+}
+
+func TestReadMode(t *testing.T) {
+	var bl = 12
+	dir, err := ioutil.TempDir("", "sktdb-read")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// read mode set before plotting takes effect once plotted
+	sk, err := pocec.NewPrivateKey(pocec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	mdb, err := sktdb_v1.NewSktDBV1(dir, 0, sk.PubKey(), bl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = mdb.SetReadMode("direct", 0); err != sktdb.ErrInvalidReadMode {
+		t.Fatalf("expected %v, got %v", sktdb.ErrInvalidReadMode, err)
+	}
+	if err = mdb.SetReadMode(sktdb.ReadModeMmap, 16); err != nil {
+		t.Fatal(err)
+	}
+	if err = <-mdb.Plot(); err != nil {
+		t.Fatal(err)
+	}
+	plotted, err := readAll(mdb, bl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mdb.Close()
+
+	expected, err := ioutil.ReadFile(mdb.Files()[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(plotted, expected[sktdb_v1.PosProofData:]) {
+		t.Fatal("data read by mmap not matched with plot file")
+	}
+
+	for _, test := range readModeTests {
+		mdb, err := sktdb_v1.NewSktDBV1(dir, 0, sk.PubKey(), bl)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = mdb.SetReadMode(test.mode, test.cachePages); err != nil {
+			t.Fatal(err)
+		}
+		data, err := readAll(mdb, bl)
+		mdb.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data, expected[sktdb_v1.PosProofData:]) {
+			t.Errorf("mode %s with %d pages: data not matched with plot file", test.mode, test.cachePages)
+		}
+	}
+}
+
+func TestCloseWhileReading(t *testing.T) {
+	var bl = 12
+	dir, err := ioutil.TempDir("", "sktdb-read")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path, err := plotForRead(dir, bl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range readModeTests {
+		mdb, err := sktdb_v1.NewSktDBV1ForTest(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = mdb.SetReadMode(test.mode, test.cachePages); err != nil {
+			t.Fatal(err)
+		}
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 10000; j++ {
+					// errors are expected once closed
+					mdb.HashMapB.Get(pocutil.PoCValue(rand.Intn(1 << uint(bl))))
+				}
+			}()
+		}
+		mdb.Close()
+		wg.Wait()
+		if _, _, err = mdb.HashMapB.Get(0); err != sktdb.ErrDBCorrupted {
+			t.Errorf("mode %s with %d pages: expected %v after closed, got %v", test.mode, test.cachePages, sktdb.ErrDBCorrupted, err)
+		}
+	}
+}
+
+// TestReadVanishedFile shrinks a mapped plot, so that reading lost pages
+// faults in the same way as a disk unplugged, which should fail the read
+// instead of crashing.
+func TestReadVanishedFile(t *testing.T) {
+	var bl = 12
+	dir, err := ioutil.TempDir("", "sktdb-read")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path, err := plotForRead(dir, bl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range readModeTests {
+		mdb, err := sktdb_v1.NewSktDBV1ForTest(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = mdb.SetReadMode(test.mode, test.cachePages); err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err = mdb.HashMapB.Get(0); err != nil {
+			t.Fatal(err)
+		}
+		if err = os.Truncate(path, sktdb_v1.PosProofData); err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err = mdb.HashMapB.Get(pocutil.PoCValue(1<<uint(bl) - 1)); err == nil {
+			t.Errorf("mode %s with %d pages: expected error reading lost pages", test.mode, test.cachePages)
+		}
+		mdb.Close()
+		if path, err = plotForRead(dir, bl); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkGetSinglePlot(b *testing.B) {
+	benchmarkGet(b, 1)
+}
+
+func BenchmarkGetManyPlots(b *testing.B) {
+	benchmarkGet(b, 32)
+}
+
+// benchmarkGet looks up proofs from plots in turn, plots are copies of a
+// plotted file, as only the read path is measured. Proofs are looked up at
+// random, or from a few hot keys which are looked up again and again.
+func benchmarkGet(b *testing.B, plots int) {
+	var bl = 20
+	dir, err := ioutil.TempDir("", "sktdb-bench")
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path, err := plotForRead(dir, bl)
+	if err != nil {
+		b.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		b.Fatal(err)
+	}
+	paths := make([]string, plots)
+	for i := range paths {
+		paths[i] = filepath.Join(dir, "copy-"+strconv.Itoa(i)+sktdb_v1.SktDBV1Suffix)
+		if err = ioutil.WriteFile(paths[i], data, 0666); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	for _, keys := range []int{1 << uint(bl), 16} {
+		for _, test := range readModeTests {
+			b.Run(fmt.Sprintf("keys-%d/%s-pages-%d", keys, test.mode, test.cachePages), func(b *testing.B) {
+				mdbs := make([]*sktdb_v1.SktDBV1, plots)
+				for i, path := range paths {
+					mdb, err := sktdb_v1.NewSktDBV1ForTest(path)
+					if err != nil {
+						b.Fatal(err)
+					}
+					defer mdb.Close()
+					if err = mdb.SetReadMode(test.mode, test.cachePages); err != nil {
+						b.Fatal(err)
+					}
+					mdbs[i] = mdb
+				}
+				r := rand.New(rand.NewSource(1))
+				hot := make([]pocutil.PoCValue, keys)
+				for i := range hot {
+					hot[i] = pocutil.PoCValue(r.Intn(1 << uint(bl)))
+				}
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					if _, _, err := mdbs[i%plots].Get(hot[r.Intn(keys)]); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,353 @@
+package sktdb_v1
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/Sukhavati-Labs/go-miner/poc"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/sktdb"
+	"github.com/Sukhavati-Labs/go-miner/poc/pocutil"
+	"github.com/Sukhavati-Labs/go-miner/pocec"
+)
+
+// resumeCheckRecords is the number of records checked below a checkpoint,
+// the checkpoint steps back by this number until all checked records are valid.
+const resumeCheckRecords = 4096
+
+// PlotFileName is the info carried by name of a plot file,
+// `ordinal_pubKey_bitLength.massdb` for HashMapB and `ordinal_pubKey_bitLength_a.massdb` for HashMapA.
+type PlotFileName struct {
+	Ordinal   int64
+	PubKey    *pocec.PublicKey
+	BitLength int
+	Type      MapType
+}
+
+// ParsePlotFileName parses base name of filePath.
+func ParsePlotFileName(filePath string) (*PlotFileName, error) {
+	name := strings.ToLower(filepath.Base(filePath))
+	if !strings.HasSuffix(name, SktDBV1Suffix) {
+		return nil, ErrDBWrongFileName
+	}
+	args := strings.Split(strings.TrimSuffix(name, SktDBV1Suffix), "_")
+	info := &PlotFileName{Type: MapTypeHashMapB}
+	if len(args) == 4 && args[3] == "a" {
+		info.Type = MapTypeHashMapA
+		args = args[:3]
+	}
+	if len(args) != 3 {
+		return nil, ErrDBWrongFileName
+	}
+
+	var err error
+	if info.Ordinal, err = strconv.ParseInt(args[0], 10, 64); err != nil {
+		return nil, ErrDBWrongFileName
+	}
+	pubKeyBytes, err := hex.DecodeString(args[1])
+	if err != nil {
+		return nil, ErrDBWrongFileName
+	}
+	if info.PubKey, err = pocec.ParsePubKey(pubKeyBytes, pocec.S256()); err != nil {
+		return nil, ErrDBWrongFileName
+	}
+	if info.BitLength, err = strconv.Atoi(args[2]); err != nil || !validBitLength(info.BitLength) {
+		return nil, ErrDBWrongFileName
+	}
+	return info, nil
+}
+
+func validBitLength(bl int) bool {
+	return bl > 0 && bl <= poc.MaxValidBitLength
+}
+
+// FileHeader is the meta info read from a plot file as it is, the file
+// could not be loaded unless Problems is empty.
+type FileHeader struct {
+	FilePath     string
+	FileSize     int64
+	ExpectedSize int64 // size of fully plotted file, zero if Type or BitLength is invalid
+	Version      uint64
+	Type         MapType
+	BitLength    int
+	Checkpoint   pocutil.PoCValue
+	PubKeyHash   pocutil.Hash
+	PubKey       *pocec.PublicKey // nil if invalid
+	Problems     []error
+}
+
+// FinalCheckpoint returns the checkpoint of fully plotted file.
+func (h *FileHeader) FinalCheckpoint() pocutil.PoCValue {
+	if !validBitLength(h.BitLength) {
+		return 0
+	}
+	switch h.Type {
+	case MapTypeHashMapA:
+		return 1 << uint(h.BitLength)
+	case MapTypeHashMapB:
+		return 1 << uint(h.BitLength-1)
+	default:
+		return 0
+	}
+}
+
+// Plotted returns true if checkpoint of file reaches the end.
+func (h *FileHeader) Plotted() bool {
+	final := h.FinalCheckpoint()
+	return final != 0 && h.Checkpoint >= final
+}
+
+// InspectFile reads header of plot file filePath without rejecting damaged
+// one, problems found are collected in order of checks made by LoadHashMap,
+// followed by checks on checkpoint, file size and file name.
+func InspectFile(filePath string) (*FileHeader, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	h := &FileHeader{FilePath: filePath, FileSize: fi.Size()}
+	var fileHeaderBytes [LenMetaInfo]byte
+	if _, err = f.ReadAt(fileHeaderBytes[:], 0); err != nil {
+		h.Problems = append(h.Problems, ErrDBWrongFileSize)
+		return h, nil
+	}
+
+	if !bytes.Equal(fileHeaderBytes[PosFileCode:PosFileCode+LenFileCode], sktdb.DBFileCode) {
+		h.Problems = append(h.Problems, ErrDBWrongFileCode)
+	}
+	if h.Version = binary.LittleEndian.Uint64(fileHeaderBytes[PosVersion:]); h.Version != dbVersion {
+		h.Problems = append(h.Problems, ErrDBWrongVersion)
+	}
+	h.BitLength = int(fileHeaderBytes[PosBitLength])
+	if !validBitLength(h.BitLength) {
+		h.Problems = append(h.Problems, ErrDBWrongBitLength)
+	}
+	h.Type = MapType(fileHeaderBytes[PosType])
+	if _, _, err = calcProofDataOffset(h.Type); err != nil {
+		h.Problems = append(h.Problems, ErrDBWrongMapType)
+	}
+	h.Checkpoint = pocutil.PoCValue(binary.LittleEndian.Uint64(fileHeaderBytes[PosCheckpoint:]))
+	copy(h.PubKeyHash[:], fileHeaderBytes[PosPubKeyHash:])
+	if h.PubKey, err = pocec.ParsePubKey(fileHeaderBytes[PosPubKey:PosPubKey+LenPubKey], pocec.S256()); err != nil {
+		h.PubKey = nil
+		h.Problems = append(h.Problems, ErrDBWrongPubKey)
+	} else if h.PubKeyHash != pocutil.PubKeyHash(h.PubKey) {
+		h.Problems = append(h.Problems, ErrDBWrongPubKeyHash)
+	}
+
+	if final := h.FinalCheckpoint(); final != 0 {
+		h.ExpectedSize = int64(calcSize(h.Type, h.BitLength))
+		if h.Checkpoint > final {
+			h.Problems = append(h.Problems, ErrDBWrongCheckpoint)
+		} else if h.FileSize < dataEnd(h.Type, h.BitLength, h.Checkpoint) || h.FileSize > h.ExpectedSize {
+			h.Problems = append(h.Problems, ErrDBWrongFileSize)
+		}
+	}
+
+	if name, err := ParsePlotFileName(filePath); err != nil {
+		h.Problems = append(h.Problems, err)
+	} else if name.Type != h.Type || name.BitLength != h.BitLength ||
+		h.PubKey == nil || !name.PubKey.IsEqual(h.PubKey) {
+		h.Problems = append(h.Problems, ErrDBNameNotMatched)
+	}
+	return h, nil
+}
+
+// checkpointBytes returns bytes of data plotted between adjacent checkpoints.
+func checkpointBytes(typ MapType, bl int) int64 {
+	var recordSize = int64(pocutil.RecordSize(bl))
+	if typ == MapTypeHashMapB {
+		// checkpoint counts pairs of z, each z holds (x, x')
+		return recordSize * 4
+	}
+	return recordSize
+}
+
+// dataEnd returns the end of data plotted before checkpoint.
+func dataEnd(typ MapType, bl int, checkpoint pocutil.PoCValue) int64 {
+	return LenMetaInfo + int64(checkpoint)*checkpointBytes(typ, bl)
+}
+
+// checkpointBySize returns the max checkpoint of data within size.
+func checkpointBySize(typ MapType, bl int, size int64) pocutil.PoCValue {
+	if size <= LenMetaInfo {
+		return 0
+	}
+	return pocutil.PoCValue((size - LenMetaInfo) / checkpointBytes(typ, bl))
+}
+
+// RepairHeader rewrites header of plot file filePath from its file name,
+// pubKey is checked against the one in file name if not nil. Checkpoint in
+// damaged header is not trusted, it is derived from file size as plotting
+// never writes beyond checkpoints, then stepped back by ResumeFile to the
+// last valid one.
+func RepairHeader(filePath string, pubKey *pocec.PublicKey) (*FileHeader, error) {
+	name, err := ParsePlotFileName(filePath)
+	if err != nil {
+		return nil, err
+	}
+	if pubKey != nil && !pubKey.IsEqual(name.PubKey) {
+		return nil, ErrDBNameNotMatched
+	}
+	fi, err := os.Stat(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &FileHeader{Type: name.Type, BitLength: name.BitLength}
+	checkpoint := checkpointBySize(name.Type, name.BitLength, fi.Size())
+	if final := h.FinalCheckpoint(); checkpoint > final {
+		checkpoint = final
+	}
+
+	f, err := os.OpenFile(filePath, os.O_RDWR, 0666)
+	if err != nil {
+		return nil, err
+	}
+	fileHeaderBytes := encodeFileHeader(name.Type, name.BitLength, checkpoint, name.PubKey)
+	if _, err = f.WriteAt(fileHeaderBytes[:], 0); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if err = f.Sync(); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if err = f.Close(); err != nil {
+		return nil, err
+	}
+
+	if _, _, err = ResumeFile(filePath); err != nil {
+		return nil, err
+	}
+	return InspectFile(filePath)
+}
+
+// ResumeFile steps back checkpoint of plot file filePath until records
+// before it are valid, and truncates data after it, so that plotting resumes
+// from the last valid checkpoint. It returns checkpoints before and after.
+func ResumeFile(filePath string) (from, to pocutil.PoCValue, err error) {
+	hmi, err := LoadHashMap(filePath)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var hm *HashMap
+	var typ MapType
+	var final pocutil.PoCValue
+	var check func(end pocutil.PoCValue) bool
+	switch m := hmi.(type) {
+	case *HashMapA:
+		hm, typ, final = &m.HashMap, MapTypeHashMapA, m.volume
+		check = func(end pocutil.PoCValue) bool { return m.checkRecords(end) }
+	case *HashMapB:
+		hm, typ, final = &m.HashMap, MapTypeHashMapB, m.volume/2
+		// checkpoint counts pairs of z
+		check = func(end pocutil.PoCValue) bool { return m.checkRecords(end * 2) }
+	}
+	defer hm.Close()
+
+	fi, err := hm.data.Stat()
+	if err != nil {
+		return 0, 0, err
+	}
+	from = hm.checkpoint
+	to = from
+	if to > final {
+		to = final
+	}
+	if bySize := checkpointBySize(typ, hm.bitLength, fi.Size()); to > bySize {
+		to = bySize
+	}
+	var step pocutil.PoCValue = resumeCheckRecords
+	if typ == MapTypeHashMapB {
+		step /= 2
+	}
+	for to > 0 && !check(to) {
+		if to < step {
+			to = 0
+		} else {
+			to -= step
+		}
+	}
+	if typ == MapTypeHashMapA {
+		// windows of HashMapA start at even points
+		to &^= 1
+	}
+
+	if to != from {
+		hm.checkpoint = to
+		hm.UpdateCheckpoint()
+	}
+	if end := dataEnd(typ, hm.bitLength, to); fi.Size() > end {
+		if err = hm.data.Truncate(end); err != nil {
+			return from, to, err
+		}
+	}
+	return from, to, hm.data.Sync()
+}
+
+// checkRecords returns true if records in the last block before end are all
+// valid or empty, and some of them are valid. Unwritten records are empty.
+func (hm *HashMapA) checkRecords(end pocutil.PoCValue) bool {
+	var bl = hm.bitLength
+	var start pocutil.PoCValue
+	if end > resumeCheckRecords {
+		start = end - resumeCheckRecords
+	}
+	var valid int
+	var value [8]byte
+	for k := start; k < end; k++ {
+		target := hm.offset + int(k)*hm.recordSize
+		if n, _ := hm.data.ReadAt(value[:hm.recordSize], int64(target)); n < hm.recordSize {
+			return false
+		}
+		xb := value[:hm.recordSize]
+		x := pocutil.Bytes2PoCValue(xb, bl)
+		if !bytes.Equal(pocutil.PoCValue2Bytes(x, bl), xb) {
+			return false
+		}
+		if x == 0 {
+			continue
+		}
+		// reverse mapping of y in HashMapA.Set
+		y := k / 2
+		if k%2 == 1 {
+			y = pocutil.FlipValue(y, bl)
+		}
+		if pocutil.P(x, bl, hm.pkHash) != y {
+			return false
+		}
+		valid++
+	}
+	return valid != 0
+}
+
+// checkRecords returns true if records in the last block before end are all
+// valid or empty, and some of them are valid. Unwritten records are empty.
+func (hm *HashMapB) checkRecords(end pocutil.PoCValue) bool {
+	var start pocutil.PoCValue
+	if end > resumeCheckRecords {
+		start = end - resumeCheckRecords
+	}
+	var valid int
+	for z := start; z < end; z++ {
+		switch hm.verifyRecord(z) {
+		case recordCorrupted:
+			return false
+		case recordValid:
+			valid++
+		}
+	}
+	return valid != 0
+}
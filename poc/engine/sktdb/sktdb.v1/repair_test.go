@@ -0,0 +1,158 @@
+package sktdb_v1_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/sktdb/sktdb.v1"
+	"github.com/Sukhavati-Labs/go-miner/poc/pocutil"
+	"github.com/Sukhavati-Labs/go-miner/pocec"
+)
+
+func TestRepairHeader(t *testing.T) {
+	var bl = 12
+	dir, err := ioutil.TempDir("", "sktdb-repair")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path, err := plotForRead(dir, bl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	name, err := sktdb_v1.ParsePlotFileName(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h, err := sktdb_v1.InspectFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(h.Problems) != 0 || !h.Plotted() || h.Type != sktdb_v1.MapTypeHashMapB || h.BitLength != bl ||
+		h.FileSize != h.ExpectedSize || !h.PubKey.IsEqual(name.PubKey) {
+		t.Fatalf("unexpected header of healthy plot %+v", h)
+	}
+
+	// wipe header
+	f, err := os.OpenFile(path, os.O_RDWR, 0666)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = f.WriteAt(make([]byte, sktdb_v1.LenMetaInfo), 0); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	if _, err = sktdb_v1.LoadHashMap(path); err != sktdb_v1.ErrDBWrongFileCode {
+		t.Fatalf("expected %v, got %v", sktdb_v1.ErrDBWrongFileCode, err)
+	}
+	if h, err = sktdb_v1.InspectFile(path); err != nil {
+		t.Fatal(err)
+	}
+	if len(h.Problems) == 0 || h.Problems[0] != sktdb_v1.ErrDBWrongFileCode {
+		t.Fatalf("unexpected problems of damaged header %v", h.Problems)
+	}
+
+	other, err := pocec.NewPrivateKey(pocec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = sktdb_v1.RepairHeader(path, other.PubKey()); err != sktdb_v1.ErrDBNameNotMatched {
+		t.Fatalf("expected %v, got %v", sktdb_v1.ErrDBNameNotMatched, err)
+	}
+	if h, err = sktdb_v1.RepairHeader(path, name.PubKey); err != nil {
+		t.Fatal(err)
+	}
+	if len(h.Problems) != 0 || !h.Plotted() {
+		t.Fatalf("unexpected header after repaired %+v", h)
+	}
+	report, err := sktdb_v1.VerifyFile(path, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Corrupted != 0 || report.Valid == 0 {
+		t.Errorf("unexpected report after repaired %+v", report)
+	}
+}
+
+func TestResumeFile(t *testing.T) {
+	// small memory makes plotting work in several windows
+	var bl = 18
+	var memory uint64 = 64 * 1024
+	sk := testPlotKey()
+	dir, err := ioutil.TempDir("", "sktdb-resume")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// stop plotting once HashMapB is half plotted
+	mdb, err := sktdb_v1.NewSktDBV1(dir, 0, sk.PubKey(), bl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mdb.SetPlotMemory(memory)
+	result := mdb.Plot()
+	for {
+		if _, _, progress := mdb.Progress(); progress > 75 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	<-mdb.StopPlot()
+	if err = <-result; err != nil {
+		t.Fatal(err)
+	}
+	path := mdb.Files()[0]
+	mdb.Close()
+
+	h, err := sktdb_v1.InspectFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(h.Problems) != 0 || h.Plotted() || h.Checkpoint == 0 {
+		t.Fatalf("unexpected header of half plotted file %+v", h)
+	}
+
+	// tear records below checkpoint and leave garbage after it
+	pairSize := int64(pocutil.RecordSize(bl) * 2)
+	end := sktdb_v1.PosProofData + int64(h.Checkpoint)*2*pairSize
+	f, err := os.OpenFile(path, os.O_RDWR, 0666)
+	if err != nil {
+		t.Fatal(err)
+	}
+	garbage := bytes.Repeat([]byte{0xff}, int(pairSize)*16)
+	if _, err = f.WriteAt(garbage, end-int64(len(garbage))); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = f.WriteAt(garbage, end+pairSize*100); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	from, to, err := sktdb_v1.ResumeFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if from != h.Checkpoint || to >= from || to == 0 {
+		t.Fatalf("unexpected checkpoint resumed from %d to %d", from, to)
+	}
+	if h, err = sktdb_v1.InspectFile(path); err != nil {
+		t.Fatal(err)
+	}
+	if len(h.Problems) != 0 || h.Checkpoint != to || h.FileSize != sktdb_v1.PosProofData+int64(to)*2*pairSize {
+		t.Fatalf("unexpected header after resumed %+v", h)
+	}
+
+	// plotting continues from the resumed checkpoint
+	file, err := plotWithThreads(dir, sk.PubKey(), bl, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !checkBaseline(t, file, bl) {
+		t.Errorf("resumed plot data not matched with single-threaded plotter")
+	}
+}
@@ -39,8 +39,13 @@ type SktDBV1 struct {
 	pubKey     *pocec.PublicKey
 	pubKeyHash pocutil.Hash
 	plotting   int32 // atomic
+	threads    int32  // atomic, count of threads used by plotting
+	memLimit   uint64 // atomic, max bytes of memory used by plotting
 	stopPlotCh chan struct{}
 	wg         sync.WaitGroup
+	readLock   sync.Mutex
+	readMode   sktdb.ReadMode
+	cachePages int
 }
 
 func (sdb *SktDBV1) Type() string {
@@ -81,6 +86,53 @@ func (sdb *SktDBV1) Plot() chan error {
 	return result
 }
 
+// SetPlotThreads sets the count of threads used by following plots,
+// a non-positive count means the count of CPUs.
+func (sdb *SktDBV1) SetPlotThreads(threads int) {
+	atomic.StoreInt32(&sdb.threads, int32(threads))
+}
+
+// PlotThreads returns the count of threads used by plotting.
+func (sdb *SktDBV1) PlotThreads() int {
+	return normalizePlotThreads(int(atomic.LoadInt32(&sdb.threads)))
+}
+
+// SetPlotMemory sets the max bytes of memory used by following plots,
+// zero means no limit other than available memory.
+func (sdb *SktDBV1) SetPlotMemory(bytes uint64) {
+	atomic.StoreUint64(&sdb.memLimit, bytes)
+}
+
+// PlotMemory returns the max bytes of memory used by plotting.
+func (sdb *SktDBV1) PlotMemory() uint64 {
+	return atomic.LoadUint64(&sdb.memLimit)
+}
+
+// SetReadMode sets the way proofs are read and the count of hot pages
+// cached, it takes effect once plotted.
+func (sdb *SktDBV1) SetReadMode(mode sktdb.ReadMode, cachePages int) error {
+	mode, err := sktdb.ParseReadMode(string(mode))
+	if err != nil {
+		return err
+	}
+	sdb.readLock.Lock()
+	defer sdb.readLock.Unlock()
+	sdb.readMode, sdb.cachePages = mode, cachePages
+	if plotted, _ := sdb.HashMapB.Progress(); plotted {
+		sdb.HashMapB.setReadMode(mode, cachePages)
+	}
+	return nil
+}
+
+// applyReadMode applies read mode to HashMapB just plotted.
+func (sdb *SktDBV1) applyReadMode() {
+	sdb.readLock.Lock()
+	defer sdb.readLock.Unlock()
+	if sdb.readMode != "" {
+		sdb.HashMapB.setReadMode(sdb.readMode, sdb.cachePages)
+	}
+}
+
 // StopPlot stops plot process
 func (sdb *SktDBV1) StopPlot() chan error {
 	result := make(chan error, 1)
@@ -98,6 +150,14 @@ func (sdb *SktDBV1) StopPlot() chan error {
 	return result
 }
 
+// Files returns paths of HashMapB and HashMapA if not plotted yet.
+func (sdb *SktDBV1) Files() []string {
+	if sdb.HashMapA != nil {
+		return []string{sdb.filePathB, sdb.filePathA}
+	}
+	return []string{sdb.filePathB}
+}
+
 func (sdb *SktDBV1) Ready() bool {
 	plotted, _ := sdb.HashMapB.Progress()
 	return plotted
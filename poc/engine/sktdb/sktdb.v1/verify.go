@@ -0,0 +1,163 @@
+package sktdb_v1
+
+import (
+	"bytes"
+	"crypto/rand"
+	"sort"
+
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/sktdb"
+	"github.com/Sukhavati-Labs/go-miner/poc/pocutil"
+)
+
+// Verify implements sktdb.Verifier.
+func (sdb *SktDBV1) Verify(samples int) (*sktdb.VerifyReport, error) {
+	if !sdb.Ready() {
+		return nil, sktdb.ErrDBNotPlotted
+	}
+	report, err := sdb.HashMapB.Verify(samples)
+	if err != nil {
+		return nil, err
+	}
+	report.FilePath = sdb.filePathB
+	return report, nil
+}
+
+// Verify samples random challenges on a plotted HashMapB, reads (x, x') pairs
+// and recomputes them by pocutil.P and pocutil.F.
+// A record is corrupted unless P(x) and P(x') are a bit-flip pair and F(x, x')
+// equals to the challenge, or both x and x' are zero (no proof for the challenge).
+// All records are checked in order if samples is not less than the volume.
+func (hm *HashMapB) Verify(samples int) (*sktdb.VerifyReport, error) {
+	if plotted, _ := hm.Progress(); !plotted {
+		return nil, sktdb.ErrDBNotPlotted
+	}
+
+	var bl = hm.bitLength
+	var recordLen = int64(hm.recordSize) * 2
+	report := &sktdb.VerifyReport{BitLength: bl}
+
+	// data after the end of file is unreadable
+	expectedSize := int64(calcSize(MapTypeHashMapB, bl))
+	fi, err := hm.data.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if size := fi.Size(); size < expectedSize {
+		report.Ranges = append(report.Ranges, sktdb.CorruptRange{Offset: size, Length: expectedSize - size})
+	}
+
+	var nextZ func() (pocutil.PoCValue, error)
+	if samples <= 0 || uint64(samples) >= uint64(hm.volume) {
+		var z pocutil.PoCValue
+		samples = int(hm.volume)
+		nextZ = func() (pocutil.PoCValue, error) {
+			z++
+			return z - 1, nil
+		}
+	} else {
+		nextZ = func() (pocutil.PoCValue, error) {
+			var challenge pocutil.Hash
+			if _, err := rand.Read(challenge[:]); err != nil {
+				return 0, err
+			}
+			return pocutil.CutHash(challenge, bl), nil
+		}
+	}
+
+	var corrupted []int64
+	for i := 0; i < samples; i++ {
+		z, err := nextZ()
+		if err != nil {
+			return nil, err
+		}
+		report.Sampled++
+		switch hm.verifyRecord(z) {
+		case recordValid:
+			report.Valid++
+		case recordEmpty:
+			report.Empty++
+		default:
+			report.Corrupted++
+			corrupted = append(corrupted, int64(hm.offset)+int64(z)*recordLen)
+		}
+	}
+
+	report.Ranges = append(mergeCorruptRanges(corrupted, recordLen), report.Ranges...)
+	return report, nil
+}
+
+type recordStatus uint8
+
+const (
+	recordValid recordStatus = iota
+	recordEmpty
+	recordCorrupted
+)
+
+func (hm *HashMapB) verifyRecord(z pocutil.PoCValue) recordStatus {
+	var bl = hm.bitLength
+	xb, xpb, err := hm.Get(z)
+	if err != nil {
+		return recordCorrupted
+	}
+	x, xp := pocutil.Bytes2PoCValue(xb, bl), pocutil.Bytes2PoCValue(xpb, bl)
+	// bits beyond bitLength are never set by plotter
+	if !bytes.Equal(pocutil.PoCValue2Bytes(x, bl), xb) || !bytes.Equal(pocutil.PoCValue2Bytes(xp, bl), xpb) {
+		return recordCorrupted
+	}
+	if x == 0 && xp == 0 {
+		return recordEmpty
+	}
+	y, yp := pocutil.P(x, bl, hm.pkHash), pocutil.P(xp, bl, hm.pkHash)
+	if y != pocutil.FlipValue(yp, bl) {
+		return recordCorrupted
+	}
+	if pocutil.F(x, xp, bl, hm.pkHash) != z {
+		return recordCorrupted
+	}
+	return recordValid
+}
+
+// mergeCorruptRanges merges offsets of corrupted records into continuous ranges.
+func mergeCorruptRanges(offsets []int64, recordLen int64) []sktdb.CorruptRange {
+	if len(offsets) == 0 {
+		return nil
+	}
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+
+	ranges := []sktdb.CorruptRange{{Offset: offsets[0], Length: recordLen}}
+	for _, offset := range offsets[1:] {
+		last := &ranges[len(ranges)-1]
+		switch {
+		case offset < last.Offset+last.Length:
+			// duplicate sample
+		case offset == last.Offset+last.Length:
+			last.Length += recordLen
+		default:
+			ranges = append(ranges, sktdb.CorruptRange{Offset: offset, Length: recordLen})
+		}
+	}
+	return ranges
+}
+
+// VerifyFile loads HashMapB from filePath and verifies it,
+// it is used for checking plots without running SpaceKeeper.
+func VerifyFile(filePath string, samples int) (*sktdb.VerifyReport, error) {
+	hmi, err := LoadHashMap(filePath)
+	if err != nil {
+		return nil, err
+	}
+	hmB, ok := hmi.(*HashMapB)
+	if !ok {
+		hmi.(*HashMapA).Close()
+		return nil, ErrDBWrongType
+	}
+	defer hmB.Close()
+
+	report, err := hmB.Verify(samples)
+	if err != nil {
+		return nil, err
+	}
+	report.FilePath = filePath
+	return report, nil
+}
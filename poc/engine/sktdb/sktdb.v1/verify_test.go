@@ -0,0 +1,94 @@
+package sktdb_v1_test
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/sktdb"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/sktdb/sktdb.v1"
+	"github.com/Sukhavati-Labs/go-miner/poc/pocutil"
+	"github.com/Sukhavati-Labs/go-miner/pocec"
+)
+
+func TestVerify(t *testing.T) {
+	// small bitLength is enough for checking records
+	var bl = 12
+	dir, err := ioutil.TempDir("", "sktdb-verify")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	sk, err := pocec.NewPrivateKey(pocec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	mdb, err := sktdb_v1.NewSktDBV1(dir, 0, sk.PubKey(), bl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mdb.Close()
+
+	if _, err = mdb.Verify(0); err != sktdb.ErrDBNotPlotted {
+		t.Fatalf("expected %v, got %v", sktdb.ErrDBNotPlotted, err)
+	}
+	if err = <-mdb.Plot(); err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := mdb.Verify(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Sampled != 1<<uint(bl) || report.Corrupted != 0 || report.Valid == 0 || len(report.Ranges) != 0 {
+		t.Fatalf("unexpected report for healthy plot: %+v", report)
+	}
+	if report.Health() != 1 {
+		t.Fatalf("expected health 1, got %f", report.Health())
+	}
+
+	// damage two adjacent records, one proof and one empty
+	var target pocutil.PoCValue
+	for z := pocutil.PoCValue(0); z < 1<<uint(bl)-1; z++ {
+		x, _, err := mdb.HashMapB.Get(z)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pocutil.Bytes2PoCValue(x, bl) != 0 {
+			target = z
+			break
+		}
+	}
+	garbage := []byte{0xff, 0x0f}
+	for _, z := range []pocutil.PoCValue{target, target + 1} {
+		if err = mdb.HashMapB.Set(z, garbage, garbage); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	report, err = mdb.Verify(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Corrupted != 2 || len(report.Ranges) != 1 {
+		t.Fatalf("unexpected report for damaged plot: %+v", report)
+	}
+	recordLen := int64(pocutil.RecordSize(bl) * 2)
+	expected := sktdb.CorruptRange{Offset: sktdb_v1.PosProofData + int64(target)*recordLen, Length: 2 * recordLen}
+	if report.Ranges[0] != expected {
+		t.Fatalf("expected range %+v, got %+v", expected, report.Ranges[0])
+	}
+	if report.Health() >= 1 {
+		t.Fatalf("expected health below 1, got %f", report.Health())
+	}
+
+	// sampling mode
+	report, err = mdb.Verify(100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Sampled != 100 {
+		t.Fatalf("expected 100 samples, got %d", report.Sampled)
+	}
+}
@@ -2,17 +2,21 @@ package capacity
 
 import (
 	"context"
-	"os"
 	"path/filepath"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/Sukhavati-Labs/go-miner/chainutil/service"
 	"github.com/Sukhavati-Labs/go-miner/logging"
 	"github.com/Sukhavati-Labs/go-miner/poc"
 	"github.com/Sukhavati-Labs/go-miner/poc/engine"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/sktdb"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/spacekeeper"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/spacekeeper/signer"
 	"github.com/Sukhavati-Labs/go-miner/poc/pocutil"
 	"github.com/Sukhavati-Labs/go-miner/pocec"
+	"github.com/Sukhavati-Labs/go-miner/wire"
 	"github.com/panjf2000/ants"
 	"github.com/shirou/gopsutil/disk"
 )
@@ -47,15 +51,29 @@ type SpaceKeeper struct {
 	workSpaceIndex        []*WorkSpaceMap
 	workSpacePaths        map[string]*WorkSpacePath
 	workSpaceList         []*WorkSpace
-	queue                 *plotterQueue
+	queue                 *plotScheduler
 	newQueuedWorkSpaceCh  chan *queuedWorkSpace
 	workerPool            *ants.Pool
 	generateInitialIndex  func() error
 	fileWatcher           func()
+	listeners             spacekeeper.Listeners
+	// workSpaces found by fileWatcher are used if configured by flags on start
+	useFoundWorkSpaces  bool
+	foundWorkSpacesPlot bool
+	foundWorkSpacesMine bool
+	mover               *spaceMover
+	moveLock            sync.Mutex // held while switching moved workSpace or watching files
+	plans               map[string]*CapacityPlan
+	planLock            sync.Mutex
+	latencies           *proofLatencies
+	readMode            sktdb.ReadMode // the way proofs are read from plotted workSpaces
+	cachePages          int            // hot pages cached per workSpace
+	signer              signer.Signer  // signs headers with remote keys instead of wallet if set
+	shadow              bool           // never signs, for blocks are only solved in shadow mode
 }
 
 func (sk *SpaceKeeper) OnStart() error {
-	if sk.wallet.IsLocked() {
+	if sk.signer == nil && !sk.shadow && sk.wallet.IsLocked() {
 		logging.CPrint(logging.ERROR, "can not start spaceKeeper with locked poc wallet", logging.LogFormat{"err": ErrWalletIsLocked})
 		return ErrWalletIsLocked
 	}
@@ -63,6 +81,7 @@ func (sk *SpaceKeeper) OnStart() error {
 	sk.quit = make(chan struct{})
 	go sk.spacePlotter()
 	go sk.fileWatcher()
+	go sk.moveSpaces()
 	logging.CPrint(logging.INFO, "spaceKeeper started")
 	return nil
 }
@@ -126,6 +145,9 @@ func (sk *SpaceKeeper) GetProof(ctx context.Context, sid string, challenge pocut
 	}
 
 	if ws, ok := sk.workSpaceIndex[allState].Items()[sid]; ok && ws.using {
+		if !ws.Available() {
+			return nil, ErrWorkSpaceIsUnavailable
+		}
 		return sk.getProof(ws, challenge), nil
 	}
 	return nil, ErrWorkSpaceDoesNotExist
@@ -138,7 +160,10 @@ func (sk *SpaceKeeper) GetProofs(ctx context.Context, flags engine.WorkSpaceStat
 
 	items := make(map[string]*WorkSpace)
 	for _, ws := range getWsByFlags(sk.workSpaceList, flags) {
-		items[ws.id.String()] = ws
+		// skip workSpaces on missing disks rather than failing on them
+		if ws.Available() {
+			items[ws.id.String()] = ws
+		}
 	}
 	if len(items) == 0 {
 		return nil, ErrWorkSpaceIsNotReady
@@ -157,6 +182,9 @@ func (sk *SpaceKeeper) GetProofReader(ctx context.Context, sid string, challenge
 	}
 
 	if ws, ok := sk.workSpaceIndex[allState].Items()[sid]; ok && ws.using {
+		if !ws.Available() {
+			return nil, ErrWorkSpaceIsUnavailable
+		}
 		prw := engine.NewProofRW(ctx, 1)
 		go func() {
 			if err := prw.Write(sk.getProof(ws, challenge)); err != nil {
@@ -176,7 +204,10 @@ func (sk *SpaceKeeper) GetProofsReader(ctx context.Context, flags engine.WorkSpa
 
 	items := make(map[string]*WorkSpace)
 	for _, ws := range getWsByFlags(sk.workSpaceList, flags) {
-		items[ws.id.String()] = ws
+		// skip workSpaces on missing disks rather than failing on them
+		if ws.Available() {
+			items[ws.id.String()] = ws
+		}
 	}
 	prw := engine.NewProofRW(ctx, len(items))
 	go func() {
@@ -246,12 +277,32 @@ func (sk *SpaceKeeper) ActOnWorkSpaces(flags engine.WorkSpaceStateFlags, action
 }
 
 func (sk *SpaceKeeper) SignHash(sid string, hash [32]byte) (*pocec.Signature, error) {
+	if sk.signer != nil {
+		return nil, ErrRemoteSignerNeedsHeader
+	}
 	if ws, ok := sk.workSpaceIndex[allState].Items()[sid]; ok {
 		return sk.wallet.SignMessage(ws.id.PubKey(), hash[:])
 	}
 	return nil, ErrWorkSpaceDoesNotExist
 }
 
+// SignHeader signs the PoC hash of header by the remote signer if configured,
+// or by wallet otherwise.
+func (sk *SpaceKeeper) SignHeader(sid string, header *wire.BlockHeader) (*pocec.Signature, error) {
+	ws, ok := sk.workSpaceIndex[allState].Items()[sid]
+	if !ok {
+		return nil, ErrWorkSpaceDoesNotExist
+	}
+	if sk.signer != nil {
+		return sk.signer.SignHeader(ws.id.PubKey(), header)
+	}
+	pocHash, err := header.PoCHash()
+	if err != nil {
+		return nil, err
+	}
+	return sk.wallet.SignMessage(ws.id.PubKey(), pocHash[:])
+}
+
 // PlotWS should make workSpace state conversion happen like:
 // registered -> plotting -> ready
 // registered -> ready
@@ -259,6 +310,13 @@ func (sk *SpaceKeeper) SignHash(sid string, hash [32]byte) (*pocec.Signature, er
 // ready -> ready
 // mining -> mining
 func (sk *SpaceKeeper) PlotWS(sid string) error {
+	return sk.PlotWSWithThreads(sid, 0)
+}
+
+// PlotWSWithThreads works as PlotWS, the registered workSpace would be
+// plotted by threads, a non-positive count means the count of CPUs.
+// It takes no effect on the workSpace already plotting.
+func (sk *SpaceKeeper) PlotWSWithThreads(sid string, threads int) error {
 	sk.stateLock.RLock()
 	defer sk.stateLock.RUnlock()
 
@@ -268,17 +326,17 @@ func (sk *SpaceKeeper) PlotWS(sid string) error {
 
 	// registered -> plotting -> ready
 	// registered -> ready
-	// TODO: check for existence in plotterQueue
 	if ws, ok := sk.workSpaceIndex[engine.Registered].Get(sid); ok {
-		sk.newQueuedWorkSpaceCh <- newQueuedWorkSpace(ws, false)
+		qws := newQueuedWorkSpace(ws, false)
+		qws.threads = threads
+		sk.newQueuedWorkSpaceCh <- qws
 		return nil
 	}
 
 	// plotting -> ready
 	if _, ok := sk.workSpaceIndex[engine.Plotting].Get(sid); ok {
-		// known that there's no more than one plotting workSpace at the same time
-		qws := sk.queue.PoppedItem()
-		if qws.ws.id.String() != sid {
+		qws := sk.queue.Running(sid)
+		if qws == nil {
 			return ErrWorkSpaceIsNotPlotting
 		}
 		qws.wouldMining = false
@@ -290,6 +348,44 @@ func (sk *SpaceKeeper) PlotWS(sid string) error {
 	return nil
 }
 
+// PausePlotWS pauses the queued workSpace, a plotting workSpace is stopped
+// and converted to registered state, it keeps its position in queue but
+// would not be plotted until resumed.
+func (sk *SpaceKeeper) PausePlotWS(sid string) error {
+	sk.stateLock.RLock()
+	defer sk.stateLock.RUnlock()
+
+	if ws, ok := sk.workSpaceIndex[allState].Get(sid); !ok || !ws.using {
+		return ErrWorkSpaceDoesNotExist
+	}
+
+	qws, err := sk.queue.Pause(sid)
+	if err != nil {
+		return err
+	}
+	if qws != nil {
+		return qws.ws.StopPlot()
+	}
+	return nil
+}
+
+// ResumePlotWS makes the paused workSpace able to be plotted again.
+func (sk *SpaceKeeper) ResumePlotWS(sid string) error {
+	sk.stateLock.RLock()
+	defer sk.stateLock.RUnlock()
+
+	if ws, ok := sk.workSpaceIndex[allState].Get(sid); !ok || !ws.using {
+		return ErrWorkSpaceDoesNotExist
+	}
+
+	return sk.queue.Resume(sid)
+}
+
+// PlotQueue returns running plots followed by waiting plots in order.
+func (sk *SpaceKeeper) PlotQueue() []QueuedPlot {
+	return sk.queue.Plots()
+}
+
 // MineWS should make workSpace state conversion happen like:
 // registered -> plotting -> mining
 // plotting   -> mining
@@ -307,7 +403,6 @@ func (sk *SpaceKeeper) MineWS(sid string) error {
 	}
 
 	// registered -> plotting -> mining
-	// TODO: check for existence in plotterQueue
 	if ws, ok := sk.workSpaceIndex[engine.Registered].Get(sid); ok {
 		sk.newQueuedWorkSpaceCh <- newQueuedWorkSpace(ws, true)
 		return nil
@@ -315,20 +410,21 @@ func (sk *SpaceKeeper) MineWS(sid string) error {
 
 	// plotting -> mining
 	if _, ok := sk.workSpaceIndex[engine.Plotting].Get(sid); ok {
-		// known that there's no more than one plotting workSpace at the same time
-		qws := sk.queue.PoppedItem()
-		if qws.ws.id.String() != sid {
+		qws := sk.queue.Running(sid)
+		if qws == nil {
 			return ErrWorkSpaceIsNotPlotting
 		}
 		qws.wouldMining = true
 		return nil
 	}
 
-	// ready -> mining
+	// ready -> mining, a demoted workSpace is given another chance
 	if ws, ok := sk.workSpaceIndex[engine.Ready].Get(sid); ok {
 		sk.workSpaceIndex[engine.Ready].Delete(sid)
 		sk.workSpaceIndex[engine.Mining].Set(sid, ws)
 		ws.state = engine.Mining
+		sk.latencies.reset(sid)
+		ws.setSlow(false)
 		return nil
 	}
 
@@ -355,9 +451,8 @@ func (sk *SpaceKeeper) StopWS(sid string) error {
 	sk.queue.Delete(sid)
 
 	if ws, ok := sk.workSpaceIndex[engine.Plotting].Get(sid); ok {
-		// known that there's no more than one plotting workSpace at the same time
-		qws := sk.queue.PoppedItem()
-		if qws.ws.id.String() != sid {
+		qws := sk.queue.Running(sid)
+		if qws == nil {
 			return ErrWorkSpaceIsNotPlotting
 		}
 		qws.wouldMining = false
@@ -417,6 +512,9 @@ func (sk *SpaceKeeper) DeleteWS(sid string) error {
 			return ErrWorkSpaceIsNotStill
 		}
 	}
+	if ws.Moving() {
+		return ErrWorkSpaceIsMoving
+	}
 
 	sk.workSpaceIndex[ws.state].Delete(sid)
 	sk.workSpaceIndex[allState].Delete(sid)
@@ -470,6 +568,56 @@ func (sk *SpaceKeeper) DeleteMultiWS(flags engine.WorkSpaceStateFlags) map[strin
 	return result
 }
 
+// VerifyWS samples records of a plotted workSpace and reports its integrity,
+// it is allowed on ready or mining workSpace, and does not change workSpace state
+func (sk *SpaceKeeper) VerifyWS(sid string, samples int) (*sktdb.VerifyReport, error) {
+	ws, err := sk.getPlottedWS(sid)
+	if err != nil {
+		return nil, err
+	}
+
+	// verification may read disk for long, so it holds the db of ws only,
+	// leaving state changes of workSpaces unblocked
+	ws.dbLock.RLock()
+	defer ws.dbLock.RUnlock()
+	if !ws.Available() {
+		return nil, ErrWorkSpaceIsUnavailable
+	}
+	verifier, ok := ws.db.(sktdb.Verifier)
+	if !ok {
+		return nil, sktdb.ErrUnimplemented
+	}
+
+	report, err := verifier.Verify(samples)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to verify workSpace", logging.LogFormat{"sid": sid, "err": err})
+		return nil, err
+	}
+	if report.Corrupted != 0 {
+		logging.CPrint(logging.WARN, "workSpace is corrupted", logging.LogFormat{
+			"sid":       sid,
+			"sampled":   report.Sampled,
+			"corrupted": report.Corrupted,
+			"health":    report.Health(),
+		})
+	}
+	return report, nil
+}
+
+// getPlottedWS returns the workSpace of sid if it is ready or mining.
+func (sk *SpaceKeeper) getPlottedWS(sid string) (*WorkSpace, error) {
+	sk.stateLock.RLock()
+	defer sk.stateLock.RUnlock()
+	ws, ok := sk.workSpaceIndex[allState].Get(sid)
+	if !ok {
+		return nil, ErrWorkSpaceDoesNotExist
+	}
+	if ws.state != engine.Ready && ws.state != engine.Mining {
+		return nil, ErrWorkSpaceIsNotReady
+	}
+	return ws, nil
+}
+
 func (sk *SpaceKeeper) Configured() bool {
 	return atomic.LoadInt32(&sk.configured) != 0
 }
@@ -602,8 +750,19 @@ func usableBitLength() []int {
 // getIndexedWorkSpaces get all indexed workSpace grouped by bitLength
 // slice of workSpace is sorted by same priority as in queuedWorkSpace
 func (sk *SpaceKeeper) getIndexedWorkSpaces() map[int][]*WorkSpace {
+	items := sk.workSpaceIndex[allState].Items()
+	wsList := make([]*WorkSpace, 0, len(items))
+	for _, ws := range items {
+		wsList = append(wsList, ws)
+	}
+	return groupByBitLength(wsList)
+}
+
+// groupByBitLength groups workSpaces by bitLength,
+// slice of workSpace is sorted by same priority as in queuedWorkSpace
+func groupByBitLength(wsList []*WorkSpace) map[int][]*WorkSpace {
 	queueMap := make(map[int]*plotterQueue)
-	for _, ws := range sk.workSpaceIndex[allState].Items() {
+	for _, ws := range wsList {
 		bl := ws.id.bitLength
 		qws := newQueuedWorkSpace(ws, false)
 		if queue, exists := queueMap[bl]; exists {
@@ -625,7 +784,33 @@ func (sk *SpaceKeeper) getIndexedWorkSpaces() map[int][]*WorkSpace {
 }
 
 func (sk *SpaceKeeper) getProof(ws *WorkSpace, challenge pocutil.Hash) *engine.WorkSpaceProof {
+	// db might be closed by moving or unavailable plot file meanwhile
+	ws.dbLock.RLock()
+	if !ws.Available() {
+		ws.dbLock.RUnlock()
+		return &engine.WorkSpaceProof{
+			SpaceID:   ws.id.String(),
+			PublicKey: ws.id.PubKey(),
+			Ordinal:   ws.id.Ordinal(),
+			Error:     ErrWorkSpaceIsUnavailable,
+		}
+	}
+	dir := ws.rootDir
+	start := time.Now()
 	proof, err := ws.db.GetProof(challenge)
+	elapsed := time.Since(start)
+	ws.dbLock.RUnlock()
+
+	proofLookupHistogram.WithLabelValues(dir).Observe(elapsed.Seconds())
+	slow, demote := sk.latencies.observe(ws.id.String(), dir, elapsed)
+	if slow != ws.Slow() {
+		ws.setSlow(slow)
+		logging.CPrint(logging.WARN, "proof lookup latency of workSpace changed",
+			logging.LogFormat{"sid": ws.id.String(), "dir": dir, "slow": slow, "budget": sk.latencies.budget})
+	}
+	if demote {
+		go sk.demoteWorkSpace(ws)
+	}
 	result := &engine.WorkSpaceProof{
 		SpaceID:   ws.id.String(),
 		Proof:     proof,
@@ -698,6 +883,7 @@ func (sk *SpaceKeeper) addWorkSpaceToIndex(ws *WorkSpace) {
 		sk.workSpacePaths[ws.rootDir] = p
 	}
 
+	ws.SetReadMode(sk.readMode, sk.cachePages)
 	sk.workSpaceIndex[allState].Set(sid, ws)
 	sk.workSpaceIndex[ws.state].Set(sid, ws)
 	return
@@ -737,7 +923,7 @@ func (sk *SpaceKeeper) applyConfiguredWorkSpaces(wsList []*WorkSpace, execPlot,
 	}
 	for !tmpQueuedList.Empty() {
 		qws := tmpQueuedList.PopItem()
-		sk.queue.Push(qws, qws.priority())
+		sk.queue.Push(qws)
 		sk.useWorkSpace(qws.ws)
 	}
 	if !(execMine || execPlot) {
@@ -886,37 +1072,20 @@ func (sk *SpaceKeeper) ConfigureBySize(targetSize uint64, execPlot, execMine boo
 		return nil, err
 	}
 
-	if targetSize < uint64(poc.BitLengthDiskSize[usableBitLength()[0]]) {
-		return failureReturn(ErrConfigUnderSizeTarget)
-	}
-
-	var currentSize = 0
-	var finished bool
-	var resultList = make([]*WorkSpace, 0)
-
-	var successfullyReturn = func() ([]engine.WorkSpaceInfo, error) {
-		wsiList, err := sk.applyConfiguredWorkSpaces(resultList, execPlot, execMine)
-		if err != nil {
-			return failureReturn(err)
-		}
-		atomic.StoreInt32(&sk.configured, 1)
-		return wsiList, nil
+	plan, err := sk.planBySize(targetSize, cointype)
+	if err != nil {
+		return failureReturn(err)
 	}
-
-	// try to fill list by indexed spaces
-	resultList, currentSize, finished = fillSpaceListBySize(resultList, sk.getIndexedWorkSpaces(), currentSize, int(targetSize))
-	if finished {
-		return successfullyReturn()
+	resultList, err := sk.executePlan(plan, false)
+	if err != nil {
+		return failureReturn(err)
 	}
-
-	// try to generate new WorkSpace to fill list
-	var err error
-	resultList, _, err = sk.generateFillSpaceListBySize(resultList, currentSize, int(targetSize), cointype)
+	wsiList, err := sk.applyConfiguredWorkSpaces(resultList, execPlot, execMine)
 	if err != nil {
 		return failureReturn(err)
 	}
-
-	return successfullyReturn()
+	atomic.StoreInt32(&sk.configured, 1)
+	return wsiList, nil
 }
 
 func fillSpaceListBySize(dstList []*WorkSpace, srcMap map[int][]*WorkSpace, currentSize, targetSize int) ([]*WorkSpace, int, bool) {
@@ -950,41 +1119,6 @@ func fillSpaceListBySize(dstList []*WorkSpace, srcMap map[int][]*WorkSpace, curr
 	return dstList, currentSize, false
 }
 
-func (sk *SpaceKeeper) generateFillSpaceListBySize(dstList []*WorkSpace, currentSize, targetSize int, cointype uint32) ([]*WorkSpace, int, error) {
-	if !sk.allowGenerateNewSpace {
-		return nil, currentSize, ErrWorkSpaceCannotGenerate
-	}
-	// check os disk size
-	if err := sk.checkOSDiskSize(targetSize - currentSize); err != nil {
-		return nil, currentSize, err
-	}
-	// get allowed BitLength in decreasing order
-	allowedBL := usableBitLength()
-	tmpLen := len(allowedBL)
-	for i := 0; i < tmpLen/2; i++ {
-		allowedBL[i], allowedBL[tmpLen-1-i] = allowedBL[tmpLen-1-i], allowedBL[i]
-	}
-	// generate new WorkSpaces of different BitLengths, until targetSize is satisfied
-	for _, bl := range allowedBL {
-	out:
-		for {
-			if targetSize-currentSize < poc.BitLengthDiskSize[bl] {
-				// Current BitLength is too large
-				break out
-			}
-			currentSize += poc.BitLengthDiskSize[bl]
-			newWS, err := sk.generateNewWorkSpace(bl, cointype)
-			if err != nil {
-				return nil, currentSize, err
-			}
-			sk.addWorkSpaceToIndex(newWS)
-			dstList = append(dstList, newWS)
-		}
-	}
-
-	return dstList, currentSize, nil
-}
-
 func (sk *SpaceKeeper) ConfigureByPubKey(PubKeyBL map[*pocec.PublicKey]int, PubKeyOrdinal map[*pocec.PublicKey]int, execPlot, execMine bool) ([]engine.WorkSpaceInfo, error) {
 	if sk.Started() {
 		return nil, ErrSpaceKeeperIsRunning
@@ -1067,7 +1201,7 @@ func (sk *SpaceKeeper) ConfigureByFlags(flags engine.WorkSpaceStateFlags, execPl
 	if sk.Started() {
 		return nil, ErrSpaceKeeperIsRunning
 	}
-	if sk.wallet.IsLocked() {
+	if sk.signer == nil && !sk.shadow && sk.wallet.IsLocked() {
 		return nil, ErrWalletIsLocked
 	}
 	if !atomic.CompareAndSwapInt32(&sk.configuring, 0, 1) {
@@ -1142,75 +1276,20 @@ func (sk *SpaceKeeper) ConfigureByPath(paths []string, sizes []int, execPlot, ex
 		return nil, err
 	}
 
-	if len(paths) == 0 || len(paths) != len(sizes) {
-		return failureReturn(ErrConfigInvalidPathSize)
-	}
-
-	// check paths
-	absDirs := make([]string, len(paths))
-	for i, dir := range paths {
-		absDir, err := filepath.Abs(dir)
-		if err != nil {
-			logging.CPrint(logging.ERROR, "fail to get abs path", logging.LogFormat{"dir": dir, "err": err})
-			return failureReturn(ErrInvalidDir)
-		}
-		if fi, err := os.Stat(absDir); err != nil {
-			if !os.IsNotExist(err) {
-				logging.CPrint(logging.ERROR, "fail to get file stat", logging.LogFormat{"err": err})
-				return failureReturn(ErrInvalidDir)
-			}
-			if err = os.MkdirAll(absDir, 0700); err != nil {
-				logging.CPrint(logging.ERROR, "mkdir failed", logging.LogFormat{"dir": absDir, "err": err})
-				return failureReturn(ErrInvalidDir)
-			}
-		} else if !fi.IsDir() {
-			logging.CPrint(logging.ERROR, "not directory", logging.LogFormat{"dir": absDir})
-			return failureReturn(ErrInvalidDir)
-		}
-		absDirs[i] = absDir
-	}
-
-	// re-generate initial index
-	sk.dbDirs = absDirs
-	if err := sk.generateInitialIndex(); err != nil {
-		logging.CPrint(logging.ERROR, "fail to re-generate initial index", logging.LogFormat{"err": err})
+	plan, err := sk.planByPath(paths, sizes, autoCreate, cointype)
+	if err != nil {
 		return failureReturn(err)
 	}
-
-	// fill workSpaces
-	var resultList = make([]*WorkSpace, 0)
-	var successfullyReturn = func() ([]engine.WorkSpaceInfo, error) {
-		wsiList, err := sk.applyConfiguredWorkSpaces(resultList, execPlot, execMine)
-		if err != nil {
-			return failureReturn(err)
-		}
-		atomic.StoreInt32(&sk.configured, 1)
-		return wsiList, nil
+	resultList, err := sk.executePlan(plan, false)
+	if err != nil {
+		return failureReturn(err)
 	}
-
-	for i := range absDirs {
-		var currentSize, targetSize = 0, sizes[i]
-		var finished bool
-		var pathResultList = make([]*WorkSpace, 0)
-		// try to fill list by indexed spaces
-		pathResultList, currentSize, finished = fillSpaceListByPathSize(absDirs[i], pathResultList, sk.getIndexedWorkSpaces(), currentSize, targetSize)
-		if finished {
-			resultList = append(resultList, pathResultList...)
-			continue
-		}
-		if autoCreate {
-			logging.CPrint(logging.INFO, "generateFillSpaceListByPathSize", logging.LogFormat{"absDirs": absDirs[i]})
-			// try to generate new WorkSpace to fill list
-			var err error
-			pathResultList, _, err = sk.generateFillSpaceListByPathSize(absDirs[i], pathResultList, currentSize, targetSize, cointype)
-			if err != nil {
-				return failureReturn(err)
-			}
-		}
-		resultList = append(resultList, pathResultList...)
+	wsiList, err := sk.applyConfiguredWorkSpaces(resultList, execPlot, execMine)
+	if err != nil {
+		return failureReturn(err)
 	}
-
-	return successfullyReturn()
+	atomic.StoreInt32(&sk.configured, 1)
+	return wsiList, nil
 }
 
 func fillSpaceListByPathSize(path string, dstList []*WorkSpace, srcMap map[int][]*WorkSpace, currentSize, targetSize int) ([]*WorkSpace, int, bool) {
@@ -1247,41 +1326,6 @@ func fillSpaceListByPathSize(path string, dstList []*WorkSpace, srcMap map[int][
 	return dstList, currentSize, false
 }
 
-func (sk *SpaceKeeper) generateFillSpaceListByPathSize(path string, dstList []*WorkSpace, currentSize, targetSize int, cointype uint32) ([]*WorkSpace, int, error) {
-	if !sk.allowGenerateNewSpace {
-		return nil, currentSize, ErrWorkSpaceCannotGenerate
-	}
-	// check os disk size by path
-	if err := checkOSDiskSizeByPath(path, targetSize-currentSize); err != nil {
-		return nil, currentSize, err
-	}
-	// get allowed BitLength in decreasing order
-	allowedBL := usableBitLength()
-	tmpLen := len(allowedBL)
-	for i := 0; i < tmpLen/2; i++ {
-		allowedBL[i], allowedBL[tmpLen-1-i] = allowedBL[tmpLen-1-i], allowedBL[i]
-	}
-	// generate new WorkSpaces of different BitLengths, until targetSize is satisfied
-	for _, bl := range allowedBL {
-	out:
-		for {
-			if targetSize-currentSize < poc.BitLengthDiskSize[bl] {
-				// Current BitLength is too large
-				break out
-			}
-			currentSize += poc.BitLengthDiskSize[bl]
-			newWS, err := sk.generateNewWorkSpaceByPath(path, bl, cointype)
-			if err != nil {
-				return nil, currentSize, err
-			}
-			sk.addWorkSpaceToIndex(newWS)
-			dstList = append(dstList, newWS)
-		}
-	}
-
-	return dstList, currentSize, nil
-}
-
 func deleteFromSlice(src []*WorkSpace, sid string) []*WorkSpace {
 	if len(src) == 0 {
 		return src
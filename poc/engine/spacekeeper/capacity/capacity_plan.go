@@ -0,0 +1,541 @@
+package capacity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/Sukhavati-Labs/go-miner/logging"
+	"github.com/Sukhavati-Labs/go-miner/poc"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine"
+	"github.com/shirou/gopsutil/disk"
+)
+
+// maxCapacityPlans is the number of latest plans kept for ApplyPlan.
+const maxCapacityPlans = 16
+
+// ordinalPeeker is implemented by wallets which could tell the ordinal of
+// the next generated public key, so that planned workSpaces have ordinals.
+type ordinalPeeker interface {
+	NextPublicKeyOrdinalByCointype(cointype uint32) (uint32, error)
+}
+
+// PlannedSpace is a workSpace to be configured by CapacityPlan.
+type PlannedSpace struct {
+	SpaceID   string // empty for new workSpace, whose public key is not generated yet
+	Ordinal   int64  // negative if ordinal of new workSpace is unknown
+	BitLength int
+	Reused    bool
+	Progress  float64 // plotting progress of reused workSpace
+}
+
+// PlannedDir is the allocation of a directory in CapacityPlan.
+type PlannedDir struct {
+	Dir    string
+	Target uint64 // requested bytes, zero if planned by total size
+	Bytes  uint64 // bytes of planned workSpaces
+	Spaces []PlannedSpace
+}
+
+// PlannedDisk is the usage of a disk after planned workSpaces are plotted.
+type PlannedDisk struct {
+	Disk     string
+	Free     uint64 // free bytes now
+	Required uint64 // bytes to be written by plotting
+	Leftover int64  // free bytes after plotting
+}
+
+// CapacityPlan describes what ConfigureBySize or ConfigureByPath would do,
+// it is applied by ApplyPlan only if nothing it depends on has changed.
+type CapacityPlan struct {
+	ID        string
+	ByPath    bool
+	Target    uint64 // requested bytes in total
+	Dirs      []PlannedDir
+	Disks     []PlannedDisk
+	Bytes     uint64        // bytes of planned workSpaces
+	PlotBytes uint64        // bytes to be plotted
+	PlotTime  time.Duration // estimated plotting time, negative if unknown
+	Created   time.Time
+
+	sizes      []int
+	autoCreate bool
+	cointype   uint32
+}
+
+// PlanBySize returns what ConfigureBySize would do without configuring anything.
+func (sk *SpaceKeeper) PlanBySize(targetSize uint64, cointype uint32) (*CapacityPlan, error) {
+	if !atomic.CompareAndSwapInt32(&sk.configuring, 0, 1) {
+		return nil, ErrSpaceKeeperIsConfiguring
+	}
+	defer atomic.StoreInt32(&sk.configuring, 0)
+
+	plan, err := sk.planBySize(targetSize, cointype)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail on PlanBySize", logging.LogFormat{
+			"target_size": targetSize,
+			"err":         err,
+		})
+		return nil, err
+	}
+	sk.savePlan(plan)
+	return plan, nil
+}
+
+// PlanByPath returns what ConfigureByPath would do without configuring anything,
+// directories are not created either.
+func (sk *SpaceKeeper) PlanByPath(paths []string, sizes []int, autoCreate bool, cointype uint32) (*CapacityPlan, error) {
+	if !atomic.CompareAndSwapInt32(&sk.configuring, 0, 1) {
+		return nil, ErrSpaceKeeperIsConfiguring
+	}
+	defer atomic.StoreInt32(&sk.configuring, 0)
+
+	plan, err := sk.planByPath(paths, sizes, autoCreate, cointype)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail on PlanByPath", logging.LogFormat{
+			"paths": paths,
+			"sizes": sizes,
+			"err":   err,
+		})
+		return nil, err
+	}
+	sk.savePlan(plan)
+	return plan, nil
+}
+
+// ApplyPlan configures spaceKeeper by the plan returned by PlanBySize or PlanByPath.
+// The plan is made again and it returns ErrCapacityPlanOutdated if anything changed.
+func (sk *SpaceKeeper) ApplyPlan(id string, execPlot, execMine bool) ([]engine.WorkSpaceInfo, error) {
+	if sk.Started() {
+		return nil, ErrSpaceKeeperIsRunning
+	}
+	if sk.wallet.IsLocked() {
+		return nil, ErrWalletIsLocked
+	}
+	if !atomic.CompareAndSwapInt32(&sk.configuring, 0, 1) {
+		return nil, ErrSpaceKeeperIsConfiguring
+	}
+	defer atomic.StoreInt32(&sk.configuring, 0)
+
+	var failureReturn = func(err error) ([]engine.WorkSpaceInfo, error) {
+		logging.CPrint(logging.ERROR, "fail on ApplyPlan", logging.LogFormat{
+			"plan_id": id,
+			"err":     err,
+		})
+		return nil, err
+	}
+
+	plan, ok := sk.getPlan(id)
+	if !ok {
+		return failureReturn(ErrCapacityPlanNotFound)
+	}
+	var current *CapacityPlan
+	var err error
+	if plan.ByPath {
+		dirs := make([]string, len(plan.Dirs))
+		for i, pd := range plan.Dirs {
+			dirs[i] = pd.Dir
+		}
+		current, err = sk.planByPath(dirs, plan.sizes, plan.autoCreate, plan.cointype)
+	} else {
+		current, err = sk.planBySize(plan.Target, plan.cointype)
+	}
+	if err != nil {
+		return failureReturn(err)
+	}
+	if current.ID != plan.ID {
+		return failureReturn(ErrCapacityPlanOutdated)
+	}
+
+	atomic.StoreInt32(&sk.configured, 0)
+	wsList, err := sk.executePlan(plan, true)
+	if err != nil {
+		return failureReturn(err)
+	}
+	wsiList, err := sk.applyConfiguredWorkSpaces(wsList, execPlot, execMine)
+	if err != nil {
+		return failureReturn(err)
+	}
+	atomic.StoreInt32(&sk.configured, 1)
+	sk.deletePlan(id)
+	return wsiList, nil
+}
+
+// planBySize is not thread safe, should use lock in upper functions
+func (sk *SpaceKeeper) planBySize(targetSize uint64, cointype uint32) (*CapacityPlan, error) {
+	if targetSize < uint64(poc.BitLengthDiskSize[usableBitLength()[0]]) {
+		return nil, ErrConfigUnderSizeTarget
+	}
+
+	// try to fill list by indexed spaces
+	reused, currentSize, finished := fillSpaceListBySize(nil, sk.getIndexedWorkSpaces(), 0, int(targetSize))
+
+	// try to generate new WorkSpace to fill list
+	var newBLs []int
+	if !finished {
+		if !sk.allowGenerateNewSpace {
+			return nil, ErrWorkSpaceCannotGenerate
+		}
+		if err := sk.checkOSDiskSize(int(targetSize) - currentSize); err != nil {
+			return nil, err
+		}
+		newBLs = fillBitLengthsBySize(currentSize, int(targetSize))
+	}
+
+	plan := &CapacityPlan{Target: targetSize, cointype: cointype}
+	next := sk.nextOrdinal(cointype)
+	dirs := make(map[string]*PlannedDir)
+	var dirOf = func(dir string) *PlannedDir {
+		if pd, ok := dirs[dir]; ok {
+			return pd
+		}
+		dirs[dir] = &PlannedDir{Dir: dir}
+		return dirs[dir]
+	}
+	for _, ws := range reused {
+		pd := dirOf(ws.rootDir)
+		pd.Spaces = append(pd.Spaces, reusedSpace(ws))
+	}
+	if len(newBLs) != 0 {
+		pd := dirOf(sk.dbDirs[0])
+		pd.Spaces, next = appendNewSpaces(pd.Spaces, newBLs, next)
+	}
+	for _, dir := range sortedDirs(dirs) {
+		plan.Dirs = append(plan.Dirs, *dirs[dir])
+	}
+	sk.summarizePlan(plan)
+	return plan, nil
+}
+
+// planByPath is not thread safe, should use lock in upper functions
+func (sk *SpaceKeeper) planByPath(paths []string, sizes []int, autoCreate bool, cointype uint32) (*CapacityPlan, error) {
+	if len(paths) == 0 || len(paths) != len(sizes) {
+		return nil, ErrConfigInvalidPathSize
+	}
+
+	// check paths
+	absDirs := make([]string, len(paths))
+	for i, dir := range paths {
+		absDir, err := filepath.Abs(dir)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "fail to get abs path", logging.LogFormat{"dir": dir, "err": err})
+			return nil, ErrInvalidDir
+		}
+		if fi, err := os.Stat(absDir); err != nil {
+			if !os.IsNotExist(err) {
+				logging.CPrint(logging.ERROR, "fail to get file stat", logging.LogFormat{"err": err})
+				return nil, ErrInvalidDir
+			}
+		} else if !fi.IsDir() {
+			logging.CPrint(logging.ERROR, "not directory", logging.LogFormat{"dir": absDir})
+			return nil, ErrInvalidDir
+		}
+		absDirs[i] = absDir
+	}
+
+	// workSpaces in paths, including those would be indexed by ConfigureByPath
+	candidates, err := sk.peekWorkSpaces(absDirs)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		for _, ws := range candidates {
+			if _, ok := sk.workSpaceIndex[allState].Get(ws.id.String()); !ok {
+				ws.Close()
+			}
+		}
+	}()
+	srcMap := groupByBitLength(candidates)
+
+	plan := &CapacityPlan{ByPath: true, sizes: sizes, autoCreate: autoCreate, cointype: cointype}
+	next := sk.nextOrdinal(cointype)
+	for i, dir := range absDirs {
+		pd := PlannedDir{Dir: dir, Target: uint64(sizes[i])}
+		plan.Target += uint64(sizes[i])
+		// try to fill list by indexed spaces
+		reused, currentSize, finished := fillSpaceListByPathSize(dir, nil, srcMap, 0, sizes[i])
+		for _, ws := range reused {
+			pd.Spaces = append(pd.Spaces, reusedSpace(ws))
+		}
+		if !finished && autoCreate {
+			// try to generate new WorkSpace to fill list
+			if !sk.allowGenerateNewSpace {
+				return nil, ErrWorkSpaceCannotGenerate
+			}
+			if err := checkOSDiskSizeByPath(existingDir(dir), sizes[i]-currentSize); err != nil {
+				return nil, err
+			}
+			pd.Spaces, next = appendNewSpaces(pd.Spaces, fillBitLengthsBySize(currentSize, sizes[i]), next)
+		}
+		plan.Dirs = append(plan.Dirs, pd)
+	}
+	sk.summarizePlan(plan)
+	return plan, nil
+}
+
+// peekWorkSpaces returns indexed workSpaces in dirs, and opens those not indexed yet.
+func (sk *SpaceKeeper) peekWorkSpaces(dirs []string) ([]*WorkSpace, error) {
+	regExpB, err := regexp.Compile(dbType2RegStr[sk.dbType])
+	if err != nil {
+		return nil, err
+	}
+	suffixB := dbType2SuffixB[sk.dbType]
+
+	var result []*WorkSpace
+	known := make(map[string]bool)
+	for _, ws := range sk.workSpaceIndex[allState].Items() {
+		known[ws.dbFilePath()] = true
+		for _, dir := range dirs {
+			if ws.rootDir == dir {
+				result = append(result, ws)
+				break
+			}
+		}
+	}
+	peeked := make(map[string]bool)
+	for _, dir := range dirs {
+		fis, err := ioutil.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, fi := range fis {
+			fileName := fi.Name()
+			if fi.IsDir() || known[filepath.Join(dir, fileName)] || !strings.HasSuffix(strings.ToUpper(fileName), suffixB) ||
+				!regExpB.MatchString(strings.ToUpper(fileName)) {
+				continue
+			}
+			ws, ok := sk.loadWorkSpace(sk.dbType, dir, fileName, suffixB)
+			if !ok {
+				continue
+			}
+			if peeked[ws.id.String()] {
+				ws.Close()
+				continue
+			}
+			peeked[ws.id.String()] = true
+			result = append(result, ws)
+		}
+	}
+	return result, nil
+}
+
+// executePlan creates workSpaces of plan and returns all planned workSpaces.
+// If strict, it fails before generating a public key of unexpected ordinal.
+// executePlan is not thread safe, should use lock in upper functions
+func (sk *SpaceKeeper) executePlan(plan *CapacityPlan, strict bool) ([]*WorkSpace, error) {
+	if plan.ByPath {
+		dirs := make([]string, len(plan.Dirs))
+		for i, pd := range plan.Dirs {
+			if err := os.MkdirAll(pd.Dir, 0700); err != nil {
+				logging.CPrint(logging.ERROR, "mkdir failed", logging.LogFormat{"dir": pd.Dir, "err": err})
+				return nil, ErrInvalidDir
+			}
+			dirs[i] = pd.Dir
+		}
+		// re-generate initial index
+		sk.dbDirs = dirs
+		if err := sk.generateInitialIndex(); err != nil {
+			logging.CPrint(logging.ERROR, "fail to re-generate initial index", logging.LogFormat{"err": err})
+			return nil, err
+		}
+	}
+
+	resultList := make([]*WorkSpace, 0)
+	for _, pd := range plan.Dirs {
+		for _, space := range pd.Spaces {
+			if space.Reused {
+				ws, ok := sk.workSpaceIndex[allState].Get(space.SpaceID)
+				if !ok || ws.rootDir != pd.Dir {
+					return nil, ErrCapacityPlanOutdated
+				}
+				resultList = append(resultList, ws)
+				continue
+			}
+			if strict && space.Ordinal >= 0 && sk.nextOrdinal(plan.cointype) != space.Ordinal {
+				return nil, ErrCapacityPlanOutdated
+			}
+			newWS, err := sk.generateNewWorkSpaceByPath(pd.Dir, space.BitLength, plan.cointype)
+			if err != nil {
+				return nil, err
+			}
+			sk.addWorkSpaceToIndex(newWS)
+			resultList = append(resultList, newWS)
+		}
+	}
+	return resultList, nil
+}
+
+// summarizePlan fills in sizes, disk usages, estimated plotting time and id of plan.
+func (sk *SpaceKeeper) summarizePlan(plan *CapacityPlan) {
+	disks := make(map[string]*PlannedDisk)
+	var plotCount int
+	for i := range plan.Dirs {
+		pd := &plan.Dirs[i]
+		pd.Bytes = 0
+		var required uint64
+		for _, space := range pd.Spaces {
+			size := uint64(poc.BitLengthDiskSize[space.BitLength])
+			pd.Bytes += size
+			if space.Progress < 100 {
+				required += uint64(float64(size) * (100 - space.Progress) / 100)
+				plotCount++
+			}
+		}
+		plan.Bytes += pd.Bytes
+		plan.PlotBytes += required
+
+		d := diskOf(existingDir(pd.Dir))
+		if _, ok := disks[d]; !ok {
+			disks[d] = &PlannedDisk{Disk: d}
+			if usage, err := disk.Usage(existingDir(pd.Dir)); err == nil {
+				disks[d].Free = usage.Free
+			}
+		}
+		disks[d].Required += required
+	}
+	for _, d := range sortedDisks(disks) {
+		d.Leftover = int64(d.Free) - int64(d.Required)
+		plan.Disks = append(plan.Disks, *d)
+	}
+	plan.PlotTime = sk.queue.Estimate(float64(plan.PlotBytes), plotCount)
+	plan.Created = time.Now()
+	plan.ID = plan.hash()
+}
+
+// hash identifies plan by what it configures, so that the same plan made
+// again has the same id unless anything it depends on has changed.
+func (plan *CapacityPlan) hash() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%t/%d/%t/%d\n", plan.ByPath, plan.Target, plan.autoCreate, plan.cointype)
+	for _, pd := range plan.Dirs {
+		fmt.Fprintf(&b, "%s/%d\n", pd.Dir, pd.Target)
+		for _, space := range pd.Spaces {
+			fmt.Fprintf(&b, "%s/%d/%d/%t\n", space.SpaceID, space.Ordinal, space.BitLength, space.Reused)
+		}
+	}
+	sum := sha256.Sum256([]byte(b.String()))
+	return hex.EncodeToString(sum[:16])
+}
+
+func (sk *SpaceKeeper) savePlan(plan *CapacityPlan) {
+	sk.planLock.Lock()
+	defer sk.planLock.Unlock()
+
+	if sk.plans == nil {
+		sk.plans = make(map[string]*CapacityPlan)
+	}
+	sk.plans[plan.ID] = plan
+	for len(sk.plans) > maxCapacityPlans {
+		var oldest *CapacityPlan
+		for _, p := range sk.plans {
+			if oldest == nil || p.Created.Before(oldest.Created) {
+				oldest = p
+			}
+		}
+		delete(sk.plans, oldest.ID)
+	}
+}
+
+func (sk *SpaceKeeper) getPlan(id string) (*CapacityPlan, bool) {
+	sk.planLock.Lock()
+	defer sk.planLock.Unlock()
+	plan, ok := sk.plans[id]
+	return plan, ok
+}
+
+func (sk *SpaceKeeper) deletePlan(id string) {
+	sk.planLock.Lock()
+	defer sk.planLock.Unlock()
+	delete(sk.plans, id)
+}
+
+// nextOrdinal returns the ordinal of next generated public key, or -1 if unknown.
+func (sk *SpaceKeeper) nextOrdinal(cointype uint32) int64 {
+	peeker, ok := sk.wallet.(ordinalPeeker)
+	if !ok {
+		return -1
+	}
+	ordinal, err := peeker.NextPublicKeyOrdinalByCointype(cointype)
+	if err != nil {
+		return -1
+	}
+	return int64(ordinal)
+}
+
+func reusedSpace(ws *WorkSpace) PlannedSpace {
+	return PlannedSpace{
+		SpaceID:   ws.id.String(),
+		Ordinal:   ws.id.Ordinal(),
+		BitLength: ws.id.BitLength(),
+		Reused:    true,
+		Progress:  ws.Progress(),
+	}
+}
+
+// appendNewSpaces appends new workSpaces of bitLengths, whose ordinals start from next.
+func appendNewSpaces(spaces []PlannedSpace, bitLengths []int, next int64) ([]PlannedSpace, int64) {
+	for _, bl := range bitLengths {
+		spaces = append(spaces, PlannedSpace{Ordinal: next, BitLength: bl})
+		if next >= 0 {
+			next++
+		}
+	}
+	return spaces, next
+}
+
+// fillBitLengthsBySize returns BitLengths of new WorkSpaces to be generated,
+// larger BitLength first, until targetSize is satisfied.
+func fillBitLengthsBySize(currentSize, targetSize int) []int {
+	// get allowed BitLength in decreasing order
+	allowedBL := usableBitLength()
+	sort.Sort(sort.Reverse(sort.IntSlice(allowedBL)))
+
+	var result []int
+	for _, bl := range allowedBL {
+		for targetSize-currentSize >= poc.BitLengthDiskSize[bl] {
+			currentSize += poc.BitLengthDiskSize[bl]
+			result = append(result, bl)
+		}
+	}
+	return result
+}
+
+// existingDir returns dir or its nearest existing parent directory.
+func existingDir(dir string) string {
+	for {
+		if _, err := os.Stat(dir); err == nil {
+			return dir
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return dir
+		}
+		dir = parent
+	}
+}
+
+func sortedDirs(dirs map[string]*PlannedDir) []string {
+	result := make([]string, 0, len(dirs))
+	for dir := range dirs {
+		result = append(result, dir)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func sortedDisks(disks map[string]*PlannedDisk) []*PlannedDisk {
+	result := make([]*PlannedDisk, 0, len(disks))
+	for _, d := range disks {
+		result = append(result, d)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Disk < result[j].Disk })
+	return result
+}
@@ -0,0 +1,180 @@
+package capacity
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Sukhavati-Labs/go-miner/chainutil/service"
+	"github.com/Sukhavati-Labs/go-miner/poc"
+	"github.com/Sukhavati-Labs/go-miner/pocec"
+)
+
+// planTestWallet generates public keys of increasing ordinals.
+type planTestWallet struct {
+	*testWallet
+	next uint32
+}
+
+func (w *planTestWallet) GenerateNewPublicKeyByCointype(cointype uint32) (*pocec.PublicKey, uint32, error) {
+	key, err := pocec.NewPrivateKey(pocec.S256())
+	if err != nil {
+		return nil, 0, err
+	}
+	ordinal := w.next
+	w.next++
+	w.ordinals[string(key.PubKey().SerializeCompressed())] = ordinal
+	return key.PubKey(), ordinal, nil
+}
+
+func (w *planTestWallet) NextPublicKeyOrdinalByCointype(cointype uint32) (uint32, error) {
+	return w.next, nil
+}
+
+func (w *planTestWallet) IsLocked() bool {
+	return false
+}
+
+func newPlanTestSpaceKeeper(t *testing.T, dir string) (*SpaceKeeper, *planTestWallet) {
+	wallet := &planTestWallet{testWallet: &testWallet{ordinals: make(map[string]uint32)}}
+	sk := newTestSpaceKeeper(wallet, dir)
+	sk.BaseService = service.NewBaseService(sk, TypeSpaceKeeperV1)
+	sk.allowGenerateNewSpace = true
+	sk.generateInitialIndex = func() error { return generateInitialIndex(sk, typeSktDBV1, regSktDBV1, suffixSktDBV1) }
+
+	// an existing workSpace of ordinal 0
+	pubKey, ordinal, err := wallet.GenerateNewPublicKeyByCointype(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ws, err := NewWorkSpace(typeSktDBV1, dir, int64(ordinal), pubKey, 24)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sk.addWorkSpaceToIndex(ws)
+	return sk, wallet
+}
+
+func closeWorkSpaces(sk *SpaceKeeper) {
+	for _, ws := range sk.workSpaceIndex[allState].Items() {
+		ws.Close()
+	}
+}
+
+func TestPlanBySize(t *testing.T) {
+	dir, err := ioutil.TempDir("", "capacity-plan")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	sk, wallet := newPlanTestSpaceKeeper(t, dir)
+	defer closeWorkSpaces(sk)
+
+	size := uint64(poc.BitLengthDiskSize[24])
+	plan, err := sk.PlanBySize(3*size, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Dirs) != 1 || len(plan.Dirs[0].Spaces) != 3 || plan.Bytes != 3*size || plan.PlotBytes != 3*size {
+		t.Fatalf("unexpected plan %+v", plan)
+	}
+	for i, space := range plan.Dirs[0].Spaces {
+		if space.Reused != (i == 0) || space.Ordinal != int64(i) || space.BitLength != 24 {
+			t.Errorf("unexpected planned space %d: %+v", i, space)
+		}
+	}
+	if len(plan.Disks) != 1 || plan.Disks[0].Required != 3*size || plan.PlotTime >= 0 {
+		t.Errorf("unexpected disks %+v and plot time %v", plan.Disks, plan.PlotTime)
+	}
+	// nothing is generated by planning
+	if files, _ := ioutil.ReadDir(dir); len(files) != 2 || wallet.next != 1 {
+		t.Fatalf("planning generated %d files and %d keys", len(files), wallet.next)
+	}
+	again, err := sk.PlanBySize(3*size, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.ID != plan.ID {
+		t.Errorf("same plan has different id %s and %s", again.ID, plan.ID)
+	}
+
+	// plan is outdated once a key is generated by others
+	if _, _, err = wallet.GenerateNewPublicKeyByCointype(0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = sk.ApplyPlan(plan.ID, false, false); err != ErrCapacityPlanOutdated {
+		t.Fatalf("apply outdated plan, got %v", err)
+	}
+	if _, err = sk.ApplyPlan("unknown", false, false); err != ErrCapacityPlanNotFound {
+		t.Fatalf("apply unknown plan, got %v", err)
+	}
+
+	plan, err = sk.PlanBySize(3*size, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wsiList, err := sk.ApplyPlan(plan.ID, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(wsiList) != 3 || !sk.Configured() {
+		t.Fatalf("applied %d workSpaces, configured %v", len(wsiList), sk.Configured())
+	}
+	for _, space := range plan.Dirs[0].Spaces[1:] {
+		found := false
+		for _, wsi := range wsiList {
+			found = found || (wsi.Ordinal == space.Ordinal && wsi.BitLength == space.BitLength)
+		}
+		if !found {
+			t.Errorf("planned space %+v not applied", space)
+		}
+	}
+	if _, err = sk.ApplyPlan(plan.ID, false, false); err != ErrCapacityPlanNotFound {
+		t.Errorf("apply plan twice, got %v", err)
+	}
+}
+
+func TestPlanByPath(t *testing.T) {
+	dir, err := ioutil.TempDir("", "capacity-plan")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	sk, _ := newPlanTestSpaceKeeper(t, dir)
+	defer closeWorkSpaces(sk)
+
+	size := poc.BitLengthDiskSize[24]
+	newDir := filepath.Join(dir, "new")
+	plan, err := sk.PlanByPath([]string{dir, newDir}, []int{size, 2 * size}, true, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Dirs) != 2 || plan.Target != uint64(3*size) {
+		t.Fatalf("unexpected plan %+v", plan)
+	}
+	if spaces := plan.Dirs[0].Spaces; len(spaces) != 1 || !spaces[0].Reused {
+		t.Errorf("unexpected spaces %+v in %s", spaces, dir)
+	}
+	if spaces := plan.Dirs[1].Spaces; len(spaces) != 2 || spaces[0].Reused || spaces[0].Ordinal != 1 || spaces[1].Ordinal != 2 {
+		t.Errorf("unexpected spaces %+v in %s", spaces, newDir)
+	}
+	// the existing workSpace is not plotted yet
+	if plan.PlotBytes != uint64(3*size) || len(plan.Disks) != 1 || plan.Disks[0].Required != uint64(3*size) {
+		t.Errorf("unexpected plot bytes %d and disks %+v", plan.PlotBytes, plan.Disks)
+	}
+	if _, err = os.Stat(newDir); !os.IsNotExist(err) {
+		t.Fatalf("planning created directory, got %v", err)
+	}
+
+	wsiList, err := sk.ApplyPlan(plan.ID, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(wsiList) != 3 {
+		t.Fatalf("applied %d workSpaces", len(wsiList))
+	}
+	if files, _ := ioutil.ReadDir(newDir); len(files) != 4 {
+		t.Errorf("new directory has %d files", len(files))
+	}
+}
@@ -0,0 +1,213 @@
+package capacity
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/Sukhavati-Labs/go-miner/logging"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/spacekeeper"
+)
+
+// dirWatchInterval is the interval of checking plot files in dbDirs,
+// a new file is registered once it is unchanged for an interval.
+const dirWatchInterval = 10 * time.Second
+
+// watchedFile is a plot file found but not registered yet.
+type watchedFile struct {
+	size    int64
+	modTime time.Time
+	failed  bool // registering failed, not retried until file changes
+}
+
+// watchDirs registers plot files newly put into dbDirs, and marks workSpaces
+// unavailable while their plot files are inaccessible, e.g. disk unmounted,
+// so that disks could be added or replaced without restarting.
+func (sk *SpaceKeeper) watchDirs() {
+	sk.wg.Add(1)
+	defer sk.wg.Done()
+
+	regExpB, err := regexp.Compile(dbType2RegStr[sk.dbType])
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to compile plot file pattern", logging.LogFormat{"err": err})
+		return
+	}
+	files := make(map[string]*watchedFile)
+	ticker := time.NewTicker(dirWatchInterval)
+	defer ticker.Stop()
+
+	logging.CPrint(logging.INFO, "plot dir watcher started", logging.LogFormat{"dirs": sk.dbDirs, "interval": dirWatchInterval})
+	for {
+		select {
+		case <-sk.quit:
+			return
+		case <-ticker.C:
+		}
+		// files are changing while switching moved workSpaces
+		sk.moveLock.Lock()
+		sk.checkWorkSpaceFiles()
+		if atomic.LoadInt32(&sk.configuring) == 0 {
+			sk.scanNewFiles(regExpB, files)
+		}
+		sk.moveLock.Unlock()
+	}
+}
+
+// RegisterListener makes listener notified of workSpaces found in dbDirs, and
+// workSpaces becoming unavailable or available.
+func (sk *SpaceKeeper) RegisterListener(listener spacekeeper.Listener) {
+	sk.listeners.Register(listener)
+}
+
+func (sk *SpaceKeeper) UnregisterListener(listener spacekeeper.Listener) {
+	sk.listeners.Unregister(listener)
+}
+
+func (sk *SpaceKeeper) notifyWorkSpaceChanged(typ string, ws *WorkSpace) {
+	sk.listeners.NotifyWorkSpaceChanged(&spacekeeper.WorkSpaceChange{
+		Type: typ,
+		Dir:  ws.rootDir,
+		Info: ws.Info(),
+	})
+}
+
+// dbFilePath returns path of the plot file, `ordinal_pubKey_bitLength.massdb`.
+func (ws *WorkSpace) dbFilePath() string {
+	pkStr := hex.EncodeToString(ws.id.PubKey().SerializeCompressed())
+	name := fmt.Sprintf("%d_%s_%d%s", ws.id.Ordinal(), pkStr, ws.id.BitLength(), strings.ToLower(dbType2SuffixB[typeSktDBV1]))
+	return filepath.Join(ws.rootDir, name)
+}
+
+// checkWorkSpaceFiles marks workSpaces unavailable once their plot files
+// are inaccessible, and reloads them once the files are back.
+func (sk *SpaceKeeper) checkWorkSpaceFiles() {
+	for sid, ws := range sk.workSpaceIndex[allState].Items() {
+		_, err := os.Stat(ws.dbFilePath())
+		switch {
+		case err != nil && ws.Available():
+			sk.disableWorkSpace(ws)
+			logging.CPrint(logging.WARN, "workSpace unavailable, plot file is inaccessible",
+				logging.LogFormat{"sid": sid, "dir": ws.rootDir, "err": err})
+			sk.notifyWorkSpaceChanged(spacekeeper.WorkSpaceUnavailable, ws)
+
+		case err == nil && !ws.Available():
+			if err = sk.enableWorkSpace(ws); err != nil {
+				logging.CPrint(logging.DEBUG, "fail to reload workSpace",
+					logging.LogFormat{"sid": sid, "dir": ws.rootDir, "err": err})
+				continue
+			}
+			logging.CPrint(logging.INFO, "workSpace available again, plot file is back",
+				logging.LogFormat{"sid": sid, "dir": ws.rootDir, "state": ws.State()})
+			sk.notifyWorkSpaceChanged(spacekeeper.WorkSpaceAvailable, ws)
+		}
+	}
+}
+
+// disableWorkSpace stops using db of ws, a plotting workSpace is stopped
+// and kept in queue until available again.
+func (sk *SpaceKeeper) disableWorkSpace(ws *WorkSpace) {
+	ws.setAvailable(false)
+	if qws := sk.queue.Running(ws.id.String()); qws != nil {
+		ws.StopPlot()
+	}
+	// release files on missing disk
+	ws.closeDB()
+}
+
+// enableWorkSpace reopens db of ws from its plot file.
+func (sk *SpaceKeeper) enableWorkSpace(ws *WorkSpace) error {
+	reloaded, err := NewWorkSpace(sk.dbType, ws.rootDir, ws.id.Ordinal(), ws.id.PubKey(), ws.id.BitLength())
+	if err != nil {
+		return err
+	}
+	reloaded.SetReadMode(sk.readMode, sk.cachePages)
+	sk.stateLock.Lock()
+	ws.setDB(reloaded.db)
+	sk.stateLock.Unlock()
+
+	ws.setAvailable(true)
+	sk.queue.Wakeup()
+	return nil
+}
+
+// scanNewFiles registers plot files in dbDirs not indexed yet. Files are
+// registered once unchanged for an interval, so that files being copied
+// are not loaded.
+func (sk *SpaceKeeper) scanNewFiles(regExpB *regexp.Regexp, files map[string]*watchedFile) {
+	known := make(map[string]bool)
+	for _, ws := range sk.workSpaceIndex[allState].Items() {
+		known[ws.dbFilePath()] = true
+	}
+	suffixB := dbType2SuffixB[sk.dbType]
+
+	found := make(map[string]bool)
+	for _, dbDir := range sk.dbDirs {
+		// directory might be an unmounted mount point
+		fis, err := ioutil.ReadDir(dbDir)
+		if err != nil {
+			continue
+		}
+		for _, fi := range fis {
+			fileName := fi.Name()
+			filePath := filepath.Join(dbDir, fileName)
+			if fi.IsDir() || known[filePath] || !strings.HasSuffix(strings.ToUpper(fileName), suffixB) ||
+				!regExpB.MatchString(strings.ToUpper(fileName)) {
+				continue
+			}
+			found[filePath] = true
+
+			wf, ok := files[filePath]
+			if !ok || wf.size != fi.Size() || !wf.modTime.Equal(fi.ModTime()) {
+				files[filePath] = &watchedFile{size: fi.Size(), modTime: fi.ModTime()}
+				continue
+			}
+			if wf.failed {
+				continue
+			}
+			ws, ok := sk.loadWorkSpace(sk.dbType, dbDir, fileName, suffixB)
+			if !ok {
+				wf.failed = true
+				continue
+			}
+			delete(files, filePath)
+			sk.registerFoundWorkSpace(ws)
+		}
+	}
+	for filePath := range files {
+		if !found[filePath] {
+			delete(files, filePath)
+		}
+	}
+}
+
+// registerFoundWorkSpace adds ws into index, it is also used the same way
+// as workSpaces configured on start if any.
+func (sk *SpaceKeeper) registerFoundWorkSpace(ws *WorkSpace) {
+	sk.stateLock.Lock()
+	sk.addWorkSpaceToIndex(ws)
+	use := sk.useFoundWorkSpaces
+	if use {
+		sk.useWorkSpace(ws)
+	}
+	sk.stateLock.Unlock()
+	if use && (sk.foundWorkSpacesPlot || sk.foundWorkSpacesMine) {
+		sk.newQueuedWorkSpaceCh <- newQueuedWorkSpace(ws, sk.foundWorkSpacesMine)
+	}
+
+	logging.CPrint(logging.INFO, "workSpace found in plot dir",
+		logging.LogFormat{"sid": ws.id.String(), "dir": ws.rootDir, "state": ws.State(), "using": use})
+	sk.notifyWorkSpaceChanged(spacekeeper.WorkSpaceFound, ws)
+}
+
+// useFoundWorkSpacesOnStart makes workSpaces found later used the same way
+// as those configured on start from all plot files in dbDirs.
+func (sk *SpaceKeeper) useFoundWorkSpacesOnStart(execPlot, execMine bool) {
+	sk.useFoundWorkSpaces = true
+	sk.foundWorkSpacesPlot, sk.foundWorkSpacesMine = execPlot, execMine
+}
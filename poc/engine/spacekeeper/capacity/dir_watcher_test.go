@@ -0,0 +1,160 @@
+package capacity
+
+import (
+	"io/ioutil"
+	"os"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/Sukhavati-Labs/go-miner/poc/engine"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/spacekeeper"
+	"github.com/Sukhavati-Labs/go-miner/pocec"
+)
+
+type testWallet struct {
+	PoCWallet
+	ordinals map[string]uint32
+}
+
+func (w *testWallet) GetPublicKeyOrdinal(pubKey *pocec.PublicKey) (uint32, bool) {
+	ordinal, ok := w.ordinals[string(pubKey.SerializeCompressed())]
+	return ordinal, ok
+}
+
+// newTestSpaceKeeper creates SpaceKeeper with empty index, which is not started.
+func newTestSpaceKeeper(wallet PoCWallet, dirs ...string) *SpaceKeeper {
+	sk := &SpaceKeeper{
+		dbDirs:               dirs,
+		dbType:               typeSktDBV1,
+		wallet:               wallet,
+		workSpacePaths:       make(map[string]*WorkSpacePath),
+		queue:                newPlotScheduler(0, 0, 0),
+		newQueuedWorkSpaceCh: make(chan *queuedWorkSpace, plotterMaxChanSize),
+		mover:                newSpaceMover(),
+		latencies:            newProofLatencies(0, false),
+		quit:                 make(chan struct{}),
+	}
+	for s := engine.FirstState; s <= allState; s++ {
+		sk.workSpaceIndex = append(sk.workSpaceIndex, NewWorkSpaceMap())
+	}
+	return sk
+}
+
+type testListener struct {
+	mu      sync.Mutex
+	changes []*spacekeeper.WorkSpaceChange
+}
+
+func (l *testListener) OnWorkSpaceChanged(change *spacekeeper.WorkSpaceChange) {
+	l.mu.Lock()
+	l.changes = append(l.changes, change)
+	l.mu.Unlock()
+}
+
+func (l *testListener) take() []*spacekeeper.WorkSpaceChange {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	changes := l.changes
+	l.changes = nil
+	return changes
+}
+
+func TestWatchDirs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dir-watcher")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// plot files put into dir
+	wallet := &testWallet{ordinals: make(map[string]uint32)}
+	var sids []string
+	for i := 0; i < 2; i++ {
+		key, err := pocec.NewPrivateKey(pocec.S256())
+		if err != nil {
+			t.Fatal(err)
+		}
+		ws, err := NewWorkSpace(typeSktDBV1, dir, int64(i), key.PubKey(), 24)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ws.Close()
+		wallet.ordinals[string(key.PubKey().SerializeCompressed())] = uint32(i)
+		sids = append(sids, ws.id.String())
+	}
+
+	sk := newTestSpaceKeeper(wallet, dir)
+	listener := &testListener{}
+	sk.RegisterListener(listener)
+	regExpB := regexp.MustCompile(regSktDBV1)
+
+	// files are registered once unchanged for an interval
+	files := make(map[string]*watchedFile)
+	sk.scanNewFiles(regExpB, files)
+	if changes := listener.take(); len(changes) != 0 {
+		t.Fatalf("registered files not checked for an interval, %d changes", len(changes))
+	}
+	sk.scanNewFiles(regExpB, files)
+	changes := listener.take()
+	if len(changes) != 2 || changes[0].Type != spacekeeper.WorkSpaceFound || changes[0].Dir != dir {
+		t.Fatalf("unexpected changes %+v", changes)
+	}
+	ws, ok := sk.workSpaceIndex[allState].Get(sids[0])
+	if !ok {
+		t.Fatalf("workSpace %s not registered", sids[0])
+	}
+	defer func() {
+		for _, ws := range sk.workSpaceIndex[allState].Items() {
+			ws.Close()
+		}
+	}()
+
+	// lookups run while db is closed and reopened, which is checked by race detector
+	lookupDone := make(chan struct{})
+	stopLookup := make(chan struct{})
+	go func() {
+		defer close(lookupDone)
+		for {
+			select {
+			case <-stopLookup:
+				return
+			default:
+			}
+			sk.getProof(ws, [32]byte{})
+		}
+	}()
+
+	// disk unmounted
+	path := ws.dbFilePath()
+	if err = os.Rename(path, path+".bak"); err != nil {
+		t.Fatal(err)
+	}
+	sk.checkWorkSpaceFiles()
+	changes = listener.take()
+	if len(changes) != 1 || changes[0].Type != spacekeeper.WorkSpaceUnavailable || !changes[0].Info.Unavailable {
+		t.Fatalf("unexpected changes %+v", changes)
+	}
+	sk.queue.Push(newQueuedWorkSpace(ws, false))
+	if qws := sk.queue.Next(); qws != nil {
+		t.Fatalf("unavailable workSpace %s scheduled", qws.ws.id)
+	}
+	if plots := sk.queue.Plots(); len(plots) != 1 || plots[0].State != PlotStateUnavailable {
+		t.Fatalf("unexpected plots %+v", plots)
+	}
+
+	// disk mounted again
+	if err = os.Rename(path+".bak", path); err != nil {
+		t.Fatal(err)
+	}
+	sk.checkWorkSpaceFiles()
+	changes = listener.take()
+	if len(changes) != 1 || changes[0].Type != spacekeeper.WorkSpaceAvailable || changes[0].Info.Unavailable {
+		t.Fatalf("unexpected changes %+v", changes)
+	}
+	if qws := sk.queue.Next(); qws == nil || qws.ws != ws {
+		t.Fatalf("available workSpace %s not scheduled", ws.id)
+	}
+	close(stopLookup)
+	<-lookupDone
+}
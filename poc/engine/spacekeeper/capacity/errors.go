@@ -10,6 +10,15 @@ var (
 	ErrWorkSpaceIsNotMining     = errors.New("non-mining workSpace")
 	ErrWorkSpaceIsNotStill      = errors.New("non-registered or non-ready workSpace")
 	ErrWorkSpaceCannotGenerate  = errors.New("not allowed to generate new workSpace")
+	ErrWorkSpaceIsNotQueued     = errors.New("non-queued workSpace")
+	ErrWorkSpaceIsNotPaused     = errors.New("non-paused workSpace")
+	ErrWorkSpaceIsUnavailable   = errors.New("unavailable workSpace")
+	ErrWorkSpaceIsMoving        = errors.New("moving workSpace")
+	ErrWorkSpaceIsPlotting      = errors.New("plotting workSpace")
+	ErrWorkSpaceCannotMove      = errors.New("workSpace cannot be moved by files")
+
+	ErrMoveToSameDir     = errors.New("workSpace is already in target directory")
+	ErrMoveCopyCorrupted = errors.New("copied file does not match with source")
 
 	ErrSktDBWrongFileName        = errors.New("db file name not standard")
 	ErrSktDBDuplicate            = errors.New("db file duplicate in root dirs")
@@ -17,6 +26,7 @@ var (
 
 	ErrWalletDoesNotContainPubKey = errors.New("wallet does not contain pubKey")
 	ErrWalletIsLocked             = errors.New("wallet is locked")
+	ErrRemoteSignerNeedsHeader    = errors.New("remote signer signs headers rather than hashes")
 
 	ErrSpaceKeeperIsRunning         = errors.New("spaceKeeper is running")
 	ErrSpaceKeeperIsNotRunning      = errors.New("spaceKeeper is not running")
@@ -29,4 +39,7 @@ var (
 	ErrInvalidRequiredBytes  = errors.New("required disk size in bytes is not valid")
 	ErrConfigInvalidPathSize = errors.New("target path and size is not matched")
 	ErrInvalidDir            = errors.New("invalid directory")
+
+	ErrCapacityPlanNotFound = errors.New("non-existent capacity plan")
+	ErrCapacityPlanOutdated = errors.New("capacity plan is outdated")
 )
@@ -0,0 +1,18 @@
+package capacity
+
+import (
+	"github.com/Sukhavati-Labs/go-miner/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var proofLookupHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: metrics.Namespace,
+	Subsystem: "capacity",
+	Name:      "proof_lookup_seconds",
+	Help:      "Time to look up a proof in a workspace, by root directory of workspace.",
+	Buckets:   prometheus.ExponentialBuckets(0.0005, 2, 14),
+}, []string{"dir"})
+
+func init() {
+	metrics.Registry.MustRegister(proofLookupHistogram)
+}
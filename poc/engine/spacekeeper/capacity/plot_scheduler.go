@@ -0,0 +1,403 @@
+package capacity
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Sukhavati-Labs/go-miner/logging"
+	"github.com/Sukhavati-Labs/go-miner/poc"
+	sktdb_v1 "github.com/Sukhavati-Labs/go-miner/poc/engine/sktdb/sktdb.v1"
+	"github.com/shirou/gopsutil/disk"
+	"github.com/shirou/gopsutil/mem"
+)
+
+const (
+	PlotStateRunning = "running"
+	PlotStateWaiting = "waiting"
+	PlotStatePaused  = "paused"
+	// PlotStateUnavailable is the state of waiting plot whose file is inaccessible
+	PlotStateUnavailable = "unavailable"
+	// PlotStateMoving is the state of waiting plot being moved to another directory
+	PlotStateMoving = "moving"
+
+	// defaultPlotMemoryShare is the share of system memory budgeted for
+	// concurrent plots, if no memory budget is configured
+	defaultPlotMemoryShare = 2
+)
+
+// QueuedPlot represents a workSpace in the plot queue.
+type QueuedPlot struct {
+	SpaceID    string
+	State      string // running, waiting, paused, unavailable or moving
+	Position   int    // position among waiting plots starting from 1, 0 for running plots
+	Progress   float64
+	Threads    int
+	Memory     uint64 // bytes of memory granted to running plot
+	Disk       string
+	Throughput float64       // plotted bytes per second
+	ETA        time.Duration // negative if unknown
+}
+
+// plotScheduler picks queued workSpaces by priority to plot, as many at once
+// as allowed by the count of concurrent plots, the memory budget and the
+// count of concurrent plots writing to each disk. Waiting workSpaces which
+// could not fit are skipped, so that those on other disks or with less memory
+// could start earlier. Unavailable or moving workSpaces are kept in queue
+// until their disks are back or they are moved.
+type plotScheduler struct {
+	sync.Mutex
+	waiting    []*queuedWorkSpace // sorted by priority in descending order
+	running    map[string]*queuedWorkSpace
+	maxPlots   int
+	memBudget  uint64 // zero for unlimited, only if one plot runs at a time
+	memUsed    uint64
+	diskWrites int
+	disks      map[string]int // count of running plots on each disk
+	throughput float64        // bytes per second of last finished plot
+	wakeup     chan struct{}
+}
+
+func newPlotScheduler(maxPlots int, memBudget uint64, diskWrites int) *plotScheduler {
+	if maxPlots <= 0 {
+		maxPlots = 1
+	}
+	if diskWrites <= 0 {
+		diskWrites = 1
+	}
+	// every plot takes as much memory as available without a budget,
+	// so that concurrent plots would run out of memory
+	if memBudget == 0 && maxPlots > 1 {
+		if stat, err := mem.VirtualMemory(); err == nil {
+			memBudget = stat.Total / defaultPlotMemoryShare
+			logging.CPrint(logging.INFO, "no plot memory budget configured, use a share of system memory",
+				logging.LogFormat{"budget": memBudget, "max_plots": maxPlots})
+		} else {
+			logging.CPrint(logging.WARN, "no plot memory budget configured, plot one at a time",
+				logging.LogFormat{"err": err, "max_plots": maxPlots})
+			maxPlots = 1
+		}
+	}
+	return &plotScheduler{
+		running:    make(map[string]*queuedWorkSpace),
+		maxPlots:   maxPlots,
+		memBudget:  memBudget,
+		diskWrites: diskWrites,
+		disks:      make(map[string]int),
+		wakeup:     make(chan struct{}, 1),
+	}
+}
+
+func (ps *plotScheduler) notify() {
+	select {
+	case ps.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+func (ps *plotScheduler) indexOf(sid string) int {
+	for i, qws := range ps.waiting {
+		if qws.ws.id.String() == sid {
+			return i
+		}
+	}
+	return -1
+}
+
+func (ps *plotScheduler) insert(qws *queuedWorkSpace) {
+	priority := qws.priority()
+	i := sort.Search(len(ps.waiting), func(i int) bool {
+		return ps.waiting[i].priority() < priority
+	})
+	ps.waiting = append(ps.waiting, nil)
+	copy(ps.waiting[i+1:], ps.waiting[i:])
+	ps.waiting[i] = qws
+}
+
+// Push adds qws into waiting plots, an existing one would be updated instead.
+func (ps *plotScheduler) Push(qws *queuedWorkSpace) {
+	ps.Lock()
+	defer ps.Unlock()
+
+	sid := qws.ws.id.String()
+	if _, ok := ps.running[sid]; ok {
+		return
+	}
+	if i := ps.indexOf(sid); i >= 0 {
+		ps.waiting[i].wouldMining = qws.wouldMining
+		ps.waiting[i].threads = qws.threads
+		return
+	}
+	ps.insert(qws)
+	ps.notify()
+}
+
+// Delete removes the waiting plot, the running plot would not be queued again once stopped.
+func (ps *plotScheduler) Delete(sid string) {
+	ps.Lock()
+	defer ps.Unlock()
+
+	if i := ps.indexOf(sid); i >= 0 {
+		ps.waiting = append(ps.waiting[:i], ps.waiting[i+1:]...)
+	}
+	if qws, ok := ps.running[sid]; ok {
+		qws.paused = false
+	}
+}
+
+// Running returns the running plot of sid, or nil if not running.
+func (ps *plotScheduler) Running(sid string) *queuedWorkSpace {
+	ps.Lock()
+	defer ps.Unlock()
+
+	return ps.running[sid]
+}
+
+// Pause marks the plot as paused, it returns the plot if it is running and should be stopped.
+func (ps *plotScheduler) Pause(sid string) (*queuedWorkSpace, error) {
+	ps.Lock()
+	defer ps.Unlock()
+
+	if qws, ok := ps.running[sid]; ok {
+		qws.paused = true
+		return qws, nil
+	}
+	if i := ps.indexOf(sid); i >= 0 {
+		ps.waiting[i].paused = true
+		return nil, nil
+	}
+	return nil, ErrWorkSpaceIsNotQueued
+}
+
+// Resume makes the paused plot able to be scheduled again.
+func (ps *plotScheduler) Resume(sid string) error {
+	ps.Lock()
+	defer ps.Unlock()
+
+	if qws, ok := ps.running[sid]; ok {
+		// plot is going to be stopped
+		qws.paused = false
+		return nil
+	}
+	if i := ps.indexOf(sid); i >= 0 {
+		if !ps.waiting[i].paused {
+			return ErrWorkSpaceIsNotPaused
+		}
+		ps.waiting[i].paused = false
+		ps.notify()
+		return nil
+	}
+	return ErrWorkSpaceIsNotQueued
+}
+
+// Wakeup makes waiting plots scheduled again, e.g. an unavailable plot is back.
+func (ps *plotScheduler) Wakeup() {
+	ps.notify()
+}
+
+// Reset removes all waiting plots.
+func (ps *plotScheduler) Reset() {
+	ps.Lock()
+	defer ps.Unlock()
+
+	ps.waiting = nil
+}
+
+func (ps *plotScheduler) Size() int {
+	ps.Lock()
+	defer ps.Unlock()
+
+	return len(ps.waiting) + len(ps.running)
+}
+
+// Next moves the first waiting plot able to run into running plots,
+// it returns nil if there's no such plot.
+func (ps *plotScheduler) Next() *queuedWorkSpace {
+	ps.Lock()
+	defer ps.Unlock()
+
+	if len(ps.running) >= ps.maxPlots {
+		return nil
+	}
+	for i, qws := range ps.waiting {
+		if qws.paused || !qws.ws.Available() || qws.ws.Moving() {
+			continue
+		}
+		d := diskOf(qws.ws.rootDir)
+		if ps.disks[d] >= ps.diskWrites {
+			continue
+		}
+		minMem, maxMem := sktdb_v1.PlotMemory(qws.ws.BitLength())
+		var grant uint64
+		if ps.memBudget != 0 {
+			if ps.memBudget-ps.memUsed < minMem {
+				continue
+			}
+			if grant = ps.memBudget - ps.memUsed; grant > maxMem {
+				grant = maxMem
+			}
+		}
+		ps.waiting = append(ps.waiting[:i], ps.waiting[i+1:]...)
+		qws.disk, qws.memory = d, grant
+		qws.started, qws.startProgress = time.Now(), qws.ws.Progress()
+		ps.running[qws.ws.id.String()] = qws
+		ps.memUsed += grant
+		ps.disks[d]++
+		return qws
+	}
+	return nil
+}
+
+// Finish releases resources of the running plot, a paused or unavailable plot is queued again.
+func (ps *plotScheduler) Finish(qws *queuedWorkSpace) {
+	ps.Lock()
+	defer ps.Unlock()
+
+	sid := qws.ws.id.String()
+	if _, ok := ps.running[sid]; !ok {
+		return
+	}
+	if throughput := qws.throughput(); throughput > 0 {
+		ps.throughput = throughput
+	}
+	delete(ps.running, sid)
+	ps.memUsed -= qws.memory
+	if ps.disks[qws.disk]--; ps.disks[qws.disk] <= 0 {
+		delete(ps.disks, qws.disk)
+	}
+	if qws.paused || !qws.ws.Available() {
+		ps.insert(qws)
+	}
+	ps.notify()
+}
+
+// Plots returns running plots followed by waiting plots in order.
+func (ps *plotScheduler) Plots() []QueuedPlot {
+	ps.Lock()
+	defer ps.Unlock()
+
+	var plots []QueuedPlot
+	var remaining, sum float64
+	for sid, qws := range ps.running {
+		throughput := qws.throughput()
+		plot := QueuedPlot{
+			SpaceID:    sid,
+			State:      PlotStateRunning,
+			Progress:   qws.ws.Progress(),
+			Threads:    qws.threads,
+			Memory:     qws.memory,
+			Disk:       qws.disk,
+			Throughput: throughput,
+			ETA:        -1,
+		}
+		left := qws.remainingBytes()
+		if throughput > 0 {
+			plot.ETA = time.Duration(left / throughput * float64(time.Second))
+			sum += throughput
+		}
+		remaining += left
+		plots = append(plots, plot)
+	}
+	sort.Slice(plots, func(i, j int) bool { return plots[i].SpaceID < plots[j].SpaceID })
+
+	// waiting plots are estimated by the average throughput of running plots
+	perPlot := ps.throughput
+	if len(plots) != 0 && sum > 0 {
+		perPlot = sum / float64(len(plots))
+	}
+	for i, qws := range ps.waiting {
+		plot := QueuedPlot{
+			SpaceID:  qws.ws.id.String(),
+			State:    PlotStateWaiting,
+			Position: i + 1,
+			Progress: qws.ws.Progress(),
+			Threads:  qws.threads,
+			Disk:     diskOf(qws.ws.rootDir),
+			ETA:      -1,
+		}
+		if qws.paused {
+			plot.State = PlotStatePaused
+		} else if !qws.ws.Available() {
+			plot.State = PlotStateUnavailable
+		} else if qws.ws.Moving() {
+			plot.State = PlotStateMoving
+		} else {
+			remaining += qws.remainingBytes()
+			if perPlot > 0 {
+				plot.ETA = time.Duration(remaining / (perPlot * float64(ps.maxPlots)) * float64(time.Second))
+			}
+		}
+		plots = append(plots, plot)
+	}
+	return plots
+}
+
+// Estimate returns the time to plot bytes by count workSpaces, at most maxPlots
+// of them are plotted at a time. It returns negative if no plot is measured yet.
+func (ps *plotScheduler) Estimate(bytes float64, count int) time.Duration {
+	ps.Lock()
+	defer ps.Unlock()
+
+	if bytes <= 0 || count <= 0 {
+		return 0
+	}
+	perPlot := ps.throughput
+	var sum float64
+	var measured int
+	for _, qws := range ps.running {
+		if throughput := qws.throughput(); throughput > 0 {
+			sum += throughput
+			measured++
+		}
+	}
+	if measured != 0 {
+		perPlot = sum / float64(measured)
+	}
+	if perPlot <= 0 {
+		return -1
+	}
+	parallel := ps.maxPlots
+	if count < parallel {
+		parallel = count
+	}
+	return time.Duration(bytes / (perPlot * float64(parallel)) * float64(time.Second))
+}
+
+func (qws *queuedWorkSpace) remainingBytes() float64 {
+	return float64(poc.BitLengthDiskSize[qws.ws.BitLength()]) * (100 - qws.ws.Progress()) / 100
+}
+
+// throughput returns plotted bytes per second since the plot started.
+func (qws *queuedWorkSpace) throughput() float64 {
+	elapsed := time.Since(qws.started).Seconds()
+	if qws.started.IsZero() || elapsed <= 0 {
+		return 0
+	}
+	plotted := float64(poc.BitLengthDiskSize[qws.ws.BitLength()]) * (qws.ws.Progress() - qws.startProgress) / 100
+	return plotted / elapsed
+}
+
+// diskOf returns the mount point of path, or path itself if unknown.
+func diskOf(path string) string {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return path
+	}
+	partitions, err := disk.Partitions(false)
+	if err != nil {
+		return path
+	}
+	var mount string
+	for _, p := range partitions {
+		mp := p.Mountpoint
+		if len(mp) > len(mount) && (path == mp || strings.HasPrefix(path, strings.TrimSuffix(mp, string(filepath.Separator))+string(filepath.Separator))) {
+			mount = mp
+		}
+	}
+	if mount == "" {
+		return path
+	}
+	return mount
+}
@@ -0,0 +1,128 @@
+package capacity
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	sktdb_v1 "github.com/Sukhavati-Labs/go-miner/poc/engine/sktdb/sktdb.v1"
+	"github.com/Sukhavati-Labs/go-miner/pocec"
+)
+
+func newTestQueuedWorkSpaces(t *testing.T, dir string, count int) []*queuedWorkSpace {
+	var list []*queuedWorkSpace
+	for i := 0; i < count; i++ {
+		sk, err := pocec.NewPrivateKey(pocec.S256())
+		if err != nil {
+			t.Fatal(err)
+		}
+		ws, err := NewWorkSpace(typeSktDBV1, dir, int64(i), sk.PubKey(), 24)
+		if err != nil {
+			t.Fatal(err)
+		}
+		list = append(list, newQueuedWorkSpace(ws, false))
+	}
+	return list
+}
+
+func closeTestQueuedWorkSpaces(list []*queuedWorkSpace) {
+	for _, qws := range list {
+		qws.ws.Close()
+	}
+}
+
+func TestPlotScheduler(t *testing.T) {
+	dir, err := ioutil.TempDir("", "plot-scheduler")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	list := newTestQueuedWorkSpaces(t, dir, 3)
+	defer closeTestQueuedWorkSpaces(list)
+	_, mem := sktdb_v1.PlotMemory(24)
+
+	var sid = func(qws *queuedWorkSpace) string {
+		if qws == nil {
+			return ""
+		}
+		return qws.ws.id.String()
+	}
+
+	tests := []struct {
+		name       string
+		maxPlots   int
+		memBudget  uint64
+		diskWrites int
+		running    int
+	}{
+		{"one plot at a time", 1, 0, 3, 1},
+		{"one write on each disk", 3, 0, 1, 1},
+		{"memory budget", 3, mem*2 + mem/2, 3, 2},
+		{"enough memory", 3, mem * 3, 3, 3},
+	}
+	for _, test := range tests {
+		ps := newPlotScheduler(test.maxPlots, test.memBudget, test.diskWrites)
+		for _, qws := range list {
+			ps.Push(qws)
+		}
+		var running []*queuedWorkSpace
+		for qws := ps.Next(); qws != nil; qws = ps.Next() {
+			running = append(running, qws)
+		}
+		if len(running) != test.running {
+			t.Fatalf("%s: running %d, expected %d", test.name, len(running), test.running)
+		}
+		// ordinal 0 has the highest priority
+		if sid(running[0]) != sid(list[0]) {
+			t.Errorf("%s: first plot %s, expected %s", test.name, sid(running[0]), sid(list[0]))
+		}
+		for _, qws := range running {
+			ps.Finish(qws)
+		}
+		if ps.memUsed != 0 || len(ps.disks) != 0 {
+			t.Errorf("%s: resources not released, memory %d, disks %v", test.name, ps.memUsed, ps.disks)
+		}
+	}
+
+	// concurrent plots are budgeted by system memory if not configured
+	if ps := newPlotScheduler(3, 0, 3); ps.memBudget == 0 {
+		t.Error("no memory budget for concurrent plots")
+	}
+	if ps := newPlotScheduler(1, 0, 3); ps.memBudget != 0 {
+		t.Errorf("unexpected memory budget %d for one plot at a time", ps.memBudget)
+	}
+
+	// paused plots keep position and are skipped
+	ps := newPlotScheduler(1, 0, 1)
+	for _, qws := range list {
+		ps.Push(qws)
+	}
+	if _, err := ps.Pause(sid(list[0])); err != nil {
+		t.Fatal(err)
+	}
+	if qws := ps.Next(); sid(qws) != sid(list[1]) {
+		t.Fatalf("next plot %s, expected %s", sid(qws), sid(list[1]))
+	}
+	if err := ps.Resume(sid(list[2])); err != ErrWorkSpaceIsNotPaused {
+		t.Errorf("resume non-paused plot, got %v", err)
+	}
+	// pausing the running plot requeues it once finished
+	if qws, err := ps.Pause(sid(list[1])); err != nil || qws != list[1] {
+		t.Fatalf("pause running plot, got %v %v", qws, err)
+	}
+	ps.Finish(list[1])
+	plots := ps.Plots()
+	if len(plots) != 3 || plots[0].State != PlotStatePaused || plots[1].State != PlotStatePaused || plots[2].State != PlotStateWaiting {
+		t.Fatalf("unexpected plots %+v", plots)
+	}
+	if err := ps.Resume(sid(list[0])); err != nil {
+		t.Fatal(err)
+	}
+	if qws := ps.Next(); sid(qws) != sid(list[0]) {
+		t.Fatalf("next plot %s, expected %s", sid(qws), sid(list[0]))
+	}
+	if _, err := ps.Pause("unknown"); err != ErrWorkSpaceIsNotQueued {
+		t.Errorf("pause unknown plot, got %v", err)
+	}
+}
@@ -0,0 +1,232 @@
+package capacity
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Sukhavati-Labs/go-miner/logging"
+	"github.com/Sukhavati-Labs/go-miner/poc"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/spacekeeper"
+)
+
+const (
+	// latencyWindowSize is the number of recent proof lookups kept for percentiles
+	latencyWindowSize = 128
+	// minSlowSamples is the number of lookups required before flagging a workSpace slow
+	minSlowSamples = 16
+	// slowPercentile of lookups exceeding budget flags a workSpace slow,
+	// which means more than one in ten lookups is late
+	slowPercentile = 90
+)
+
+// DefaultProofLatencyBudget is a quarter of PoCSlot, the interval miner checks
+// proofs against targets of new slots.
+var DefaultProofLatencyBudget = time.Duration(poc.PoCSlot) * time.Second / 4
+
+// ProofLatency is rolling percentiles of recent proof lookups.
+type ProofLatency struct {
+	Samples    int    // lookups in window
+	Total      uint64 // lookups since tracked
+	OverBudget int    // lookups in window exceeding budget
+	P50        time.Duration
+	P90        time.Duration
+	P99        time.Duration
+	Max        time.Duration
+	Last       time.Duration
+}
+
+// SpaceLatency is the proof lookup latency of a workSpace.
+type SpaceLatency struct {
+	SpaceID string
+	Dir     string
+	Slow    bool // regularly exceeds budget
+	Demoted bool // stopped mining for being slow
+	ProofLatency
+}
+
+// DirLatency is the proof lookup latency of all workSpaces in a directory.
+type DirLatency struct {
+	Dir string
+	ProofLatency
+}
+
+// latencyWindow keeps durations of recent lookups in a ring.
+type latencyWindow struct {
+	durations  [latencyWindowSize]time.Duration
+	next       int
+	samples    int
+	total      uint64
+	last       time.Duration
+	overBudget int
+}
+
+// add is not thread safe, should use lock in upper functions
+func (w *latencyWindow) add(d, budget time.Duration) {
+	if w.samples == latencyWindowSize {
+		if w.durations[w.next] > budget {
+			w.overBudget--
+		}
+	} else {
+		w.samples++
+	}
+	if d > budget {
+		w.overBudget++
+	}
+	w.durations[w.next] = d
+	w.next = (w.next + 1) % latencyWindowSize
+	w.total++
+	w.last = d
+}
+
+// slow reports whether more lookups than allowed by slowPercentile exceed budget.
+func (w *latencyWindow) slow() bool {
+	return w.samples >= minSlowSamples && w.overBudget*100 > w.samples*(100-slowPercentile)
+}
+
+// stats is not thread safe, should use lock in upper functions
+func (w *latencyWindow) stats() ProofLatency {
+	sorted := make([]time.Duration, w.samples)
+	copy(sorted, w.durations[:w.samples])
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	percentile := func(p int) time.Duration {
+		if len(sorted) == 0 {
+			return 0
+		}
+		return sorted[(len(sorted)-1)*p/100]
+	}
+	return ProofLatency{
+		Samples:    w.samples,
+		Total:      w.total,
+		OverBudget: w.overBudget,
+		P50:        percentile(50),
+		P90:        percentile(90),
+		P99:        percentile(99),
+		Max:        percentile(100),
+		Last:       w.last,
+	}
+}
+
+type spaceLatencyWindow struct {
+	latencyWindow
+	dir     string
+	demoted bool
+}
+
+// proofLatencies tracks proof lookups by workSpace and by directory, a
+// workSpace regularly exceeding budget is flagged slow.
+type proofLatencies struct {
+	mu     sync.Mutex
+	budget time.Duration
+	demote bool // stop mining slow workSpaces
+	spaces map[string]*spaceLatencyWindow
+	dirs   map[string]*latencyWindow
+}
+
+func newProofLatencies(budget time.Duration, demote bool) *proofLatencies {
+	if budget <= 0 {
+		budget = DefaultProofLatencyBudget
+	}
+	return &proofLatencies{
+		budget: budget,
+		demote: demote,
+		spaces: make(map[string]*spaceLatencyWindow),
+		dirs:   make(map[string]*latencyWindow),
+	}
+}
+
+// observe records a lookup of workSpace sid in dir, it returns whether the
+// workSpace is slow, and true for demote once it should be demoted.
+func (pl *proofLatencies) observe(sid, dir string, d time.Duration) (slow, demote bool) {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+
+	sw, ok := pl.spaces[sid]
+	if !ok {
+		sw = &spaceLatencyWindow{}
+		pl.spaces[sid] = sw
+	}
+	sw.dir = dir
+	sw.add(d, pl.budget)
+	dw, ok := pl.dirs[dir]
+	if !ok {
+		dw = &latencyWindow{}
+		pl.dirs[dir] = dw
+	}
+	dw.add(d, pl.budget)
+
+	slow = sw.slow()
+	if slow && pl.demote && !sw.demoted {
+		sw.demoted = true
+		demote = true
+	}
+	return slow, demote
+}
+
+// reset forgets lookups of workSpace sid, e.g. it is mined again after demoted.
+func (pl *proofLatencies) reset(sid string) {
+	pl.mu.Lock()
+	delete(pl.spaces, sid)
+	pl.mu.Unlock()
+}
+
+// snapshot returns latencies of workSpaces accepted by keep, sorted by id, and
+// latencies of directories sorted by path.
+func (pl *proofLatencies) snapshot(keep func(sid string) bool) ([]SpaceLatency, []DirLatency) {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+
+	spaces := make([]SpaceLatency, 0, len(pl.spaces))
+	for sid, sw := range pl.spaces {
+		if !keep(sid) {
+			delete(pl.spaces, sid)
+			continue
+		}
+		spaces = append(spaces, SpaceLatency{
+			SpaceID:      sid,
+			Dir:          sw.dir,
+			Slow:         sw.slow(),
+			Demoted:      sw.demoted,
+			ProofLatency: sw.stats(),
+		})
+	}
+	sort.Slice(spaces, func(i, j int) bool { return spaces[i].SpaceID < spaces[j].SpaceID })
+
+	dirs := make([]DirLatency, 0, len(pl.dirs))
+	for dir, dw := range pl.dirs {
+		dirs = append(dirs, DirLatency{Dir: dir, ProofLatency: dw.stats()})
+	}
+	sort.Slice(dirs, func(i, j int) bool { return dirs[i].Dir < dirs[j].Dir })
+	return spaces, dirs
+}
+
+// ProofLatencies returns proof lookup latencies of workSpaces and directories,
+// and the budget a slow workSpace regularly exceeds.
+func (sk *SpaceKeeper) ProofLatencies() ([]SpaceLatency, []DirLatency, time.Duration) {
+	index := sk.workSpaceIndex[allState]
+	spaces, dirs := sk.latencies.snapshot(func(sid string) bool {
+		_, ok := index.Get(sid)
+		return ok
+	})
+	return spaces, dirs, sk.latencies.budget
+}
+
+// demoteWorkSpace stops mining a slow workSpace, it is mined again by MineWS.
+func (sk *SpaceKeeper) demoteWorkSpace(ws *WorkSpace) {
+	sid := ws.id.String()
+	sk.stateLock.Lock()
+	_, mining := sk.workSpaceIndex[engine.Mining].Get(sid)
+	if mining {
+		sk.workSpaceIndex[engine.Mining].Delete(sid)
+		sk.workSpaceIndex[engine.Ready].Set(sid, ws)
+		ws.state = engine.Ready
+	}
+	sk.stateLock.Unlock()
+	if !mining {
+		return
+	}
+	logging.CPrint(logging.WARN, "workSpace demoted from mining, proof lookups regularly exceed budget",
+		logging.LogFormat{"sid": sid, "dir": ws.rootDir, "budget": sk.latencies.budget})
+	sk.notifyWorkSpaceChanged(spacekeeper.WorkSpaceDemoted, ws)
+}
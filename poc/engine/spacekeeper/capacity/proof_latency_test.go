@@ -0,0 +1,109 @@
+package capacity
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Sukhavati-Labs/go-miner/poc/engine"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/spacekeeper"
+	"github.com/Sukhavati-Labs/go-miner/poc/pocutil"
+)
+
+func TestLatencyWindow(t *testing.T) {
+	var w latencyWindow
+	budget := 100 * time.Millisecond
+	for i := 1; i <= 100; i++ {
+		w.add(time.Duration(i)*time.Millisecond, budget)
+	}
+	stats := w.stats()
+	if stats.Samples != 100 || stats.OverBudget != 0 || stats.P50 != 50*time.Millisecond ||
+		stats.P90 != 90*time.Millisecond || stats.Max != 100*time.Millisecond || w.slow() {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+
+	// the window keeps the latest lookups only
+	for i := 0; i < latencyWindowSize; i++ {
+		w.add(200*time.Millisecond, budget)
+	}
+	stats = w.stats()
+	if stats.Samples != latencyWindowSize || stats.Total != 100+latencyWindowSize ||
+		stats.OverBudget != latencyWindowSize || stats.P50 != 200*time.Millisecond || !w.slow() {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+	for i := 0; i < latencyWindowSize*95/100; i++ {
+		w.add(time.Millisecond, budget)
+	}
+	if stats = w.stats(); w.slow() || stats.OverBudget != latencyWindowSize-latencyWindowSize*95/100 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+}
+
+func TestDemoteSlowWorkSpace(t *testing.T) {
+	dir, err := ioutil.TempDir("", "proof-latency")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	sk, _ := newPlanTestSpaceKeeper(t, dir)
+	defer closeWorkSpaces(sk)
+	listener := &testListener{}
+	sk.RegisterListener(listener)
+
+	// every lookup exceeds budget
+	sk.latencies = newProofLatencies(time.Nanosecond, true)
+	var ws *WorkSpace
+	for _, item := range sk.workSpaceIndex[allState].Items() {
+		ws = item
+	}
+	sid := ws.id.String()
+	sk.workSpaceIndex[ws.state].Delete(sid)
+	ws.state = engine.Mining
+	sk.workSpaceIndex[engine.Mining].Set(sid, ws)
+	sk.useWorkSpace(ws)
+
+	// workSpace is demoted in another goroutine, read its state under stateLock
+	info := func() engine.WorkSpaceInfo {
+		sk.stateLock.RLock()
+		defer sk.stateLock.RUnlock()
+		return ws.Info()
+	}
+	for i := 0; i < minSlowSamples; i++ {
+		sk.getProof(ws, pocutil.Hash{byte(i)})
+	}
+	if !ws.Slow() || !info().Slow {
+		t.Fatal("workSpace is not flagged slow")
+	}
+	// the demotion is finished once listeners are notified
+	var changes []*spacekeeper.WorkSpaceChange
+	for i := 0; i < 100 && len(changes) == 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+		changes = listener.take()
+	}
+	if len(changes) != 1 || changes[0].Type != spacekeeper.WorkSpaceDemoted {
+		t.Fatalf("unexpected changes %+v", changes)
+	}
+	if state := info().State; state != engine.Ready {
+		t.Fatalf("slow workSpace is not demoted, state %s", state)
+	}
+
+	spaces, dirs, budget := sk.ProofLatencies()
+	if budget != time.Nanosecond || len(spaces) != 1 || len(dirs) != 1 {
+		t.Fatalf("unexpected latencies %+v and %+v", spaces, dirs)
+	}
+	if space := spaces[0]; space.SpaceID != sid || space.Dir != dir || !space.Slow || !space.Demoted || space.Samples != minSlowSamples {
+		t.Errorf("unexpected space latency %+v", space)
+	}
+
+	// mined again with latencies reset
+	if err = sk.MineWS(sid); err != nil {
+		t.Fatal(err)
+	}
+	if ws.State() != engine.Mining || ws.Slow() {
+		t.Errorf("unexpected state %s and slow %v", ws.State(), ws.Slow())
+	}
+	if spaces, _, _ = sk.ProofLatencies(); len(spaces) != 0 {
+		t.Errorf("latencies are not reset, %+v", spaces)
+	}
+}
@@ -0,0 +1,593 @@
+package capacity
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+
+	"github.com/Sukhavati-Labs/go-miner/chainutil"
+	"github.com/Sukhavati-Labs/go-miner/logging"
+	"github.com/Sukhavati-Labs/go-miner/poc"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/sktdb"
+	"github.com/shirou/gopsutil/disk"
+)
+
+const (
+	MoveStateWaiting = "waiting"
+	MoveStateCopying = "copying"
+	MoveStateDone    = "done"
+	MoveStateFailed  = "failed"
+
+	// moveJournalSuffix is the suffix of journal kept in source directory
+	// during a move, named as `spaceID.move`.
+	moveJournalSuffix = ".move"
+	// moveTempSuffix is the suffix of files being copied into target directory,
+	// which are never loaded as workSpaces.
+	moveTempSuffix     = ".moving"
+	moveCopyBufferSize = 4 * poc.MiB
+	// maxFinishedMoves is the count of finished moves kept for reporting.
+	maxFinishedMoves = 64
+)
+
+// SpaceMove represents a move of workSpace from one directory to another.
+type SpaceMove struct {
+	SpaceID string
+	From    string
+	To      string
+	State   string // waiting, copying, done or failed
+	Bytes   uint64 // total bytes of files to copy
+	Copied  uint64 // bytes copied
+	Err     error  // reason of failed move
+}
+
+type spaceMove struct {
+	ws     *WorkSpace
+	from   string
+	to     string
+	files  []string // base names of files
+	bytes  uint64
+	copied uint64 // atomic
+	state  string
+	err    error
+}
+
+func (m *spaceMove) info() SpaceMove {
+	return SpaceMove{
+		SpaceID: m.ws.id.String(),
+		From:    m.from,
+		To:      m.to,
+		State:   m.state,
+		Bytes:   m.bytes,
+		Copied:  atomic.LoadUint64(&m.copied),
+		Err:     m.err,
+	}
+}
+
+// spaceMover runs moves one at a time in order of requests.
+type spaceMover struct {
+	sync.Mutex
+	moves  []*spaceMove
+	wakeup chan struct{}
+}
+
+func newSpaceMover() *spaceMover {
+	return &spaceMover{wakeup: make(chan struct{}, 1)}
+}
+
+func (sm *spaceMover) Push(m *spaceMove) {
+	sm.Lock()
+	defer sm.Unlock()
+
+	m.state = MoveStateWaiting
+	sm.moves = append(sm.moves, m)
+	select {
+	case sm.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+// Next marks the first waiting move as copying, it returns nil if there's no such move.
+func (sm *spaceMover) Next() *spaceMove {
+	sm.Lock()
+	defer sm.Unlock()
+
+	for _, m := range sm.moves {
+		if m.state == MoveStateWaiting {
+			m.state = MoveStateCopying
+			return m
+		}
+	}
+	return nil
+}
+
+// Finish records result of the move, and drops the oldest finished moves beyond maxFinishedMoves.
+func (sm *spaceMover) Finish(m *spaceMove, err error) {
+	sm.Lock()
+	defer sm.Unlock()
+
+	if m.err = err; err != nil {
+		m.state = MoveStateFailed
+	} else {
+		m.state = MoveStateDone
+	}
+	var finished int
+	for _, m := range sm.moves {
+		if m.state == MoveStateDone || m.state == MoveStateFailed {
+			finished++
+		}
+	}
+	moves := sm.moves[:0]
+	for _, m := range sm.moves {
+		if finished > maxFinishedMoves && (m.state == MoveStateDone || m.state == MoveStateFailed) {
+			finished--
+			continue
+		}
+		moves = append(moves, m)
+	}
+	sm.moves = moves
+}
+
+// Cancel fails all waiting moves with err.
+func (sm *spaceMover) Cancel(err error) {
+	sm.Lock()
+	defer sm.Unlock()
+
+	for _, m := range sm.moves {
+		if m.state == MoveStateWaiting {
+			m.state, m.err = MoveStateFailed, err
+			m.ws.setMoving(false)
+		}
+	}
+}
+
+func (sm *spaceMover) Moves() []SpaceMove {
+	sm.Lock()
+	defer sm.Unlock()
+
+	moves := make([]SpaceMove, len(sm.moves))
+	for i, m := range sm.moves {
+		moves[i] = m.info()
+	}
+	return moves
+}
+
+// moveJournal records a move in progress, so that a move interrupted by crash
+// is rolled back if files are not copied yet, or finished otherwise.
+type moveJournal struct {
+	SpaceID string   `json:"space_id"`
+	From    string   `json:"from"`
+	To      string   `json:"to"`
+	Files   []string `json:"files"`
+	Copied  bool     `json:"copied"` // all files are copied and verified
+}
+
+func (j *moveJournal) path() string {
+	return filepath.Join(j.From, j.SpaceID+moveJournalSuffix)
+}
+
+// save writes the journal durably by replacing the old one.
+func (j *moveJournal) save() error {
+	data, err := json.Marshal(j)
+	if err != nil {
+		return err
+	}
+	return chainutil.WriteFileAtomic(j.path(), data, 0600)
+}
+
+// finish moves copied files into place and removes source files,
+// it could be repeated until the journal is removed.
+func (j *moveJournal) finish() error {
+	for _, name := range j.Files {
+		dst := filepath.Join(j.To, name)
+		if err := os.Rename(dst+moveTempSuffix, dst); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		// never remove source without the copy in place
+		if _, err := os.Stat(dst); err != nil {
+			return err
+		}
+		if err := os.Remove(filepath.Join(j.From, name)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return os.Remove(j.path())
+}
+
+// rollback removes copied files, source files are untouched.
+func (j *moveJournal) rollback() error {
+	for _, name := range j.Files {
+		if err := os.Remove(filepath.Join(j.To, name+moveTempSuffix)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return os.Remove(j.path())
+}
+
+// recoverMoves finishes or rolls back moves interrupted by crash, it should
+// be called before loading workSpaces from dirs.
+func recoverMoves(dirs []string) {
+	for _, dir := range dirs {
+		paths, err := filepath.Glob(filepath.Join(dir, "*"+moveJournalSuffix))
+		if err != nil {
+			continue
+		}
+		for _, path := range paths {
+			data, err := ioutil.ReadFile(path)
+			if err != nil {
+				logging.CPrint(logging.ERROR, "fail to read move journal", logging.LogFormat{"path": path, "err": err})
+				continue
+			}
+			j := &moveJournal{}
+			// journal is kept in source directory
+			if err = json.Unmarshal(data, j); err != nil || !sameDir(j.From, filepath.Dir(path)) {
+				logging.CPrint(logging.ERROR, "invalid move journal", logging.LogFormat{"path": path, "err": err})
+				continue
+			}
+			action := "rollback"
+			if j.Copied {
+				action = "finish"
+				err = j.finish()
+			} else {
+				err = j.rollback()
+			}
+			if err != nil {
+				logging.CPrint(logging.ERROR, "fail to recover interrupted move",
+					logging.LogFormat{"sid": j.SpaceID, "from": j.From, "to": j.To, "action": action, "err": err})
+				continue
+			}
+			logging.CPrint(logging.INFO, "interrupted move recovered",
+				logging.LogFormat{"sid": j.SpaceID, "from": j.From, "to": j.To, "action": action})
+		}
+	}
+}
+
+func sameDir(a, b string) bool {
+	absA, errA := filepath.Abs(a)
+	absB, errB := filepath.Abs(b)
+	return errA == nil && errB == nil && absA == absB
+}
+
+// MoveWS moves the workSpace into targetDir, which should be another one of dbDirs.
+// Files are copied while the workSpace keeps mining, then the workSpace switches
+// to copies, so that both copies are never mining at the same time.
+func (sk *SpaceKeeper) MoveWS(sid, targetDir string) (SpaceMove, error) {
+	if !sk.Started() {
+		return SpaceMove{}, ErrSpaceKeeperIsNotRunning
+	}
+	sk.stateLock.Lock()
+	defer sk.stateLock.Unlock()
+
+	ws, ok := sk.workSpaceIndex[allState].Get(sid)
+	if !ok {
+		return SpaceMove{}, ErrWorkSpaceDoesNotExist
+	}
+	m, err := sk.newSpaceMove(ws, targetDir)
+	if err != nil {
+		return SpaceMove{}, err
+	}
+	sk.mover.Push(m)
+	logging.CPrint(logging.INFO, "workSpace move queued",
+		logging.LogFormat{"sid": sid, "from": m.from, "to": m.to, "bytes": m.bytes})
+	return m.info(), nil
+}
+
+// RebalanceWS moves plotted workSpaces among dirs, all dbDirs if empty, to even out
+// free space of disks. It returns moves queued, which are run one at a time.
+func (sk *SpaceKeeper) RebalanceWS(dirs []string) ([]SpaceMove, error) {
+	if !sk.Started() {
+		return nil, ErrSpaceKeeperIsNotRunning
+	}
+	sk.stateLock.Lock()
+	defer sk.stateLock.Unlock()
+
+	if len(dirs) == 0 {
+		dirs = sk.dbDirs
+	}
+	type diskSpace struct {
+		dir    string // target of moves to this disk
+		free   uint64
+		spaces []*WorkSpace
+		sizes  map[*WorkSpace]uint64
+	}
+	var disks []*diskSpace
+	byMount := make(map[string]*diskSpace)
+	for _, dir := range dirs {
+		absDir, err := sk.moveTargetDir(dir)
+		if err != nil {
+			return nil, err
+		}
+		mount := diskOf(absDir)
+		d, ok := byMount[mount]
+		if !ok {
+			usage, err := disk.Usage(absDir)
+			if err != nil {
+				return nil, err
+			}
+			d = &diskSpace{dir: absDir, free: usage.Free, sizes: make(map[*WorkSpace]uint64)}
+			byMount[mount] = d
+			disks = append(disks, d)
+		}
+		if p, ok := sk.workSpacePaths[absDir]; ok {
+			for _, ws := range p.WorkSpaces() {
+				if (ws.state != engine.Ready && ws.state != engine.Mining) || !ws.Available() || ws.Moving() {
+					continue
+				}
+				if _, size, err := workSpaceFiles(ws); err == nil {
+					d.spaces = append(d.spaces, ws)
+					d.sizes[ws] = size
+				}
+			}
+		}
+	}
+
+	// moves the largest workSpace able to narrow the gap between
+	// the fullest disk and the emptiest one, until none could
+	var moves []SpaceMove
+	for len(disks) > 1 {
+		fullest, emptiest := disks[0], disks[0]
+		for _, d := range disks {
+			if d.free < fullest.free {
+				fullest = d
+			}
+			if d.free > emptiest.free {
+				emptiest = d
+			}
+		}
+		gap := emptiest.free - fullest.free
+		var pick *WorkSpace
+		for _, ws := range fullest.spaces {
+			if size := fullest.sizes[ws]; size*2 <= gap && (pick == nil || size > fullest.sizes[pick]) {
+				pick = ws
+			}
+		}
+		if pick == nil {
+			break
+		}
+		size := fullest.sizes[pick]
+		fullest.spaces = deleteFromSlice(fullest.spaces, pick.id.String())
+		m, err := sk.newSpaceMove(pick, emptiest.dir)
+		if err != nil {
+			logging.CPrint(logging.WARN, "skip workSpace on rebalancing",
+				logging.LogFormat{"sid": pick.id.String(), "to": emptiest.dir, "err": err})
+			continue
+		}
+		fullest.free += size
+		emptiest.free -= size
+		sk.mover.Push(m)
+		moves = append(moves, m.info())
+	}
+	logging.CPrint(logging.INFO, "workSpaces rebalancing queued", logging.LogFormat{"dirs": dirs, "moves": len(moves)})
+	return moves, nil
+}
+
+// SpaceMoves returns moves in order of requests, including recently finished ones.
+func (sk *SpaceKeeper) SpaceMoves() []SpaceMove {
+	return sk.mover.Moves()
+}
+
+// moveTargetDir returns the absolute path of dir, which should be one of dbDirs.
+func (sk *SpaceKeeper) moveTargetDir(dir string) (string, error) {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", ErrInvalidDir
+	}
+	for _, dbDir := range sk.dbDirs {
+		if dbDir == absDir {
+			return absDir, nil
+		}
+	}
+	return "", ErrInvalidDir
+}
+
+// newSpaceMove marks ws as moving, it is not thread safe, should use lock in upper functions
+func (sk *SpaceKeeper) newSpaceMove(ws *WorkSpace, targetDir string) (*spaceMove, error) {
+	to, err := sk.moveTargetDir(targetDir)
+	if err != nil {
+		return nil, err
+	}
+	if to == ws.rootDir {
+		return nil, ErrMoveToSameDir
+	}
+	if !ws.Available() {
+		return nil, ErrWorkSpaceIsUnavailable
+	}
+	files, size, err := workSpaceFiles(ws)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkOSDiskSizeByPath(to, int(size)); err != nil {
+		return nil, err
+	}
+	if !ws.setMoving(true) {
+		return nil, ErrWorkSpaceIsMoving
+	}
+	// plotting is never started on moving workSpace
+	if ws.state == engine.Plotting || sk.queue.Running(ws.id.String()) != nil {
+		ws.setMoving(false)
+		return nil, ErrWorkSpaceIsPlotting
+	}
+	names := make([]string, len(files))
+	for i, file := range files {
+		names[i] = filepath.Base(file)
+	}
+	return &spaceMove{ws: ws, from: ws.rootDir, to: to, files: names, bytes: size}, nil
+}
+
+// workSpaceFiles returns files of ws and their total size.
+func workSpaceFiles(ws *WorkSpace) ([]string, uint64, error) {
+	holder, ok := ws.db.(sktdb.FileHolder)
+	if !ok {
+		return nil, 0, ErrWorkSpaceCannotMove
+	}
+	files := holder.Files()
+	var size uint64
+	for _, file := range files {
+		fi, err := os.Stat(file)
+		if err != nil {
+			return nil, 0, err
+		}
+		size += uint64(fi.Size())
+	}
+	return files, size, nil
+}
+
+// moveSpaces runs queued moves one at a time.
+func (sk *SpaceKeeper) moveSpaces() {
+	sk.wg.Add(1)
+	defer sk.wg.Done()
+	defer sk.mover.Cancel(ErrSpaceKeeperIsNotRunning)
+
+	for {
+		for m := sk.mover.Next(); m != nil; m = sk.mover.Next() {
+			sid := m.ws.id.String()
+			logging.CPrint(logging.INFO, "start moving workSpace",
+				logging.LogFormat{"sid": sid, "from": m.from, "to": m.to, "bytes": m.bytes})
+			err := sk.moveWorkSpace(m)
+			sk.mover.Finish(m, err)
+			if err != nil {
+				logging.CPrint(logging.ERROR, "fail to move workSpace",
+					logging.LogFormat{"sid": sid, "from": m.from, "to": m.to, "err": err})
+			} else {
+				logging.CPrint(logging.INFO, "workSpace moved", logging.LogFormat{"sid": sid, "from": m.from, "to": m.to})
+			}
+			select {
+			case <-sk.quit:
+				return
+			default:
+			}
+		}
+		select {
+		case <-sk.quit:
+			return
+		case <-sk.mover.wakeup:
+		}
+	}
+}
+
+// moveWorkSpace copies files of ws into target directory and switches ws to them,
+// copies are removed if failed before switching.
+func (sk *SpaceKeeper) moveWorkSpace(m *spaceMove) (err error) {
+	ws := m.ws
+	defer sk.queue.Wakeup()
+	defer ws.setMoving(false)
+
+	j := &moveJournal{SpaceID: ws.id.String(), From: m.from, To: m.to, Files: m.files}
+	if err = j.save(); err != nil {
+		return err
+	}
+	var switched bool
+	defer func() {
+		if err != nil && !switched {
+			if rbErr := j.rollback(); rbErr != nil {
+				logging.CPrint(logging.ERROR, "fail to remove copies of workSpace",
+					logging.LogFormat{"sid": j.SpaceID, "to": m.to, "err": rbErr})
+			}
+		}
+	}()
+
+	// Step 1: copy files while ws keeps mining on source files
+	for _, name := range m.files {
+		src, dst := filepath.Join(m.from, name), filepath.Join(m.to, name+moveTempSuffix)
+		if err = copyFileVerified(src, dst, &m.copied, sk.quit); err != nil {
+			return err
+		}
+	}
+
+	// Step 2: switch ws to copies, no proof is made from ws meanwhile
+	sk.moveLock.Lock()
+	defer sk.moveLock.Unlock()
+	ws.setAvailable(false)
+	j.Copied = true
+	if err = j.save(); err != nil {
+		ws.setAvailable(true)
+		return err
+	}
+	// move would be finished by recoverMoves once crashed since now
+	switched = true
+	ws.closeDB()
+	err = j.finish()
+
+	sk.stateLock.Lock()
+	if p, ok := sk.workSpacePaths[m.from]; ok {
+		p.Remove(ws)
+	}
+	ws.dbLock.Lock()
+	ws.rootDir = m.to
+	ws.dbLock.Unlock()
+	if p, ok := sk.workSpacePaths[m.to]; ok {
+		p.Add(ws)
+	} else {
+		p = NewWorkSpacePath(m.to)
+		p.Add(ws)
+		sk.workSpacePaths[m.to] = p
+	}
+	sk.stateLock.Unlock()
+	if err != nil {
+		// ws is reloaded by fileWatcher once files are in place
+		return err
+	}
+
+	return sk.enableWorkSpace(ws)
+}
+
+// copyFileVerified copies src to dst, and verifies dst by comparing its
+// checksum with src. Copied bytes are added to copied.
+func copyFileVerified(src, dst string, copied *uint64, quit chan struct{}) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	srcHash := sha256.New()
+	buf := make([]byte, moveCopyBufferSize)
+	for {
+		select {
+		case <-quit:
+			return ErrSpaceKeeperIsNotRunning
+		default:
+		}
+		n, err := in.Read(buf)
+		if n > 0 {
+			srcHash.Write(buf[:n])
+			if _, err := out.Write(buf[:n]); err != nil {
+				return err
+			}
+			atomic.AddUint64(copied, uint64(n))
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if err = out.Sync(); err != nil {
+		return err
+	}
+
+	verify, err := os.Open(dst)
+	if err != nil {
+		return err
+	}
+	defer verify.Close()
+	dstHash := sha256.New()
+	if _, err = io.CopyBuffer(dstHash, verify, buf); err != nil {
+		return err
+	}
+	if string(srcHash.Sum(nil)) != string(dstHash.Sum(nil)) {
+		return ErrMoveCopyCorrupted
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package capacity
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/sktdb"
+	"github.com/Sukhavati-Labs/go-miner/pocec"
+)
+
+func TestMoveWorkSpace(t *testing.T) {
+	root, err := ioutil.TempDir("", "space-mover")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	from, to := filepath.Join(root, "from"), filepath.Join(root, "to")
+	for _, dir := range []string{from, to} {
+		if err = os.Mkdir(dir, 0700); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	key, err := pocec.NewPrivateKey(pocec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ws, err := NewWorkSpace(typeSktDBV1, from, 0, key.PubKey(), 24)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { ws.Close() }()
+	sk := newTestSpaceKeeper(nil, from, to)
+	sk.addWorkSpaceToIndex(ws)
+	files := ws.db.(sktdb.FileHolder).Files()
+	if len(files) != 2 {
+		t.Fatalf("unplotted workSpace has %d files, expected 2", len(files))
+	}
+
+	if _, err = sk.newSpaceMove(ws, from); err != ErrMoveToSameDir {
+		t.Errorf("move to same dir, got %v", err)
+	}
+	if _, err = sk.newSpaceMove(ws, root); err != ErrInvalidDir {
+		t.Errorf("move out of dbDirs, got %v", err)
+	}
+	m, err := sk.newSpaceMove(ws, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = sk.newSpaceMove(ws, to); err != ErrWorkSpaceIsMoving {
+		t.Errorf("move twice, got %v", err)
+	}
+	// plotting is held while moving
+	sk.queue.Push(newQueuedWorkSpace(ws, false))
+	if qws := sk.queue.Next(); qws != nil {
+		t.Fatal("moving workSpace scheduled to plot")
+	}
+
+	if err = sk.moveWorkSpace(m); err != nil {
+		t.Fatal(err)
+	}
+	if ws.rootDir != to || ws.Moving() || !ws.Available() || m.copied != m.bytes {
+		t.Fatalf("unexpected workSpace after move, dir %s, moving %v, available %v, copied %d/%d",
+			ws.rootDir, ws.Moving(), ws.Available(), m.copied, m.bytes)
+	}
+	for _, file := range files {
+		if _, err = os.Stat(file); !os.IsNotExist(err) {
+			t.Errorf("source file %s not removed, %v", file, err)
+		}
+		if _, err = os.Stat(filepath.Join(to, filepath.Base(file))); err != nil {
+			t.Errorf("target file not found, %v", err)
+		}
+	}
+	if left, _ := filepath.Glob(filepath.Join(from, "*")); len(left) != 0 {
+		t.Errorf("files left in source dir %v", left)
+	}
+	if _, ok := sk.workSpacePaths[from].exists[ws.id.String()]; ok {
+		t.Error("workSpace not removed from source path")
+	}
+	if _, ok := sk.workSpacePaths[to].exists[ws.id.String()]; !ok {
+		t.Error("workSpace not added to target path")
+	}
+	if _, _, progress := ws.db.Progress(); progress != 0 {
+		t.Errorf("reloaded progress %f", progress)
+	}
+	if qws := sk.queue.Next(); qws == nil || qws.ws != ws {
+		t.Fatal("moved workSpace not scheduled to plot")
+	}
+}
+
+func TestRecoverMoves(t *testing.T) {
+	root, err := ioutil.TempDir("", "space-mover")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	from, to := filepath.Join(root, "from"), filepath.Join(root, "to")
+	for _, dir := range []string{from, to} {
+		if err = os.Mkdir(dir, 0700); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var write = func(path string) {
+		if err := ioutil.WriteFile(path, []byte(path), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var exists = func(path string) bool {
+		_, err := os.Stat(path)
+		return err == nil
+	}
+
+	// crashed while copying
+	copying := &moveJournal{SpaceID: "a-24", From: from, To: to, Files: []string{"a_b.massdb", "a_a.massdb"}}
+	for _, name := range copying.Files {
+		write(filepath.Join(from, name))
+	}
+	write(filepath.Join(to, copying.Files[0]+moveTempSuffix))
+	if err = copying.save(); err != nil {
+		t.Fatal(err)
+	}
+	// crashed while switching, the first file is in place
+	copied := &moveJournal{SpaceID: "b-24", From: from, To: to, Files: []string{"b_b.massdb", "b_a.massdb"}, Copied: true}
+	for _, name := range copied.Files {
+		write(filepath.Join(from, name))
+		write(filepath.Join(to, name+moveTempSuffix))
+	}
+	if err = os.Rename(filepath.Join(to, copied.Files[0]+moveTempSuffix), filepath.Join(to, copied.Files[0])); err != nil {
+		t.Fatal(err)
+	}
+	if err = copied.save(); err != nil {
+		t.Fatal(err)
+	}
+
+	recoverMoves([]string{from, to})
+	for _, name := range copying.Files {
+		if !exists(filepath.Join(from, name)) || exists(filepath.Join(to, name+moveTempSuffix)) {
+			t.Errorf("%s: copying move not rolled back", name)
+		}
+	}
+	for _, name := range copied.Files {
+		if exists(filepath.Join(from, name)) || !exists(filepath.Join(to, name)) || exists(filepath.Join(to, name+moveTempSuffix)) {
+			t.Errorf("%s: copied move not finished", name)
+		}
+	}
+	if journals, _ := filepath.Glob(filepath.Join(from, "*"+moveJournalSuffix)); len(journals) != 0 {
+		t.Errorf("journals not removed %v", journals)
+	}
+}
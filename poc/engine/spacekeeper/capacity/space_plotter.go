@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"math"
 	"sync"
+	"time"
 
 	"github.com/Sukhavati-Labs/go-miner/logging"
 	"github.com/Sukhavati-Labs/go-miner/poc/engine"
@@ -13,6 +14,14 @@ import (
 type queuedWorkSpace struct {
 	ws          *WorkSpace
 	wouldMining bool
+	threads     int  // count of plotting threads, non-positive for the count of CPUs
+	paused      bool // paused plot is kept in queue without being scheduled
+
+	// assigned by plotScheduler once running
+	disk          string
+	memory        uint64
+	started       time.Time
+	startProgress float64
 }
 
 // newQueuedWorkSpace creates queuedWorkSpace from an existing workSpace.
@@ -30,10 +39,9 @@ func (qws *queuedWorkSpace) priority() float32 {
 	return -float32(qws.ws.id.Ordinal()) + diff
 }
 
+// plotterQueue sorts workSpaces by the same priority as plotScheduler.
 type plotterQueue struct {
 	*prque.Prque
-	sync.Mutex
-	poppedItem *queuedWorkSpace
 }
 
 func newPlotterQueue() *plotterQueue {
@@ -42,54 +50,8 @@ func newPlotterQueue() *plotterQueue {
 	}
 }
 
-func (pq *plotterQueue) Pop() (*queuedWorkSpace, float32) {
-	pq.Lock()
-	defer pq.Unlock()
-
-	data, priority := pq.Prque.Pop()
-	ws := data.(*queuedWorkSpace)
-	pq.poppedItem = ws
-	return ws, priority
-}
-
 func (pq *plotterQueue) PopItem() *queuedWorkSpace {
-	pq.Lock()
-	defer pq.Unlock()
-
-	ws := pq.Prque.PopItem().(*queuedWorkSpace)
-	pq.poppedItem = ws
-	return ws
-}
-
-func (pq *plotterQueue) Delete(sid string) {
-	pq.Lock()
-	defer pq.Unlock()
-
-	newQueue := prque.New()
-	for !pq.Empty() {
-		qws, priority := pq.Prque.Pop()
-		if qws.(*queuedWorkSpace).ws.id.String() == sid {
-			continue
-		}
-		newQueue.Push(qws, priority)
-	}
-	pq.Prque = newQueue
-}
-
-func (pq *plotterQueue) PoppedItem() *queuedWorkSpace {
-	pq.Lock()
-	defer pq.Unlock()
-
-	return pq.poppedItem
-}
-
-func (pq *plotterQueue) Reset() {
-	pq.Lock()
-	defer pq.Unlock()
-
-	pq.Prque.Reset()
-	pq.poppedItem = nil
-	return
+	return pq.Prque.PopItem().(*queuedWorkSpace)
 }
 
 func (sk *SpaceKeeper) spacePlotter() {
@@ -99,6 +61,9 @@ func (sk *SpaceKeeper) spacePlotter() {
 	var wg sync.WaitGroup
 
 	var plotSpace = func(qws *queuedWorkSpace) {
+		defer wg.Done()
+		defer sk.queue.Finish(qws)
+
 		ws := qws.ws
 		sid := ws.id.String()
 		var changeState = func(old, new engine.WorkSpaceState) {
@@ -119,8 +84,21 @@ func (sk *SpaceKeeper) spacePlotter() {
 		}
 		sk.stateLock.Unlock()
 
-		// Step 2: plot space (wait for finishing)
+		// Step 2: plot space (wait for finishing), stop plotting on quit
+		logging.CPrint(logging.INFO, "start plotting workSpace",
+			logging.LogFormat{"sid": sid, "threads": qws.threads, "memory": qws.memory, "disk": qws.disk})
+		killMonitorCh := make(chan struct{})
+		go func() {
+			select {
+			case <-sk.quit:
+				ws.StopPlot()
+			case <-killMonitorCh:
+			}
+		}()
+		ws.SetPlotThreads(qws.threads)
+		ws.SetPlotMemory(qws.memory)
 		ws.Plot()
+		close(killMonitorCh)
 
 		// Step 3: change workSpace state
 		sk.stateLock.Lock()
@@ -136,48 +114,22 @@ func (sk *SpaceKeeper) spacePlotter() {
 		sk.stateLock.Unlock()
 	}
 
-	var addSpaces = func(newSpace *queuedWorkSpace, newSpaceChan chan *queuedWorkSpace) {
-		sk.queue.Push(newSpace, newSpace.priority())
-	out:
-		for {
-			select {
-			case qws := <-newSpaceChan:
-				sk.queue.Push(qws, qws.priority())
-			default:
-				break out
-			}
-		}
-	}
-
-	var monitor = func(ws *WorkSpace, killMonitorCh chan struct{}) {
-		select {
-		case <-sk.quit:
-			ws.StopPlot()
-		case <-killMonitorCh:
-		}
-		wg.Done()
-	}
-
 	logging.CPrint(logging.INFO, "space plotter started", logging.LogFormat{"queue_length": sk.queue.Size()})
 	defer func() {
 		sk.queue.Reset()
 	}()
 
 	for {
-		for !sk.queue.Empty() {
-			select {
-			case <-sk.quit:
-				wg.Wait()
-				return
-			default:
-			}
+		select {
+		case <-sk.quit:
+			wg.Wait()
+			return
+		default:
+		}
 
-			qws := sk.queue.PopItem()
-			killMonitorCh := make(chan struct{}, 1)
+		for qws := sk.queue.Next(); qws != nil; qws = sk.queue.Next() {
 			wg.Add(1)
-			go monitor(qws.ws, killMonitorCh)
-			plotSpace(qws)
-			close(killMonitorCh)
+			go plotSpace(qws)
 		}
 
 		select {
@@ -185,8 +137,8 @@ func (sk *SpaceKeeper) spacePlotter() {
 			wg.Wait()
 			return
 		case qws := <-sk.newQueuedWorkSpaceCh:
-			addSpaces(qws, sk.newQueuedWorkSpaceCh)
+			sk.queue.Push(qws)
+		case <-sk.queue.wakeup:
 		}
-
 	}
 }
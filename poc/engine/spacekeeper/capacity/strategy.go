@@ -9,14 +9,18 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/Sukhavati-Labs/go-miner/chainutil"
 	"github.com/Sukhavati-Labs/go-miner/chainutil/service"
 	"github.com/Sukhavati-Labs/go-miner/config"
 	"github.com/Sukhavati-Labs/go-miner/logging"
 	"github.com/Sukhavati-Labs/go-miner/poc"
 	"github.com/Sukhavati-Labs/go-miner/poc/engine"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/sktdb"
 	sktdb_v1 "github.com/Sukhavati-Labs/go-miner/poc/engine/sktdb/sktdb.v1"
 	"github.com/Sukhavati-Labs/go-miner/poc/engine/spacekeeper"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/spacekeeper/signer"
 	"github.com/Sukhavati-Labs/go-miner/pocec"
 	"github.com/panjf2000/ants"
 )
@@ -40,6 +44,14 @@ func NewSpaceKeeperV1(args ...interface{}) (spacekeeper.SpaceKeeper, error) {
 	if err != nil {
 		return nil, err
 	}
+	readMode, err := sktdb.ParseReadMode(cfg.Miner.ProofReadMode)
+	if err != nil {
+		return nil, err
+	}
+	remoteSigner, err := newRemoteSigner(cfg)
+	if err != nil {
+		return nil, err
+	}
 	workerPool, err := ants.NewPoolPreMalloc(maxPoolWorker)
 	if err != nil {
 		return nil, err
@@ -52,12 +64,18 @@ func NewSpaceKeeperV1(args ...interface{}) (spacekeeper.SpaceKeeper, error) {
 		workSpaceIndex:        make([]*WorkSpaceMap, 0),
 		workSpacePaths:        make(map[string]*WorkSpacePath),
 		workSpaceList:         make([]*WorkSpace, 0),
-		queue:                 newPlotterQueue(),
+		queue:                 newPlotScheduler(int(cfg.Miner.PlotMaxConcurrent), cfg.Miner.PlotMemoryBudget*poc.MiB, int(cfg.Miner.PlotDiskWrites)),
 		newQueuedWorkSpaceCh:  make(chan *queuedWorkSpace, plotterMaxChanSize),
+		mover:                 newSpaceMover(),
 		workerPool:            workerPool,
-		fileWatcher:           func() {},
+		latencies:             newProofLatencies(time.Duration(cfg.Miner.ProofLatencyBudget)*time.Millisecond, cfg.Miner.DemoteSlowSpaces),
+		readMode:              readMode,
+		cachePages:            int(cfg.Miner.ProofCachePages),
+		signer:                remoteSigner,
+		shadow:                cfg.Miner.Shadow,
 	}
 	sk.BaseService = service.NewBaseService(sk, TypeSpaceKeeperV1)
+	sk.fileWatcher = sk.watchDirs
 	sk.generateInitialIndex = func() error { return generateInitialIndex(sk, typeSktDBV1, regSktDBV1, suffixSktDBV1) }
 
 	if err = upgradeSktDBFile(sk); err != nil {
@@ -71,6 +89,9 @@ func NewSpaceKeeperV1(args ...interface{}) (spacekeeper.SpaceKeeper, error) {
 		if err = poCWallet.Unlock([]byte(cfg.Miner.PrivatePassword)); err != nil {
 			return nil, err
 		}
+	}
+	// a locked wallet still knows public keys of plotted workSpaces
+	if !poCWallet.IsLocked() || remoteSigner != nil || cfg.Miner.Shadow {
 		var wsiList []engine.WorkSpaceInfo
 		var configureMethod string
 		if cfg.Miner.ProofList != "" {
@@ -89,6 +110,7 @@ func NewSpaceKeeperV1(args ...interface{}) (spacekeeper.SpaceKeeper, error) {
 			if err != nil && err != ErrSpaceKeeperConfiguredNothing {
 				return nil, err
 			}
+			sk.useFoundWorkSpacesOnStart(cfg.Miner.Plot, cfg.Miner.Generate)
 			configureMethod = "ConfigureByFlags"
 		}
 		logging.CPrint(logging.DEBUG, "try configure spaceKeeper", logging.LogFormat{"content": wsiList, "err": err, "method": configureMethod})
@@ -97,6 +119,19 @@ func NewSpaceKeeperV1(args ...interface{}) (spacekeeper.SpaceKeeper, error) {
 	return sk, nil
 }
 
+// newRemoteSigner returns nil if miner.remote_signer is not configured.
+func newRemoteSigner(cfg *config.Config) (signer.Signer, error) {
+	if cfg.Miner.RemoteSigner == "" {
+		return nil, nil
+	}
+	tlsConfig, err := chainutil.NewClientTLSConfig(cfg.Miner.RemoteSignerTlsCert)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to load certificate of signer", logging.LogFormat{"cert": cfg.Miner.RemoteSignerTlsCert, "err": err})
+		return nil, err
+	}
+	return signer.NewClient(cfg.Miner.RemoteSigner, cfg.Miner.RemoteSignerToken, tlsConfig, signer.DefaultTimeout)
+}
+
 func parseArgs(args ...interface{}) (*config.Config, PoCWallet, error) {
 	if len(args) != 2 {
 		return nil, nil, spacekeeper.ErrInvalidSKArgs
@@ -123,6 +158,7 @@ func generateInitialIndex(sk *SpaceKeeper, dbType, regStrB, suffixB string) erro
 		return err
 	}
 
+	recoverMoves(sk.dbDirs)
 	dbDirs, dirFileInfos := prepareDirs(sk.dbDirs)
 	sk.dbDirs = dbDirs
 
@@ -134,45 +170,53 @@ func generateInitialIndex(sk *SpaceKeeper, dbType, regStrB, suffixB string) erro
 				continue
 			}
 
-			filePath := filepath.Join(dbDir, fileName)
-			// extract args
-			args := strings.Split(fileName[:len(fileName)-len(suffixB)], "_")
-			dbIndex, pubKey, bitLength, err := parseSktDBArgsFromString(args[0], args[1], args[2])
-			if err != nil {
-				logging.CPrint(logging.ERROR, "cannot parse SktDB args from filename", logging.LogFormat{"filepath": filePath, "err": err})
-				continue
-			}
-			logging.CPrint(logging.INFO, "load public key", logging.LogFormat{"pubkey": args[1]})
-			// verify db ordinal
-			ordinal, exists := sk.wallet.GetPublicKeyOrdinal(pubKey)
-			if !exists || dbIndex != int(ordinal) {
-				logging.CPrint(logging.WARN, "spaceKeeper wallet does not contain pubKey",
-					logging.LogFormat{"filePath": filePath, "err": ErrWalletDoesNotContainPubKey})
-				continue
+			if ws, ok := sk.loadWorkSpace(dbType, dbDir, fileName, suffixB); ok {
+				// Add workSpace into index
+				sk.addWorkSpaceToIndex(ws)
 			}
+		}
+	}
 
-			// prevent duplicate SktDB
-			sid := NewSpaceID(int64(ordinal), pubKey, bitLength).String()
-			if _, ok := sk.workSpaceIndex[allState].Get(sid); ok {
-				logging.CPrint(logging.WARN, "duplicate sktdb in root dirs",
-					logging.LogFormat{"filepath": filePath, "err": ErrSktDBDuplicate})
-				continue
-			}
+	return nil
+}
 
-			// NewWorkSpace
-			ws, err := NewWorkSpace(dbType, dbDir, int64(ordinal), pubKey, bitLength)
-			if err != nil {
-				logging.CPrint(logging.WARN, "fail on NewWorkSpace",
-					logging.LogFormat{"filepath": filePath, "err": err, "db_index": dbIndex})
-				continue
-			}
+// loadWorkSpace loads workSpace from the plot file named as `ordinal_pubKey_bitLength.suffix`,
+// it returns false if the file is not loaded, and the reason is logged.
+func (sk *SpaceKeeper) loadWorkSpace(dbType, dbDir, fileName, suffixB string) (*WorkSpace, bool) {
+	filePath := filepath.Join(dbDir, fileName)
+	// extract args
+	args := strings.Split(fileName[:len(fileName)-len(suffixB)], "_")
+	dbIndex, pubKey, bitLength, err := parseSktDBArgsFromString(args[0], args[1], args[2])
+	if err != nil {
+		logging.CPrint(logging.ERROR, "cannot parse SktDB args from filename", logging.LogFormat{"filepath": filePath, "err": err})
+		return nil, false
+	}
+	logging.CPrint(logging.INFO, "load public key", logging.LogFormat{"pubkey": args[1]})
+	// verify db ordinal
+	ordinal, exists := sk.wallet.GetPublicKeyOrdinal(pubKey)
+	if !exists || dbIndex != int(ordinal) {
+		logging.CPrint(logging.WARN, "spaceKeeper wallet does not contain pubKey",
+			logging.LogFormat{"filePath": filePath, "err": ErrWalletDoesNotContainPubKey})
+		return nil, false
+	}
 
-			// Add workSpace into index
-			sk.addWorkSpaceToIndex(ws)
-		}
+	// prevent duplicate SktDB
+	sid := NewSpaceID(int64(ordinal), pubKey, bitLength).String()
+	if _, ok := sk.workSpaceIndex[allState].Get(sid); ok {
+		logging.CPrint(logging.WARN, "duplicate sktdb in root dirs",
+			logging.LogFormat{"filepath": filePath, "err": ErrSktDBDuplicate})
+		return nil, false
 	}
 
-	return nil
+	// NewWorkSpace
+	ws, err := NewWorkSpace(dbType, dbDir, int64(ordinal), pubKey, bitLength)
+	if err != nil {
+		logging.CPrint(logging.WARN, "fail on NewWorkSpace",
+			logging.LogFormat{"filepath": filePath, "err": err, "db_index": dbIndex})
+		return nil, false
+	}
+
+	return ws, true
 }
 
 func upgradeSktDBFile(sk *SpaceKeeper) error {
@@ -1,9 +1,13 @@
 package capacity
 
 import (
+	"time"
+
 	"github.com/Sukhavati-Labs/go-miner/chainutil/service"
 	"github.com/Sukhavati-Labs/go-miner/logging"
+	"github.com/Sukhavati-Labs/go-miner/poc"
 	"github.com/Sukhavati-Labs/go-miner/poc/engine"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/sktdb"
 	"github.com/Sukhavati-Labs/go-miner/poc/engine/spacekeeper"
 	"github.com/panjf2000/ants"
 )
@@ -15,6 +19,14 @@ func NewSpaceKeeperPlasterer(args ...interface{}) (spacekeeper.SpaceKeeper, erro
 	if err != nil {
 		return nil, err
 	}
+	readMode, err := sktdb.ParseReadMode(cfg.Miner.ProofReadMode)
+	if err != nil {
+		return nil, err
+	}
+	remoteSigner, err := newRemoteSigner(cfg)
+	if err != nil {
+		return nil, err
+	}
 	workerPool, err := ants.NewPoolPreMalloc(maxPoolWorker)
 	if err != nil {
 		return nil, err
@@ -27,12 +39,18 @@ func NewSpaceKeeperPlasterer(args ...interface{}) (spacekeeper.SpaceKeeper, erro
 		workSpaceIndex:        make([]*WorkSpaceMap, 0),
 		workSpacePaths:        make(map[string]*WorkSpacePath),
 		workSpaceList:         make([]*WorkSpace, 0),
-		queue:                 newPlotterQueue(),
+		queue:                 newPlotScheduler(int(cfg.Miner.PlotMaxConcurrent), cfg.Miner.PlotMemoryBudget*poc.MiB, int(cfg.Miner.PlotDiskWrites)),
 		newQueuedWorkSpaceCh:  make(chan *queuedWorkSpace, plotterMaxChanSize),
+		mover:                 newSpaceMover(),
 		workerPool:            workerPool,
-		fileWatcher:           func() {},
+		latencies:             newProofLatencies(time.Duration(cfg.Miner.ProofLatencyBudget)*time.Millisecond, cfg.Miner.DemoteSlowSpaces),
+		readMode:              readMode,
+		cachePages:            int(cfg.Miner.ProofCachePages),
+		signer:                remoteSigner,
+		shadow:                cfg.Miner.Shadow,
 	}
 	sk.BaseService = service.NewBaseService(sk, TypeSpaceKeeperV1)
+	sk.fileWatcher = sk.watchDirs
 	sk.generateInitialIndex = func() error { return generateInitialIndex(sk, typeSktDBV1, regSktDBV1, suffixSktDBV1) }
 
 	if err = sk.generateInitialIndex(); err != nil {
@@ -43,12 +61,16 @@ func NewSpaceKeeperPlasterer(args ...interface{}) (spacekeeper.SpaceKeeper, erro
 		if err = poCWallet.Unlock([]byte(cfg.Miner.PrivatePassword)); err != nil {
 			return nil, err
 		}
+	}
+	// a locked wallet still knows public keys of plotted workSpaces
+	if !poCWallet.IsLocked() || remoteSigner != nil || cfg.Miner.Shadow {
 		var wsiList []engine.WorkSpaceInfo
 		var configureMethod = "ConfigureByFlags"
 		wsiList, err = sk.ConfigureByFlags(engine.SFAll, cfg.Miner.Plot, cfg.Miner.Generate)
 		if err != nil {
 			return nil, err
 		}
+		sk.useFoundWorkSpacesOnStart(cfg.Miner.Plot, cfg.Miner.Generate)
 		logging.CPrint(logging.DEBUG, "try configure spaceKeeper", logging.LogFormat{"content": wsiList, "err": err, "method": configureMethod})
 	}
 
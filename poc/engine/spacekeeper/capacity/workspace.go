@@ -2,7 +2,10 @@ package capacity
 
 import (
 	"bytes"
+	"sync"
+	"sync/atomic"
 
+	"github.com/Sukhavati-Labs/go-miner/logging"
 	"github.com/Sukhavati-Labs/go-miner/poc/engine"
 	"github.com/Sukhavati-Labs/go-miner/poc/engine/sktdb"
 	"github.com/Sukhavati-Labs/go-miner/poc/pocutil"
@@ -16,6 +19,15 @@ type WorkSpace struct {
 	state   engine.WorkSpaceState
 	using   bool
 	rootDir string
+
+	unavailable int32 // atomic, set while plot file is inaccessible
+	moving      int32 // atomic, set while being moved to another directory
+	slow        int32 // atomic, set while proof lookups regularly exceed budget
+
+	// dbLock is held for reading by proof lookups and verifications, and for
+	// writing while db is closed or replaced, so that no lookup runs on a
+	// closed db.
+	dbLock sync.RWMutex
 }
 
 // NewWorkSpace loads SktDB from given rootDir with PubKey&BitLength,
@@ -55,12 +67,79 @@ func NewWorkSpace(dbType string, rootDir string, ordinal int64, pubKey *pocec.Pu
 
 func (ws *WorkSpace) Info() engine.WorkSpaceInfo {
 	return engine.WorkSpaceInfo{
-		SpaceID:   ws.id.String(),
-		PublicKey: ws.id.PubKey(),
-		Ordinal:   ws.id.Ordinal(),
-		BitLength: ws.id.BitLength(),
-		Progress:  ws.Progress(),
-		State:     ws.state,
+		SpaceID:     ws.id.String(),
+		PublicKey:   ws.id.PubKey(),
+		Ordinal:     ws.id.Ordinal(),
+		BitLength:   ws.id.BitLength(),
+		Progress:    ws.Progress(),
+		State:       ws.state,
+		Unavailable: !ws.Available(),
+		Slow:        ws.Slow(),
+	}
+}
+
+// Available returns false while plot file of workSpace is inaccessible.
+func (ws *WorkSpace) Available() bool {
+	return atomic.LoadInt32(&ws.unavailable) == 0
+}
+
+func (ws *WorkSpace) setAvailable(available bool) {
+	if available {
+		atomic.StoreInt32(&ws.unavailable, 0)
+	} else {
+		atomic.StoreInt32(&ws.unavailable, 1)
+	}
+}
+
+// closeDB closes db once lookups in flight are done, ws is made unavailable
+// before that if it is to be reopened.
+func (ws *WorkSpace) closeDB() error {
+	ws.dbLock.Lock()
+	defer ws.dbLock.Unlock()
+	return ws.db.Close()
+}
+
+// setDB replaces the closed db of ws with db.
+func (ws *WorkSpace) setDB(db sktdb.SktDB) {
+	ws.dbLock.Lock()
+	ws.db = db
+	ws.dbLock.Unlock()
+}
+
+// Slow returns true while proof lookups of workSpace regularly exceed budget.
+func (ws *WorkSpace) Slow() bool {
+	return atomic.LoadInt32(&ws.slow) != 0
+}
+
+func (ws *WorkSpace) setSlow(slow bool) {
+	if slow {
+		atomic.StoreInt32(&ws.slow, 1)
+	} else {
+		atomic.StoreInt32(&ws.slow, 0)
+	}
+}
+
+// Moving returns true while workSpace is being moved to another directory.
+func (ws *WorkSpace) Moving() bool {
+	return atomic.LoadInt32(&ws.moving) != 0
+}
+
+// setMoving returns false if moving is not changed.
+func (ws *WorkSpace) setMoving(moving bool) bool {
+	if moving {
+		return atomic.CompareAndSwapInt32(&ws.moving, 0, 1)
+	}
+	return atomic.CompareAndSwapInt32(&ws.moving, 1, 0)
+}
+
+// SetReadMode sets the way proofs are read and the count of hot pages cached,
+// it takes no effect if the db only reads by files.
+func (ws *WorkSpace) SetReadMode(mode sktdb.ReadMode, cachePages int) {
+	if r, ok := ws.db.(sktdb.ProofReader); ok {
+		if err := r.SetReadMode(mode, cachePages); err != nil {
+			logging.CPrint(logging.WARN, "fail to set read mode of workSpace",
+				logging.LogFormat{"sid": ws.id.String(), "mode": mode, "err": err})
+		}
 	}
 }
 
@@ -94,6 +173,22 @@ func (ws *WorkSpace) Plot() error {
 	return <-result
 }
 
+// SetPlotThreads sets the count of threads used by plotting,
+// it takes no effect if the db is unable to plot in parallel.
+func (ws *WorkSpace) SetPlotThreads(threads int) {
+	if p, ok := ws.db.(sktdb.ParallelPlotter); ok {
+		p.SetPlotThreads(threads)
+	}
+}
+
+// SetPlotMemory sets the max bytes of memory used by plotting, zero for no limit,
+// it takes no effect if the db is unable to limit memory.
+func (ws *WorkSpace) SetPlotMemory(bytes uint64) {
+	if l, ok := ws.db.(sktdb.PlotMemoryLimiter); ok {
+		l.SetPlotMemory(bytes)
+	}
+}
+
 func (ws *WorkSpace) StopPlot() error {
 	result := ws.db.StopPlot()
 	return <-result
@@ -106,7 +201,7 @@ func (ws *WorkSpace) Delete() error {
 
 func (ws *WorkSpace) Close() error {
 	ws.StopPlot()
-	return ws.db.Close()
+	return ws.closeDB()
 }
 
 type WorkSpaceMap struct {
@@ -187,6 +282,15 @@ func (p *WorkSpacePath) Add(ws *WorkSpace) {
 	p.insert(len(p.spaces), ws)
 }
 
+func (p *WorkSpacePath) Remove(ws *WorkSpace) {
+	sid := ws.id.String()
+	if _, exists := p.exists[sid]; !exists {
+		return
+	}
+	delete(p.exists, sid)
+	p.spaces = deleteFromSlice(p.spaces, sid)
+}
+
 func (p *WorkSpacePath) insert(i int, ws *WorkSpace) {
 	p.spaces = append(p.spaces, ws)
 	copy(p.spaces[i+1:], p.spaces[i:])
@@ -0,0 +1,48 @@
+package spacekeeper
+
+import (
+	"github.com/Sukhavati-Labs/go-miner/metrics"
+	"github.com/Sukhavati-Labs/go-miner/poc"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// collector reads workspaces of SpaceKeeper when metrics are scraped.
+type collector struct {
+	sk     SpaceKeeper
+	bytes  *prometheus.Desc
+	spaces *prometheus.Desc
+}
+
+// NewCollector returns a collector reporting plotted bytes and count of
+// workspaces of sk by state.
+func NewCollector(sk SpaceKeeper) prometheus.Collector {
+	return &collector{
+		sk:     sk,
+		bytes:  prometheus.NewDesc(prometheus.BuildFQName(metrics.Namespace, "capacity", "plotted_bytes"), "Plotted bytes of workspaces by state.", []string{"state"}, nil),
+		spaces: prometheus.NewDesc(prometheus.BuildFQName(metrics.Namespace, "capacity", "workspaces"), "Count of workspaces by state.", []string{"state"}, nil),
+	}
+}
+
+func (c *collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.bytes
+	ch <- c.spaces
+}
+
+func (c *collector) Collect(ch chan<- prometheus.Metric) {
+	wsiList, err := c.sk.WorkSpaceInfos(engine.SFAll)
+	if err != nil {
+		return
+	}
+	states := []engine.WorkSpaceState{engine.Registered, engine.Plotting, engine.Ready, engine.Mining}
+	bytes := make(map[engine.WorkSpaceState]float64, len(states))
+	counts := make(map[engine.WorkSpaceState]float64, len(states))
+	for _, wsi := range wsiList {
+		bytes[wsi.State] += float64(poc.BitLengthDiskSize[wsi.BitLength]) * wsi.Progress / 100
+		counts[wsi.State]++
+	}
+	for _, state := range states {
+		ch <- prometheus.MustNewConstMetric(c.bytes, prometheus.GaugeValue, bytes[state], state.String())
+		ch <- prometheus.MustNewConstMetric(c.spaces, prometheus.GaugeValue, counts[state], state.String())
+	}
+}
@@ -0,0 +1,97 @@
+package remote
+
+import (
+	"errors"
+
+	"github.com/Sukhavati-Labs/go-miner/poc"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/spacekeeper/remote/pb"
+	"github.com/Sukhavati-Labs/go-miner/poc/pocutil"
+	"github.com/Sukhavati-Labs/go-miner/pocec"
+)
+
+func workSpaceInfoToPB(info engine.WorkSpaceInfo) *harvesterpb.WorkSpaceInfo {
+	return &harvesterpb.WorkSpaceInfo{
+		SpaceId:   info.SpaceID,
+		PublicKey: info.PublicKey.SerializeCompressed(),
+		Ordinal:   info.Ordinal,
+		BitLength: int32(info.BitLength),
+		Progress:  info.Progress,
+		State:     uint32(info.State),
+	}
+}
+
+func workSpaceInfoFromPB(info *harvesterpb.WorkSpaceInfo) (engine.WorkSpaceInfo, error) {
+	pk, err := pocec.ParsePubKey(info.PublicKey, pocec.S256())
+	if err != nil {
+		return engine.WorkSpaceInfo{}, err
+	}
+	state := engine.WorkSpaceState(info.State)
+	if !state.IsValid() {
+		return engine.WorkSpaceInfo{}, engine.ErrInvalidState
+	}
+	return engine.WorkSpaceInfo{
+		SpaceID:   info.SpaceId,
+		PublicKey: pk,
+		Ordinal:   info.Ordinal,
+		BitLength: int(info.BitLength),
+		Progress:  info.Progress,
+		State:     state,
+	}, nil
+}
+
+func workSpaceProofToPB(wsp *engine.WorkSpaceProof) *harvesterpb.WorkSpaceProof {
+	result := &harvesterpb.WorkSpaceProof{
+		SpaceId: wsp.SpaceID,
+		Ordinal: wsp.Ordinal,
+	}
+	if wsp.PublicKey != nil {
+		result.PublicKey = wsp.PublicKey.SerializeCompressed()
+	}
+	if wsp.Error != nil {
+		result.Error = wsp.Error.Error()
+		return result
+	}
+	if wsp.Proof != nil {
+		result.Proof = &harvesterpb.Proof{
+			X:         wsp.Proof.X,
+			XPrime:    wsp.Proof.XPrime,
+			BitLength: int32(wsp.Proof.BitLength),
+		}
+	}
+	return result
+}
+
+// workSpaceProofFromPB never returns nil, malformed proofs and proofs not
+// verified for challenge are reported by WorkSpaceProof.Error.
+func workSpaceProofFromPB(wsp *harvesterpb.WorkSpaceProof, challenge pocutil.Hash) *engine.WorkSpaceProof {
+	result := &engine.WorkSpaceProof{
+		SpaceID: wsp.SpaceId,
+		Ordinal: wsp.Ordinal,
+	}
+	if len(wsp.PublicKey) != 0 {
+		pk, err := pocec.ParsePubKey(wsp.PublicKey, pocec.S256())
+		if err != nil {
+			result.Error = err
+			return result
+		}
+		result.PublicKey = pk
+	}
+	if wsp.Error != "" {
+		result.Error = errors.New(wsp.Error)
+		return result
+	}
+	if wsp.Proof == nil || result.PublicKey == nil {
+		result.Error = ErrInvalidProof
+		return result
+	}
+	result.Proof = &poc.Proof{
+		X:         wsp.Proof.X,
+		XPrime:    wsp.Proof.XPrime,
+		BitLength: int(wsp.Proof.BitLength),
+	}
+	if err := poc.VerifyProof(result.Proof, pocutil.PubKeyHash(result.PublicKey), challenge); err != nil {
+		result.Error = ErrInvalidProof
+	}
+	return result
+}
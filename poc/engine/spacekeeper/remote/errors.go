@@ -0,0 +1,13 @@
+package remote
+
+import "errors"
+
+var (
+	ErrNoHarvester             = errors.New("no harvester is configured")
+	ErrEmptyToken              = errors.New("harvester token is empty")
+	ErrNoTLSConfig             = errors.New("no tls config for harvester connections")
+	ErrUnauthenticated         = errors.New("invalid harvester token")
+	ErrSpaceKeeperIsNotRunning = errors.New("remote spaceKeeper is not running")
+	ErrWorkSpaceDoesNotExist   = errors.New("workspace does not exist on any harvester")
+	ErrInvalidProof            = errors.New("invalid proof received from harvester")
+)
@@ -0,0 +1,152 @@
+package remote
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+
+	"github.com/Sukhavati-Labs/go-miner/logging"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/spacekeeper"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/spacekeeper/remote/pb"
+	"github.com/Sukhavati-Labs/go-miner/poc/pocutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+)
+
+// Harvester serves a local SpaceKeeper over TLS to the nodes running
+// spacekeeper.remote, requests are rejected unless they carry the shared token.
+type Harvester struct {
+	sk     spacekeeper.SpaceKeeper
+	server *grpc.Server
+}
+
+func NewHarvester(sk spacekeeper.SpaceKeeper, token string, tlsConfig *tls.Config) (*Harvester, error) {
+	if token == "" {
+		return nil, ErrEmptyToken
+	}
+	if tlsConfig == nil {
+		return nil, ErrNoTLSConfig
+	}
+	auth := tokenAuthenticator(token)
+	h := &Harvester{
+		sk: sk,
+		server: grpc.NewServer(
+			grpc.Creds(credentials.NewTLS(tlsConfig)),
+			grpc.UnaryInterceptor(auth.unaryInterceptor),
+			grpc.StreamInterceptor(auth.streamInterceptor),
+		),
+	}
+	harvesterpb.RegisterHarvesterServer(h.server, h)
+	return h, nil
+}
+
+// Serve accepts connections on lis, it blocks until Stop is called.
+func (h *Harvester) Serve(lis net.Listener) error {
+	logging.CPrint(logging.INFO, "harvester listening", logging.LogFormat{"addr": lis.Addr().String()})
+	return h.server.Serve(lis)
+}
+
+func (h *Harvester) Stop() {
+	h.server.GracefulStop()
+	logging.CPrint(logging.INFO, "harvester stopped")
+}
+
+func (h *Harvester) WorkSpaceInfos(ctx context.Context, in *harvesterpb.WorkSpaceInfosRequest) (*harvesterpb.WorkSpaceInfosResponse, error) {
+	infos, err := h.sk.WorkSpaceInfos(engine.WorkSpaceStateFlags(in.Flags))
+	if err != nil {
+		return nil, err
+	}
+	resp := &harvesterpb.WorkSpaceInfosResponse{Infos: make([]*harvesterpb.WorkSpaceInfo, len(infos))}
+	for i := range infos {
+		resp.Infos[i] = workSpaceInfoToPB(infos[i])
+	}
+	return resp, nil
+}
+
+func (h *Harvester) GetProof(ctx context.Context, in *harvesterpb.GetProofRequest) (*harvesterpb.WorkSpaceProof, error) {
+	challenge, err := decodeHash(in.Challenge)
+	if err != nil {
+		return nil, err
+	}
+	wsp, err := h.sk.GetProof(ctx, in.SpaceId, challenge)
+	if err != nil {
+		return nil, err
+	}
+	return workSpaceProofToPB(wsp), nil
+}
+
+func (h *Harvester) GetProofs(in *harvesterpb.GetProofsRequest, stream harvesterpb.Harvester_GetProofsServer) error {
+	challenge, err := decodeHash(in.Challenge)
+	if err != nil {
+		return err
+	}
+	reader, err := h.sk.GetProofsReader(stream.Context(), engine.WorkSpaceStateFlags(in.Flags), challenge)
+	if err != nil {
+		return err
+	}
+	for {
+		wsp, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if wsp == nil {
+			continue
+		}
+		if err = stream.Send(workSpaceProofToPB(wsp)); err != nil {
+			logging.CPrint(logging.WARN, "fail to send proof to node", logging.LogFormat{"err": err, "challenge": challenge})
+			return err
+		}
+	}
+}
+
+func (h *Harvester) ActOnWorkSpace(ctx context.Context, in *harvesterpb.ActOnWorkSpaceRequest) (*harvesterpb.ActOnWorkSpaceResponse, error) {
+	if err := h.sk.ActOnWorkSpace(in.SpaceId, engine.ActionType(in.Action)); err != nil {
+		return nil, err
+	}
+	return &harvesterpb.ActOnWorkSpaceResponse{}, nil
+}
+
+func (h *Harvester) ActOnWorkSpaces(ctx context.Context, in *harvesterpb.ActOnWorkSpacesRequest) (*harvesterpb.ActOnWorkSpacesResponse, error) {
+	errs, err := h.sk.ActOnWorkSpaces(engine.WorkSpaceStateFlags(in.Flags), engine.ActionType(in.Action))
+	if err != nil {
+		return nil, err
+	}
+	resp := &harvesterpb.ActOnWorkSpacesResponse{Errors: make(map[string]string, len(errs))}
+	for sid, err := range errs {
+		if err != nil {
+			resp.Errors[sid] = err.Error()
+		} else {
+			resp.Errors[sid] = ""
+		}
+	}
+	return resp, nil
+}
+
+func (h *Harvester) SignHash(ctx context.Context, in *harvesterpb.SignHashRequest) (*harvesterpb.SignHashResponse, error) {
+	hash, err := decodeHash(in.Hash)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := h.sk.SignHash(in.SpaceId, hash)
+	if err != nil {
+		return nil, err
+	}
+	logging.CPrint(logging.INFO, "sign hash for node", logging.LogFormat{"sid": in.SpaceId, "hash": hash})
+	return &harvesterpb.SignHashResponse{Signature: sig.Serialize()}, nil
+}
+
+func decodeHash(b []byte) (pocutil.Hash, error) {
+	var hash pocutil.Hash
+	if len(b) != len(hash) {
+		return hash, status.Errorf(codes.InvalidArgument, "invalid hash length %d", len(b))
+	}
+	copy(hash[:], b)
+	return hash, nil
+}
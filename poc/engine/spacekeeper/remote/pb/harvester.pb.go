@@ -0,0 +1,989 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: harvester.proto
+
+package harvesterpb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type WorkSpaceInfosRequest struct {
+	Flags                uint32   `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkSpaceInfosRequest) Reset()         { *m = WorkSpaceInfosRequest{} }
+func (m *WorkSpaceInfosRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkSpaceInfosRequest) ProtoMessage()    {}
+func (*WorkSpaceInfosRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c69c0ef45c5b2f2, []int{0}
+}
+func (m *WorkSpaceInfosRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkSpaceInfosRequest.Unmarshal(m, b)
+}
+func (m *WorkSpaceInfosRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkSpaceInfosRequest.Marshal(b, m, deterministic)
+}
+func (m *WorkSpaceInfosRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkSpaceInfosRequest.Merge(m, src)
+}
+func (m *WorkSpaceInfosRequest) XXX_Size() int {
+	return xxx_messageInfo_WorkSpaceInfosRequest.Size(m)
+}
+func (m *WorkSpaceInfosRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkSpaceInfosRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkSpaceInfosRequest proto.InternalMessageInfo
+
+func (m *WorkSpaceInfosRequest) GetFlags() uint32 {
+	if m != nil {
+		return m.Flags
+	}
+	return 0
+}
+
+type WorkSpaceInfosResponse struct {
+	Infos                []*WorkSpaceInfo `protobuf:"bytes,1,rep,name=infos,proto3" json:"infos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *WorkSpaceInfosResponse) Reset()         { *m = WorkSpaceInfosResponse{} }
+func (m *WorkSpaceInfosResponse) String() string { return proto.CompactTextString(m) }
+func (*WorkSpaceInfosResponse) ProtoMessage()    {}
+func (*WorkSpaceInfosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c69c0ef45c5b2f2, []int{1}
+}
+func (m *WorkSpaceInfosResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkSpaceInfosResponse.Unmarshal(m, b)
+}
+func (m *WorkSpaceInfosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkSpaceInfosResponse.Marshal(b, m, deterministic)
+}
+func (m *WorkSpaceInfosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkSpaceInfosResponse.Merge(m, src)
+}
+func (m *WorkSpaceInfosResponse) XXX_Size() int {
+	return xxx_messageInfo_WorkSpaceInfosResponse.Size(m)
+}
+func (m *WorkSpaceInfosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkSpaceInfosResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkSpaceInfosResponse proto.InternalMessageInfo
+
+func (m *WorkSpaceInfosResponse) GetInfos() []*WorkSpaceInfo {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+type WorkSpaceInfo struct {
+	SpaceId              string   `protobuf:"bytes,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	PublicKey            []byte   `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Ordinal              int64    `protobuf:"varint,3,opt,name=ordinal,proto3" json:"ordinal,omitempty"`
+	BitLength            int32    `protobuf:"varint,4,opt,name=bit_length,json=bitLength,proto3" json:"bit_length,omitempty"`
+	Progress             float64  `protobuf:"fixed64,5,opt,name=progress,proto3" json:"progress,omitempty"`
+	State                uint32   `protobuf:"varint,6,opt,name=state,proto3" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkSpaceInfo) Reset()         { *m = WorkSpaceInfo{} }
+func (m *WorkSpaceInfo) String() string { return proto.CompactTextString(m) }
+func (*WorkSpaceInfo) ProtoMessage()    {}
+func (*WorkSpaceInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c69c0ef45c5b2f2, []int{2}
+}
+func (m *WorkSpaceInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkSpaceInfo.Unmarshal(m, b)
+}
+func (m *WorkSpaceInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkSpaceInfo.Marshal(b, m, deterministic)
+}
+func (m *WorkSpaceInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkSpaceInfo.Merge(m, src)
+}
+func (m *WorkSpaceInfo) XXX_Size() int {
+	return xxx_messageInfo_WorkSpaceInfo.Size(m)
+}
+func (m *WorkSpaceInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkSpaceInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkSpaceInfo proto.InternalMessageInfo
+
+func (m *WorkSpaceInfo) GetSpaceId() string {
+	if m != nil {
+		return m.SpaceId
+	}
+	return ""
+}
+
+func (m *WorkSpaceInfo) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *WorkSpaceInfo) GetOrdinal() int64 {
+	if m != nil {
+		return m.Ordinal
+	}
+	return 0
+}
+
+func (m *WorkSpaceInfo) GetBitLength() int32 {
+	if m != nil {
+		return m.BitLength
+	}
+	return 0
+}
+
+func (m *WorkSpaceInfo) GetProgress() float64 {
+	if m != nil {
+		return m.Progress
+	}
+	return 0
+}
+
+func (m *WorkSpaceInfo) GetState() uint32 {
+	if m != nil {
+		return m.State
+	}
+	return 0
+}
+
+type GetProofRequest struct {
+	SpaceId              string   `protobuf:"bytes,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Challenge            []byte   `protobuf:"bytes,2,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetProofRequest) Reset()         { *m = GetProofRequest{} }
+func (m *GetProofRequest) String() string { return proto.CompactTextString(m) }
+func (*GetProofRequest) ProtoMessage()    {}
+func (*GetProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c69c0ef45c5b2f2, []int{3}
+}
+func (m *GetProofRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProofRequest.Unmarshal(m, b)
+}
+func (m *GetProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProofRequest.Marshal(b, m, deterministic)
+}
+func (m *GetProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProofRequest.Merge(m, src)
+}
+func (m *GetProofRequest) XXX_Size() int {
+	return xxx_messageInfo_GetProofRequest.Size(m)
+}
+func (m *GetProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProofRequest proto.InternalMessageInfo
+
+func (m *GetProofRequest) GetSpaceId() string {
+	if m != nil {
+		return m.SpaceId
+	}
+	return ""
+}
+
+func (m *GetProofRequest) GetChallenge() []byte {
+	if m != nil {
+		return m.Challenge
+	}
+	return nil
+}
+
+type GetProofsRequest struct {
+	Flags                uint32   `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Challenge            []byte   `protobuf:"bytes,2,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetProofsRequest) Reset()         { *m = GetProofsRequest{} }
+func (m *GetProofsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetProofsRequest) ProtoMessage()    {}
+func (*GetProofsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c69c0ef45c5b2f2, []int{4}
+}
+func (m *GetProofsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProofsRequest.Unmarshal(m, b)
+}
+func (m *GetProofsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProofsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetProofsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProofsRequest.Merge(m, src)
+}
+func (m *GetProofsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetProofsRequest.Size(m)
+}
+func (m *GetProofsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProofsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProofsRequest proto.InternalMessageInfo
+
+func (m *GetProofsRequest) GetFlags() uint32 {
+	if m != nil {
+		return m.Flags
+	}
+	return 0
+}
+
+func (m *GetProofsRequest) GetChallenge() []byte {
+	if m != nil {
+		return m.Challenge
+	}
+	return nil
+}
+
+type Proof struct {
+	X                    []byte   `protobuf:"bytes,1,opt,name=x,proto3" json:"x,omitempty"`
+	XPrime               []byte   `protobuf:"bytes,2,opt,name=x_prime,json=xPrime,proto3" json:"x_prime,omitempty"`
+	BitLength            int32    `protobuf:"varint,3,opt,name=bit_length,json=bitLength,proto3" json:"bit_length,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Proof) Reset()         { *m = Proof{} }
+func (m *Proof) String() string { return proto.CompactTextString(m) }
+func (*Proof) ProtoMessage()    {}
+func (*Proof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c69c0ef45c5b2f2, []int{5}
+}
+func (m *Proof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Proof.Unmarshal(m, b)
+}
+func (m *Proof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Proof.Marshal(b, m, deterministic)
+}
+func (m *Proof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Proof.Merge(m, src)
+}
+func (m *Proof) XXX_Size() int {
+	return xxx_messageInfo_Proof.Size(m)
+}
+func (m *Proof) XXX_DiscardUnknown() {
+	xxx_messageInfo_Proof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Proof proto.InternalMessageInfo
+
+func (m *Proof) GetX() []byte {
+	if m != nil {
+		return m.X
+	}
+	return nil
+}
+
+func (m *Proof) GetXPrime() []byte {
+	if m != nil {
+		return m.XPrime
+	}
+	return nil
+}
+
+func (m *Proof) GetBitLength() int32 {
+	if m != nil {
+		return m.BitLength
+	}
+	return 0
+}
+
+type WorkSpaceProof struct {
+	SpaceId              string   `protobuf:"bytes,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Proof                *Proof   `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	PublicKey            []byte   `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Ordinal              int64    `protobuf:"varint,4,opt,name=ordinal,proto3" json:"ordinal,omitempty"`
+	Error                string   `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkSpaceProof) Reset()         { *m = WorkSpaceProof{} }
+func (m *WorkSpaceProof) String() string { return proto.CompactTextString(m) }
+func (*WorkSpaceProof) ProtoMessage()    {}
+func (*WorkSpaceProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c69c0ef45c5b2f2, []int{6}
+}
+func (m *WorkSpaceProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkSpaceProof.Unmarshal(m, b)
+}
+func (m *WorkSpaceProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkSpaceProof.Marshal(b, m, deterministic)
+}
+func (m *WorkSpaceProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkSpaceProof.Merge(m, src)
+}
+func (m *WorkSpaceProof) XXX_Size() int {
+	return xxx_messageInfo_WorkSpaceProof.Size(m)
+}
+func (m *WorkSpaceProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkSpaceProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkSpaceProof proto.InternalMessageInfo
+
+func (m *WorkSpaceProof) GetSpaceId() string {
+	if m != nil {
+		return m.SpaceId
+	}
+	return ""
+}
+
+func (m *WorkSpaceProof) GetProof() *Proof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *WorkSpaceProof) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *WorkSpaceProof) GetOrdinal() int64 {
+	if m != nil {
+		return m.Ordinal
+	}
+	return 0
+}
+
+func (m *WorkSpaceProof) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ActOnWorkSpaceRequest struct {
+	SpaceId              string   `protobuf:"bytes,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Action               uint32   `protobuf:"varint,2,opt,name=action,proto3" json:"action,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ActOnWorkSpaceRequest) Reset()         { *m = ActOnWorkSpaceRequest{} }
+func (m *ActOnWorkSpaceRequest) String() string { return proto.CompactTextString(m) }
+func (*ActOnWorkSpaceRequest) ProtoMessage()    {}
+func (*ActOnWorkSpaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c69c0ef45c5b2f2, []int{7}
+}
+func (m *ActOnWorkSpaceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActOnWorkSpaceRequest.Unmarshal(m, b)
+}
+func (m *ActOnWorkSpaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActOnWorkSpaceRequest.Marshal(b, m, deterministic)
+}
+func (m *ActOnWorkSpaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActOnWorkSpaceRequest.Merge(m, src)
+}
+func (m *ActOnWorkSpaceRequest) XXX_Size() int {
+	return xxx_messageInfo_ActOnWorkSpaceRequest.Size(m)
+}
+func (m *ActOnWorkSpaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActOnWorkSpaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActOnWorkSpaceRequest proto.InternalMessageInfo
+
+func (m *ActOnWorkSpaceRequest) GetSpaceId() string {
+	if m != nil {
+		return m.SpaceId
+	}
+	return ""
+}
+
+func (m *ActOnWorkSpaceRequest) GetAction() uint32 {
+	if m != nil {
+		return m.Action
+	}
+	return 0
+}
+
+type ActOnWorkSpaceResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ActOnWorkSpaceResponse) Reset()         { *m = ActOnWorkSpaceResponse{} }
+func (m *ActOnWorkSpaceResponse) String() string { return proto.CompactTextString(m) }
+func (*ActOnWorkSpaceResponse) ProtoMessage()    {}
+func (*ActOnWorkSpaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c69c0ef45c5b2f2, []int{8}
+}
+func (m *ActOnWorkSpaceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActOnWorkSpaceResponse.Unmarshal(m, b)
+}
+func (m *ActOnWorkSpaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActOnWorkSpaceResponse.Marshal(b, m, deterministic)
+}
+func (m *ActOnWorkSpaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActOnWorkSpaceResponse.Merge(m, src)
+}
+func (m *ActOnWorkSpaceResponse) XXX_Size() int {
+	return xxx_messageInfo_ActOnWorkSpaceResponse.Size(m)
+}
+func (m *ActOnWorkSpaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActOnWorkSpaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActOnWorkSpaceResponse proto.InternalMessageInfo
+
+type ActOnWorkSpacesRequest struct {
+	Flags                uint32   `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Action               uint32   `protobuf:"varint,2,opt,name=action,proto3" json:"action,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ActOnWorkSpacesRequest) Reset()         { *m = ActOnWorkSpacesRequest{} }
+func (m *ActOnWorkSpacesRequest) String() string { return proto.CompactTextString(m) }
+func (*ActOnWorkSpacesRequest) ProtoMessage()    {}
+func (*ActOnWorkSpacesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c69c0ef45c5b2f2, []int{9}
+}
+func (m *ActOnWorkSpacesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActOnWorkSpacesRequest.Unmarshal(m, b)
+}
+func (m *ActOnWorkSpacesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActOnWorkSpacesRequest.Marshal(b, m, deterministic)
+}
+func (m *ActOnWorkSpacesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActOnWorkSpacesRequest.Merge(m, src)
+}
+func (m *ActOnWorkSpacesRequest) XXX_Size() int {
+	return xxx_messageInfo_ActOnWorkSpacesRequest.Size(m)
+}
+func (m *ActOnWorkSpacesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActOnWorkSpacesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActOnWorkSpacesRequest proto.InternalMessageInfo
+
+func (m *ActOnWorkSpacesRequest) GetFlags() uint32 {
+	if m != nil {
+		return m.Flags
+	}
+	return 0
+}
+
+func (m *ActOnWorkSpacesRequest) GetAction() uint32 {
+	if m != nil {
+		return m.Action
+	}
+	return 0
+}
+
+type ActOnWorkSpacesResponse struct {
+	Errors               map[string]string `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ActOnWorkSpacesResponse) Reset()         { *m = ActOnWorkSpacesResponse{} }
+func (m *ActOnWorkSpacesResponse) String() string { return proto.CompactTextString(m) }
+func (*ActOnWorkSpacesResponse) ProtoMessage()    {}
+func (*ActOnWorkSpacesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c69c0ef45c5b2f2, []int{10}
+}
+func (m *ActOnWorkSpacesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActOnWorkSpacesResponse.Unmarshal(m, b)
+}
+func (m *ActOnWorkSpacesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActOnWorkSpacesResponse.Marshal(b, m, deterministic)
+}
+func (m *ActOnWorkSpacesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActOnWorkSpacesResponse.Merge(m, src)
+}
+func (m *ActOnWorkSpacesResponse) XXX_Size() int {
+	return xxx_messageInfo_ActOnWorkSpacesResponse.Size(m)
+}
+func (m *ActOnWorkSpacesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActOnWorkSpacesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActOnWorkSpacesResponse proto.InternalMessageInfo
+
+func (m *ActOnWorkSpacesResponse) GetErrors() map[string]string {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+type SignHashRequest struct {
+	SpaceId              string   `protobuf:"bytes,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Hash                 []byte   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignHashRequest) Reset()         { *m = SignHashRequest{} }
+func (m *SignHashRequest) String() string { return proto.CompactTextString(m) }
+func (*SignHashRequest) ProtoMessage()    {}
+func (*SignHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c69c0ef45c5b2f2, []int{11}
+}
+func (m *SignHashRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignHashRequest.Unmarshal(m, b)
+}
+func (m *SignHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignHashRequest.Marshal(b, m, deterministic)
+}
+func (m *SignHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignHashRequest.Merge(m, src)
+}
+func (m *SignHashRequest) XXX_Size() int {
+	return xxx_messageInfo_SignHashRequest.Size(m)
+}
+func (m *SignHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignHashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignHashRequest proto.InternalMessageInfo
+
+func (m *SignHashRequest) GetSpaceId() string {
+	if m != nil {
+		return m.SpaceId
+	}
+	return ""
+}
+
+func (m *SignHashRequest) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+type SignHashResponse struct {
+	Signature            []byte   `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignHashResponse) Reset()         { *m = SignHashResponse{} }
+func (m *SignHashResponse) String() string { return proto.CompactTextString(m) }
+func (*SignHashResponse) ProtoMessage()    {}
+func (*SignHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c69c0ef45c5b2f2, []int{12}
+}
+func (m *SignHashResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignHashResponse.Unmarshal(m, b)
+}
+func (m *SignHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignHashResponse.Marshal(b, m, deterministic)
+}
+func (m *SignHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignHashResponse.Merge(m, src)
+}
+func (m *SignHashResponse) XXX_Size() int {
+	return xxx_messageInfo_SignHashResponse.Size(m)
+}
+func (m *SignHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignHashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignHashResponse proto.InternalMessageInfo
+
+func (m *SignHashResponse) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*WorkSpaceInfosRequest)(nil), "harvesterpb.WorkSpaceInfosRequest")
+	proto.RegisterType((*WorkSpaceInfosResponse)(nil), "harvesterpb.WorkSpaceInfosResponse")
+	proto.RegisterType((*WorkSpaceInfo)(nil), "harvesterpb.WorkSpaceInfo")
+	proto.RegisterType((*GetProofRequest)(nil), "harvesterpb.GetProofRequest")
+	proto.RegisterType((*GetProofsRequest)(nil), "harvesterpb.GetProofsRequest")
+	proto.RegisterType((*Proof)(nil), "harvesterpb.Proof")
+	proto.RegisterType((*WorkSpaceProof)(nil), "harvesterpb.WorkSpaceProof")
+	proto.RegisterType((*ActOnWorkSpaceRequest)(nil), "harvesterpb.ActOnWorkSpaceRequest")
+	proto.RegisterType((*ActOnWorkSpaceResponse)(nil), "harvesterpb.ActOnWorkSpaceResponse")
+	proto.RegisterType((*ActOnWorkSpacesRequest)(nil), "harvesterpb.ActOnWorkSpacesRequest")
+	proto.RegisterType((*ActOnWorkSpacesResponse)(nil), "harvesterpb.ActOnWorkSpacesResponse")
+	proto.RegisterMapType((map[string]string)(nil), "harvesterpb.ActOnWorkSpacesResponse.ErrorsEntry")
+	proto.RegisterType((*SignHashRequest)(nil), "harvesterpb.SignHashRequest")
+	proto.RegisterType((*SignHashResponse)(nil), "harvesterpb.SignHashResponse")
+}
+
+func init() { proto.RegisterFile("harvester.proto", fileDescriptor_2c69c0ef45c5b2f2) }
+
+var fileDescriptor_2c69c0ef45c5b2f2 = []byte{
+	// 624 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcd, 0x6e, 0xdb, 0x3c,
+	0x10, 0x04, 0xa3, 0xc8, 0xb1, 0xd6, 0x49, 0x1c, 0x10, 0xf9, 0xd1, 0xa7, 0x2f, 0x01, 0x04, 0xa6,
+	0x07, 0x5d, 0x6a, 0x18, 0xe9, 0xa5, 0xed, 0xa9, 0x3d, 0xe4, 0xc7, 0x6e, 0x8b, 0x06, 0xcc, 0xa1,
+	0x28, 0x50, 0xc0, 0x90, 0x6d, 0xda, 0x12, 0xa2, 0x4a, 0x2a, 0x49, 0x07, 0xf6, 0xcb, 0xf4, 0xd4,
+	0x47, 0xe8, 0xd3, 0xf5, 0x54, 0x88, 0x94, 0x9c, 0x48, 0xb5, 0x15, 0xdf, 0x3c, 0xcb, 0xdd, 0xe1,
+	0xee, 0xcc, 0x52, 0x86, 0x76, 0xe0, 0xf3, 0x07, 0x26, 0x24, 0xe3, 0x9d, 0x94, 0x27, 0x32, 0xc1,
+	0xad, 0x65, 0x20, 0x1d, 0x92, 0x97, 0x70, 0xf4, 0x25, 0xe1, 0xf7, 0x77, 0xa9, 0x3f, 0x62, 0xbd,
+	0x78, 0x92, 0x08, 0xca, 0x7e, 0xcc, 0x98, 0x90, 0xf8, 0x10, 0xcc, 0x49, 0xe4, 0x4f, 0x85, 0x8d,
+	0x5c, 0xe4, 0xed, 0x51, 0x0d, 0x48, 0x1f, 0x8e, 0xab, 0xe9, 0x22, 0x4d, 0x62, 0xc1, 0x70, 0x17,
+	0xcc, 0x30, 0x0b, 0xd8, 0xc8, 0x35, 0xbc, 0xd6, 0x85, 0xd3, 0x79, 0x72, 0x4b, 0xa7, 0x54, 0x43,
+	0x75, 0x22, 0xf9, 0x8d, 0x60, 0xaf, 0x74, 0x80, 0xff, 0x83, 0xa6, 0xc8, 0xc0, 0x20, 0x1c, 0xab,
+	0x6b, 0x2d, 0xba, 0xa3, 0x70, 0x6f, 0x8c, 0xcf, 0x00, 0xd2, 0xd9, 0x30, 0x0a, 0x47, 0x83, 0x7b,
+	0xb6, 0xb0, 0xb7, 0x5c, 0xe4, 0xed, 0x52, 0x4b, 0x47, 0x3e, 0xb0, 0x05, 0xb6, 0x61, 0x27, 0xe1,
+	0xe3, 0x30, 0xf6, 0x23, 0xdb, 0x70, 0x91, 0x67, 0xd0, 0x02, 0x66, 0x85, 0xc3, 0x50, 0x0e, 0x22,
+	0x16, 0x4f, 0x65, 0x60, 0x6f, 0xbb, 0xc8, 0x33, 0xa9, 0x35, 0x0c, 0xe5, 0x47, 0x15, 0xc0, 0x0e,
+	0x34, 0x53, 0x9e, 0x4c, 0x39, 0x13, 0xc2, 0x36, 0x5d, 0xe4, 0x21, 0xba, 0xc4, 0x99, 0x04, 0x42,
+	0xfa, 0x92, 0xd9, 0x0d, 0x2d, 0x81, 0x02, 0xa4, 0x0f, 0xed, 0x6b, 0x26, 0x6f, 0x79, 0x92, 0x4c,
+	0x0a, 0xad, 0x6a, 0xfa, 0x3e, 0x05, 0x6b, 0x14, 0xf8, 0x51, 0x76, 0x3d, 0x2b, 0xda, 0x5e, 0x06,
+	0xc8, 0x15, 0x1c, 0x14, 0x5c, 0xf5, 0xc2, 0x3f, 0xc3, 0xf3, 0x09, 0x4c, 0x45, 0x82, 0x77, 0x01,
+	0xcd, 0x55, 0xe1, 0x2e, 0x45, 0x73, 0x7c, 0x02, 0x3b, 0xf3, 0x41, 0xca, 0xc3, 0xef, 0x45, 0x49,
+	0x63, 0x7e, 0x9b, 0xa1, 0x8a, 0x28, 0x46, 0x45, 0x14, 0xf2, 0x0b, 0xc1, 0xfe, 0xd2, 0x19, 0x4d,
+	0x5c, 0x33, 0xa2, 0x07, 0x66, 0x9a, 0xe5, 0xa8, 0x3b, 0x5a, 0x17, 0xb8, 0xe4, 0xbc, 0xd6, 0x49,
+	0x27, 0x54, 0x4c, 0x34, 0x6a, 0x4c, 0xdc, 0x2e, 0x9b, 0x78, 0x08, 0x26, 0xe3, 0x3c, 0xe1, 0xca,
+	0x22, 0x8b, 0x6a, 0x40, 0xfa, 0x70, 0xf4, 0x7e, 0x24, 0x3f, 0xc7, 0xcb, 0x56, 0x37, 0xf0, 0xe3,
+	0x18, 0x1a, 0xfe, 0x48, 0x86, 0x49, 0xac, 0xba, 0xdd, 0xa3, 0x39, 0x22, 0x36, 0x1c, 0x57, 0xb9,
+	0xf4, 0x62, 0x93, 0xab, 0xea, 0xc9, 0x33, 0x4e, 0xad, 0xbb, 0xe1, 0x27, 0x82, 0x93, 0x7f, 0x88,
+	0xf2, 0xc7, 0x73, 0x03, 0x0d, 0x35, 0x52, 0xf1, 0x7a, 0xba, 0x25, 0x0d, 0xd7, 0x54, 0x75, 0x2e,
+	0x55, 0xc9, 0x65, 0x2c, 0xf9, 0x82, 0xe6, 0xf5, 0xce, 0x1b, 0x68, 0x3d, 0x09, 0xe3, 0x03, 0x30,
+	0x32, 0xa9, 0xb5, 0x08, 0xd9, 0xcf, 0xac, 0xe9, 0x07, 0x3f, 0x9a, 0xe9, 0x8d, 0xb0, 0xa8, 0x06,
+	0x6f, 0xb7, 0x5e, 0x23, 0xf2, 0x0e, 0xda, 0x77, 0xe1, 0x34, 0xbe, 0xf1, 0x45, 0xb0, 0x81, 0x90,
+	0x18, 0xb6, 0x03, 0x5f, 0x04, 0xf9, 0x62, 0xa9, 0xdf, 0xa4, 0x0b, 0x07, 0x8f, 0x0c, 0xf9, 0x68,
+	0xa7, 0x60, 0x89, 0x70, 0x1a, 0xfb, 0x72, 0xc6, 0x59, 0xbe, 0x99, 0x8f, 0x81, 0x8b, 0x3f, 0x06,
+	0x58, 0x37, 0xc5, 0xa8, 0xf8, 0x2b, 0xec, 0x97, 0x3e, 0x08, 0x02, 0x93, 0xf5, 0x9f, 0x91, 0xc2,
+	0x06, 0xe7, 0xbc, 0x36, 0x27, 0x6f, 0xe3, 0x12, 0x9a, 0xc5, 0x4b, 0xc3, 0xa7, 0xa5, 0x82, 0xca,
+	0x63, 0x76, 0xfe, 0x5f, 0x4d, 0xa7, 0x4b, 0x7b, 0x60, 0x15, 0xf9, 0x02, 0x9f, 0xad, 0xe4, 0x11,
+	0x9b, 0x10, 0x75, 0x51, 0x36, 0x6c, 0xd9, 0xd8, 0xca, 0xb0, 0x2b, 0x57, 0xdb, 0x39, 0xaf, 0xcd,
+	0xc9, 0x87, 0xfd, 0x06, 0xed, 0xf2, 0x89, 0xc0, 0xe7, 0xf5, 0x1b, 0xa5, 0xc9, 0x5f, 0x6c, 0xb2,
+	0x76, 0xf8, 0x1a, 0x9a, 0x85, 0xcb, 0x15, 0x29, 0x2b, 0xeb, 0xe3, 0x9c, 0xad, 0x39, 0xd5, 0x44,
+	0xc3, 0x86, 0xfa, 0x3f, 0x7a, 0xf5, 0x77, 0x00, 0x1d, 0xf0, 0x67, 0x89, 0xa2, 0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// HarvesterClient is the client API for Harvester service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type HarvesterClient interface {
+	WorkSpaceInfos(ctx context.Context, in *WorkSpaceInfosRequest, opts ...grpc.CallOption) (*WorkSpaceInfosResponse, error)
+	GetProof(ctx context.Context, in *GetProofRequest, opts ...grpc.CallOption) (*WorkSpaceProof, error)
+	GetProofs(ctx context.Context, in *GetProofsRequest, opts ...grpc.CallOption) (Harvester_GetProofsClient, error)
+	ActOnWorkSpace(ctx context.Context, in *ActOnWorkSpaceRequest, opts ...grpc.CallOption) (*ActOnWorkSpaceResponse, error)
+	ActOnWorkSpaces(ctx context.Context, in *ActOnWorkSpacesRequest, opts ...grpc.CallOption) (*ActOnWorkSpacesResponse, error)
+	SignHash(ctx context.Context, in *SignHashRequest, opts ...grpc.CallOption) (*SignHashResponse, error)
+}
+
+type harvesterClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewHarvesterClient(cc *grpc.ClientConn) HarvesterClient {
+	return &harvesterClient{cc}
+}
+
+func (c *harvesterClient) WorkSpaceInfos(ctx context.Context, in *WorkSpaceInfosRequest, opts ...grpc.CallOption) (*WorkSpaceInfosResponse, error) {
+	out := new(WorkSpaceInfosResponse)
+	err := c.cc.Invoke(ctx, "/harvesterpb.Harvester/WorkSpaceInfos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *harvesterClient) GetProof(ctx context.Context, in *GetProofRequest, opts ...grpc.CallOption) (*WorkSpaceProof, error) {
+	out := new(WorkSpaceProof)
+	err := c.cc.Invoke(ctx, "/harvesterpb.Harvester/GetProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *harvesterClient) GetProofs(ctx context.Context, in *GetProofsRequest, opts ...grpc.CallOption) (Harvester_GetProofsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Harvester_serviceDesc.Streams[0], "/harvesterpb.Harvester/GetProofs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &harvesterGetProofsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Harvester_GetProofsClient interface {
+	Recv() (*WorkSpaceProof, error)
+	grpc.ClientStream
+}
+
+type harvesterGetProofsClient struct {
+	grpc.ClientStream
+}
+
+func (x *harvesterGetProofsClient) Recv() (*WorkSpaceProof, error) {
+	m := new(WorkSpaceProof)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *harvesterClient) ActOnWorkSpace(ctx context.Context, in *ActOnWorkSpaceRequest, opts ...grpc.CallOption) (*ActOnWorkSpaceResponse, error) {
+	out := new(ActOnWorkSpaceResponse)
+	err := c.cc.Invoke(ctx, "/harvesterpb.Harvester/ActOnWorkSpace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *harvesterClient) ActOnWorkSpaces(ctx context.Context, in *ActOnWorkSpacesRequest, opts ...grpc.CallOption) (*ActOnWorkSpacesResponse, error) {
+	out := new(ActOnWorkSpacesResponse)
+	err := c.cc.Invoke(ctx, "/harvesterpb.Harvester/ActOnWorkSpaces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *harvesterClient) SignHash(ctx context.Context, in *SignHashRequest, opts ...grpc.CallOption) (*SignHashResponse, error) {
+	out := new(SignHashResponse)
+	err := c.cc.Invoke(ctx, "/harvesterpb.Harvester/SignHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HarvesterServer is the server API for Harvester service.
+type HarvesterServer interface {
+	WorkSpaceInfos(context.Context, *WorkSpaceInfosRequest) (*WorkSpaceInfosResponse, error)
+	GetProof(context.Context, *GetProofRequest) (*WorkSpaceProof, error)
+	GetProofs(*GetProofsRequest, Harvester_GetProofsServer) error
+	ActOnWorkSpace(context.Context, *ActOnWorkSpaceRequest) (*ActOnWorkSpaceResponse, error)
+	ActOnWorkSpaces(context.Context, *ActOnWorkSpacesRequest) (*ActOnWorkSpacesResponse, error)
+	SignHash(context.Context, *SignHashRequest) (*SignHashResponse, error)
+}
+
+// UnimplementedHarvesterServer can be embedded to have forward compatible implementations.
+type UnimplementedHarvesterServer struct {
+}
+
+func (*UnimplementedHarvesterServer) WorkSpaceInfos(ctx context.Context, req *WorkSpaceInfosRequest) (*WorkSpaceInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WorkSpaceInfos not implemented")
+}
+func (*UnimplementedHarvesterServer) GetProof(ctx context.Context, req *GetProofRequest) (*WorkSpaceProof, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProof not implemented")
+}
+func (*UnimplementedHarvesterServer) GetProofs(req *GetProofsRequest, srv Harvester_GetProofsServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetProofs not implemented")
+}
+func (*UnimplementedHarvesterServer) ActOnWorkSpace(ctx context.Context, req *ActOnWorkSpaceRequest) (*ActOnWorkSpaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActOnWorkSpace not implemented")
+}
+func (*UnimplementedHarvesterServer) ActOnWorkSpaces(ctx context.Context, req *ActOnWorkSpacesRequest) (*ActOnWorkSpacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActOnWorkSpaces not implemented")
+}
+func (*UnimplementedHarvesterServer) SignHash(ctx context.Context, req *SignHashRequest) (*SignHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignHash not implemented")
+}
+
+func RegisterHarvesterServer(s *grpc.Server, srv HarvesterServer) {
+	s.RegisterService(&_Harvester_serviceDesc, srv)
+}
+
+func _Harvester_WorkSpaceInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkSpaceInfosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HarvesterServer).WorkSpaceInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/harvesterpb.Harvester/WorkSpaceInfos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HarvesterServer).WorkSpaceInfos(ctx, req.(*WorkSpaceInfosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Harvester_GetProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HarvesterServer).GetProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/harvesterpb.Harvester/GetProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HarvesterServer).GetProof(ctx, req.(*GetProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Harvester_GetProofs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetProofsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HarvesterServer).GetProofs(m, &harvesterGetProofsServer{stream})
+}
+
+type Harvester_GetProofsServer interface {
+	Send(*WorkSpaceProof) error
+	grpc.ServerStream
+}
+
+type harvesterGetProofsServer struct {
+	grpc.ServerStream
+}
+
+func (x *harvesterGetProofsServer) Send(m *WorkSpaceProof) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Harvester_ActOnWorkSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActOnWorkSpaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HarvesterServer).ActOnWorkSpace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/harvesterpb.Harvester/ActOnWorkSpace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HarvesterServer).ActOnWorkSpace(ctx, req.(*ActOnWorkSpaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Harvester_ActOnWorkSpaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActOnWorkSpacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HarvesterServer).ActOnWorkSpaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/harvesterpb.Harvester/ActOnWorkSpaces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HarvesterServer).ActOnWorkSpaces(ctx, req.(*ActOnWorkSpacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Harvester_SignHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HarvesterServer).SignHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/harvesterpb.Harvester/SignHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HarvesterServer).SignHash(ctx, req.(*SignHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Harvester_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "harvesterpb.Harvester",
+	HandlerType: (*HarvesterServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "WorkSpaceInfos",
+			Handler:    _Harvester_WorkSpaceInfos_Handler,
+		},
+		{
+			MethodName: "GetProof",
+			Handler:    _Harvester_GetProof_Handler,
+		},
+		{
+			MethodName: "ActOnWorkSpace",
+			Handler:    _Harvester_ActOnWorkSpace_Handler,
+		},
+		{
+			MethodName: "ActOnWorkSpaces",
+			Handler:    _Harvester_ActOnWorkSpaces_Handler,
+		},
+		{
+			MethodName: "SignHash",
+			Handler:    _Harvester_SignHash_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetProofs",
+			Handler:       _Harvester_GetProofs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "harvester.proto",
+}
@@ -0,0 +1,319 @@
+package remote_test
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/hex"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Sukhavati-Labs/go-miner/chainutil"
+	"github.com/Sukhavati-Labs/go-miner/chainutil/service"
+	"github.com/Sukhavati-Labs/go-miner/poc"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/spacekeeper"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/spacekeeper/capacity"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/spacekeeper/remote"
+	"github.com/Sukhavati-Labs/go-miner/poc/pocutil"
+	"github.com/Sukhavati-Labs/go-miner/pocec"
+)
+
+const (
+	testToken     = "secret"
+	testChallenge = "f17a8b5534fb1a9d34c831d0766fbc77b0b718500412c6647f48fda0dd8fa780"
+	testPubKey    = "02be7ff1bbbd42b808cb6b7de2d22cd53dea771c9c599fb034c7b15bae0ec53eb3"
+)
+
+// mockKeeper serves a single workspace and answers challenges after delay,
+// with a proof valid for testChallenge unless bad is set. Signatures are made
+// by a random key since the private key of the proof is unknown.
+type mockKeeper struct {
+	*service.BaseService
+	sid   string
+	pk    *pocec.PublicKey
+	sk    *pocec.PrivateKey
+	delay time.Duration
+	bad   bool
+}
+
+func newMockKeeper(t *testing.T, sid string, delay time.Duration) *mockKeeper {
+	sk, err := pocec.NewPrivateKey(pocec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkBytes, err := hex.DecodeString(testPubKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk, err := pocec.ParsePubKey(pkBytes, pocec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &mockKeeper{sid: sid, pk: pk, sk: sk, delay: delay}
+	m.BaseService = service.NewBaseService(m, "mock")
+	return m
+}
+
+func testChallengeHash(t *testing.T) pocutil.Hash {
+	challenge, err := pocutil.DecodeStringToHash(testChallenge)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return challenge
+}
+
+func (m *mockKeeper) Type() string { return m.Name() }
+
+func (m *mockKeeper) WorkSpaceIDs(flags engine.WorkSpaceStateFlags) ([]string, error) {
+	return []string{m.sid}, nil
+}
+
+func (m *mockKeeper) WorkSpaceInfos(flags engine.WorkSpaceStateFlags) ([]engine.WorkSpaceInfo, error) {
+	return []engine.WorkSpaceInfo{{SpaceID: m.sid, PublicKey: m.pk, Ordinal: 1, BitLength: 32, Progress: 100, State: engine.Mining}}, nil
+}
+
+func (m *mockKeeper) proof() *engine.WorkSpaceProof {
+	proof := &poc.Proof{X: []byte{0xeb, 0xd0, 0x8b, 0xeb}, XPrime: []byte{0x98, 0x87, 0x63, 0x0a}, BitLength: 32}
+	if m.bad {
+		proof.X = []byte{1, 2, 3, 4}
+	}
+	return &engine.WorkSpaceProof{
+		SpaceID:   m.sid,
+		Proof:     proof,
+		PublicKey: m.pk,
+		Ordinal:   1,
+	}
+}
+
+func (m *mockKeeper) GetProof(ctx context.Context, sid string, challenge pocutil.Hash) (*engine.WorkSpaceProof, error) {
+	return m.proof(), nil
+}
+
+func (m *mockKeeper) GetProofs(ctx context.Context, flags engine.WorkSpaceStateFlags, challenge pocutil.Hash) ([]*engine.WorkSpaceProof, error) {
+	return []*engine.WorkSpaceProof{m.proof()}, nil
+}
+
+func (m *mockKeeper) GetProofReader(ctx context.Context, sid string, challenge pocutil.Hash) (engine.ProofReader, error) {
+	return m.GetProofsReader(ctx, engine.SFAll, challenge)
+}
+
+func (m *mockKeeper) GetProofsReader(ctx context.Context, flags engine.WorkSpaceStateFlags, challenge pocutil.Hash) (engine.ProofReader, error) {
+	prw := engine.NewProofRW(ctx, 1)
+	go func() {
+		time.Sleep(m.delay)
+		prw.Write(m.proof())
+		prw.Close()
+	}()
+	return prw, nil
+}
+
+func (m *mockKeeper) ActOnWorkSpace(sid string, action engine.ActionType) error {
+	if action == engine.Plot {
+		return capacity.ErrWorkSpaceIsNotRegistered
+	}
+	return nil
+}
+
+func (m *mockKeeper) ActOnWorkSpaces(flags engine.WorkSpaceStateFlags, action engine.ActionType) (map[string]error, error) {
+	return map[string]error{m.sid: nil}, nil
+}
+
+func (m *mockKeeper) SignHash(sid string, hash [32]byte) (*pocec.Signature, error) {
+	if sid != m.sid {
+		return nil, spacekeeper.ErrUnimplemented
+	}
+	return m.sk.Sign(hash[:])
+}
+
+// testTLSConfig generates a certificate pair for harvesters, and returns
+// TLS configs of harvesters and nodes.
+func testTLSConfig(t *testing.T) (server, client *tls.Config) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "harvester.cert")
+	server, _, err := chainutil.LoadTLSConfig(certFile, filepath.Join(dir, "harvester.key"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client, err = chainutil.NewClientTLSConfig(certFile); err != nil {
+		t.Fatal(err)
+	}
+	return server, client
+}
+
+func startHarvester(t *testing.T, sk spacekeeper.SpaceKeeper, tlsConfig *tls.Config) (addr string, stop func()) {
+	h, err := remote.NewHarvester(sk, testToken, tlsConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go h.Serve(lis)
+	return lis.Addr().String(), h.Stop
+}
+
+func TestRemoteSpaceKeeper(t *testing.T) {
+	serverTLS, clientTLS := testTLSConfig(t)
+	fast, slow := newMockKeeper(t, "fast", 0), newMockKeeper(t, "slow", 2*time.Second)
+	fastAddr, stopFast := startHarvester(t, fast, serverTLS)
+	defer stopFast()
+	slowAddr, stopSlow := startHarvester(t, slow, serverTLS)
+	defer stopSlow()
+
+	sk, err := remote.NewSpaceKeeper([]string{fastAddr, slowAddr}, testToken, clientTLS, 500*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = sk.GetProofs(context.Background(), engine.SFMining, pocutil.Hash{}); err != remote.ErrSpaceKeeperIsNotRunning {
+		t.Fatalf("expected %v, got %v", remote.ErrSpaceKeeperIsNotRunning, err)
+	}
+	if err = sk.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer sk.Stop()
+
+	infos, err := sk.WorkSpaceInfos(engine.SFAll)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(infos) != 2 {
+		t.Fatalf("expected 2 workspaces, got %d", len(infos))
+	}
+
+	// slow harvester must be dropped instead of stalling
+	start := time.Now()
+	proofs, err := sk.GetProofs(context.Background(), engine.SFMining, testChallengeHash(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("GetProofs stalled by slow harvester, elapsed %v", elapsed)
+	}
+	if len(proofs) != 1 || proofs[0].SpaceID != "fast" || proofs[0].Error != nil {
+		t.Fatalf("unexpected proofs %+v", proofs)
+	}
+	if !proofs[0].PublicKey.IsEqual(fast.pk) {
+		t.Fatal("public key mismatched")
+	}
+
+	// requests are routed to the harvester holding the workspace
+	var hash [32]byte
+	hash[0] = 1
+	sig, err := sk.SignHash("slow", hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sig.Verify(hash[:], slow.sk.PubKey()) {
+		t.Fatal("invalid signature")
+	}
+	if _, err = sk.SignHash("unknown", hash); err != remote.ErrWorkSpaceDoesNotExist {
+		t.Fatalf("expected %v, got %v", remote.ErrWorkSpaceDoesNotExist, err)
+	}
+
+	errs, err := sk.ActOnWorkSpaces(engine.SFAll, engine.Mine)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(errs))
+	}
+
+	// errors of harvesters are mapped back to sentinel errors
+	if err = sk.ActOnWorkSpace("fast", engine.Plot); err != capacity.ErrWorkSpaceIsNotRegistered {
+		t.Fatalf("expected %v, got %v", capacity.ErrWorkSpaceIsNotRegistered, err)
+	}
+	// connections are closed on stop, and dialed again on restart
+	if err = sk.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	if err = sk.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = sk.SignHash("fast", hash); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRemoteInvalidProof(t *testing.T) {
+	serverTLS, clientTLS := testTLSConfig(t)
+	good, bad := newMockKeeper(t, "good", 0), newMockKeeper(t, "bad", 0)
+	bad.bad = true
+	goodAddr, stopGood := startHarvester(t, good, serverTLS)
+	defer stopGood()
+	badAddr, stopBad := startHarvester(t, bad, serverTLS)
+	defer stopBad()
+
+	sk, err := remote.NewSpaceKeeper([]string{goodAddr, badAddr}, testToken, clientTLS, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = sk.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer sk.Stop()
+
+	// the bad proof is reported by its error, instead of failing proofs of others
+	challenge := testChallengeHash(t)
+	proofs, err := sk.GetProofs(context.Background(), engine.SFMining, challenge)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(proofs) != 2 {
+		t.Fatalf("expected 2 proofs, got %d", len(proofs))
+	}
+	for _, proof := range proofs {
+		switch proof.SpaceID {
+		case "good":
+			if proof.Error != nil {
+				t.Errorf("unexpected error of good proof, %v", proof.Error)
+			}
+		case "bad":
+			if proof.Error != remote.ErrInvalidProof {
+				t.Errorf("expected %v, got %v", remote.ErrInvalidProof, proof.Error)
+			}
+		default:
+			t.Errorf("unexpected proof %s", proof.SpaceID)
+		}
+	}
+	proof, err := sk.GetProof(context.Background(), "bad", challenge)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proof.Error != remote.ErrInvalidProof {
+		t.Errorf("expected %v, got %v", remote.ErrInvalidProof, proof.Error)
+	}
+}
+
+func TestHarvesterAuth(t *testing.T) {
+	serverTLS, clientTLS := testTLSConfig(t)
+	addr, stop := startHarvester(t, newMockKeeper(t, "ws", 0), serverTLS)
+	defer stop()
+
+	sk, err := remote.NewSpaceKeeper([]string{addr}, "wrong", clientTLS, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = sk.WorkSpaceInfos(engine.SFAll); err != remote.ErrUnauthenticated {
+		t.Fatalf("expected %v, got %v", remote.ErrUnauthenticated, err)
+	}
+
+	// harvesters with other certificates are not trusted
+	_, otherTLS := testTLSConfig(t)
+	sk, err = remote.NewSpaceKeeper([]string{addr}, testToken, otherTLS, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = sk.WorkSpaceInfos(engine.SFAll); err == nil {
+		t.Fatal("expected error for untrusted harvester")
+	}
+
+	if _, err = remote.NewHarvester(newMockKeeper(t, "ws", 0), "", serverTLS); err != remote.ErrEmptyToken {
+		t.Fatalf("expected %v, got %v", remote.ErrEmptyToken, err)
+	}
+	if _, err = remote.NewSpaceKeeper([]string{addr}, testToken, nil, time.Second); err != remote.ErrNoTLSConfig {
+		t.Fatalf("expected %v, got %v", remote.ErrNoTLSConfig, err)
+	}
+}
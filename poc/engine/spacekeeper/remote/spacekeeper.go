@@ -0,0 +1,552 @@
+package remote
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/Sukhavati-Labs/go-miner/chainutil"
+	"github.com/Sukhavati-Labs/go-miner/chainutil/service"
+	"github.com/Sukhavati-Labs/go-miner/config"
+	"github.com/Sukhavati-Labs/go-miner/logging"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/spacekeeper"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/spacekeeper/capacity"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/spacekeeper/remote/pb"
+	"github.com/Sukhavati-Labs/go-miner/poc/pocutil"
+	"github.com/Sukhavati-Labs/go-miner/pocec"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	TypeSpaceKeeperRemote = "spacekeeper.remote"
+
+	// requestTimeout limits requests other than querying proofs
+	requestTimeout  = 10 * time.Second
+	minProofBufSize = 64
+)
+
+type harvester struct {
+	addr   string
+	conn   *grpc.ClientConn
+	client harvesterpb.HarvesterClient
+}
+
+// SpaceKeeper implements spacekeeper.SpaceKeeper by sending challenges to
+// remote harvesters and merging their WorkSpaceProofs.
+//
+// Harvesters not responding in proofTimeout are dropped for that challenge,
+// so that a slow box never stalls the miner.
+type SpaceKeeper struct {
+	*service.BaseService
+	addrs        []string
+	tlsConfig    *tls.Config
+	token        string
+	closed       bool // connections are closed by OnStop
+	harvestLock  sync.RWMutex
+	harvesters   []*harvester // replaced by dial
+	proofTimeout time.Duration
+	routeLock    sync.RWMutex
+	routes       map[string]*harvester // sid -> harvester
+}
+
+// NewSpaceKeeperRemote
+func NewSpaceKeeperRemote(args ...interface{}) (spacekeeper.SpaceKeeper, error) {
+	cfg, err := parseArgs(args...)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig, err := chainutil.NewClientTLSConfig(cfg.Miner.HarvesterTlsCert)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to load certificates of harvesters", logging.LogFormat{"cert": cfg.Miner.HarvesterTlsCert, "err": err})
+		return nil, err
+	}
+	return NewSpaceKeeper(cfg.Miner.Harvester, cfg.Miner.HarvesterToken, tlsConfig, time.Duration(cfg.Miner.HarvesterTimeout)*time.Millisecond)
+}
+
+// NewSpaceKeeper dials harvesters listening on addrs over TLS, connections
+// are established lazily and re-established on failure by grpc.
+func NewSpaceKeeper(addrs []string, token string, tlsConfig *tls.Config, proofTimeout time.Duration) (*SpaceKeeper, error) {
+	if len(addrs) == 0 {
+		return nil, ErrNoHarvester
+	}
+	if token == "" {
+		return nil, ErrEmptyToken
+	}
+	if tlsConfig == nil {
+		return nil, ErrNoTLSConfig
+	}
+	sk := &SpaceKeeper{
+		addrs:        addrs,
+		tlsConfig:    tlsConfig,
+		token:        token,
+		proofTimeout: proofTimeout,
+		routes:       make(map[string]*harvester),
+	}
+	sk.BaseService = service.NewBaseService(sk, TypeSpaceKeeperRemote)
+	if err := sk.dial(); err != nil {
+		return nil, err
+	}
+	return sk, nil
+}
+
+// dial replaces connections to harvesters, routes to old ones are removed.
+func (sk *SpaceKeeper) dial() error {
+	harvesters := make([]*harvester, 0, len(sk.addrs))
+	for _, addr := range sk.addrs {
+		conn, err := grpc.Dial(addr,
+			grpc.WithTransportCredentials(credentials.NewTLS(sk.tlsConfig)),
+			grpc.WithPerRPCCredentials(tokenCredentials(sk.token)))
+		if err != nil {
+			for _, h := range harvesters {
+				h.conn.Close()
+			}
+			return err
+		}
+		harvesters = append(harvesters, &harvester{
+			addr:   addr,
+			conn:   conn,
+			client: harvesterpb.NewHarvesterClient(conn),
+		})
+	}
+	sk.harvestLock.Lock()
+	sk.harvesters = harvesters
+	sk.harvestLock.Unlock()
+	sk.closed = false
+	sk.routeLock.Lock()
+	sk.routes = make(map[string]*harvester)
+	sk.routeLock.Unlock()
+	return nil
+}
+
+// parseArgs accepts the same args as spacekeeper.v1, the wallet is ignored
+// since harvesters sign with their own wallets.
+func parseArgs(args ...interface{}) (*config.Config, error) {
+	if len(args) == 0 {
+		return nil, spacekeeper.ErrInvalidSKArgs
+	}
+	cfg, ok := args[0].(*config.Config)
+	if !ok {
+		return nil, spacekeeper.ErrInvalidSKArgs
+	}
+	return cfg, nil
+}
+
+func (sk *SpaceKeeper) OnStart() error {
+	// connections closed by OnStop are dialed again on restart
+	if sk.closed {
+		if err := sk.dial(); err != nil {
+			return err
+		}
+	}
+	if _, err := sk.WorkSpaceInfos(engine.SFAll); err != nil {
+		logging.CPrint(logging.WARN, "no harvester is reachable on start", logging.LogFormat{"err": err})
+	}
+	logging.CPrint(logging.INFO, "remote spaceKeeper started", logging.LogFormat{"harvesters": len(sk.getHarvesters())})
+	return nil
+}
+
+func (sk *SpaceKeeper) OnStop() error {
+	sk.closeConns()
+	sk.closed = true
+	logging.CPrint(logging.INFO, "remote spaceKeeper stopped")
+	return nil
+}
+
+func (sk *SpaceKeeper) Type() string {
+	return sk.Name()
+}
+
+func (sk *SpaceKeeper) WorkSpaceIDs(flags engine.WorkSpaceStateFlags) ([]string, error) {
+	infos, err := sk.WorkSpaceInfos(flags)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]string, len(infos))
+	for i := range infos {
+		result[i] = infos[i].SpaceID
+	}
+	return result, nil
+}
+
+// WorkSpaceInfos merges WorkSpaceInfos of all reachable harvesters,
+// an error is returned only if none of them is reachable.
+func (sk *SpaceKeeper) WorkSpaceInfos(flags engine.WorkSpaceStateFlags) ([]engine.WorkSpaceInfo, error) {
+	harvesters := sk.getHarvesters()
+	results := make([][]engine.WorkSpaceInfo, len(harvesters))
+	errs := make([]error, len(harvesters))
+	forEachHarvester(harvesters, func(i int, h *harvester) {
+		ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+		defer cancel()
+		resp, err := h.client.WorkSpaceInfos(ctx, &harvesterpb.WorkSpaceInfosRequest{Flags: uint32(flags)})
+		if err != nil {
+			logging.CPrint(logging.WARN, "fail to query workspaces from harvester", logging.LogFormat{"addr": h.addr, "err": err})
+			errs[i] = remoteError(err)
+			return
+		}
+		infos := make([]engine.WorkSpaceInfo, 0, len(resp.Infos))
+		for _, info := range resp.Infos {
+			wsi, err := workSpaceInfoFromPB(info)
+			if err != nil {
+				logging.CPrint(logging.WARN, "invalid workspace info from harvester", logging.LogFormat{"addr": h.addr, "sid": info.SpaceId, "err": err})
+				continue
+			}
+			infos = append(infos, wsi)
+		}
+		results[i] = infos
+		sk.updateRoutes(h, infos, flags.Contains(engine.SFAll))
+	})
+
+	result := make([]engine.WorkSpaceInfo, 0)
+	var failed int
+	for i := range results {
+		if errs[i] != nil {
+			failed++
+			continue
+		}
+		result = append(result, results[i]...)
+	}
+	if failed == len(harvesters) {
+		return nil, errs[0]
+	}
+	return result, nil
+}
+
+func (sk *SpaceKeeper) GetProof(ctx context.Context, sid string, challenge pocutil.Hash) (*engine.WorkSpaceProof, error) {
+	if !sk.Started() {
+		return nil, ErrSpaceKeeperIsNotRunning
+	}
+	h, err := sk.route(sid)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, sk.proofTimeout)
+	defer cancel()
+	resp, err := h.client.GetProof(ctx, &harvesterpb.GetProofRequest{SpaceId: sid, Challenge: challenge[:]})
+	if err != nil {
+		return nil, remoteError(err)
+	}
+	wsp := workSpaceProofFromPB(resp, challenge)
+	if wsp.Error == ErrInvalidProof {
+		logging.CPrint(logging.WARN, "invalid proof from harvester", logging.LogFormat{"addr": h.addr, "sid": wsp.SpaceID})
+	}
+	return wsp, nil
+}
+
+func (sk *SpaceKeeper) GetProofs(ctx context.Context, flags engine.WorkSpaceStateFlags, challenge pocutil.Hash) ([]*engine.WorkSpaceProof, error) {
+	reader, err := sk.GetProofsReader(ctx, flags, challenge)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*engine.WorkSpaceProof, 0)
+	for {
+		wsp, err := reader.Read()
+		if err == io.EOF {
+			return result, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if wsp != nil {
+			result = append(result, wsp)
+		}
+	}
+}
+
+func (sk *SpaceKeeper) GetProofReader(ctx context.Context, sid string, challenge pocutil.Hash) (engine.ProofReader, error) {
+	wsp, err := sk.GetProof(ctx, sid, challenge)
+	if err != nil {
+		return nil, err
+	}
+	prw := engine.NewProofRW(ctx, 1)
+	prw.Write(wsp)
+	prw.Close()
+	return prw, nil
+}
+
+// GetProofsReader sends challenge to all harvesters, proofs are written to
+// the reader as soon as they arrive.
+// The reader is closed once every harvester has finished or proofTimeout
+// is reached, harvesters running late are dropped for this challenge.
+func (sk *SpaceKeeper) GetProofsReader(ctx context.Context, flags engine.WorkSpaceStateFlags, challenge pocutil.Hash) (engine.ProofReader, error) {
+	if !sk.Started() {
+		return nil, ErrSpaceKeeperIsNotRunning
+	}
+
+	sk.routeLock.RLock()
+	bufSize := len(sk.routes)
+	sk.routeLock.RUnlock()
+	if bufSize < minProofBufSize {
+		bufSize = minProofBufSize
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, sk.proofTimeout)
+	prw := engine.NewProofRW(ctx, bufSize)
+	go func() {
+		forEachHarvester(sk.getHarvesters(), func(i int, h *harvester) {
+			count, err := sk.streamProofs(ctx, h, flags, challenge, prw)
+			if err == nil {
+				return
+			}
+			if ctx.Err() == context.DeadlineExceeded {
+				logging.CPrint(logging.WARN, "harvester runs late, dropped for this challenge", logging.LogFormat{
+					"addr":      h.addr,
+					"received":  count,
+					"timeout":   sk.proofTimeout,
+					"challenge": challenge,
+				})
+				return
+			}
+			logging.CPrint(logging.WARN, "fail to get proofs from harvester", logging.LogFormat{"addr": h.addr, "received": count, "err": err})
+		})
+		cancel()
+		prw.Close()
+	}()
+	return prw, nil
+}
+
+func (sk *SpaceKeeper) streamProofs(ctx context.Context, h *harvester, flags engine.WorkSpaceStateFlags, challenge pocutil.Hash, prw *engine.ProofRW) (count int, err error) {
+	stream, err := h.client.GetProofs(ctx, &harvesterpb.GetProofsRequest{Flags: uint32(flags), Challenge: challenge[:]})
+	if err != nil {
+		return 0, err
+	}
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return count, nil
+		}
+		if err != nil {
+			return count, err
+		}
+		wsp := workSpaceProofFromPB(resp, challenge)
+		if wsp.Error == ErrInvalidProof {
+			logging.CPrint(logging.WARN, "invalid proof from harvester", logging.LogFormat{"addr": h.addr, "sid": wsp.SpaceID})
+		}
+		sk.addRoute(wsp.SpaceID, h)
+		if err = prw.Write(wsp); err != nil {
+			return count, err
+		}
+		count++
+	}
+}
+
+func (sk *SpaceKeeper) ActOnWorkSpace(sid string, action engine.ActionType) error {
+	if !action.IsValid() {
+		return engine.ErrInvalidAction
+	}
+	h, err := sk.route(sid)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	if _, err = h.client.ActOnWorkSpace(ctx, &harvesterpb.ActOnWorkSpaceRequest{SpaceId: sid, Action: uint32(action)}); err != nil {
+		return remoteError(err)
+	}
+	return nil
+}
+
+// ActOnWorkSpaces acts on all harvesters, workspaces on unreachable
+// harvesters are reported with the request error.
+func (sk *SpaceKeeper) ActOnWorkSpaces(flags engine.WorkSpaceStateFlags, action engine.ActionType) (map[string]error, error) {
+	if !action.IsValid() {
+		return nil, engine.ErrInvalidAction
+	}
+
+	var mu sync.Mutex
+	result := make(map[string]error)
+	forEachHarvester(sk.getHarvesters(), func(i int, h *harvester) {
+		ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+		defer cancel()
+		resp, err := h.client.ActOnWorkSpaces(ctx, &harvesterpb.ActOnWorkSpacesRequest{Flags: uint32(flags), Action: uint32(action)})
+
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			logging.CPrint(logging.WARN, "fail to act on harvester", logging.LogFormat{"addr": h.addr, "action": action, "err": err})
+			err = remoteError(err)
+			for _, sid := range sk.harvesterSpaceIDs(h) {
+				result[sid] = err
+			}
+			return
+		}
+		for sid, msg := range resp.Errors {
+			if msg != "" {
+				result[sid] = sentinelError(msg)
+			} else {
+				result[sid] = nil
+			}
+		}
+	})
+	return result, nil
+}
+
+func (sk *SpaceKeeper) SignHash(sid string, hash [32]byte) (*pocec.Signature, error) {
+	h, err := sk.route(sid)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	resp, err := h.client.SignHash(ctx, &harvesterpb.SignHashRequest{SpaceId: sid, Hash: hash[:]})
+	if err != nil {
+		return nil, remoteError(err)
+	}
+	return pocec.ParseDERSignature(resp.Signature, pocec.S256())
+}
+
+// getHarvesters returns harvesters dialed last time.
+func (sk *SpaceKeeper) getHarvesters() []*harvester {
+	sk.harvestLock.RLock()
+	defer sk.harvestLock.RUnlock()
+	return sk.harvesters
+}
+
+// forEachHarvester calls fn concurrently and waits for all of them.
+func forEachHarvester(harvesters []*harvester, fn func(i int, h *harvester)) {
+	var wg sync.WaitGroup
+	for i, h := range harvesters {
+		wg.Add(1)
+		go func(i int, h *harvester) {
+			defer wg.Done()
+			fn(i, h)
+		}(i, h)
+	}
+	wg.Wait()
+}
+
+// route returns the harvester holding sid, routes are refreshed
+// if sid is unknown.
+func (sk *SpaceKeeper) route(sid string) (*harvester, error) {
+	sk.routeLock.RLock()
+	h, ok := sk.routes[sid]
+	sk.routeLock.RUnlock()
+	if ok {
+		return h, nil
+	}
+
+	if _, err := sk.WorkSpaceInfos(engine.SFAll); err != nil {
+		return nil, err
+	}
+	sk.routeLock.RLock()
+	defer sk.routeLock.RUnlock()
+	if h, ok = sk.routes[sid]; ok {
+		return h, nil
+	}
+	return nil, ErrWorkSpaceDoesNotExist
+}
+
+func (sk *SpaceKeeper) addRoute(sid string, h *harvester) {
+	sk.routeLock.Lock()
+	sk.routes[sid] = h
+	sk.routeLock.Unlock()
+}
+
+// updateRoutes records workspaces of h, stale routes of h are
+// removed if infos contains all of its workspaces.
+func (sk *SpaceKeeper) updateRoutes(h *harvester, infos []engine.WorkSpaceInfo, complete bool) {
+	sk.routeLock.Lock()
+	defer sk.routeLock.Unlock()
+
+	if complete {
+		for sid, owner := range sk.routes {
+			if owner == h {
+				delete(sk.routes, sid)
+			}
+		}
+	}
+	for _, info := range infos {
+		if owner, ok := sk.routes[info.SpaceID]; ok && owner != h {
+			logging.CPrint(logging.WARN, "workspace is served by multiple harvesters",
+				logging.LogFormat{"sid": info.SpaceID, "addr": h.addr, "previous": owner.addr})
+		}
+		sk.routes[info.SpaceID] = h
+	}
+}
+
+func (sk *SpaceKeeper) harvesterSpaceIDs(h *harvester) []string {
+	sk.routeLock.RLock()
+	defer sk.routeLock.RUnlock()
+
+	result := make([]string, 0)
+	for sid, owner := range sk.routes {
+		if owner == h {
+			result = append(result, sid)
+		}
+	}
+	return result
+}
+
+func (sk *SpaceKeeper) closeConns() {
+	for _, h := range sk.getHarvesters() {
+		h.conn.Close()
+	}
+}
+
+// remoteError strips the grpc status wrapper of errors returned by
+// SpaceKeeper on harvesters, and restores sentinel errors from messages.
+func remoteError(err error) error {
+	s, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	switch s.Code() {
+	case codes.Unknown:
+		return sentinelError(s.Message())
+	case codes.Unauthenticated:
+		return ErrUnauthenticated
+	default:
+		return err
+	}
+}
+
+// sentinelErrors are errors of SpaceKeeper on harvesters that callers
+// compare with, indexed by their messages.
+var sentinelErrors = func() map[string]error {
+	m := make(map[string]error)
+	for _, err := range []error{
+		spacekeeper.ErrUnimplemented,
+		engine.ErrInvalidAction,
+		capacity.ErrWorkSpaceDoesNotExist,
+		capacity.ErrWorkSpaceIsNotRegistered,
+		capacity.ErrWorkSpaceIsNotPlotting,
+		capacity.ErrWorkSpaceIsNotReady,
+		capacity.ErrWorkSpaceIsNotMining,
+		capacity.ErrWorkSpaceIsNotStill,
+		capacity.ErrWorkSpaceCannotGenerate,
+		capacity.ErrWorkSpaceIsNotQueued,
+		capacity.ErrWorkSpaceIsNotPaused,
+		capacity.ErrWorkSpaceIsUnavailable,
+		capacity.ErrWorkSpaceIsMoving,
+		capacity.ErrWorkSpaceIsPlotting,
+		capacity.ErrWalletDoesNotContainPubKey,
+		capacity.ErrWalletIsLocked,
+		capacity.ErrSpaceKeeperIsNotRunning,
+		capacity.ErrSpaceKeeperIsConfiguring,
+	} {
+		m[err.Error()] = err
+	}
+	return m
+}()
+
+// sentinelError returns the sentinel error of msg, or a new error of msg
+// if there is none.
+func sentinelError(msg string) error {
+	if err, ok := sentinelErrors[msg]; ok {
+		return err
+	}
+	return errors.New(msg)
+}
+
+func init() {
+	spacekeeper.AddSpaceKeeperBackend(spacekeeper.SKBackend{
+		Typ:            TypeSpaceKeeperRemote,
+		NewSpaceKeeper: NewSpaceKeeperRemote,
+	})
+}
@@ -0,0 +1,73 @@
+package signer
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/Sukhavati-Labs/go-miner/pocec"
+	"github.com/Sukhavati-Labs/go-miner/wire"
+)
+
+const auditLogFilename = "audit.log"
+
+// AuditEntry records a signing request, whether signed or refused.
+type AuditEntry struct {
+	Time      time.Time `json:"time"`
+	Peer      string    `json:"peer"`
+	PublicKey string    `json:"public_key"`
+	Height    uint64    `json:"height"`
+	PoCHash   string    `json:"poc_hash"`
+	Signed    bool      `json:"signed"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// auditLog appends entries to a file as json lines, entries are never rewritten.
+type auditLog struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+func openAuditLog(dir string) (*auditLog, error) {
+	f, err := os.OpenFile(filepath.Join(dir, auditLogFilename), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &auditLog{file: f}, nil
+}
+
+func (al *auditLog) Record(peer string, pubKey *pocec.PublicKey, height uint64, pocHash wire.Hash, signErr error) error {
+	entry := AuditEntry{
+		Time:    time.Now(),
+		Peer:    peer,
+		Height:  height,
+		PoCHash: pocHash.String(),
+		Signed:  signErr == nil,
+	}
+	if pubKey != nil {
+		entry.PublicKey = hex.EncodeToString(pubKey.SerializeCompressed())
+	}
+	if signErr != nil {
+		entry.Error = signErr.Error()
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	al.mu.Lock()
+	defer al.mu.Unlock()
+	if _, err = al.file.Write(append(data, '\n')); err != nil {
+		return err
+	}
+	return al.file.Sync()
+}
+
+func (al *auditLog) Close() error {
+	al.mu.Lock()
+	defer al.mu.Unlock()
+	return al.file.Close()
+}
@@ -0,0 +1,48 @@
+package signer
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const metadataTokenKey = "signer-token"
+
+// tokenCredentials attaches the shared signer token to every request,
+// it is never sent without TLS.
+type tokenCredentials string
+
+func (t tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{metadataTokenKey: string(t)}, nil
+}
+
+func (t tokenCredentials) RequireTransportSecurity() bool {
+	return true
+}
+
+// tokenAuthenticator rejects requests not carrying the shared signer token.
+type tokenAuthenticator []byte
+
+func (t tokenAuthenticator) authenticate(ctx context.Context) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, ErrUnauthenticated.Error())
+	}
+	for _, token := range md.Get(metadataTokenKey) {
+		if subtle.ConstantTimeCompare([]byte(token), t) == 1 {
+			return nil
+		}
+	}
+	return status.Error(codes.Unauthenticated, ErrUnauthenticated.Error())
+}
+
+func (t tokenAuthenticator) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := t.authenticate(ctx); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
@@ -0,0 +1,14 @@
+package signer
+
+import "errors"
+
+var (
+	ErrEmptyToken      = errors.New("signer token is empty")
+	ErrNoTLSConfig     = errors.New("no tls config for signer connections")
+	ErrUnauthenticated = errors.New("invalid signer token")
+	ErrPubKeyMismatch  = errors.New("header is not mined by the public key")
+	ErrInvalidProof    = errors.New("header carries an invalid proof")
+	ErrDoubleSigning   = errors.New("refuse to sign a height not above the signed one")
+	ErrHeightTooFar    = errors.New("refuse to sign a height too far above the signed one")
+	ErrInvalidResponse = errors.New("invalid signature received from signer")
+)
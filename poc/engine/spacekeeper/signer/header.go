@@ -0,0 +1,58 @@
+package signer
+
+import (
+	"bytes"
+
+	"github.com/Sukhavati-Labs/go-miner/poc"
+	"github.com/Sukhavati-Labs/go-miner/pocec"
+	"github.com/Sukhavati-Labs/go-miner/wire"
+	wirepb "github.com/Sukhavati-Labs/go-miner/wire/pb"
+	"github.com/golang/protobuf/proto"
+)
+
+// unsignedHeader contains elements of a header checked by signer.
+type unsignedHeader struct {
+	height    uint64
+	challenge wire.Hash
+	pubKey    *pocec.PublicKey
+	proof     *poc.Proof
+	pocHash   wire.Hash
+}
+
+// encodeHeader marshals header without signature, since an unsigned
+// header carries an empty signature which could not be decoded.
+func encodeHeader(header *wire.BlockHeader) ([]byte, error) {
+	pb := header.ToProto()
+	pb.Signature = nil
+	return proto.Marshal(pb)
+}
+
+// decodeHeader computes the PoC hash from header itself, so that signer
+// never signs a hash it could not check.
+func decodeHeader(b []byte) (*unsignedHeader, error) {
+	pb := new(wirepb.BlockHeader)
+	if err := proto.Unmarshal(b, pb); err != nil {
+		return nil, err
+	}
+	header := &unsignedHeader{
+		height: pb.Height,
+		pubKey: new(pocec.PublicKey),
+		proof:  new(poc.Proof),
+	}
+	if err := header.challenge.FromProto(pb.Challenge); err != nil {
+		return nil, err
+	}
+	if err := wirepb.ProtoToPublicKey(pb.PubKey, header.pubKey); err != nil {
+		return nil, err
+	}
+	if err := wirepb.ProtoToProof(pb.Proof, header.proof); err != nil {
+		return nil, err
+	}
+	// same as wire.BlockHeader.PoCHash
+	var buf bytes.Buffer
+	if _, err := pb.WritePoC(&buf); err != nil {
+		return nil, err
+	}
+	header.pocHash = wire.DoubleHashH(buf.Bytes())
+	return header, nil
+}
@@ -0,0 +1,211 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: signer.proto
+
+package signerpb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type SignHeaderRequest struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Header               []byte   `protobuf:"bytes,2,opt,name=header,proto3" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignHeaderRequest) Reset()         { *m = SignHeaderRequest{} }
+func (m *SignHeaderRequest) String() string { return proto.CompactTextString(m) }
+func (*SignHeaderRequest) ProtoMessage()    {}
+func (*SignHeaderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df2490657d73dbfd, []int{0}
+}
+func (m *SignHeaderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignHeaderRequest.Unmarshal(m, b)
+}
+func (m *SignHeaderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignHeaderRequest.Marshal(b, m, deterministic)
+}
+func (m *SignHeaderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignHeaderRequest.Merge(m, src)
+}
+func (m *SignHeaderRequest) XXX_Size() int {
+	return xxx_messageInfo_SignHeaderRequest.Size(m)
+}
+func (m *SignHeaderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignHeaderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignHeaderRequest proto.InternalMessageInfo
+
+func (m *SignHeaderRequest) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *SignHeaderRequest) GetHeader() []byte {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type SignHeaderResponse struct {
+	Signature            []byte   `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignHeaderResponse) Reset()         { *m = SignHeaderResponse{} }
+func (m *SignHeaderResponse) String() string { return proto.CompactTextString(m) }
+func (*SignHeaderResponse) ProtoMessage()    {}
+func (*SignHeaderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df2490657d73dbfd, []int{1}
+}
+func (m *SignHeaderResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignHeaderResponse.Unmarshal(m, b)
+}
+func (m *SignHeaderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignHeaderResponse.Marshal(b, m, deterministic)
+}
+func (m *SignHeaderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignHeaderResponse.Merge(m, src)
+}
+func (m *SignHeaderResponse) XXX_Size() int {
+	return xxx_messageInfo_SignHeaderResponse.Size(m)
+}
+func (m *SignHeaderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignHeaderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignHeaderResponse proto.InternalMessageInfo
+
+func (m *SignHeaderResponse) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SignHeaderRequest)(nil), "signerpb.SignHeaderRequest")
+	proto.RegisterType((*SignHeaderResponse)(nil), "signerpb.SignHeaderResponse")
+}
+
+func init() { proto.RegisterFile("signer.proto", fileDescriptor_df2490657d73dbfd) }
+
+var fileDescriptor_df2490657d73dbfd = []byte{
+	// 162 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x29, 0xce, 0x4c, 0xcf,
+	0x4b, 0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x80, 0xf0, 0x0a, 0x92, 0x94, 0xbc,
+	0xb8, 0x04, 0x83, 0x33, 0xd3, 0xf3, 0x3c, 0x52, 0x13, 0x53, 0x52, 0x8b, 0x82, 0x52, 0x0b, 0x4b,
+	0x53, 0x8b, 0x4b, 0x84, 0x64, 0xb9, 0xb8, 0x0a, 0x4a, 0x93, 0x72, 0x32, 0x93, 0xe3, 0xb3, 0x53,
+	0x2b, 0x25, 0x18, 0x15, 0x18, 0x35, 0x78, 0x82, 0x38, 0x21, 0x22, 0xde, 0xa9, 0x95, 0x42, 0x62,
+	0x5c, 0x6c, 0x19, 0x60, 0xf5, 0x12, 0x4c, 0x60, 0x29, 0x28, 0x4f, 0xc9, 0x88, 0x4b, 0x08, 0xd9,
+	0xac, 0xe2, 0x82, 0xfc, 0xbc, 0xe2, 0x54, 0x21, 0x19, 0x2e, 0x4e, 0x90, 0x6d, 0x89, 0x25, 0xa5,
+	0x45, 0xa9, 0x30, 0xb3, 0xe0, 0x02, 0x46, 0x81, 0x5c, 0x6c, 0xc1, 0x60, 0xb7, 0x08, 0xb9, 0x73,
+	0x71, 0x21, 0x74, 0x0b, 0x49, 0xeb, 0xc1, 0x9c, 0xa8, 0x87, 0xe1, 0x3e, 0x29, 0x19, 0xec, 0x92,
+	0x10, 0x0b, 0x93, 0xd8, 0xc0, 0x7e, 0x34, 0x06, 0x0c, 0x00, 0x4e, 0x98, 0x01, 0x4d, 0xf3, 0x00,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// SignerClient is the client API for Signer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SignerClient interface {
+	SignHeader(ctx context.Context, in *SignHeaderRequest, opts ...grpc.CallOption) (*SignHeaderResponse, error)
+}
+
+type signerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSignerClient(cc *grpc.ClientConn) SignerClient {
+	return &signerClient{cc}
+}
+
+func (c *signerClient) SignHeader(ctx context.Context, in *SignHeaderRequest, opts ...grpc.CallOption) (*SignHeaderResponse, error) {
+	out := new(SignHeaderResponse)
+	err := c.cc.Invoke(ctx, "/signerpb.Signer/SignHeader", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SignerServer is the server API for Signer service.
+type SignerServer interface {
+	SignHeader(context.Context, *SignHeaderRequest) (*SignHeaderResponse, error)
+}
+
+// UnimplementedSignerServer can be embedded to have forward compatible implementations.
+type UnimplementedSignerServer struct {
+}
+
+func (*UnimplementedSignerServer) SignHeader(ctx context.Context, req *SignHeaderRequest) (*SignHeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignHeader not implemented")
+}
+
+func RegisterSignerServer(s *grpc.Server, srv SignerServer) {
+	s.RegisterService(&_Signer_serviceDesc, srv)
+}
+
+func _Signer_SignHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignHeaderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).SignHeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/signerpb.Signer/SignHeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).SignHeader(ctx, req.(*SignHeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Signer_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "signerpb.Signer",
+	HandlerType: (*SignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SignHeader",
+			Handler:    _Signer_SignHeader_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "signer.proto",
+}
@@ -0,0 +1,105 @@
+package signer
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/Sukhavati-Labs/go-miner/chainutil"
+	"github.com/Sukhavati-Labs/go-miner/pocec"
+	"github.com/Sukhavati-Labs/go-miner/wire"
+)
+
+const signedHeightsFilename = "signed_heights.json"
+
+// signedHeight is the highest height signed for a public key.
+type signedHeight struct {
+	Height  uint64 `json:"height"`
+	PoCHash string `json:"poc_hash"`
+}
+
+// signedHeights records the highest signed height of each public key,
+// it is saved durably before any signature is returned.
+//
+// Proofs answer challenges chosen by the node, so the height of a header
+// is not proven at all. A height more than maxGap above the recorded one
+// is refused, otherwise a single forged header far in the future would
+// block signing forever. 0 maxGap accepts any height.
+type signedHeights struct {
+	mu      sync.Mutex
+	path    string
+	maxGap  uint64
+	heights map[string]signedHeight // compressed public key in hex -> signedHeight
+}
+
+func loadSignedHeights(dir string, maxGap uint64) (*signedHeights, error) {
+	sh := &signedHeights{
+		path:    filepath.Join(dir, signedHeightsFilename),
+		maxGap:  maxGap,
+		heights: make(map[string]signedHeight),
+	}
+	data, err := ioutil.ReadFile(sh.path)
+	if os.IsNotExist(err) {
+		return sh, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(data, &sh.heights); err != nil {
+		return nil, err
+	}
+	return sh, nil
+}
+
+// Get returns the highest signed height of pubKey.
+func (sh *signedHeights) Get(pubKey *pocec.PublicKey) (uint64, bool) {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	rec, ok := sh.heights[hex.EncodeToString(pubKey.SerializeCompressed())]
+	return rec.Height, ok
+}
+
+// Put records height as signed for pubKey. Signing a height not above the
+// recorded one is refused, unless it is the very same PoC hash requested again,
+// and so is signing a height more than maxGap above the recorded one.
+func (sh *signedHeights) Put(pubKey *pocec.PublicKey, height uint64, pocHash wire.Hash) error {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+
+	key := hex.EncodeToString(pubKey.SerializeCompressed())
+	if rec, ok := sh.heights[key]; ok {
+		if height <= rec.Height {
+			if height == rec.Height && rec.PoCHash == pocHash.String() {
+				return nil
+			}
+			return ErrDoubleSigning
+		}
+		if sh.maxGap != 0 && height-rec.Height > sh.maxGap {
+			return ErrHeightTooFar
+		}
+	}
+
+	heights := make(map[string]signedHeight, len(sh.heights)+1)
+	for k, v := range sh.heights {
+		heights[k] = v
+	}
+	heights[key] = signedHeight{Height: height, PoCHash: pocHash.String()}
+	if err := sh.save(heights); err != nil {
+		return err
+	}
+	sh.heights = heights
+	return nil
+}
+
+// save writes heights durably by replacing the old file, the rename is
+// synced as well so that a recorded height never comes back after a crash.
+func (sh *signedHeights) save(heights map[string]signedHeight) error {
+	data, err := json.Marshal(heights)
+	if err != nil {
+		return err
+	}
+	return chainutil.WriteFileAtomic(sh.path, data, 0600)
+}
@@ -0,0 +1,127 @@
+package signer
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"os"
+
+	"github.com/Sukhavati-Labs/go-miner/logging"
+	"github.com/Sukhavati-Labs/go-miner/poc"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/spacekeeper/signer/pb"
+	"github.com/Sukhavati-Labs/go-miner/poc/pocutil"
+	"github.com/Sukhavati-Labs/go-miner/pocec"
+	"github.com/Sukhavati-Labs/go-miner/wire"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Wallet holds the private keys of PoC public keys.
+type Wallet interface {
+	SignMessage(pubKey *pocec.PublicKey, hash []byte) (*pocec.Signature, error)
+}
+
+// Server signs block headers for nodes configured with miner.remote_signer.
+//
+// A header is signed only if it carries a valid proof of the requested public
+// key, and its height is above any height signed before for that key, but not
+// too far above. Every request is written to the audit log in dataDir.
+type Server struct {
+	wallet  Wallet
+	heights *signedHeights
+	audit   *auditLog
+	server  *grpc.Server
+}
+
+// NewServer returns a signer serving nodes over TLS with tlsConfig. Heights
+// more than maxHeightGap above the signed one are refused, 0 for no limit.
+func NewServer(wallet Wallet, dataDir, token string, tlsConfig *tls.Config, maxHeightGap uint64) (*Server, error) {
+	if token == "" {
+		return nil, ErrEmptyToken
+	}
+	if tlsConfig == nil {
+		return nil, ErrNoTLSConfig
+	}
+	if err := os.MkdirAll(dataDir, 0700); err != nil {
+		return nil, err
+	}
+	heights, err := loadSignedHeights(dataDir, maxHeightGap)
+	if err != nil {
+		return nil, err
+	}
+	audit, err := openAuditLog(dataDir)
+	if err != nil {
+		return nil, err
+	}
+	auth := tokenAuthenticator(token)
+	s := &Server{
+		wallet:  wallet,
+		heights: heights,
+		audit:   audit,
+		server:  grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsConfig)), grpc.UnaryInterceptor(auth.unaryInterceptor)),
+	}
+	signerpb.RegisterSignerServer(s.server, s)
+	return s, nil
+}
+
+// Serve accepts connections on lis, it blocks until Stop is called.
+func (s *Server) Serve(lis net.Listener) error {
+	logging.CPrint(logging.INFO, "signer listening", logging.LogFormat{"addr": lis.Addr().String()})
+	return s.server.Serve(lis)
+}
+
+func (s *Server) Stop() {
+	s.server.GracefulStop()
+	s.audit.Close()
+	logging.CPrint(logging.INFO, "signer stopped")
+}
+
+func (s *Server) SignHeader(ctx context.Context, in *signerpb.SignHeaderRequest) (*signerpb.SignHeaderResponse, error) {
+	var peerAddr string
+	if p, ok := peer.FromContext(ctx); ok {
+		peerAddr = p.Addr.String()
+	}
+	pubKey, err := pocec.ParsePubKey(in.PublicKey, pocec.S256())
+	if err != nil {
+		s.record(peerAddr, nil, 0, wire.Hash{}, err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	header, err := decodeHeader(in.Header)
+	if err != nil {
+		s.record(peerAddr, pubKey, 0, wire.Hash{}, err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sig, err := s.sign(pubKey, header)
+	s.record(peerAddr, pubKey, header.height, header.pocHash, err)
+	if err != nil {
+		logging.CPrint(logging.WARN, "refuse to sign header", logging.LogFormat{"peer": peerAddr, "height": header.height, "err": err})
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	logging.CPrint(logging.INFO, "sign header for node", logging.LogFormat{"peer": peerAddr, "height": header.height, "poc_hash": header.pocHash})
+	return &signerpb.SignHeaderResponse{Signature: sig.Serialize()}, nil
+}
+
+// sign saves the height before signing, so that a signature is never
+// returned without the height recorded.
+func (s *Server) sign(pubKey *pocec.PublicKey, header *unsignedHeader) (*pocec.Signature, error) {
+	if !header.pubKey.IsEqual(pubKey) {
+		return nil, ErrPubKeyMismatch
+	}
+	if err := poc.VerifyProof(header.proof, pocutil.PubKeyHash(pubKey), pocutil.Hash(header.challenge)); err != nil {
+		return nil, ErrInvalidProof
+	}
+	if err := s.heights.Put(pubKey, header.height, header.pocHash); err != nil {
+		return nil, err
+	}
+	return s.wallet.SignMessage(pubKey, header.pocHash[:])
+}
+
+func (s *Server) record(peer string, pubKey *pocec.PublicKey, height uint64, pocHash wire.Hash, signErr error) {
+	if err := s.audit.Record(peer, pubKey, height, pocHash, signErr); err != nil {
+		logging.CPrint(logging.ERROR, "fail to write audit log", logging.LogFormat{"err": err, "height": height})
+	}
+}
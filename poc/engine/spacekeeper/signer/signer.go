@@ -0,0 +1,80 @@
+package signer
+
+import (
+	"context"
+	"crypto/tls"
+	"time"
+
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/spacekeeper/signer/pb"
+	"github.com/Sukhavati-Labs/go-miner/pocec"
+	"github.com/Sukhavati-Labs/go-miner/wire"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	DefaultTimeout = 3 * time.Second
+	// DefaultMaxHeightGap is the default limit of a height signed above
+	// the signed one, raise it if the signer is offline for long.
+	DefaultMaxHeightGap = 10000
+)
+
+// Signer signs the PoC hash of block headers with private keys
+// kept out of the mining host.
+type Signer interface {
+	SignHeader(pubKey *pocec.PublicKey, header *wire.BlockHeader) (*pocec.Signature, error)
+}
+
+// Client implements Signer by sending headers to a remote signer Server.
+type Client struct {
+	conn    *grpc.ClientConn
+	client  signerpb.SignerClient
+	timeout time.Duration
+}
+
+// NewClient dials the signer listening on addr over TLS, the connection is
+// established lazily and re-established on failure by grpc.
+func NewClient(addr, token string, tlsConfig *tls.Config, timeout time.Duration) (*Client, error) {
+	if token == "" {
+		return nil, ErrEmptyToken
+	}
+	if tlsConfig == nil {
+		return nil, ErrNoTLSConfig
+	}
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+		grpc.WithPerRPCCredentials(tokenCredentials(token)))
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		conn:    conn,
+		client:  signerpb.NewSignerClient(conn),
+		timeout: timeout,
+	}, nil
+}
+
+func (c *Client) SignHeader(pubKey *pocec.PublicKey, header *wire.BlockHeader) (*pocec.Signature, error) {
+	b, err := encodeHeader(header)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	resp, err := c.client.SignHeader(ctx, &signerpb.SignHeaderRequest{PublicKey: pubKey.SerializeCompressed(), Header: b})
+	if err != nil {
+		return nil, err
+	}
+	pocHash, err := header.PoCHash()
+	if err != nil {
+		return nil, err
+	}
+	sig, err := pocec.ParseSignature(resp.Signature, pocec.S256())
+	if err != nil || !sig.Verify(pocHash[:], pubKey) {
+		return nil, ErrInvalidResponse
+	}
+	return sig, nil
+}
+
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
@@ -0,0 +1,299 @@
+package signer_test
+
+import (
+	"bufio"
+	"crypto/tls"
+	"encoding/binary"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Sukhavati-Labs/go-miner/chainutil"
+	"github.com/Sukhavati-Labs/go-miner/poc"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/spacekeeper/signer"
+	"github.com/Sukhavati-Labs/go-miner/poc/pocutil"
+	"github.com/Sukhavati-Labs/go-miner/pocec"
+	"github.com/Sukhavati-Labs/go-miner/wire"
+)
+
+const testToken = "secret"
+
+type mockWallet map[string]*pocec.PrivateKey
+
+func (w mockWallet) SignMessage(pubKey *pocec.PublicKey, hash []byte) (*pocec.Signature, error) {
+	return w[string(pubKey.SerializeCompressed())].Sign(hash)
+}
+
+// newTestProof finds a proof of RegTestBitLength for pubKey, and a challenge it answers.
+func newTestProof(t *testing.T, pubKey *pocec.PublicKey) (*poc.Proof, wire.Hash) {
+	var bl = poc.RegTestBitLength
+	var pkHash = pocutil.PubKeyHash(pubKey)
+
+	xs := make(map[pocutil.PoCValue]pocutil.PoCValue)
+	for x := pocutil.PoCValue(0); x < 1<<uint(bl); x++ {
+		y := pocutil.P(x, bl, pkHash)
+		if xp, ok := xs[pocutil.FlipValue(y, bl)]; ok {
+			var challenge wire.Hash
+			binary.LittleEndian.PutUint64(challenge[:8], uint64(pocutil.F(x, xp, bl, pkHash)))
+			return &poc.Proof{
+				X:         pocutil.PoCValue2Bytes(x, bl),
+				XPrime:    pocutil.PoCValue2Bytes(xp, bl),
+				BitLength: bl,
+			}, challenge
+		}
+		xs[y] = x
+	}
+	t.Fatal("no proof found")
+	return nil, wire.Hash{}
+}
+
+type testEnv struct {
+	privKey   *pocec.PrivateKey
+	proof     *poc.Proof
+	challenge wire.Hash
+	wallet    mockWallet
+	dir       string
+	maxGap    uint64
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	privKey, err := pocec.NewPrivateKey(pocec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof, challenge := newTestProof(t, privKey.PubKey())
+	return &testEnv{
+		privKey:   privKey,
+		proof:     proof,
+		challenge: challenge,
+		wallet:    mockWallet{string(privKey.PubKey().SerializeCompressed()): privKey},
+		dir:       t.TempDir(),
+		maxGap:    signer.DefaultMaxHeightGap,
+	}
+}
+
+func (env *testEnv) header(height uint64, timestamp int64) *wire.BlockHeader {
+	header := wire.NewEmptyBlockHeader()
+	header.Height = height
+	header.Timestamp = time.Unix(timestamp, 0)
+	header.Challenge = env.challenge
+	header.PubKey = env.privKey.PubKey()
+	header.Proof = env.proof
+	return header
+}
+
+func testTLSConfig(t *testing.T) (server, client *tls.Config) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "signer.cert")
+	server, _, err := chainutil.LoadTLSConfig(certFile, filepath.Join(dir, "signer.key"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client, err = chainutil.NewClientTLSConfig(certFile); err != nil {
+		t.Fatal(err)
+	}
+	return server, client
+}
+
+// startServer returns a client connected to a signer serving env.
+func (env *testEnv) startServer(t *testing.T) (*signer.Server, *signer.Client) {
+	serverTLS, clientTLS := testTLSConfig(t)
+	srv, err := signer.NewServer(env.wallet, env.dir, testToken, serverTLS, env.maxGap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go srv.Serve(lis)
+	client, err := signer.NewClient(lis.Addr().String(), testToken, clientTLS, signer.DefaultTimeout)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return srv, client
+}
+
+func setRegTestBitLength(t *testing.T) {
+	if err := poc.SetMinBitLength(poc.RegTestBitLength); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSignHeader(t *testing.T) {
+	setRegTestBitLength(t)
+	defer poc.SetMinBitLength(poc.MinValidBitLength)
+
+	env := newTestEnv(t)
+	srv, client := env.startServer(t)
+	defer client.Close()
+
+	header := env.header(10, 1000)
+	pocHash, err := header.PoCHash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := client.SignHeader(env.privKey.PubKey(), header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sig.Verify(pocHash[:], env.privKey.PubKey()) {
+		t.Fatal("invalid signature")
+	}
+
+	// the very same header could be signed again, e.g. on retry
+	if _, err = client.SignHeader(env.privKey.PubKey(), header); err != nil {
+		t.Fatalf("fail to sign header again, %v", err)
+	}
+	if _, err = client.SignHeader(env.privKey.PubKey(), env.header(10, 1003)); err == nil {
+		t.Fatal("signed another header at the same height")
+	}
+	if _, err = client.SignHeader(env.privKey.PubKey(), env.header(9, 1003)); err == nil {
+		t.Fatal("signed header below the signed height")
+	}
+	if _, err = client.SignHeader(env.privKey.PubKey(), env.header(11, 1003)); err != nil {
+		t.Fatal(err)
+	}
+	srv.Stop()
+
+	// signed heights survive restart
+	srv, client = env.startServer(t)
+	defer srv.Stop()
+	defer client.Close()
+	if _, err = client.SignHeader(env.privKey.PubKey(), env.header(11, 1006)); err == nil {
+		t.Fatal("signed another header at the same height after restart")
+	}
+	if _, err = client.SignHeader(env.privKey.PubKey(), env.header(12, 1006)); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := os.Open(filepath.Join(env.dir, "audit.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var lines int
+	for scanner := bufio.NewScanner(f); scanner.Scan(); {
+		lines++
+	}
+	if lines != 7 {
+		t.Errorf("audit log has %d entries, expect 7", lines)
+	}
+}
+
+func TestSignHeaderRefused(t *testing.T) {
+	setRegTestBitLength(t)
+	defer poc.SetMinBitLength(poc.MinValidBitLength)
+
+	env := newTestEnv(t)
+	srv, client := env.startServer(t)
+	defer srv.Stop()
+	defer client.Close()
+
+	other, err := pocec.NewPrivateKey(pocec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	env.wallet[string(other.PubKey().SerializeCompressed())] = other
+	if _, err = client.SignHeader(other.PubKey(), env.header(10, 1000)); err == nil {
+		t.Error("signed header mined by another public key")
+	}
+
+	header := env.header(10, 1000)
+	header.Proof = &poc.Proof{X: env.proof.XPrime, XPrime: env.proof.XPrime, BitLength: env.proof.BitLength}
+	if _, err = client.SignHeader(env.privKey.PubKey(), header); err == nil {
+		t.Error("signed header carrying invalid proof")
+	}
+
+	header = env.header(10, 1000)
+	header.Challenge[0] ^= 0xff
+	if _, err = client.SignHeader(env.privKey.PubKey(), header); err == nil {
+		t.Error("signed header for another challenge")
+	}
+
+	// refused requests never take the height
+	if _, err = client.SignHeader(env.privKey.PubKey(), env.header(10, 1000)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSignHeaderTooFar(t *testing.T) {
+	setRegTestBitLength(t)
+	defer poc.SetMinBitLength(poc.MinValidBitLength)
+
+	env := newTestEnv(t)
+	env.maxGap = 100
+	srv, client := env.startServer(t)
+	defer client.Close()
+
+	// the first height of a public key is not bounded
+	if _, err := client.SignHeader(env.privKey.PubKey(), env.header(1000, 1000)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.SignHeader(env.privKey.PubKey(), env.header(1101, 1003)); err == nil {
+		t.Fatal("signed header too far above the signed height")
+	}
+	if _, err := client.SignHeader(env.privKey.PubKey(), env.header(1100, 1003)); err != nil {
+		t.Fatal(err)
+	}
+	srv.Stop()
+
+	// operators could raise the gap for a known jump
+	env.maxGap = 0
+	srv, client = env.startServer(t)
+	defer srv.Stop()
+	defer client.Close()
+	if _, err := client.SignHeader(env.privKey.PubKey(), env.header(1000000, 1006)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSignHeaderUnauthenticated(t *testing.T) {
+	setRegTestBitLength(t)
+	defer poc.SetMinBitLength(poc.MinValidBitLength)
+
+	env := newTestEnv(t)
+	serverTLS, clientTLS := testTLSConfig(t)
+	srv, err := signer.NewServer(env.wallet, env.dir, testToken, serverTLS, env.maxGap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer srv.Stop()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go srv.Serve(lis)
+
+	client, err := signer.NewClient(lis.Addr().String(), "wrong", clientTLS, signer.DefaultTimeout)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	if _, err = client.SignHeader(env.privKey.PubKey(), env.header(10, 1000)); err == nil {
+		t.Error("signed header for request with wrong token")
+	}
+
+	// signers with other certificates are not trusted
+	_, otherTLS := testTLSConfig(t)
+	untrusted, err := signer.NewClient(lis.Addr().String(), testToken, otherTLS, signer.DefaultTimeout)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer untrusted.Close()
+	if _, err = untrusted.SignHeader(env.privKey.PubKey(), env.header(10, 1000)); err == nil {
+		t.Error("signed header for untrusted signer")
+	}
+
+	if _, err = signer.NewServer(env.wallet, env.dir, "", serverTLS, env.maxGap); err != signer.ErrEmptyToken {
+		t.Errorf("expect ErrEmptyToken, got %v", err)
+	}
+	if _, err = signer.NewServer(env.wallet, env.dir, testToken, nil, env.maxGap); err != signer.ErrNoTLSConfig {
+		t.Errorf("expect ErrNoTLSConfig, got %v", err)
+	}
+	if _, err = signer.NewClient(lis.Addr().String(), testToken, nil, signer.DefaultTimeout); err != signer.ErrNoTLSConfig {
+		t.Errorf("expect ErrNoTLSConfig, got %v", err)
+	}
+}
@@ -3,10 +3,12 @@ package spacekeeper
 import (
 	"context"
 	"errors"
+	"sync"
 
 	"github.com/Sukhavati-Labs/go-miner/poc/engine"
 	"github.com/Sukhavati-Labs/go-miner/poc/pocutil"
 	"github.com/Sukhavati-Labs/go-miner/pocec"
+	"github.com/Sukhavati-Labs/go-miner/wire"
 )
 
 type SpaceKeeper interface {
@@ -25,6 +27,70 @@ type SpaceKeeper interface {
 	SignHash(sid string, hash [32]byte) (*pocec.Signature, error)
 }
 
+// HeaderSigner is implemented by SpaceKeepers able to sign the PoC hash of
+// header, which is preferred to SignHash for the signer could check header.
+type HeaderSigner interface {
+	SignHeader(sid string, header *wire.BlockHeader) (*pocec.Signature, error)
+}
+
+const (
+	// WorkSpaceFound reports a workSpace registered from a newly found plot file
+	WorkSpaceFound = "found"
+	// WorkSpaceUnavailable reports a workSpace whose plot file is inaccessible, e.g. disk unmounted
+	WorkSpaceUnavailable = "unavailable"
+	// WorkSpaceAvailable reports an unavailable workSpace whose plot file is accessible again
+	WorkSpaceAvailable = "available"
+	// WorkSpaceDemoted reports a workSpace stopped mining for slow proof lookups
+	WorkSpaceDemoted = "demoted"
+)
+
+// WorkSpaceChange reports a workSpace changed by SpaceKeeper itself rather than requested.
+type WorkSpaceChange struct {
+	Type string
+	Dir  string
+	Info engine.WorkSpaceInfo
+}
+
+// Listener is notified of workSpace changes, it should return quickly.
+type Listener interface {
+	OnWorkSpaceChanged(*WorkSpaceChange)
+}
+
+// Notifier is implemented by SpaceKeepers able to report workSpace changes.
+type Notifier interface {
+	RegisterListener(listener Listener)
+	UnregisterListener(listener Listener)
+}
+
+// Listeners is a set of Listener safe for concurrent access.
+type Listeners struct {
+	mu        sync.RWMutex
+	listeners map[Listener]struct{}
+}
+
+func (ls *Listeners) Register(listener Listener) {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	if ls.listeners == nil {
+		ls.listeners = make(map[Listener]struct{})
+	}
+	ls.listeners[listener] = struct{}{}
+}
+
+func (ls *Listeners) Unregister(listener Listener) {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	delete(ls.listeners, listener)
+}
+
+func (ls *Listeners) NotifyWorkSpaceChanged(change *WorkSpaceChange) {
+	ls.mu.RLock()
+	defer ls.mu.RUnlock()
+	for listener := range ls.listeners {
+		listener.OnWorkSpaceChanged(change)
+	}
+}
+
 var (
 	ErrInvalidSKType = errors.New("invalid SpaceKeeper type")
 	ErrInvalidSKArgs = errors.New("invalid SpaceKeeper args")
@@ -23,6 +23,10 @@ const (
 
 	// MaxValidBitLength represents biggest BitLength
 	MaxValidBitLength = 40
+
+	// RegTestBitLength represents the tiny BitLength for regression test networks,
+	// it is valid only after being enabled by SetMinBitLength.
+	RegTestBitLength = 16
 )
 
 var (
@@ -36,11 +40,19 @@ var (
 
 	// validBitLength represents a slice of valid BitLength in increasing order.
 	validBitLength []int // 24,26,28,30,32,34,36,38,40    len:9
+
+	// minBitLength represents smallest BitLength of current network.
+	minBitLength = MinValidBitLength
 )
 
 func init() {
+	initValidBitLength()
+}
+
+func initValidBitLength() {
 	BitLengthDiskSize = make(map[int]int)
-	for i := MinValidBitLength; i <= MaxValidBitLength; i = i + 2 {
+	validBitLength = nil
+	for i := minBitLength; i <= MaxValidBitLength; i = i + 2 {
 		validBitLength = append(validBitLength, i)
 	}
 	// TODO
@@ -59,9 +71,21 @@ type Proof struct {
 	BitLength int    // 4 bytes | save 1 byte
 }
 
+// SetMinBitLength sets the smallest valid BitLength, which should be an even
+// number in [RegTestBitLength, MinValidBitLength].
+// It is called once on selecting network, before any proof is verified.
+func SetMinBitLength(bitLength int) error {
+	if bitLength < RegTestBitLength || bitLength > MinValidBitLength || bitLength%2 != 0 {
+		return ErrProofInvalidBitLength
+	}
+	minBitLength = bitLength
+	initValidBitLength()
+	return nil
+}
+
 // EnsureBitLength returns whether it is a valid bitLength.
 func EnsureBitLength(bitLength int) bool {
-	if bitLength >= MinValidBitLength && bitLength <= MaxValidBitLength && bitLength%2 == 0 {
+	if bitLength >= minBitLength && bitLength <= MaxValidBitLength && bitLength%2 == 0 {
 		return true
 	}
 	return false
@@ -108,7 +132,8 @@ func (proof *Proof) Decode(data []byte) error {
 }
 
 // VerifyProof verifies proof:
-// (1) make sure BitLength is Valid. Should be integer even number in [24, 40].
+// (1) make sure BitLength is Valid. Should be integer even number in [24, 40],
+//     or [16, 40] on regression test networks.
 // (2) perform function P on x and x_prime, the corresponding result
 //     y and y_prime should be a bit-flip pair.
 // (3) perform function F on x and x_prime, the result z should
@@ -1369,6 +1369,23 @@ func (kmc *KeystoreManagerForPoC) GenerateNewPublicKeyByCointype(cointype uint32
 	}
 	return kmc.GenerateNewPublicKey(accountID)
 }
+
+// NextPublicKeyOrdinalByCointype returns the ordinal of public key which
+// would be generated by GenerateNewPublicKeyByCointype, nothing is generated.
+func (kmc *KeystoreManagerForPoC) NextPublicKeyOrdinalByCointype(cointype uint32) (uint32, error) {
+	kmc.mu.Lock()
+	defer kmc.mu.Unlock()
+
+	for _, account := range kmc.managedKeystores {
+		if account.hdScope.Coin == cointype {
+			account.mu.Lock()
+			defer account.mu.Unlock()
+			return account.branchInfo.nextExternalIndex, nil
+		}
+	}
+	return 0, ErrAccountNotFound
+}
+
 func (kmc *KeystoreManagerForPoC) GenerateNewPublicKey(accountID string) (*pocec.PublicKey, uint32, error) {
 	managedAddresses := make([]*ManagedAddress, 0)
 	var pubkey *pocec.PublicKey
@@ -0,0 +1,242 @@
+package rpc
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"net"
+	"strings"
+
+	"github.com/Sukhavati-Labs/go-miner/chainutil"
+	configpb "github.com/Sukhavati-Labs/go-miner/config/pb"
+	"github.com/Sukhavati-Labs/go-miner/logging"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// MetadataAuthorization carries "Bearer <api_key>".
+	MetadataAuthorization = "authorization"
+	// MetadataAPIKey carries "<api_key>".
+	MetadataAPIKey = "x-api-key"
+
+	bearerPrefix         = "Bearer "
+	metadataForwardedFor = "x-forwarded-for"
+	metadataGatewayToken = "x-gateway-token"
+	gatewayTokenSize     = 32
+)
+
+var (
+	errMissingAPIKey = status.New(codes.Unauthenticated, "missing api key").Err()
+	errInvalidAPIKey = status.New(codes.Unauthenticated, "invalid api key").Err()
+)
+
+// loadTLSConfig loads the certificate pair of API listeners,
+// a self-signed pair is generated if neither file exists.
+func loadTLSConfig(cfg *configpb.RPCConfig) (*tls.Config, error) {
+	tlsConfig, generated, err := chainutil.LoadTLSConfig(cfg.ApiTlsCert, cfg.ApiTlsKey, cfg.ApiTlsHosts)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to load rpc certificate pair",
+			logging.LogFormat{"cert": cfg.ApiTlsCert, "key": cfg.ApiTlsKey, "err": err})
+		return nil, err
+	}
+	if generated {
+		logging.CPrint(logging.INFO, "generated rpc certificate pair", logging.LogFormat{"cert": cfg.ApiTlsCert, "key": cfg.ApiTlsKey})
+	}
+	return tlsConfig, nil
+}
+
+// gatewayTLSConfig trusts the certificate of gRPC server only,
+// serverName should be covered by the certificate.
+func gatewayTLSConfig(serverTLS *tls.Config, serverName string) (*tls.Config, error) {
+	pool := x509.NewCertPool()
+	for _, der := range serverTLS.Certificates[0].Certificate {
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, err
+		}
+		pool.AddCert(cert)
+	}
+	return &tls.Config{RootCAs: pool, ServerName: serverName, MinVersion: tls.VersionTLS12}, nil
+}
+
+// loopbackHost returns the host for dialing a listener on host locally.
+func loopbackHost(host string) string {
+	if ip := net.ParseIP(host); ip != nil && ip.IsUnspecified() {
+		if ip.To4() == nil {
+			return "::1"
+		}
+		return "127.0.0.1"
+	}
+	return host
+}
+
+// newGatewayToken returns a random token marking requests of the gateway,
+// it is known to the gateway and the gRPC server of this process only.
+func newGatewayToken() (string, error) {
+	token := make([]byte, gatewayTokenSize)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(token), nil
+}
+
+// accessController grants roles to requests, by the api key carried in either
+// "authorization: Bearer <api_key>" or "x-api-key: <api_key>", or by the client
+// address if there is no api key.
+// Keys in api_keys grant all roles, and all roles are granted to any address
+// unless api keys or address roles are configured.
+type accessController struct {
+	keys         []apiKeyRoles
+	addrs        map[string]roleSet // client ip or "*"
+	requireKey   bool
+	gatewayToken []byte
+}
+
+type apiKeyRoles struct {
+	key   []byte
+	roles roleSet
+}
+
+func newAccessController(cfg *configpb.RPCConfig, gatewayToken string) (*accessController, error) {
+	ac := &accessController{addrs: make(map[string]roleSet), gatewayToken: []byte(gatewayToken)}
+	for _, key := range cfg.ApiKeys {
+		roles, _ := newRoleSet([]string{string(RoleAll)})
+		ac.keys = append(ac.keys, apiKeyRoles{key: []byte(key), roles: roles})
+	}
+	for _, cred := range cfg.ApiCredentials {
+		roles, err := newRoleSet(cred.Roles)
+		if err != nil {
+			return nil, err
+		}
+		ac.keys = append(ac.keys, apiKeyRoles{key: []byte(cred.ApiKey), roles: roles})
+	}
+	for _, entry := range cfg.ApiAddressRoles {
+		roles, err := newRoleSet(entry.Roles)
+		if err != nil {
+			return nil, err
+		}
+		addr := entry.Address
+		if ip := net.ParseIP(addr); ip != nil {
+			addr = ip.String()
+		}
+		ac.addrs[addr] = roles
+	}
+	ac.requireKey = len(ac.keys) > 0
+	return ac, nil
+}
+
+// enabled returns false if all requests are granted all roles.
+func (ac *accessController) enabled() bool {
+	return len(ac.keys) > 0 || len(ac.addrs) > 0
+}
+
+func (ac *accessController) roles(ctx context.Context, method string) (roleSet, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	var key string
+	if values := md.Get(MetadataAPIKey); len(values) > 0 {
+		key = values[0]
+	} else if values := md.Get(MetadataAuthorization); len(values) > 0 && strings.HasPrefix(values[0], bearerPrefix) {
+		key = strings.TrimPrefix(values[0], bearerPrefix)
+	}
+	if key != "" {
+		var roles roleSet
+		for _, k := range ac.keys {
+			if subtle.ConstantTimeCompare(k.key, []byte(key)) == 1 {
+				roles = k.roles
+			}
+		}
+		if roles == nil {
+			logging.CPrint(logging.WARN, "rpc rejected request with invalid api key", logging.LogFormat{"method": method})
+			return nil, errInvalidAPIKey
+		}
+		return roles, nil
+	}
+
+	addr := ac.clientAddress(ctx, md)
+	if roles, ok := ac.addrs[addr]; ok {
+		return roles, nil
+	}
+	if roles, ok := ac.addrs["*"]; ok {
+		return roles, nil
+	}
+	if ac.requireKey {
+		logging.CPrint(logging.WARN, "rpc rejected request without api key", logging.LogFormat{"method": method, "address": addr})
+		return nil, errMissingAPIKey
+	}
+	logging.CPrint(logging.WARN, "rpc rejected request from address without roles", logging.LogFormat{"method": method, "address": addr})
+	return nil, permissionDenied(method, requiredRole(method))
+}
+
+func (ac *accessController) authorize(ctx context.Context, method string) error {
+	roles, err := ac.roles(ctx, method)
+	if err != nil {
+		return err
+	}
+	if role := requiredRole(method); !roles.has(role) {
+		logging.CPrint(logging.WARN, "rpc rejected request without permission", logging.LogFormat{"method": method, "role": role})
+		return permissionDenied(method, role)
+	}
+	return nil
+}
+
+// clientAddress returns ip of the client, requests through the gateway come
+// from loopback with the gateway token, then the address seen by the gateway
+// is used.
+func (ac *accessController) clientAddress(ctx context.Context, md metadata.MD) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return ""
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return host
+	}
+	if ip.IsLoopback() && ac.fromGateway(md) {
+		// the gateway appends the remote address to the last
+		if values := md.Get(metadataForwardedFor); len(values) > 0 {
+			forwarded := strings.Split(values[len(values)-1], ",")
+			if fip := net.ParseIP(strings.TrimSpace(forwarded[len(forwarded)-1])); fip != nil {
+				return fip.String()
+			}
+		}
+	}
+	return ip.String()
+}
+
+// fromGateway tells whether the request is sent by the gateway of this process.
+func (ac *accessController) fromGateway(md metadata.MD) bool {
+	if len(ac.gatewayToken) == 0 {
+		return false
+	}
+	for _, token := range md.Get(metadataGatewayToken) {
+		if subtle.ConstantTimeCompare(ac.gatewayToken, []byte(token)) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+func (ac *accessController) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := ac.authorize(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (ac *accessController) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := ac.authorize(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
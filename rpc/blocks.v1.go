@@ -4,7 +4,11 @@ import (
 	"encoding/hex"
 	"sort"
 
+	"github.com/Sukhavati-Labs/go-miner/chainutil"
+	"github.com/Sukhavati-Labs/go-miner/config"
 	"github.com/Sukhavati-Labs/go-miner/logging"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/pocminer"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/pocminer/miner"
 	"github.com/Sukhavati-Labs/go-miner/pocec"
 	pb "github.com/Sukhavati-Labs/go-miner/rpc/proto"
 	"github.com/Sukhavati-Labs/go-miner/wire"
@@ -13,6 +17,11 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+const (
+	// maxGenerateBlocks limits blocks generated by a single GenerateBlocks request
+	maxGenerateBlocks = 1000
+)
+
 func (s *Server) GetBestBlock(ctx context.Context, msg *empty.Empty) (*pb.GetBestBlockResponse, error) {
 	logging.CPrint(logging.INFO, "rpc get the best block height and hash")
 	node := s.chain.BestBlockNode()
@@ -76,6 +85,43 @@ func (s *Server) GetBlockByHeight(ctx context.Context, in *pb.GetBlockByHeightRe
 	return blockReply, err
 }
 
+func (s *Server) GenerateBlocks(ctx context.Context, in *pb.GenerateBlocksRequest) (*pb.GenerateBlocksResponse, error) {
+	logging.CPrint(logging.INFO, "rpc generate blocks", logging.LogFormat{"count": in.Count, "payout_address": in.PayoutAddress})
+	if in.Count == 0 || in.Count > maxGenerateBlocks {
+		logging.CPrint(logging.ERROR, "invalid count of blocks to generate", logging.LogFormat{"count": in.Count, "max": maxGenerateBlocks})
+		return nil, status.New(ErrAPIInvalidParameter, ErrCode[ErrAPIInvalidParameter]).Err()
+	}
+	generator, ok := s.pocMiner.(pocminer.BlockGenerator)
+	if !ok {
+		logging.CPrint(logging.ERROR, "miner is unable to generate blocks", logging.LogFormat{"type": s.pocMiner.Type()})
+		return nil, status.New(ErrAPIMinerGenerate, ErrCode[ErrAPIMinerGenerate]).Err()
+	}
+	addresses, err := chainutil.NewAddressesFromStringList([]string{in.PayoutAddress}, &config.ChainParams)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "invalid payout address", logging.LogFormat{"address": in.PayoutAddress, "error": err})
+		return nil, status.New(ErrAPIMinerInvalidAddress, ErrCode[ErrAPIMinerInvalidAddress]).Err()
+	}
+
+	hashes, err := generator.GenerateBlocks(int(in.Count), addresses[0])
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to generate blocks", logging.LogFormat{"generated": len(hashes), "error": err})
+		if err == miner.ErrGenerateNotAllowed {
+			return nil, status.New(ErrAPIMinerGenerate, ErrCode[ErrAPIMinerGenerate]).Err()
+		}
+		return nil, status.New(ErrAPIMinerInternal, err.Error()).Err()
+	}
+
+	resp := &pb.GenerateBlocksResponse{
+		Hashes: make([]string, len(hashes)),
+		Height: s.chain.BestBlockHeight(),
+	}
+	for i, hash := range hashes {
+		resp.Hashes[i] = hash.String()
+	}
+	logging.CPrint(logging.INFO, "rpc generate blocks succeed", logging.LogFormat{"count": len(hashes), "height": resp.Height})
+	return resp, nil
+}
+
 func (s *Server) GetBlockHeader(ctx context.Context, in *pb.GetBlockHeaderRequest) (*pb.GetBlockHeaderResponse, error) {
 	logging.CPrint(logging.INFO, "a request is received to query the block header according to the block hash", logging.LogFormat{"hash": in.Hash})
 	err := checkHashLen(in.Hash)
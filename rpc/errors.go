@@ -70,6 +70,20 @@ const (
 	ErrAPIMinerNoAddress         = 1809
 	ErrAPIMinerWrongPassphrase   = 1810
 	ErrAPIMinerInvalidAllocation = 1811
+	ErrAPIMinerGenerate          = 1812
+	ErrAPIMinerSpaceNotQueued    = 1813
+	ErrAPIMinerSpaceNotPaused    = 1814
+	ErrAPIMinerInvalidDir        = 1815
+	ErrAPIMinerSpaceBusy         = 1816
+	ErrAPIMinerNoDiskSpace       = 1817
+	ErrAPIMinerPlanNotFound      = 1818
+	ErrAPIMinerPlanOutdated      = 1819
+	ErrAPIMinerNotEnoughBlocks   = 1820
+	ErrAPIMinerNotShadow         = 1821
+	ErrAPIMinerNoPayoutManager   = 1822
+	ErrAPIMinerPayoutExists      = 1823
+	ErrAPIMinerPayoutNotFound    = 1824
+	ErrAPIMinerInvalidStrategy   = 1825
 
 	// Wallet err
 	ErrAPIExportWallet   = 1901
@@ -135,6 +149,20 @@ var ErrCode = map[uint32]string{
 	ErrAPIMinerNoAddress:         "Missing miner payout addresses",
 	ErrAPIMinerWrongPassphrase:   "Wrong miner passphrase",
 	ErrAPIMinerInvalidAllocation: "Invalid miner allocation",
+	ErrAPIMinerGenerate:          "Generating blocks is not allowed",
+	ErrAPIMinerSpaceNotQueued:    "Space is not queued for plotting",
+	ErrAPIMinerSpaceNotPaused:    "Space plotting is not paused",
+	ErrAPIMinerInvalidDir:        "Invalid space directory",
+	ErrAPIMinerSpaceBusy:         "Space is plotting, moving or unavailable",
+	ErrAPIMinerNoDiskSpace:       "Not enough disk space",
+	ErrAPIMinerPlanNotFound:      "Capacity plan not found",
+	ErrAPIMinerPlanOutdated:      "Capacity plan is outdated, please plan again",
+	ErrAPIMinerNotEnoughBlocks:   "Not enough blocks to estimate network space",
+	ErrAPIMinerNotShadow:         "Miner is not running in shadow mode",
+	ErrAPIMinerNoPayoutManager:   "Miner does not support managing payout addresses",
+	ErrAPIMinerPayoutExists:      "Payout address already exists",
+	ErrAPIMinerPayoutNotFound:    "Payout address not found",
+	ErrAPIMinerInvalidStrategy:   "Invalid payout strategy",
 	ErrAPIInvalidTxId:            "Invalid transaction id",
 	ErrAPIInvalidTxHex:           "Invalid txHex",
 
@@ -1,10 +1,12 @@
 package rpc
 
 import (
+	"crypto/tls"
 	"flag"
 	"fmt"
 	"net"
 	"net/http"
+	"net/textproto"
 
 	"github.com/Sukhavati-Labs/go-miner/config"
 	"github.com/Sukhavati-Labs/go-miner/errors"
@@ -13,40 +15,78 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
 )
 
 const (
 	DefaultHTTPLimit = 128 // DefaultHTTPLimit default max http connections
 )
 
-func Run(cfg *config.Config) error {
+// Run starts the http gateway of gRPC server, both of them serve TLS if
+// tlsConfig is not nil.
+// Credentials in "Authorization" and "X-Api-Key" headers are passed through,
+// and gatewayToken marks requests of the gateway, so that gRPC server takes
+// the address of HTTP clients from them.
+func Run(cfg *config.Config, tlsConfig *tls.Config, gatewayToken string) error {
 	portHttp := cfg.Network.Rpc.ApiPortHttp
 	portGRPC := cfg.Network.Rpc.ApiPortGrpc
+	hostGRPC := loopbackHost(cfg.Network.Rpc.ApiHostGrpc)
 
 	ctx := context.Background()
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
 	mux := runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard,
-		&runtime.JSONPb{OrigName: true, EmitDefaults: true}))
-	opts := []grpc.DialOption{grpc.WithInsecure(), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxMsgSize))}
-	echoEndpoint := flag.String("echo_endpoint", ":"+portGRPC, "endpoint of Service")
+		&runtime.JSONPb{OrigName: true, EmitDefaults: true}),
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+		runtime.WithMetadata(func(ctx context.Context, req *http.Request) metadata.MD {
+			return metadata.Pairs(metadataGatewayToken, gatewayToken)
+		}))
+	opts := []grpc.DialOption{grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxMsgSize))}
+	if tlsConfig != nil {
+		clientTLS, err := gatewayTLSConfig(tlsConfig, hostGRPC)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(clientTLS)))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+	echoEndpoint := flag.String("echo_endpoint", net.JoinHostPort(hostGRPC, portGRPC), "endpoint of Service")
 	err := gw.RegisterApiServiceHandlerFromEndpoint(ctx, mux, *echoEndpoint, opts)
 	if err != nil {
 		return err
 	}
 
 	// TODO: CORS
-	isAllowedAddress, err := getIPAccessControlFunc(cfg.Network.Rpc.ApiWhitelist, cfg.Network.Rpc.ApiAllowedLan)
+	// addresses granted roles are allowed as well
+	whitelist := cfg.Network.Rpc.ApiWhitelist
+	for _, entry := range cfg.Network.Rpc.ApiAddressRoles {
+		whitelist = append(whitelist, entry.Address)
+	}
+	isAllowedAddress, err := getIPAccessControlFunc(whitelist, cfg.Network.Rpc.ApiAllowedLan)
 	if err != nil {
 		return err
 	}
 
 	handle := accessControlHandler(concurrentRequestHandler(maxBytesHandler(mux)), isAllowedAddress)
 	port := fmt.Sprintf("%s%s", ":", portHttp)
+	if tlsConfig != nil {
+		server := &http.Server{Addr: port, Handler: handle, TLSConfig: tlsConfig}
+		return server.ListenAndServeTLS("", "")
+	}
 	return http.ListenAndServe(port, handle)
 }
 
+// incomingHeaderMatcher passes X-Api-Key through besides the default headers.
+func incomingHeaderMatcher(key string) (string, bool) {
+	if textproto.CanonicalMIMEHeaderKey(key) == "X-Api-Key" {
+		return MetadataAPIKey, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
 var (
 	rfc1918_10  = net.IPNet{IP: net.ParseIP("10.0.0.0"), Mask: net.CIDRMask(8, 32)}
 	rfc1918_192 = net.IPNet{IP: net.ParseIP("192.168.0.0"), Mask: net.CIDRMask(16, 32)}
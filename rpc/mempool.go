@@ -0,0 +1,128 @@
+package rpc
+
+import (
+	"encoding/hex"
+
+	"github.com/Sukhavati-Labs/go-miner/blockchain"
+	"github.com/Sukhavati-Labs/go-miner/chainutil"
+	"github.com/Sukhavati-Labs/go-miner/errors"
+	"github.com/Sukhavati-Labs/go-miner/logging"
+	pb "github.com/Sukhavati-Labs/go-miner/rpc/proto"
+	"github.com/Sukhavati-Labs/go-miner/wire"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/status"
+)
+
+// reject codes of TestMempoolAcceptResponse
+const (
+	rejectDuplicate     = "duplicate"
+	rejectDust          = "dust"
+	rejectNonStandard   = "non-standard"
+	rejectInsufficient  = "insufficient-fee"
+	rejectDoubleSpend   = "double-spend"
+	rejectMissingInputs = "missing-inputs"
+	rejectInvalid       = "invalid"
+)
+
+// rejectCodes classifies policy errors of txpool, any other error
+// is a consensus rule violation.
+var rejectCodes = map[error]string{
+	errors.ErrTxAlreadyExists:           rejectDuplicate,
+	blockchain.ErrDust:                  rejectDust,
+	blockchain.ErrNonStandardType:       rejectNonStandard,
+	blockchain.ErrNonStandardTxSize:     rejectNonStandard,
+	blockchain.ErrWitnessSize:           rejectNonStandard,
+	blockchain.ErrSignaturePushOnly:     rejectNonStandard,
+	blockchain.ErrNuLLDataScript:        rejectNonStandard,
+	blockchain.ErrParseInputScript:      rejectNonStandard,
+	blockchain.ErrExpectedSignInput:     rejectNonStandard,
+	blockchain.ErrStandardBindingTx:     rejectNonStandard,
+	blockchain.ErrStandardPoolingTx:     rejectNonStandard,
+	blockchain.ErrTxMsgPayloadSize:      rejectNonStandard,
+	blockchain.ErrInsufficientFee:       rejectInsufficient,
+	blockchain.ErrInsufficientPriority:  rejectInsufficient,
+	blockchain.ErrDoubleSpend:           rejectDoubleSpend,
+	blockchain.ErrProhibitionOrphanTx:   rejectMissingInputs,
+	blockchain.ErrUnfinishedTx:          rejectNonStandard,
+	blockchain.ErrInvalidTxVersion:      rejectNonStandard,
+	blockchain.ErrNotAllowedTx:          rejectNonStandard,
+	blockchain.ErrInvalidStakingTxValue: rejectNonStandard,
+	blockchain.ErrInvalidFrozenPeriod:   rejectNonStandard,
+}
+
+func rejectCode(err error) string {
+	if code, ok := rejectCodes[err]; ok {
+		return code
+	}
+	return rejectInvalid
+}
+
+func decodeRawTransaction(txHex string) (*chainutil.Tx, error) {
+	serializedTx, err := hex.DecodeString(txHex)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to decode raw transaction hex", logging.LogFormat{"err": err})
+		return nil, status.New(ErrAPIDecodeHexString, ErrCode[ErrAPIDecodeHexString]).Err()
+	}
+	tx, err := chainutil.NewTxFromBytes(serializedTx, wire.Packet)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to deserialize raw transaction", logging.LogFormat{"err": err})
+		return nil, status.New(ErrAPIInvalidTxHex, ErrCode[ErrAPIInvalidTxHex]).Err()
+	}
+	return tx, nil
+}
+
+// SendRawTransaction submits a serialized transaction to the mempool,
+// accepted transactions are relayed to peers by netsync.
+func (s *Server) SendRawTransaction(ctx context.Context, in *pb.SendRawTransactionRequest) (*pb.SendRawTransactionResponse, error) {
+	logging.CPrint(logging.INFO, "rpc SendRawTransaction called", logging.LogFormat{})
+
+	tx, err := decodeRawTransaction(in.Hex)
+	if err != nil {
+		return nil, err
+	}
+
+	// orphans are rejected as there is no way to report them back later
+	if _, err = s.txMemPool.ProcessTransaction(tx, false, false); err != nil {
+		logging.CPrint(logging.ERROR, "transaction rejected by mempool",
+			logging.LogFormat{"tx_id": tx.Hash(), "err": err})
+		return nil, status.New(ErrAPIRejectTx, err.Error()).Err()
+	}
+
+	logging.CPrint(logging.INFO, "rpc SendRawTransaction done", logging.LogFormat{"tx_id": tx.Hash()})
+	return &pb.SendRawTransactionResponse{TxId: tx.Hash().String()}, nil
+}
+
+// TestMempoolAccept checks a serialized transaction the same way as
+// SendRawTransaction does, but leaves the mempool untouched.
+func (s *Server) TestMempoolAccept(ctx context.Context, in *pb.TestMempoolAcceptRequest) (*pb.TestMempoolAcceptResponse, error) {
+	logging.CPrint(logging.INFO, "rpc TestMempoolAccept called", logging.LogFormat{})
+
+	tx, err := decodeRawTransaction(in.Hex)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.TestMempoolAcceptResponse{TxId: tx.Hash().String()}
+	missingParents, fee, err := s.txMemPool.TestAcceptTransaction(tx, false)
+	switch {
+	case err != nil:
+		resp.RejectCode = rejectCode(err)
+		resp.RejectReason = err.Error()
+	case len(missingParents) > 0:
+		resp.RejectCode = rejectMissingInputs
+		resp.RejectReason = blockchain.ErrProhibitionOrphanTx.Error()
+		for _, hash := range missingParents {
+			resp.MissingParents = append(resp.MissingParents, hash.String())
+		}
+	default:
+		resp.Allowed = true
+		if resp.Fee, err = AmountToString(fee.IntValue()); err != nil {
+			logging.CPrint(logging.ERROR, "failed to convert fee", logging.LogFormat{"fee": fee, "err": err})
+			return nil, status.New(ErrAPIFailedToSukhavati, ErrCode[ErrAPIFailedToSukhavati]).Err()
+		}
+	}
+
+	logging.CPrint(logging.INFO, "rpc TestMempoolAccept done",
+		logging.LogFormat{"tx_id": resp.TxId, "allowed": resp.Allowed, "reject_code": resp.RejectCode})
+	return resp, nil
+}
@@ -0,0 +1,33 @@
+package rpc
+
+import (
+	"path"
+	"time"
+
+	"github.com/Sukhavati-Labs/go-miner/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var requestHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: metrics.Namespace,
+	Subsystem: "rpc",
+	Name:      "request_seconds",
+	Help:      "Latency of unary rpc requests by method and status code.",
+	Buckets:   prometheus.ExponentialBuckets(0.0005, 2, 16),
+}, []string{"method", "code"})
+
+func init() {
+	metrics.Registry.MustRegister(requestHistogram)
+}
+
+// metricsInterceptor observes the latency of unary requests, requests
+// rejected by the access controller are observed as well.
+func metricsInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	requestHistogram.WithLabelValues(path.Base(info.FullMethod), status.Code(err).String()).Observe(time.Since(start).Seconds())
+	return resp, err
+}
@@ -0,0 +1,265 @@
+package rpc
+
+import (
+	"encoding/hex"
+	"time"
+
+	"github.com/Sukhavati-Labs/go-miner/chainutil"
+	"github.com/Sukhavati-Labs/go-miner/logging"
+	"github.com/Sukhavati-Labs/go-miner/mining"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/pocminer"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/pocminer/miner"
+	"github.com/Sukhavati-Labs/go-miner/pocec"
+	pb "github.com/Sukhavati-Labs/go-miner/rpc/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) GetShadowReport(ctx context.Context, in *pb.GetShadowReportRequest) (*pb.GetShadowReportResponse, error) {
+	logging.CPrint(logging.INFO, "Received a request for GetShadowReport", logging.LogFormat{"start_time": in.StartTime, "end_time": in.EndTime})
+	reporter, ok := s.pocMiner.(pocminer.ShadowReporter)
+	if !ok || !reporter.ShadowEnabled() {
+		logging.CPrint(logging.ERROR, "miner is not running in shadow mode", logging.LogFormat{"type": s.pocMiner.Type()})
+		return nil, status.New(ErrAPIMinerNotShadow, ErrCode[ErrAPIMinerNotShadow]).Err()
+	}
+	if in.StartTime < 0 || in.EndTime < 0 || (in.EndTime != 0 && in.EndTime <= in.StartTime) {
+		logging.CPrint(logging.ERROR, "invalid time range of shadow report", logging.LogFormat{"start_time": in.StartTime, "end_time": in.EndTime})
+		return nil, status.New(ErrAPIInvalidParameter, ErrCode[ErrAPIInvalidParameter]).Err()
+	}
+
+	slots := reporter.ShadowReport(unixTime(in.StartTime), unixTime(in.EndTime))
+	resp := &pb.GetShadowReportResponse{
+		Count: uint32(len(slots)),
+		Slots: make([]*pb.GetShadowReportResponse_Slot, len(slots)),
+	}
+	for i, slot := range slots {
+		if slot.Found {
+			resp.Found++
+		}
+		if slot.Won {
+			resp.Won++
+		}
+		resp.Slots[i] = shadowSlot2Proto(slot)
+	}
+	if resp.Count != 0 {
+		resp.WinRate = float64(resp.Won) / float64(resp.Count)
+	}
+	logging.CPrint(logging.INFO, "GetShadowReport completed", logging.LogFormat{"count": resp.Count, "won": resp.Won})
+	return resp, nil
+}
+
+func shadowSlot2Proto(slot *pocminer.ShadowSlot) *pb.GetShadowReportResponse_Slot {
+	msg := &pb.GetShadowReportResponse_Slot{
+		Height:     slot.Height,
+		Slot:       slot.Slot,
+		Found:      slot.Found,
+		WinnerHash: slot.WinnerHash.String(),
+		WinnerSlot: slot.WinnerSlot,
+		Won:        slot.Won,
+		Time:       slot.Time.Unix(),
+	}
+	if slot.Quality != nil {
+		msg.Quality = slot.Quality.String()
+	}
+	if slot.WinnerQuality != nil {
+		msg.WinnerQuality = slot.WinnerQuality.String()
+	}
+	return msg
+}
+
+func (s *Server) GetMinedBlocks(ctx context.Context, in *pb.GetMinedBlocksRequest) (*pb.GetMinedBlocksResponse, error) {
+	logging.CPrint(logging.INFO, "Received a request for GetMinedBlocks", logging.LogFormat{"in": in.String()})
+	if in.StartTime < 0 || in.EndTime < 0 || (in.EndTime != 0 && in.EndTime <= in.StartTime) {
+		logging.CPrint(logging.ERROR, "invalid time range of mined blocks", logging.LogFormat{"start_time": in.StartTime, "end_time": in.EndTime})
+		return nil, status.New(ErrAPIInvalidParameter, ErrCode[ErrAPIInvalidParameter]).Err()
+	}
+	var pubKey *pocec.PublicKey
+	if in.PublicKey != "" {
+		pkBytes, err := hex.DecodeString(in.PublicKey)
+		if err == nil {
+			pubKey, err = pocec.ParsePubKey(pkBytes, pocec.S256())
+		}
+		if err != nil {
+			logging.CPrint(logging.ERROR, "invalid public key", logging.LogFormat{"public_key": in.PublicKey, "err": err})
+			return nil, status.New(ErrAPIInvalidPublicKey, ErrCode[ErrAPIInvalidPublicKey]).Err()
+		}
+	}
+
+	blocks := s.ledger.Blocks(unixTime(in.StartTime), unixTime(in.EndTime), pubKey)
+	resp := &pb.GetMinedBlocksResponse{
+		Count:  uint32(len(blocks)),
+		Blocks: make([]*pb.GetMinedBlocksResponse_Block, len(blocks)),
+	}
+	total := chainutil.ZeroAmount()
+	for i, block := range blocks {
+		msg, err := minedBlock2Proto(block)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "fail to convert mined block", logging.LogFormat{"height": block.Height, "err": err})
+			return nil, status.New(ErrAPIFailedToSukhavati, ErrCode[ErrAPIFailedToSukhavati]).Err()
+		}
+		resp.Blocks[i] = msg
+		if block.Orphaned {
+			resp.Orphaned++
+			continue
+		}
+		if total, err = total.Add(block.Reward); err != nil {
+			logging.CPrint(logging.ERROR, "fail to sum mined reward", logging.LogFormat{"height": block.Height, "err": err})
+			return nil, status.New(ErrAPIMinerInternal, ErrCode[ErrAPIMinerInternal]).Err()
+		}
+	}
+	var err error
+	if resp.Reward, err = AmountToString(total.IntValue()); err != nil {
+		return nil, status.New(ErrAPIFailedToSukhavati, ErrCode[ErrAPIFailedToSukhavati]).Err()
+	}
+	logging.CPrint(logging.INFO, "GetMinedBlocks completed", logging.LogFormat{"count": resp.Count, "orphaned": resp.Orphaned, "reward": resp.Reward})
+	return resp, nil
+}
+
+func minedBlock2Proto(block *mining.MinedBlock) (*pb.GetMinedBlocksResponse_Block, error) {
+	msg := &pb.GetMinedBlocksResponse_Block{
+		Height:        block.Height,
+		Hash:          block.Hash.String(),
+		Time:          block.Time.Unix(),
+		PublicKey:     hex.EncodeToString(block.PublicKey.SerializeCompressed()),
+		BitLength:     uint32(block.BitLength),
+		PayoutAddress: block.PayoutAddress,
+		Orphaned:      block.Orphaned,
+	}
+	amounts := []struct {
+		amount chainutil.Amount
+		dst    *string
+	}{
+		{block.Reward, &msg.Reward},
+		{block.MinerSubsidy, &msg.MinerSubsidy},
+		{block.BindingSubsidy, &msg.BindingSubsidy},
+		{block.StakingSubsidy, &msg.StakingSubsidy},
+		{block.SenateSubsidy, &msg.SenateSubsidy},
+	}
+	var err error
+	for _, amount := range amounts {
+		if *amount.dst, err = AmountToString(amount.amount.IntValue()); err != nil {
+			return nil, err
+		}
+	}
+	return msg, nil
+}
+
+func (s *Server) GetPayoutAddresses(ctx context.Context, in *empty.Empty) (*pb.GetPayoutAddressesResponse, error) {
+	logging.CPrint(logging.INFO, "Received a request for GetPayoutAddresses")
+	manager, err := s.payoutManager()
+	if err != nil {
+		return nil, err
+	}
+	return payoutAddresses2Proto(manager), nil
+}
+
+func (s *Server) AddPayoutAddress(ctx context.Context, in *pb.AddPayoutAddressRequest) (*pb.GetPayoutAddressesResponse, error) {
+	logging.CPrint(logging.INFO, "Received a request for AddPayoutAddress", logging.LogFormat{"in": in.String()})
+	manager, err := s.payoutManager()
+	if err != nil {
+		return nil, err
+	}
+	addresses, err := parsePayoutAddresses([]string{in.Address})
+	if err != nil {
+		return nil, err
+	}
+	payout := &pocminer.PayoutAddress{Address: addresses[0], Weight: in.Weight}
+	if in.PublicKey != "" {
+		pkBytes, err := hex.DecodeString(in.PublicKey)
+		if err == nil {
+			payout.PublicKey, err = pocec.ParsePubKey(pkBytes, pocec.S256())
+		}
+		if err != nil {
+			logging.CPrint(logging.ERROR, "invalid public key", logging.LogFormat{"public_key": in.PublicKey, "err": err})
+			return nil, status.New(ErrAPIInvalidPublicKey, ErrCode[ErrAPIInvalidPublicKey]).Err()
+		}
+	}
+	if err = manager.AddPayoutAddress(payout); err != nil {
+		logging.CPrint(logging.ERROR, "fail to add payout address", logging.LogFormat{"address": in.Address, "err": err})
+		return nil, payoutError(err)
+	}
+	logging.CPrint(logging.INFO, "AddPayoutAddress completed", logging.LogFormat{"address": in.Address, "weight": payout.Weight})
+	return payoutAddresses2Proto(manager), nil
+}
+
+func (s *Server) RemovePayoutAddress(ctx context.Context, in *pb.RemovePayoutAddressRequest) (*pb.GetPayoutAddressesResponse, error) {
+	logging.CPrint(logging.INFO, "Received a request for RemovePayoutAddress", logging.LogFormat{"address": in.Address})
+	manager, err := s.payoutManager()
+	if err != nil {
+		return nil, err
+	}
+	addresses, err := parsePayoutAddresses([]string{in.Address})
+	if err != nil {
+		return nil, err
+	}
+	if err = manager.RemovePayoutAddress(addresses[0]); err != nil {
+		logging.CPrint(logging.ERROR, "fail to remove payout address", logging.LogFormat{"address": in.Address, "err": err})
+		return nil, payoutError(err)
+	}
+	logging.CPrint(logging.INFO, "RemovePayoutAddress completed", logging.LogFormat{"address": in.Address})
+	return payoutAddresses2Proto(manager), nil
+}
+
+func (s *Server) SetPayoutStrategy(ctx context.Context, in *pb.SetPayoutStrategyRequest) (*pb.GetPayoutAddressesResponse, error) {
+	logging.CPrint(logging.INFO, "Received a request for SetPayoutStrategy", logging.LogFormat{"strategy": in.Strategy})
+	manager, err := s.payoutManager()
+	if err != nil {
+		return nil, err
+	}
+	if err = manager.SetPayoutStrategy(in.Strategy); err != nil {
+		logging.CPrint(logging.ERROR, "fail to set payout strategy", logging.LogFormat{"strategy": in.Strategy, "err": err})
+		return nil, payoutError(err)
+	}
+	logging.CPrint(logging.INFO, "SetPayoutStrategy completed", logging.LogFormat{"strategy": in.Strategy})
+	return payoutAddresses2Proto(manager), nil
+}
+
+func (s *Server) payoutManager() (pocminer.PayoutManager, error) {
+	manager, ok := s.pocMiner.(pocminer.PayoutManager)
+	if !ok {
+		logging.CPrint(logging.ERROR, "miner does not support managing payout addresses", logging.LogFormat{"type": s.pocMiner.Type()})
+		return nil, status.New(ErrAPIMinerNoPayoutManager, ErrCode[ErrAPIMinerNoPayoutManager]).Err()
+	}
+	return manager, nil
+}
+
+func payoutAddresses2Proto(manager pocminer.PayoutManager) *pb.GetPayoutAddressesResponse {
+	payouts := manager.PayoutAddresses()
+	resp := &pb.GetPayoutAddressesResponse{
+		Strategy:  manager.PayoutStrategy(),
+		Addresses: make([]*pb.PayoutAddress, len(payouts)),
+	}
+	for i, payout := range payouts {
+		resp.Addresses[i] = &pb.PayoutAddress{Address: payout.Address.EncodeAddress(), Weight: payout.Weight}
+		if payout.PublicKey != nil {
+			resp.Addresses[i].PublicKey = hex.EncodeToString(payout.PublicKey.SerializeCompressed())
+		}
+	}
+	return resp
+}
+
+func payoutError(err error) error {
+	switch err {
+	case miner.ErrPayoutAddressExists:
+		return status.New(ErrAPIMinerPayoutExists, ErrCode[ErrAPIMinerPayoutExists]).Err()
+	case miner.ErrPayoutAddressNotFound:
+		return status.New(ErrAPIMinerPayoutNotFound, ErrCode[ErrAPIMinerPayoutNotFound]).Err()
+	case miner.ErrInvalidPayoutStrategy:
+		return status.New(ErrAPIMinerInvalidStrategy, ErrCode[ErrAPIMinerInvalidStrategy]).Err()
+	case miner.ErrNoPayoutAddresses:
+		return status.New(ErrAPIMinerNoAddress, ErrCode[ErrAPIMinerNoAddress]).Err()
+	case miner.ErrTooManyPayoutAddresses:
+		return status.New(ErrAPIMinerInvalidAddress, ErrCode[ErrAPIMinerInvalidAddress]).Err()
+	default:
+		return status.New(ErrAPIMinerInternal, err.Error()).Err()
+	}
+}
+
+// unixTime converts unix seconds to time, 0 is converted to zero time.
+func unixTime(sec int64) time.Time {
+	if sec == 0 {
+		return time.Time{}
+	}
+	return time.Unix(sec, 0)
+}
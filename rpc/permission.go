@@ -0,0 +1,161 @@
+package rpc
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Role is a permission group over methods of ApiService.
+type Role string
+
+const (
+	RoleChainRead   Role = "chain-read"   // query blocks, transactions, txpool, governance and node status
+	RoleChainWrite  Role = "chain-write"  // submit transactions
+	RoleSpaceRead   Role = "space-read"   // query spaces
+	RoleSpaceAdmin  Role = "space-admin"  // configure, plot, mine and stop spaces, implies space-read
+	RoleWalletRead  Role = "wallet-read"  // list keystores
+	RoleWalletAdmin Role = "wallet-admin" // export, import, unlock keystores and change passphrases, implies wallet-read
+	RoleNodeAdmin   Role = "node-admin"   // stop the node and generate blocks
+
+	// RoleAll grants all roles.
+	RoleAll Role = "*"
+)
+
+const apiServicePrefix = "/rpcprotobuf.ApiService/"
+
+var (
+	allRoles = []Role{RoleChainRead, RoleChainWrite, RoleSpaceRead, RoleSpaceAdmin, RoleWalletRead, RoleWalletAdmin, RoleNodeAdmin}
+
+	// impliedRoles lists roles granted along with a role.
+	impliedRoles = map[Role][]Role{
+		RoleSpaceAdmin:  {RoleSpaceRead},
+		RoleWalletAdmin: {RoleWalletRead},
+		RoleAll:         allRoles,
+	}
+
+	// methodRoles maps methods of ApiService to the required role,
+	// methods not listed here require RoleNodeAdmin.
+	methodRoles = map[string]Role{
+		"GetBestBlock":           RoleChainRead,
+		"GetBlock":               RoleChainRead,
+		"GetBlockHashByHeight":   RoleChainRead,
+		"GetBlockByHeight":       RoleChainRead,
+		"GetBlockHeader":         RoleChainRead,
+		"GetBlockHeightByPubKey": RoleChainRead,
+		"GetBlockV2":             RoleChainRead,
+		"GetBlockHeaderV2":       RoleChainRead,
+		"GetBlockVerbose1V2":     RoleChainRead,
+		"GetCoinbase":            RoleChainRead,
+		"GetTxPool":              RoleChainRead,
+		"GetTxPoolVerbose0":      RoleChainRead,
+		"GetTxPoolVerbose1":      RoleChainRead,
+		"GetStakingTxPoolInfo":   RoleChainRead,
+		"GetStakingRewardRecord": RoleChainRead,
+		"GetRawTransaction":      RoleChainRead,
+		"TestMempoolAccept":      RoleChainRead,
+		"GetClientStatus":        RoleChainRead,
+		"GetGovernConfig":        RoleChainRead,
+		"GetGovernConfigHistory": RoleChainRead,
+		"SubscribeBlocks":        RoleChainRead,
+		"SubscribeTxPool":        RoleChainRead,
+
+		"SendRawTransaction": RoleChainWrite,
+
+		"GetCapacitySpaces":       RoleSpaceRead,
+		"GetCapacitySpacesByDirs": RoleSpaceRead,
+		"GetCapacitySpace":        RoleSpaceRead,
+		"SubscribeWorkSpaces":     RoleSpaceRead,
+		"SubscribeMining":         RoleSpaceRead,
+		"GetPlotQueue":            RoleSpaceRead,
+		"GetCapacitySpaceMoves":   RoleSpaceRead,
+		"PlanCapacity":            RoleSpaceRead,
+		"EstimateMiningRevenue":   RoleSpaceRead,
+		"GetProofLatencies":       RoleSpaceRead,
+		"GetShadowReport":         RoleSpaceRead,
+		"GetMinedBlocks":          RoleSpaceRead,
+		"GetPayoutAddresses":      RoleSpaceRead,
+
+		"ConfigureCapacity":       RoleSpaceAdmin,
+		"ConfigureCapacityByDirs": RoleSpaceAdmin,
+		"PlotCapacitySpaces":      RoleSpaceAdmin,
+		"PlotCapacitySpace":       RoleSpaceAdmin,
+		"MineCapacitySpaces":      RoleSpaceAdmin,
+		"MineCapacitySpace":       RoleSpaceAdmin,
+		"StopCapacitySpaces":      RoleSpaceAdmin,
+		"StopCapacitySpace":       RoleSpaceAdmin,
+		"VerifyCapacitySpace":     RoleSpaceAdmin,
+		"PausePlotCapacitySpace":  RoleSpaceAdmin,
+		"ResumePlotCapacitySpace": RoleSpaceAdmin,
+		"MoveCapacitySpace":       RoleSpaceAdmin,
+		"RebalanceCapacitySpaces": RoleSpaceAdmin,
+		"ApplyCapacityPlan":       RoleSpaceAdmin,
+		"AddPayoutAddress":        RoleSpaceAdmin,
+		"RemovePayoutAddress":     RoleSpaceAdmin,
+		"SetPayoutStrategy":       RoleSpaceAdmin,
+
+		"GetKeystore": RoleWalletRead,
+
+		"GetKeystoreDetail":   RoleWalletAdmin,
+		"ExportKeystore":      RoleWalletAdmin,
+		"ExportKeystoreByDir": RoleWalletAdmin,
+		"ImportKeystore":      RoleWalletAdmin,
+		"ImportKeystoreByDir": RoleWalletAdmin,
+		"UnlockWallet":        RoleWalletAdmin,
+		"LockWallet":          RoleWalletAdmin,
+		"ChangePrivatePass":   RoleWalletAdmin,
+		"ChangePublicPass":    RoleWalletAdmin,
+
+		"QuitClient":     RoleNodeAdmin,
+		"GenerateBlocks": RoleNodeAdmin,
+	}
+)
+
+// roleSet is a set of granted roles, implied roles included.
+type roleSet map[Role]struct{}
+
+// newRoleSet parses role names, it fails on unknown roles.
+func newRoleSet(names []string) (roleSet, error) {
+	set := make(roleSet)
+	for _, name := range names {
+		role := Role(strings.TrimSpace(name))
+		if role != RoleAll && !isKnownRole(role) {
+			return nil, fmt.Errorf("unknown rpc role %q", name)
+		}
+		set[role] = struct{}{}
+		for _, implied := range impliedRoles[role] {
+			set[implied] = struct{}{}
+		}
+	}
+	return set, nil
+}
+
+func isKnownRole(role Role) bool {
+	for _, r := range allRoles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+func (set roleSet) has(role Role) bool {
+	_, ok := set[role]
+	return ok
+}
+
+// requiredRole returns the role required by the full gRPC method name.
+func requiredRole(fullMethod string) Role {
+	if strings.HasPrefix(fullMethod, apiServicePrefix) {
+		if role, ok := methodRoles[strings.TrimPrefix(fullMethod, apiServicePrefix)]; ok {
+			return role
+		}
+	}
+	return RoleNodeAdmin
+}
+
+func permissionDenied(fullMethod string, role Role) error {
+	return status.New(codes.PermissionDenied, fmt.Sprintf("%s requires role %s", fullMethod, role)).Err()
+}
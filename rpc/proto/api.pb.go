@@ -447,7 +447,7 @@ func (m *AddrManager) GetUse() uint32 {
 	return 0
 }
 
-// ========== block ====================
+//========== block ====================
 type GetBlockHashByHeightRequest struct {
 	Height               uint64   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -2679,6 +2679,198 @@ func (m *GetRawTransactionResponse) GetCoinbase() bool {
 	return false
 }
 
+type SendRawTransactionRequest struct {
+	Hex                  string   `protobuf:"bytes,1,opt,name=hex,proto3" json:"hex,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SendRawTransactionRequest) Reset()         { *m = SendRawTransactionRequest{} }
+func (m *SendRawTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*SendRawTransactionRequest) ProtoMessage()    {}
+func (*SendRawTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{35}
+}
+func (m *SendRawTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendRawTransactionRequest.Unmarshal(m, b)
+}
+func (m *SendRawTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendRawTransactionRequest.Marshal(b, m, deterministic)
+}
+func (m *SendRawTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendRawTransactionRequest.Merge(m, src)
+}
+func (m *SendRawTransactionRequest) XXX_Size() int {
+	return xxx_messageInfo_SendRawTransactionRequest.Size(m)
+}
+func (m *SendRawTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendRawTransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendRawTransactionRequest proto.InternalMessageInfo
+
+func (m *SendRawTransactionRequest) GetHex() string {
+	if m != nil {
+		return m.Hex
+	}
+	return ""
+}
+
+type SendRawTransactionResponse struct {
+	TxId                 string   `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SendRawTransactionResponse) Reset()         { *m = SendRawTransactionResponse{} }
+func (m *SendRawTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*SendRawTransactionResponse) ProtoMessage()    {}
+func (*SendRawTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{36}
+}
+func (m *SendRawTransactionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendRawTransactionResponse.Unmarshal(m, b)
+}
+func (m *SendRawTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendRawTransactionResponse.Marshal(b, m, deterministic)
+}
+func (m *SendRawTransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendRawTransactionResponse.Merge(m, src)
+}
+func (m *SendRawTransactionResponse) XXX_Size() int {
+	return xxx_messageInfo_SendRawTransactionResponse.Size(m)
+}
+func (m *SendRawTransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendRawTransactionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendRawTransactionResponse proto.InternalMessageInfo
+
+func (m *SendRawTransactionResponse) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+type TestMempoolAcceptRequest struct {
+	Hex                  string   `protobuf:"bytes,1,opt,name=hex,proto3" json:"hex,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TestMempoolAcceptRequest) Reset()         { *m = TestMempoolAcceptRequest{} }
+func (m *TestMempoolAcceptRequest) String() string { return proto.CompactTextString(m) }
+func (*TestMempoolAcceptRequest) ProtoMessage()    {}
+func (*TestMempoolAcceptRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{37}
+}
+func (m *TestMempoolAcceptRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TestMempoolAcceptRequest.Unmarshal(m, b)
+}
+func (m *TestMempoolAcceptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TestMempoolAcceptRequest.Marshal(b, m, deterministic)
+}
+func (m *TestMempoolAcceptRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TestMempoolAcceptRequest.Merge(m, src)
+}
+func (m *TestMempoolAcceptRequest) XXX_Size() int {
+	return xxx_messageInfo_TestMempoolAcceptRequest.Size(m)
+}
+func (m *TestMempoolAcceptRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TestMempoolAcceptRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TestMempoolAcceptRequest proto.InternalMessageInfo
+
+func (m *TestMempoolAcceptRequest) GetHex() string {
+	if m != nil {
+		return m.Hex
+	}
+	return ""
+}
+
+type TestMempoolAcceptResponse struct {
+	TxId                 string   `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Allowed              bool     `protobuf:"varint,2,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	RejectCode           string   `protobuf:"bytes,3,opt,name=reject_code,json=rejectCode,proto3" json:"reject_code,omitempty"`
+	RejectReason         string   `protobuf:"bytes,4,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"`
+	Fee                  string   `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	MissingParents       []string `protobuf:"bytes,6,rep,name=missing_parents,json=missingParents,proto3" json:"missing_parents,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TestMempoolAcceptResponse) Reset()         { *m = TestMempoolAcceptResponse{} }
+func (m *TestMempoolAcceptResponse) String() string { return proto.CompactTextString(m) }
+func (*TestMempoolAcceptResponse) ProtoMessage()    {}
+func (*TestMempoolAcceptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{38}
+}
+func (m *TestMempoolAcceptResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TestMempoolAcceptResponse.Unmarshal(m, b)
+}
+func (m *TestMempoolAcceptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TestMempoolAcceptResponse.Marshal(b, m, deterministic)
+}
+func (m *TestMempoolAcceptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TestMempoolAcceptResponse.Merge(m, src)
+}
+func (m *TestMempoolAcceptResponse) XXX_Size() int {
+	return xxx_messageInfo_TestMempoolAcceptResponse.Size(m)
+}
+func (m *TestMempoolAcceptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TestMempoolAcceptResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TestMempoolAcceptResponse proto.InternalMessageInfo
+
+func (m *TestMempoolAcceptResponse) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *TestMempoolAcceptResponse) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
+func (m *TestMempoolAcceptResponse) GetRejectCode() string {
+	if m != nil {
+		return m.RejectCode
+	}
+	return ""
+}
+
+func (m *TestMempoolAcceptResponse) GetRejectReason() string {
+	if m != nil {
+		return m.RejectReason
+	}
+	return ""
+}
+
+func (m *TestMempoolAcceptResponse) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+func (m *TestMempoolAcceptResponse) GetMissingParents() []string {
+	if m != nil {
+		return m.MissingParents
+	}
+	return nil
+}
+
 type GetTxDescVerbose0Response struct {
 	TxId                 string   `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
 	PlainSize            uint32   `protobuf:"varint,2,opt,name=plain_size,json=plainSize,proto3" json:"plain_size,omitempty"`
@@ -2697,7 +2889,7 @@ func (m *GetTxDescVerbose0Response) Reset()         { *m = GetTxDescVerbose0Resp
 func (m *GetTxDescVerbose0Response) String() string { return proto.CompactTextString(m) }
 func (*GetTxDescVerbose0Response) ProtoMessage()    {}
 func (*GetTxDescVerbose0Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{35}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{39}
 }
 func (m *GetTxDescVerbose0Response) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTxDescVerbose0Response.Unmarshal(m, b)
@@ -2793,7 +2985,7 @@ func (m *GetTxDescVerbose1Response) Reset()         { *m = GetTxDescVerbose1Resp
 func (m *GetTxDescVerbose1Response) String() string { return proto.CompactTextString(m) }
 func (*GetTxDescVerbose1Response) ProtoMessage()    {}
 func (*GetTxDescVerbose1Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{36}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{40}
 }
 func (m *GetTxDescVerbose1Response) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTxDescVerbose1Response.Unmarshal(m, b)
@@ -2897,7 +3089,7 @@ func (m *GetOrphanTxDescResponse) Reset()         { *m = GetOrphanTxDescResponse
 func (m *GetOrphanTxDescResponse) String() string { return proto.CompactTextString(m) }
 func (*GetOrphanTxDescResponse) ProtoMessage()    {}
 func (*GetOrphanTxDescResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{37}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{41}
 }
 func (m *GetOrphanTxDescResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetOrphanTxDescResponse.Unmarshal(m, b)
@@ -2963,7 +3155,7 @@ func (m *GetTxPoolResponse) Reset()         { *m = GetTxPoolResponse{} }
 func (m *GetTxPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTxPoolResponse) ProtoMessage()    {}
 func (*GetTxPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{38}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{42}
 }
 func (m *GetTxPoolResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTxPoolResponse.Unmarshal(m, b)
@@ -3059,7 +3251,7 @@ func (m *GetTxPoolVerbose0Response) Reset()         { *m = GetTxPoolVerbose0Resp
 func (m *GetTxPoolVerbose0Response) String() string { return proto.CompactTextString(m) }
 func (*GetTxPoolVerbose0Response) ProtoMessage()    {}
 func (*GetTxPoolVerbose0Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{39}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{43}
 }
 func (m *GetTxPoolVerbose0Response) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTxPoolVerbose0Response.Unmarshal(m, b)
@@ -3169,7 +3361,7 @@ func (m *GetTxPoolVerbose1Response) Reset()         { *m = GetTxPoolVerbose1Resp
 func (m *GetTxPoolVerbose1Response) String() string { return proto.CompactTextString(m) }
 func (*GetTxPoolVerbose1Response) ProtoMessage()    {}
 func (*GetTxPoolVerbose1Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{40}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{44}
 }
 func (m *GetTxPoolVerbose1Response) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTxPoolVerbose1Response.Unmarshal(m, b)
@@ -3276,7 +3468,7 @@ func (m *GetStakingTxPoolInfoResponse) Reset()         { *m = GetStakingTxPoolIn
 func (m *GetStakingTxPoolInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetStakingTxPoolInfoResponse) ProtoMessage()    {}
 func (*GetStakingTxPoolInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{41}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{45}
 }
 func (m *GetStakingTxPoolInfoResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetStakingTxPoolInfoResponse.Unmarshal(m, b)
@@ -3356,7 +3548,7 @@ func (m *GetStakingRewardRecordRequest) Reset()         { *m = GetStakingRewardR
 func (m *GetStakingRewardRecordRequest) String() string { return proto.CompactTextString(m) }
 func (*GetStakingRewardRecordRequest) ProtoMessage()    {}
 func (*GetStakingRewardRecordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{42}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{46}
 }
 func (m *GetStakingRewardRecordRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetStakingRewardRecordRequest.Unmarshal(m, b)
@@ -3396,7 +3588,7 @@ func (m *StakingRewardRecord) Reset()         { *m = StakingRewardRecord{} }
 func (m *StakingRewardRecord) String() string { return proto.CompactTextString(m) }
 func (*StakingRewardRecord) ProtoMessage()    {}
 func (*StakingRewardRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{43}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{47}
 }
 func (m *StakingRewardRecord) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StakingRewardRecord.Unmarshal(m, b)
@@ -3448,7 +3640,7 @@ func (m *GetStakingRewardRecordResponse) Reset()         { *m = GetStakingReward
 func (m *GetStakingRewardRecordResponse) String() string { return proto.CompactTextString(m) }
 func (*GetStakingRewardRecordResponse) ProtoMessage()    {}
 func (*GetStakingRewardRecordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{44}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{48}
 }
 func (m *GetStakingRewardRecordResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetStakingRewardRecordResponse.Unmarshal(m, b)
@@ -3489,7 +3681,7 @@ func (m *ConfigureSpaceKeeperRequest) Reset()         { *m = ConfigureSpaceKeepe
 func (m *ConfigureSpaceKeeperRequest) String() string { return proto.CompactTextString(m) }
 func (*ConfigureSpaceKeeperRequest) ProtoMessage()    {}
 func (*ConfigureSpaceKeeperRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{45}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{49}
 }
 func (m *ConfigureSpaceKeeperRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigureSpaceKeeperRequest.Unmarshal(m, b)
@@ -3544,6 +3736,8 @@ type WorkSpace struct {
 	BitLength            uint32   `protobuf:"varint,4,opt,name=bit_length,json=bitLength,proto3" json:"bit_length,omitempty"`
 	State                string   `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
 	Progress             float64  `protobuf:"fixed64,6,opt,name=progress,proto3" json:"progress,omitempty"`
+	Unavailable          bool     `protobuf:"varint,7,opt,name=unavailable,proto3" json:"unavailable,omitempty"`
+	Slow                 bool     `protobuf:"varint,8,opt,name=slow,proto3" json:"slow,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3553,7 +3747,7 @@ func (m *WorkSpace) Reset()         { *m = WorkSpace{} }
 func (m *WorkSpace) String() string { return proto.CompactTextString(m) }
 func (*WorkSpace) ProtoMessage()    {}
 func (*WorkSpace) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{46}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{50}
 }
 func (m *WorkSpace) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_WorkSpace.Unmarshal(m, b)
@@ -3615,6 +3809,20 @@ func (m *WorkSpace) GetProgress() float64 {
 	return 0
 }
 
+func (m *WorkSpace) GetUnavailable() bool {
+	if m != nil {
+		return m.Unavailable
+	}
+	return false
+}
+
+func (m *WorkSpace) GetSlow() bool {
+	if m != nil {
+		return m.Slow
+	}
+	return false
+}
+
 type WorkSpaceRequest struct {
 	SpaceId              string   `protobuf:"bytes,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -3626,7 +3834,7 @@ func (m *WorkSpaceRequest) Reset()         { *m = WorkSpaceRequest{} }
 func (m *WorkSpaceRequest) String() string { return proto.CompactTextString(m) }
 func (*WorkSpaceRequest) ProtoMessage()    {}
 func (*WorkSpaceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{47}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{51}
 }
 func (m *WorkSpaceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_WorkSpaceRequest.Unmarshal(m, b)
@@ -3666,7 +3874,7 @@ func (m *WorkSpaceResponse) Reset()         { *m = WorkSpaceResponse{} }
 func (m *WorkSpaceResponse) String() string { return proto.CompactTextString(m) }
 func (*WorkSpaceResponse) ProtoMessage()    {}
 func (*WorkSpaceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{48}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{52}
 }
 func (m *WorkSpaceResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_WorkSpaceResponse.Unmarshal(m, b)
@@ -3721,7 +3929,7 @@ func (m *WorkSpacesResponse) Reset()         { *m = WorkSpacesResponse{} }
 func (m *WorkSpacesResponse) String() string { return proto.CompactTextString(m) }
 func (*WorkSpacesResponse) ProtoMessage()    {}
 func (*WorkSpacesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{49}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{53}
 }
 func (m *WorkSpacesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_WorkSpacesResponse.Unmarshal(m, b)
@@ -3781,7 +3989,7 @@ func (m *ActOnSpaceKeeperResponse) Reset()         { *m = ActOnSpaceKeeperRespon
 func (m *ActOnSpaceKeeperResponse) String() string { return proto.CompactTextString(m) }
 func (*ActOnSpaceKeeperResponse) ProtoMessage()    {}
 func (*ActOnSpaceKeeperResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{50}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{54}
 }
 func (m *ActOnSpaceKeeperResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ActOnSpaceKeeperResponse.Unmarshal(m, b)
@@ -3815,2286 +4023,4980 @@ func (m *ActOnSpaceKeeperResponse) GetErrorMessage() string {
 	return ""
 }
 
-type ConfigureSpaceKeeperByDirsRequest struct {
-	Allocations          []*ConfigureSpaceKeeperByDirsRequest_Allocation `protobuf:"bytes,1,rep,name=allocations,proto3" json:"allocations,omitempty"`
-	PayoutAddresses      []string                                        `protobuf:"bytes,2,rep,name=payout_addresses,json=payoutAddresses,proto3" json:"payout_addresses,omitempty"`
-	Passphrase           string                                          `protobuf:"bytes,3,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
-	Cointype             uint32                                          `protobuf:"varint,4,opt,name=cointype,proto3" json:"cointype,omitempty"`
-	AutoCreate           int32                                           `protobuf:"varint,5,opt,name=auto_create,json=autoCreate,proto3" json:"auto_create,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                        `json:"-"`
-	XXX_unrecognized     []byte                                          `json:"-"`
-	XXX_sizecache        int32                                           `json:"-"`
+type PlotWorkSpaceRequest struct {
+	SpaceId              string   `protobuf:"bytes,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Threads              uint32   `protobuf:"varint,2,opt,name=threads,proto3" json:"threads,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ConfigureSpaceKeeperByDirsRequest) Reset()         { *m = ConfigureSpaceKeeperByDirsRequest{} }
-func (m *ConfigureSpaceKeeperByDirsRequest) String() string { return proto.CompactTextString(m) }
-func (*ConfigureSpaceKeeperByDirsRequest) ProtoMessage()    {}
-func (*ConfigureSpaceKeeperByDirsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{51}
-}
-func (m *ConfigureSpaceKeeperByDirsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ConfigureSpaceKeeperByDirsRequest.Unmarshal(m, b)
+func (m *PlotWorkSpaceRequest) Reset()         { *m = PlotWorkSpaceRequest{} }
+func (m *PlotWorkSpaceRequest) String() string { return proto.CompactTextString(m) }
+func (*PlotWorkSpaceRequest) ProtoMessage()    {}
+func (*PlotWorkSpaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{55}
 }
-func (m *ConfigureSpaceKeeperByDirsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ConfigureSpaceKeeperByDirsRequest.Marshal(b, m, deterministic)
+func (m *PlotWorkSpaceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlotWorkSpaceRequest.Unmarshal(m, b)
 }
-func (m *ConfigureSpaceKeeperByDirsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConfigureSpaceKeeperByDirsRequest.Merge(m, src)
+func (m *PlotWorkSpaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlotWorkSpaceRequest.Marshal(b, m, deterministic)
 }
-func (m *ConfigureSpaceKeeperByDirsRequest) XXX_Size() int {
-	return xxx_messageInfo_ConfigureSpaceKeeperByDirsRequest.Size(m)
+func (m *PlotWorkSpaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlotWorkSpaceRequest.Merge(m, src)
 }
-func (m *ConfigureSpaceKeeperByDirsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConfigureSpaceKeeperByDirsRequest.DiscardUnknown(m)
+func (m *PlotWorkSpaceRequest) XXX_Size() int {
+	return xxx_messageInfo_PlotWorkSpaceRequest.Size(m)
 }
-
-var xxx_messageInfo_ConfigureSpaceKeeperByDirsRequest proto.InternalMessageInfo
-
-func (m *ConfigureSpaceKeeperByDirsRequest) GetAllocations() []*ConfigureSpaceKeeperByDirsRequest_Allocation {
-	if m != nil {
-		return m.Allocations
-	}
-	return nil
+func (m *PlotWorkSpaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlotWorkSpaceRequest.DiscardUnknown(m)
 }
 
-func (m *ConfigureSpaceKeeperByDirsRequest) GetPayoutAddresses() []string {
-	if m != nil {
-		return m.PayoutAddresses
-	}
-	return nil
-}
+var xxx_messageInfo_PlotWorkSpaceRequest proto.InternalMessageInfo
 
-func (m *ConfigureSpaceKeeperByDirsRequest) GetPassphrase() string {
+func (m *PlotWorkSpaceRequest) GetSpaceId() string {
 	if m != nil {
-		return m.Passphrase
+		return m.SpaceId
 	}
 	return ""
 }
 
-func (m *ConfigureSpaceKeeperByDirsRequest) GetCointype() uint32 {
-	if m != nil {
-		return m.Cointype
-	}
-	return 0
-}
-
-func (m *ConfigureSpaceKeeperByDirsRequest) GetAutoCreate() int32 {
+func (m *PlotWorkSpaceRequest) GetThreads() uint32 {
 	if m != nil {
-		return m.AutoCreate
+		return m.Threads
 	}
 	return 0
 }
 
-type ConfigureSpaceKeeperByDirsRequest_Allocation struct {
-	Directory            string   `protobuf:"bytes,1,opt,name=directory,proto3" json:"directory,omitempty"`
-	Capacity             uint64   `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+type VerifyWorkSpaceRequest struct {
+	SpaceId              string   `protobuf:"bytes,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Samples              uint32   `protobuf:"varint,2,opt,name=samples,proto3" json:"samples,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ConfigureSpaceKeeperByDirsRequest_Allocation) Reset() {
-	*m = ConfigureSpaceKeeperByDirsRequest_Allocation{}
-}
-func (m *ConfigureSpaceKeeperByDirsRequest_Allocation) String() string {
-	return proto.CompactTextString(m)
-}
-func (*ConfigureSpaceKeeperByDirsRequest_Allocation) ProtoMessage() {}
-func (*ConfigureSpaceKeeperByDirsRequest_Allocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{51, 0}
+func (m *VerifyWorkSpaceRequest) Reset()         { *m = VerifyWorkSpaceRequest{} }
+func (m *VerifyWorkSpaceRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyWorkSpaceRequest) ProtoMessage()    {}
+func (*VerifyWorkSpaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{56}
 }
-func (m *ConfigureSpaceKeeperByDirsRequest_Allocation) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ConfigureSpaceKeeperByDirsRequest_Allocation.Unmarshal(m, b)
+func (m *VerifyWorkSpaceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyWorkSpaceRequest.Unmarshal(m, b)
 }
-func (m *ConfigureSpaceKeeperByDirsRequest_Allocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ConfigureSpaceKeeperByDirsRequest_Allocation.Marshal(b, m, deterministic)
+func (m *VerifyWorkSpaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyWorkSpaceRequest.Marshal(b, m, deterministic)
 }
-func (m *ConfigureSpaceKeeperByDirsRequest_Allocation) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConfigureSpaceKeeperByDirsRequest_Allocation.Merge(m, src)
+func (m *VerifyWorkSpaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyWorkSpaceRequest.Merge(m, src)
 }
-func (m *ConfigureSpaceKeeperByDirsRequest_Allocation) XXX_Size() int {
-	return xxx_messageInfo_ConfigureSpaceKeeperByDirsRequest_Allocation.Size(m)
+func (m *VerifyWorkSpaceRequest) XXX_Size() int {
+	return xxx_messageInfo_VerifyWorkSpaceRequest.Size(m)
 }
-func (m *ConfigureSpaceKeeperByDirsRequest_Allocation) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConfigureSpaceKeeperByDirsRequest_Allocation.DiscardUnknown(m)
+func (m *VerifyWorkSpaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyWorkSpaceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ConfigureSpaceKeeperByDirsRequest_Allocation proto.InternalMessageInfo
+var xxx_messageInfo_VerifyWorkSpaceRequest proto.InternalMessageInfo
 
-func (m *ConfigureSpaceKeeperByDirsRequest_Allocation) GetDirectory() string {
+func (m *VerifyWorkSpaceRequest) GetSpaceId() string {
 	if m != nil {
-		return m.Directory
+		return m.SpaceId
 	}
 	return ""
 }
 
-func (m *ConfigureSpaceKeeperByDirsRequest_Allocation) GetCapacity() uint64 {
+func (m *VerifyWorkSpaceRequest) GetSamples() uint32 {
 	if m != nil {
-		return m.Capacity
+		return m.Samples
 	}
 	return 0
 }
 
-type WorkSpacesByDirsResponse struct {
-	DirectoryCount       uint32                                 `protobuf:"varint,1,opt,name=directory_count,json=directoryCount,proto3" json:"directory_count,omitempty"`
-	Allocations          []*WorkSpacesByDirsResponse_Allocation `protobuf:"bytes,2,rep,name=allocations,proto3" json:"allocations,omitempty"`
-	ErrorCode            uint32                                 `protobuf:"varint,3,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
-	ErrorMessage         string                                 `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                               `json:"-"`
-	XXX_unrecognized     []byte                                 `json:"-"`
-	XXX_sizecache        int32                                  `json:"-"`
+type VerifyWorkSpaceResponse struct {
+	SpaceId              string                                  `protobuf:"bytes,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	FilePath             string                                  `protobuf:"bytes,2,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	BitLength            uint32                                  `protobuf:"varint,3,opt,name=bit_length,json=bitLength,proto3" json:"bit_length,omitempty"`
+	Sampled              uint64                                  `protobuf:"varint,4,opt,name=sampled,proto3" json:"sampled,omitempty"`
+	Valid                uint64                                  `protobuf:"varint,5,opt,name=valid,proto3" json:"valid,omitempty"`
+	Empty                uint64                                  `protobuf:"varint,6,opt,name=empty,proto3" json:"empty,omitempty"`
+	Corrupted            uint64                                  `protobuf:"varint,7,opt,name=corrupted,proto3" json:"corrupted,omitempty"`
+	Health               float64                                 `protobuf:"fixed64,8,opt,name=health,proto3" json:"health,omitempty"`
+	Ranges               []*VerifyWorkSpaceResponse_CorruptRange `protobuf:"bytes,9,rep,name=ranges,proto3" json:"ranges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
+	XXX_unrecognized     []byte                                  `json:"-"`
+	XXX_sizecache        int32                                   `json:"-"`
 }
 
-func (m *WorkSpacesByDirsResponse) Reset()         { *m = WorkSpacesByDirsResponse{} }
-func (m *WorkSpacesByDirsResponse) String() string { return proto.CompactTextString(m) }
-func (*WorkSpacesByDirsResponse) ProtoMessage()    {}
-func (*WorkSpacesByDirsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{52}
+func (m *VerifyWorkSpaceResponse) Reset()         { *m = VerifyWorkSpaceResponse{} }
+func (m *VerifyWorkSpaceResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyWorkSpaceResponse) ProtoMessage()    {}
+func (*VerifyWorkSpaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{57}
 }
-func (m *WorkSpacesByDirsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_WorkSpacesByDirsResponse.Unmarshal(m, b)
+func (m *VerifyWorkSpaceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyWorkSpaceResponse.Unmarshal(m, b)
 }
-func (m *WorkSpacesByDirsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_WorkSpacesByDirsResponse.Marshal(b, m, deterministic)
+func (m *VerifyWorkSpaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyWorkSpaceResponse.Marshal(b, m, deterministic)
 }
-func (m *WorkSpacesByDirsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WorkSpacesByDirsResponse.Merge(m, src)
+func (m *VerifyWorkSpaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyWorkSpaceResponse.Merge(m, src)
 }
-func (m *WorkSpacesByDirsResponse) XXX_Size() int {
-	return xxx_messageInfo_WorkSpacesByDirsResponse.Size(m)
+func (m *VerifyWorkSpaceResponse) XXX_Size() int {
+	return xxx_messageInfo_VerifyWorkSpaceResponse.Size(m)
 }
-func (m *WorkSpacesByDirsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_WorkSpacesByDirsResponse.DiscardUnknown(m)
+func (m *VerifyWorkSpaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyWorkSpaceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WorkSpacesByDirsResponse proto.InternalMessageInfo
+var xxx_messageInfo_VerifyWorkSpaceResponse proto.InternalMessageInfo
 
-func (m *WorkSpacesByDirsResponse) GetDirectoryCount() uint32 {
+func (m *VerifyWorkSpaceResponse) GetSpaceId() string {
 	if m != nil {
-		return m.DirectoryCount
+		return m.SpaceId
 	}
-	return 0
+	return ""
 }
 
-func (m *WorkSpacesByDirsResponse) GetAllocations() []*WorkSpacesByDirsResponse_Allocation {
+func (m *VerifyWorkSpaceResponse) GetFilePath() string {
 	if m != nil {
-		return m.Allocations
+		return m.FilePath
 	}
-	return nil
+	return ""
 }
 
-func (m *WorkSpacesByDirsResponse) GetErrorCode() uint32 {
+func (m *VerifyWorkSpaceResponse) GetBitLength() uint32 {
 	if m != nil {
-		return m.ErrorCode
+		return m.BitLength
 	}
 	return 0
 }
 
-func (m *WorkSpacesByDirsResponse) GetErrorMessage() string {
+func (m *VerifyWorkSpaceResponse) GetSampled() uint64 {
 	if m != nil {
-		return m.ErrorMessage
+		return m.Sampled
 	}
-	return ""
-}
-
-type WorkSpacesByDirsResponse_Allocation struct {
-	Directory            string       `protobuf:"bytes,1,opt,name=directory,proto3" json:"directory,omitempty"`
-	Capacity             string       `protobuf:"bytes,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	SpaceCount           uint32       `protobuf:"varint,3,opt,name=space_count,json=spaceCount,proto3" json:"space_count,omitempty"`
-	Spaces               []*WorkSpace `protobuf:"bytes,4,rep,name=spaces,proto3" json:"spaces,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	return 0
 }
 
-func (m *WorkSpacesByDirsResponse_Allocation) Reset()         { *m = WorkSpacesByDirsResponse_Allocation{} }
-func (m *WorkSpacesByDirsResponse_Allocation) String() string { return proto.CompactTextString(m) }
-func (*WorkSpacesByDirsResponse_Allocation) ProtoMessage()    {}
-func (*WorkSpacesByDirsResponse_Allocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{52, 0}
-}
-func (m *WorkSpacesByDirsResponse_Allocation) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_WorkSpacesByDirsResponse_Allocation.Unmarshal(m, b)
-}
-func (m *WorkSpacesByDirsResponse_Allocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_WorkSpacesByDirsResponse_Allocation.Marshal(b, m, deterministic)
-}
-func (m *WorkSpacesByDirsResponse_Allocation) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WorkSpacesByDirsResponse_Allocation.Merge(m, src)
-}
-func (m *WorkSpacesByDirsResponse_Allocation) XXX_Size() int {
-	return xxx_messageInfo_WorkSpacesByDirsResponse_Allocation.Size(m)
-}
-func (m *WorkSpacesByDirsResponse_Allocation) XXX_DiscardUnknown() {
-	xxx_messageInfo_WorkSpacesByDirsResponse_Allocation.DiscardUnknown(m)
+func (m *VerifyWorkSpaceResponse) GetValid() uint64 {
+	if m != nil {
+		return m.Valid
+	}
+	return 0
 }
 
-var xxx_messageInfo_WorkSpacesByDirsResponse_Allocation proto.InternalMessageInfo
-
-func (m *WorkSpacesByDirsResponse_Allocation) GetDirectory() string {
+func (m *VerifyWorkSpaceResponse) GetEmpty() uint64 {
 	if m != nil {
-		return m.Directory
+		return m.Empty
 	}
-	return ""
+	return 0
 }
 
-func (m *WorkSpacesByDirsResponse_Allocation) GetCapacity() string {
+func (m *VerifyWorkSpaceResponse) GetCorrupted() uint64 {
 	if m != nil {
-		return m.Capacity
+		return m.Corrupted
 	}
-	return ""
+	return 0
 }
 
-func (m *WorkSpacesByDirsResponse_Allocation) GetSpaceCount() uint32 {
+func (m *VerifyWorkSpaceResponse) GetHealth() float64 {
 	if m != nil {
-		return m.SpaceCount
+		return m.Health
 	}
 	return 0
 }
 
-func (m *WorkSpacesByDirsResponse_Allocation) GetSpaces() []*WorkSpace {
+func (m *VerifyWorkSpaceResponse) GetRanges() []*VerifyWorkSpaceResponse_CorruptRange {
 	if m != nil {
-		return m.Spaces
+		return m.Ranges
 	}
 	return nil
 }
 
-type GetClientStatusResponse struct {
-	Version              string                                `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
-	PeerListening        bool                                  `protobuf:"varint,2,opt,name=peer_listening,json=peerListening,proto3" json:"peer_listening,omitempty"`
-	Syncing              bool                                  `protobuf:"varint,3,opt,name=syncing,proto3" json:"syncing,omitempty"`
-	Mining               bool                                  `protobuf:"varint,4,opt,name=mining,proto3" json:"mining,omitempty"`
-	SpaceKeeping         bool                                  `protobuf:"varint,5,opt,name=space_keeping,json=spaceKeeping,proto3" json:"space_keeping,omitempty"`
-	ChainId              string                                `protobuf:"bytes,6,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	LocalBestHeight      uint64                                `protobuf:"varint,7,opt,name=local_best_height,json=localBestHeight,proto3" json:"local_best_height,omitempty"`
-	KnownBestHeight      uint64                                `protobuf:"varint,8,opt,name=known_best_height,json=knownBestHeight,proto3" json:"known_best_height,omitempty"`
-	P2PId                string                                `protobuf:"bytes,9,opt,name=p2p_id,json=p2pId,proto3" json:"p2p_id,omitempty"`
-	PeerCount            *GetClientStatusResponsePeerCountInfo `protobuf:"bytes,10,opt,name=peer_count,json=peerCount,proto3" json:"peer_count,omitempty"`
-	Peers                *GetClientStatusResponsePeerList      `protobuf:"bytes,11,opt,name=peers,proto3" json:"peers,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
-	XXX_unrecognized     []byte                                `json:"-"`
-	XXX_sizecache        int32                                 `json:"-"`
+type VerifyWorkSpaceResponse_CorruptRange struct {
+	Offset               int64    `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Length               int64    `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetClientStatusResponse) Reset()         { *m = GetClientStatusResponse{} }
-func (m *GetClientStatusResponse) String() string { return proto.CompactTextString(m) }
-func (*GetClientStatusResponse) ProtoMessage()    {}
-func (*GetClientStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{53}
+func (m *VerifyWorkSpaceResponse_CorruptRange) Reset()         { *m = VerifyWorkSpaceResponse_CorruptRange{} }
+func (m *VerifyWorkSpaceResponse_CorruptRange) String() string { return proto.CompactTextString(m) }
+func (*VerifyWorkSpaceResponse_CorruptRange) ProtoMessage()    {}
+func (*VerifyWorkSpaceResponse_CorruptRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{57, 0}
 }
-func (m *GetClientStatusResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetClientStatusResponse.Unmarshal(m, b)
+func (m *VerifyWorkSpaceResponse_CorruptRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyWorkSpaceResponse_CorruptRange.Unmarshal(m, b)
 }
-func (m *GetClientStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetClientStatusResponse.Marshal(b, m, deterministic)
+func (m *VerifyWorkSpaceResponse_CorruptRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyWorkSpaceResponse_CorruptRange.Marshal(b, m, deterministic)
 }
-func (m *GetClientStatusResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetClientStatusResponse.Merge(m, src)
+func (m *VerifyWorkSpaceResponse_CorruptRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyWorkSpaceResponse_CorruptRange.Merge(m, src)
 }
-func (m *GetClientStatusResponse) XXX_Size() int {
-	return xxx_messageInfo_GetClientStatusResponse.Size(m)
+func (m *VerifyWorkSpaceResponse_CorruptRange) XXX_Size() int {
+	return xxx_messageInfo_VerifyWorkSpaceResponse_CorruptRange.Size(m)
 }
-func (m *GetClientStatusResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetClientStatusResponse.DiscardUnknown(m)
+func (m *VerifyWorkSpaceResponse_CorruptRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyWorkSpaceResponse_CorruptRange.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetClientStatusResponse proto.InternalMessageInfo
+var xxx_messageInfo_VerifyWorkSpaceResponse_CorruptRange proto.InternalMessageInfo
 
-func (m *GetClientStatusResponse) GetVersion() string {
+func (m *VerifyWorkSpaceResponse_CorruptRange) GetOffset() int64 {
 	if m != nil {
-		return m.Version
+		return m.Offset
 	}
-	return ""
+	return 0
 }
 
-func (m *GetClientStatusResponse) GetPeerListening() bool {
+func (m *VerifyWorkSpaceResponse_CorruptRange) GetLength() int64 {
 	if m != nil {
-		return m.PeerListening
+		return m.Length
 	}
-	return false
+	return 0
 }
 
-func (m *GetClientStatusResponse) GetSyncing() bool {
+type GetPlotQueueResponse struct {
+	Plots                []*GetPlotQueueResponse_Plot `protobuf:"bytes,1,rep,name=plots,proto3" json:"plots,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *GetPlotQueueResponse) Reset()         { *m = GetPlotQueueResponse{} }
+func (m *GetPlotQueueResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPlotQueueResponse) ProtoMessage()    {}
+func (*GetPlotQueueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{58}
+}
+func (m *GetPlotQueueResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPlotQueueResponse.Unmarshal(m, b)
+}
+func (m *GetPlotQueueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPlotQueueResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPlotQueueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPlotQueueResponse.Merge(m, src)
+}
+func (m *GetPlotQueueResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPlotQueueResponse.Size(m)
+}
+func (m *GetPlotQueueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPlotQueueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPlotQueueResponse proto.InternalMessageInfo
+
+func (m *GetPlotQueueResponse) GetPlots() []*GetPlotQueueResponse_Plot {
 	if m != nil {
-		return m.Syncing
+		return m.Plots
 	}
-	return false
+	return nil
 }
 
-func (m *GetClientStatusResponse) GetMining() bool {
+type GetPlotQueueResponse_Plot struct {
+	SpaceId              string   `protobuf:"bytes,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	State                string   `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Position             uint32   `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	Progress             float64  `protobuf:"fixed64,4,opt,name=progress,proto3" json:"progress,omitempty"`
+	Threads              uint32   `protobuf:"varint,5,opt,name=threads,proto3" json:"threads,omitempty"`
+	Memory               uint64   `protobuf:"varint,6,opt,name=memory,proto3" json:"memory,omitempty"`
+	Disk                 string   `protobuf:"bytes,7,opt,name=disk,proto3" json:"disk,omitempty"`
+	Throughput           float64  `protobuf:"fixed64,8,opt,name=throughput,proto3" json:"throughput,omitempty"`
+	Eta                  int64    `protobuf:"varint,9,opt,name=eta,proto3" json:"eta,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPlotQueueResponse_Plot) Reset()         { *m = GetPlotQueueResponse_Plot{} }
+func (m *GetPlotQueueResponse_Plot) String() string { return proto.CompactTextString(m) }
+func (*GetPlotQueueResponse_Plot) ProtoMessage()    {}
+func (*GetPlotQueueResponse_Plot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{58, 0}
+}
+func (m *GetPlotQueueResponse_Plot) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPlotQueueResponse_Plot.Unmarshal(m, b)
+}
+func (m *GetPlotQueueResponse_Plot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPlotQueueResponse_Plot.Marshal(b, m, deterministic)
+}
+func (m *GetPlotQueueResponse_Plot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPlotQueueResponse_Plot.Merge(m, src)
+}
+func (m *GetPlotQueueResponse_Plot) XXX_Size() int {
+	return xxx_messageInfo_GetPlotQueueResponse_Plot.Size(m)
+}
+func (m *GetPlotQueueResponse_Plot) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPlotQueueResponse_Plot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPlotQueueResponse_Plot proto.InternalMessageInfo
+
+func (m *GetPlotQueueResponse_Plot) GetSpaceId() string {
 	if m != nil {
-		return m.Mining
+		return m.SpaceId
 	}
-	return false
+	return ""
 }
 
-func (m *GetClientStatusResponse) GetSpaceKeeping() bool {
+func (m *GetPlotQueueResponse_Plot) GetState() string {
 	if m != nil {
-		return m.SpaceKeeping
+		return m.State
 	}
-	return false
+	return ""
 }
 
-func (m *GetClientStatusResponse) GetChainId() string {
+func (m *GetPlotQueueResponse_Plot) GetPosition() uint32 {
 	if m != nil {
-		return m.ChainId
+		return m.Position
 	}
-	return ""
+	return 0
 }
 
-func (m *GetClientStatusResponse) GetLocalBestHeight() uint64 {
+func (m *GetPlotQueueResponse_Plot) GetProgress() float64 {
 	if m != nil {
-		return m.LocalBestHeight
+		return m.Progress
 	}
 	return 0
 }
 
-func (m *GetClientStatusResponse) GetKnownBestHeight() uint64 {
+func (m *GetPlotQueueResponse_Plot) GetThreads() uint32 {
 	if m != nil {
-		return m.KnownBestHeight
+		return m.Threads
 	}
 	return 0
 }
 
-func (m *GetClientStatusResponse) GetP2PId() string {
+func (m *GetPlotQueueResponse_Plot) GetMemory() uint64 {
 	if m != nil {
-		return m.P2PId
+		return m.Memory
+	}
+	return 0
+}
+
+func (m *GetPlotQueueResponse_Plot) GetDisk() string {
+	if m != nil {
+		return m.Disk
 	}
 	return ""
 }
 
-func (m *GetClientStatusResponse) GetPeerCount() *GetClientStatusResponsePeerCountInfo {
+func (m *GetPlotQueueResponse_Plot) GetThroughput() float64 {
 	if m != nil {
-		return m.PeerCount
+		return m.Throughput
 	}
-	return nil
+	return 0
 }
 
-func (m *GetClientStatusResponse) GetPeers() *GetClientStatusResponsePeerList {
+func (m *GetPlotQueueResponse_Plot) GetEta() int64 {
 	if m != nil {
-		return m.Peers
+		return m.Eta
 	}
-	return nil
+	return 0
 }
 
-type GetClientStatusResponsePeerCountInfo struct {
-	Total                uint32   `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	Outbound             uint32   `protobuf:"varint,2,opt,name=outbound,proto3" json:"outbound,omitempty"`
-	Inbound              uint32   `protobuf:"varint,3,opt,name=inbound,proto3" json:"inbound,omitempty"`
+type MoveCapacitySpaceRequest struct {
+	SpaceId              string   `protobuf:"bytes,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	TargetDir            string   `protobuf:"bytes,2,opt,name=target_dir,json=targetDir,proto3" json:"target_dir,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetClientStatusResponsePeerCountInfo) Reset()         { *m = GetClientStatusResponsePeerCountInfo{} }
-func (m *GetClientStatusResponsePeerCountInfo) String() string { return proto.CompactTextString(m) }
-func (*GetClientStatusResponsePeerCountInfo) ProtoMessage()    {}
-func (*GetClientStatusResponsePeerCountInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{53, 0}
+func (m *MoveCapacitySpaceRequest) Reset()         { *m = MoveCapacitySpaceRequest{} }
+func (m *MoveCapacitySpaceRequest) String() string { return proto.CompactTextString(m) }
+func (*MoveCapacitySpaceRequest) ProtoMessage()    {}
+func (*MoveCapacitySpaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{59}
 }
-func (m *GetClientStatusResponsePeerCountInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetClientStatusResponsePeerCountInfo.Unmarshal(m, b)
+func (m *MoveCapacitySpaceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoveCapacitySpaceRequest.Unmarshal(m, b)
 }
-func (m *GetClientStatusResponsePeerCountInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetClientStatusResponsePeerCountInfo.Marshal(b, m, deterministic)
+func (m *MoveCapacitySpaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoveCapacitySpaceRequest.Marshal(b, m, deterministic)
 }
-func (m *GetClientStatusResponsePeerCountInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetClientStatusResponsePeerCountInfo.Merge(m, src)
+func (m *MoveCapacitySpaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveCapacitySpaceRequest.Merge(m, src)
 }
-func (m *GetClientStatusResponsePeerCountInfo) XXX_Size() int {
-	return xxx_messageInfo_GetClientStatusResponsePeerCountInfo.Size(m)
+func (m *MoveCapacitySpaceRequest) XXX_Size() int {
+	return xxx_messageInfo_MoveCapacitySpaceRequest.Size(m)
 }
-func (m *GetClientStatusResponsePeerCountInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetClientStatusResponsePeerCountInfo.DiscardUnknown(m)
+func (m *MoveCapacitySpaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveCapacitySpaceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetClientStatusResponsePeerCountInfo proto.InternalMessageInfo
+var xxx_messageInfo_MoveCapacitySpaceRequest proto.InternalMessageInfo
 
-func (m *GetClientStatusResponsePeerCountInfo) GetTotal() uint32 {
+func (m *MoveCapacitySpaceRequest) GetSpaceId() string {
 	if m != nil {
-		return m.Total
+		return m.SpaceId
 	}
-	return 0
+	return ""
 }
 
-func (m *GetClientStatusResponsePeerCountInfo) GetOutbound() uint32 {
+func (m *MoveCapacitySpaceRequest) GetTargetDir() string {
 	if m != nil {
-		return m.Outbound
+		return m.TargetDir
 	}
-	return 0
+	return ""
 }
 
-func (m *GetClientStatusResponsePeerCountInfo) GetInbound() uint32 {
+type RebalanceCapacitySpacesRequest struct {
+	Dirs                 []string `protobuf:"bytes,1,rep,name=dirs,proto3" json:"dirs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RebalanceCapacitySpacesRequest) Reset()         { *m = RebalanceCapacitySpacesRequest{} }
+func (m *RebalanceCapacitySpacesRequest) String() string { return proto.CompactTextString(m) }
+func (*RebalanceCapacitySpacesRequest) ProtoMessage()    {}
+func (*RebalanceCapacitySpacesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{60}
+}
+func (m *RebalanceCapacitySpacesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RebalanceCapacitySpacesRequest.Unmarshal(m, b)
+}
+func (m *RebalanceCapacitySpacesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RebalanceCapacitySpacesRequest.Marshal(b, m, deterministic)
+}
+func (m *RebalanceCapacitySpacesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebalanceCapacitySpacesRequest.Merge(m, src)
+}
+func (m *RebalanceCapacitySpacesRequest) XXX_Size() int {
+	return xxx_messageInfo_RebalanceCapacitySpacesRequest.Size(m)
+}
+func (m *RebalanceCapacitySpacesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebalanceCapacitySpacesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebalanceCapacitySpacesRequest proto.InternalMessageInfo
+
+func (m *RebalanceCapacitySpacesRequest) GetDirs() []string {
 	if m != nil {
-		return m.Inbound
+		return m.Dirs
 	}
-	return 0
+	return nil
 }
 
-type GetClientStatusResponsePeerInfo struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Direction            string   `protobuf:"bytes,3,opt,name=direction,proto3" json:"direction,omitempty"`
+type SpaceMove struct {
+	SpaceId              string   `protobuf:"bytes,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	From                 string   `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string   `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	State                string   `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	Bytes                uint64   `protobuf:"varint,5,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Copied               uint64   `protobuf:"varint,6,opt,name=copied,proto3" json:"copied,omitempty"`
+	Error                string   `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetClientStatusResponsePeerInfo) Reset()         { *m = GetClientStatusResponsePeerInfo{} }
-func (m *GetClientStatusResponsePeerInfo) String() string { return proto.CompactTextString(m) }
-func (*GetClientStatusResponsePeerInfo) ProtoMessage()    {}
-func (*GetClientStatusResponsePeerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{53, 1}
+func (m *SpaceMove) Reset()         { *m = SpaceMove{} }
+func (m *SpaceMove) String() string { return proto.CompactTextString(m) }
+func (*SpaceMove) ProtoMessage()    {}
+func (*SpaceMove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{61}
 }
-func (m *GetClientStatusResponsePeerInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetClientStatusResponsePeerInfo.Unmarshal(m, b)
+func (m *SpaceMove) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SpaceMove.Unmarshal(m, b)
 }
-func (m *GetClientStatusResponsePeerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetClientStatusResponsePeerInfo.Marshal(b, m, deterministic)
+func (m *SpaceMove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SpaceMove.Marshal(b, m, deterministic)
 }
-func (m *GetClientStatusResponsePeerInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetClientStatusResponsePeerInfo.Merge(m, src)
+func (m *SpaceMove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpaceMove.Merge(m, src)
 }
-func (m *GetClientStatusResponsePeerInfo) XXX_Size() int {
-	return xxx_messageInfo_GetClientStatusResponsePeerInfo.Size(m)
+func (m *SpaceMove) XXX_Size() int {
+	return xxx_messageInfo_SpaceMove.Size(m)
 }
-func (m *GetClientStatusResponsePeerInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetClientStatusResponsePeerInfo.DiscardUnknown(m)
+func (m *SpaceMove) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpaceMove.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetClientStatusResponsePeerInfo proto.InternalMessageInfo
+var xxx_messageInfo_SpaceMove proto.InternalMessageInfo
 
-func (m *GetClientStatusResponsePeerInfo) GetId() string {
+func (m *SpaceMove) GetSpaceId() string {
 	if m != nil {
-		return m.Id
+		return m.SpaceId
 	}
 	return ""
 }
 
-func (m *GetClientStatusResponsePeerInfo) GetAddress() string {
+func (m *SpaceMove) GetFrom() string {
 	if m != nil {
-		return m.Address
+		return m.From
 	}
 	return ""
 }
 
-func (m *GetClientStatusResponsePeerInfo) GetDirection() string {
+func (m *SpaceMove) GetTo() string {
 	if m != nil {
-		return m.Direction
+		return m.To
 	}
 	return ""
 }
 
-type GetClientStatusResponsePeerList struct {
-	Outbound             []*GetClientStatusResponsePeerInfo `protobuf:"bytes,1,rep,name=outbound,proto3" json:"outbound,omitempty"`
-	Inbound              []*GetClientStatusResponsePeerInfo `protobuf:"bytes,2,rep,name=inbound,proto3" json:"inbound,omitempty"`
-	Other                []*GetClientStatusResponsePeerInfo `protobuf:"bytes,3,rep,name=other,proto3" json:"other,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
-	XXX_unrecognized     []byte                             `json:"-"`
-	XXX_sizecache        int32                              `json:"-"`
+func (m *SpaceMove) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
 }
 
-func (m *GetClientStatusResponsePeerList) Reset()         { *m = GetClientStatusResponsePeerList{} }
-func (m *GetClientStatusResponsePeerList) String() string { return proto.CompactTextString(m) }
-func (*GetClientStatusResponsePeerList) ProtoMessage()    {}
-func (*GetClientStatusResponsePeerList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{53, 2}
-}
-func (m *GetClientStatusResponsePeerList) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetClientStatusResponsePeerList.Unmarshal(m, b)
-}
-func (m *GetClientStatusResponsePeerList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetClientStatusResponsePeerList.Marshal(b, m, deterministic)
-}
-func (m *GetClientStatusResponsePeerList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetClientStatusResponsePeerList.Merge(m, src)
-}
-func (m *GetClientStatusResponsePeerList) XXX_Size() int {
-	return xxx_messageInfo_GetClientStatusResponsePeerList.Size(m)
-}
-func (m *GetClientStatusResponsePeerList) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetClientStatusResponsePeerList.DiscardUnknown(m)
+func (m *SpaceMove) GetBytes() uint64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
 }
 
-var xxx_messageInfo_GetClientStatusResponsePeerList proto.InternalMessageInfo
-
-func (m *GetClientStatusResponsePeerList) GetOutbound() []*GetClientStatusResponsePeerInfo {
+func (m *SpaceMove) GetCopied() uint64 {
 	if m != nil {
-		return m.Outbound
+		return m.Copied
 	}
-	return nil
+	return 0
 }
 
-func (m *GetClientStatusResponsePeerList) GetInbound() []*GetClientStatusResponsePeerInfo {
+func (m *SpaceMove) GetError() string {
 	if m != nil {
-		return m.Inbound
+		return m.Error
 	}
-	return nil
+	return ""
 }
 
-func (m *GetClientStatusResponsePeerList) GetOther() []*GetClientStatusResponsePeerInfo {
+type GetCapacitySpaceMovesResponse struct {
+	Moves                []*SpaceMove `protobuf:"bytes,1,rep,name=moves,proto3" json:"moves,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GetCapacitySpaceMovesResponse) Reset()         { *m = GetCapacitySpaceMovesResponse{} }
+func (m *GetCapacitySpaceMovesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCapacitySpaceMovesResponse) ProtoMessage()    {}
+func (*GetCapacitySpaceMovesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{62}
+}
+func (m *GetCapacitySpaceMovesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCapacitySpaceMovesResponse.Unmarshal(m, b)
+}
+func (m *GetCapacitySpaceMovesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCapacitySpaceMovesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCapacitySpaceMovesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCapacitySpaceMovesResponse.Merge(m, src)
+}
+func (m *GetCapacitySpaceMovesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCapacitySpaceMovesResponse.Size(m)
+}
+func (m *GetCapacitySpaceMovesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCapacitySpaceMovesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCapacitySpaceMovesResponse proto.InternalMessageInfo
+
+func (m *GetCapacitySpaceMovesResponse) GetMoves() []*SpaceMove {
 	if m != nil {
-		return m.Other
+		return m.Moves
 	}
 	return nil
 }
 
-type QuitClientResponse struct {
-	ErrorCode            uint32   `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
-	Msg                  string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// PlanCapacityRequest plans as ConfigureCapacity if allocations are empty,
+// otherwise as ConfigureCapacityByDirs.
+type PlanCapacityRequest struct {
+	Capacity             uint64                                          `protobuf:"varint,1,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Allocations          []*ConfigureSpaceKeeperByDirsRequest_Allocation `protobuf:"bytes,2,rep,name=allocations,proto3" json:"allocations,omitempty"`
+	Cointype             uint32                                          `protobuf:"varint,3,opt,name=cointype,proto3" json:"cointype,omitempty"`
+	AutoCreate           int32                                           `protobuf:"varint,4,opt,name=auto_create,json=autoCreate,proto3" json:"auto_create,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                        `json:"-"`
+	XXX_unrecognized     []byte                                          `json:"-"`
+	XXX_sizecache        int32                                           `json:"-"`
 }
 
-func (m *QuitClientResponse) Reset()         { *m = QuitClientResponse{} }
-func (m *QuitClientResponse) String() string { return proto.CompactTextString(m) }
-func (*QuitClientResponse) ProtoMessage()    {}
-func (*QuitClientResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{54}
+func (m *PlanCapacityRequest) Reset()         { *m = PlanCapacityRequest{} }
+func (m *PlanCapacityRequest) String() string { return proto.CompactTextString(m) }
+func (*PlanCapacityRequest) ProtoMessage()    {}
+func (*PlanCapacityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{63}
 }
-func (m *QuitClientResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_QuitClientResponse.Unmarshal(m, b)
+func (m *PlanCapacityRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlanCapacityRequest.Unmarshal(m, b)
 }
-func (m *QuitClientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_QuitClientResponse.Marshal(b, m, deterministic)
+func (m *PlanCapacityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlanCapacityRequest.Marshal(b, m, deterministic)
 }
-func (m *QuitClientResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuitClientResponse.Merge(m, src)
+func (m *PlanCapacityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanCapacityRequest.Merge(m, src)
 }
-func (m *QuitClientResponse) XXX_Size() int {
-	return xxx_messageInfo_QuitClientResponse.Size(m)
+func (m *PlanCapacityRequest) XXX_Size() int {
+	return xxx_messageInfo_PlanCapacityRequest.Size(m)
 }
-func (m *QuitClientResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuitClientResponse.DiscardUnknown(m)
+func (m *PlanCapacityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanCapacityRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuitClientResponse proto.InternalMessageInfo
+var xxx_messageInfo_PlanCapacityRequest proto.InternalMessageInfo
 
-func (m *QuitClientResponse) GetErrorCode() uint32 {
+func (m *PlanCapacityRequest) GetCapacity() uint64 {
 	if m != nil {
-		return m.ErrorCode
+		return m.Capacity
 	}
 	return 0
 }
 
-func (m *QuitClientResponse) GetMsg() string {
+func (m *PlanCapacityRequest) GetAllocations() []*ConfigureSpaceKeeperByDirsRequest_Allocation {
 	if m != nil {
-		return m.Msg
+		return m.Allocations
 	}
-	return ""
+	return nil
 }
 
-type GetBlockHeightByPubKeyRequest struct {
-	PublicKey            string   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *PlanCapacityRequest) GetCointype() uint32 {
+	if m != nil {
+		return m.Cointype
+	}
+	return 0
 }
 
-func (m *GetBlockHeightByPubKeyRequest) Reset()         { *m = GetBlockHeightByPubKeyRequest{} }
-func (m *GetBlockHeightByPubKeyRequest) String() string { return proto.CompactTextString(m) }
-func (*GetBlockHeightByPubKeyRequest) ProtoMessage()    {}
-func (*GetBlockHeightByPubKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{55}
+func (m *PlanCapacityRequest) GetAutoCreate() int32 {
+	if m != nil {
+		return m.AutoCreate
+	}
+	return 0
 }
-func (m *GetBlockHeightByPubKeyRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetBlockHeightByPubKeyRequest.Unmarshal(m, b)
+
+type CapacityPlan struct {
+	PlanId               string                    `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	Method               string                    `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Directories          []*CapacityPlan_Directory `protobuf:"bytes,3,rep,name=directories,proto3" json:"directories,omitempty"`
+	Disks                []*CapacityPlan_Disk      `protobuf:"bytes,4,rep,name=disks,proto3" json:"disks,omitempty"`
+	Bytes                uint64                    `protobuf:"varint,5,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	PlotBytes            uint64                    `protobuf:"varint,6,opt,name=plot_bytes,json=plotBytes,proto3" json:"plot_bytes,omitempty"`
+	PlotTime             int64                     `protobuf:"varint,7,opt,name=plot_time,json=plotTime,proto3" json:"plot_time,omitempty"`
+	Created              int64                     `protobuf:"varint,8,opt,name=created,proto3" json:"created,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
-func (m *GetBlockHeightByPubKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetBlockHeightByPubKeyRequest.Marshal(b, m, deterministic)
+
+func (m *CapacityPlan) Reset()         { *m = CapacityPlan{} }
+func (m *CapacityPlan) String() string { return proto.CompactTextString(m) }
+func (*CapacityPlan) ProtoMessage()    {}
+func (*CapacityPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{64}
 }
-func (m *GetBlockHeightByPubKeyRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetBlockHeightByPubKeyRequest.Merge(m, src)
+func (m *CapacityPlan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CapacityPlan.Unmarshal(m, b)
 }
-func (m *GetBlockHeightByPubKeyRequest) XXX_Size() int {
-	return xxx_messageInfo_GetBlockHeightByPubKeyRequest.Size(m)
+func (m *CapacityPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CapacityPlan.Marshal(b, m, deterministic)
 }
-func (m *GetBlockHeightByPubKeyRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetBlockHeightByPubKeyRequest.DiscardUnknown(m)
+func (m *CapacityPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CapacityPlan.Merge(m, src)
+}
+func (m *CapacityPlan) XXX_Size() int {
+	return xxx_messageInfo_CapacityPlan.Size(m)
+}
+func (m *CapacityPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_CapacityPlan.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetBlockHeightByPubKeyRequest proto.InternalMessageInfo
+var xxx_messageInfo_CapacityPlan proto.InternalMessageInfo
 
-func (m *GetBlockHeightByPubKeyRequest) GetPublicKey() string {
+func (m *CapacityPlan) GetPlanId() string {
 	if m != nil {
-		return m.PublicKey
+		return m.PlanId
 	}
 	return ""
 }
 
-type GetBlockHeightByPubKeyResponse struct {
-	Heights              []uint64 `protobuf:"varint,1,rep,packed,name=heights,proto3" json:"heights,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *CapacityPlan) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
 }
 
-func (m *GetBlockHeightByPubKeyResponse) Reset()         { *m = GetBlockHeightByPubKeyResponse{} }
-func (m *GetBlockHeightByPubKeyResponse) String() string { return proto.CompactTextString(m) }
-func (*GetBlockHeightByPubKeyResponse) ProtoMessage()    {}
-func (*GetBlockHeightByPubKeyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{56}
-}
-func (m *GetBlockHeightByPubKeyResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetBlockHeightByPubKeyResponse.Unmarshal(m, b)
-}
-func (m *GetBlockHeightByPubKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetBlockHeightByPubKeyResponse.Marshal(b, m, deterministic)
+func (m *CapacityPlan) GetDirectories() []*CapacityPlan_Directory {
+	if m != nil {
+		return m.Directories
+	}
+	return nil
 }
-func (m *GetBlockHeightByPubKeyResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetBlockHeightByPubKeyResponse.Merge(m, src)
+
+func (m *CapacityPlan) GetDisks() []*CapacityPlan_Disk {
+	if m != nil {
+		return m.Disks
+	}
+	return nil
 }
-func (m *GetBlockHeightByPubKeyResponse) XXX_Size() int {
-	return xxx_messageInfo_GetBlockHeightByPubKeyResponse.Size(m)
+
+func (m *CapacityPlan) GetBytes() uint64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
 }
-func (m *GetBlockHeightByPubKeyResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetBlockHeightByPubKeyResponse.DiscardUnknown(m)
+
+func (m *CapacityPlan) GetPlotBytes() uint64 {
+	if m != nil {
+		return m.PlotBytes
+	}
+	return 0
 }
 
-var xxx_messageInfo_GetBlockHeightByPubKeyResponse proto.InternalMessageInfo
+func (m *CapacityPlan) GetPlotTime() int64 {
+	if m != nil {
+		return m.PlotTime
+	}
+	return 0
+}
 
-func (m *GetBlockHeightByPubKeyResponse) GetHeights() []uint64 {
+func (m *CapacityPlan) GetCreated() int64 {
 	if m != nil {
-		return m.Heights
+		return m.Created
 	}
-	return nil
+	return 0
 }
 
-type GetCoinbaseRequest struct {
-	Height               uint64   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+type CapacityPlan_Space struct {
+	SpaceId              string   `protobuf:"bytes,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Ordinal              int64    `protobuf:"varint,2,opt,name=ordinal,proto3" json:"ordinal,omitempty"`
+	BitLength            uint32   `protobuf:"varint,3,opt,name=bit_length,json=bitLength,proto3" json:"bit_length,omitempty"`
+	Reused               bool     `protobuf:"varint,4,opt,name=reused,proto3" json:"reused,omitempty"`
+	Progress             float64  `protobuf:"fixed64,5,opt,name=progress,proto3" json:"progress,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetCoinbaseRequest) Reset()         { *m = GetCoinbaseRequest{} }
-func (m *GetCoinbaseRequest) String() string { return proto.CompactTextString(m) }
-func (*GetCoinbaseRequest) ProtoMessage()    {}
-func (*GetCoinbaseRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{57}
+func (m *CapacityPlan_Space) Reset()         { *m = CapacityPlan_Space{} }
+func (m *CapacityPlan_Space) String() string { return proto.CompactTextString(m) }
+func (*CapacityPlan_Space) ProtoMessage()    {}
+func (*CapacityPlan_Space) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{64, 0}
 }
-func (m *GetCoinbaseRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCoinbaseRequest.Unmarshal(m, b)
+func (m *CapacityPlan_Space) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CapacityPlan_Space.Unmarshal(m, b)
 }
-func (m *GetCoinbaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCoinbaseRequest.Marshal(b, m, deterministic)
+func (m *CapacityPlan_Space) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CapacityPlan_Space.Marshal(b, m, deterministic)
 }
-func (m *GetCoinbaseRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCoinbaseRequest.Merge(m, src)
+func (m *CapacityPlan_Space) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CapacityPlan_Space.Merge(m, src)
 }
-func (m *GetCoinbaseRequest) XXX_Size() int {
-	return xxx_messageInfo_GetCoinbaseRequest.Size(m)
+func (m *CapacityPlan_Space) XXX_Size() int {
+	return xxx_messageInfo_CapacityPlan_Space.Size(m)
 }
-func (m *GetCoinbaseRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCoinbaseRequest.DiscardUnknown(m)
+func (m *CapacityPlan_Space) XXX_DiscardUnknown() {
+	xxx_messageInfo_CapacityPlan_Space.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCoinbaseRequest proto.InternalMessageInfo
+var xxx_messageInfo_CapacityPlan_Space proto.InternalMessageInfo
 
-func (m *GetCoinbaseRequest) GetHeight() uint64 {
+func (m *CapacityPlan_Space) GetSpaceId() string {
 	if m != nil {
-		return m.Height
+		return m.SpaceId
+	}
+	return ""
+}
+
+func (m *CapacityPlan_Space) GetOrdinal() int64 {
+	if m != nil {
+		return m.Ordinal
 	}
 	return 0
 }
 
-type CoinbaseVout struct {
-	Value                string              `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
-	N                    uint32              `protobuf:"varint,2,opt,name=n,proto3" json:"n,omitempty"`
-	ScriptPublicKey      *ScriptPubKeyResult `protobuf:"bytes,3,opt,name=script_public_key,json=scriptPublicKey,proto3" json:"script_public_key,omitempty"`
-	Type                 string              `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+func (m *CapacityPlan_Space) GetBitLength() uint32 {
+	if m != nil {
+		return m.BitLength
+	}
+	return 0
 }
 
-func (m *CoinbaseVout) Reset()         { *m = CoinbaseVout{} }
-func (m *CoinbaseVout) String() string { return proto.CompactTextString(m) }
-func (*CoinbaseVout) ProtoMessage()    {}
-func (*CoinbaseVout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{58}
+func (m *CapacityPlan_Space) GetReused() bool {
+	if m != nil {
+		return m.Reused
+	}
+	return false
 }
-func (m *CoinbaseVout) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CoinbaseVout.Unmarshal(m, b)
+
+func (m *CapacityPlan_Space) GetProgress() float64 {
+	if m != nil {
+		return m.Progress
+	}
+	return 0
 }
-func (m *CoinbaseVout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CoinbaseVout.Marshal(b, m, deterministic)
+
+type CapacityPlan_Directory struct {
+	Directory            string                `protobuf:"bytes,1,opt,name=directory,proto3" json:"directory,omitempty"`
+	Capacity             uint64                `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Bytes                uint64                `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Spaces               []*CapacityPlan_Space `protobuf:"bytes,4,rep,name=spaces,proto3" json:"spaces,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
-func (m *CoinbaseVout) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CoinbaseVout.Merge(m, src)
+
+func (m *CapacityPlan_Directory) Reset()         { *m = CapacityPlan_Directory{} }
+func (m *CapacityPlan_Directory) String() string { return proto.CompactTextString(m) }
+func (*CapacityPlan_Directory) ProtoMessage()    {}
+func (*CapacityPlan_Directory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{64, 1}
 }
-func (m *CoinbaseVout) XXX_Size() int {
-	return xxx_messageInfo_CoinbaseVout.Size(m)
+func (m *CapacityPlan_Directory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CapacityPlan_Directory.Unmarshal(m, b)
 }
-func (m *CoinbaseVout) XXX_DiscardUnknown() {
-	xxx_messageInfo_CoinbaseVout.DiscardUnknown(m)
+func (m *CapacityPlan_Directory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CapacityPlan_Directory.Marshal(b, m, deterministic)
+}
+func (m *CapacityPlan_Directory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CapacityPlan_Directory.Merge(m, src)
+}
+func (m *CapacityPlan_Directory) XXX_Size() int {
+	return xxx_messageInfo_CapacityPlan_Directory.Size(m)
+}
+func (m *CapacityPlan_Directory) XXX_DiscardUnknown() {
+	xxx_messageInfo_CapacityPlan_Directory.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CoinbaseVout proto.InternalMessageInfo
+var xxx_messageInfo_CapacityPlan_Directory proto.InternalMessageInfo
 
-func (m *CoinbaseVout) GetValue() string {
+func (m *CapacityPlan_Directory) GetDirectory() string {
 	if m != nil {
-		return m.Value
+		return m.Directory
 	}
 	return ""
 }
 
-func (m *CoinbaseVout) GetN() uint32 {
+func (m *CapacityPlan_Directory) GetCapacity() uint64 {
 	if m != nil {
-		return m.N
+		return m.Capacity
 	}
 	return 0
 }
 
-func (m *CoinbaseVout) GetScriptPublicKey() *ScriptPubKeyResult {
+func (m *CapacityPlan_Directory) GetBytes() uint64 {
 	if m != nil {
-		return m.ScriptPublicKey
+		return m.Bytes
 	}
-	return nil
+	return 0
 }
 
-func (m *CoinbaseVout) GetType() string {
+func (m *CapacityPlan_Directory) GetSpaces() []*CapacityPlan_Space {
 	if m != nil {
-		return m.Type
+		return m.Spaces
 	}
-	return ""
+	return nil
 }
 
-type GetCoinbaseResponse struct {
-	TxId                 string          `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
-	Version              uint32          `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
-	LockTime             uint64          `protobuf:"varint,3,opt,name=lock_time,json=lockTime,proto3" json:"lock_time,omitempty"`
-	Block                *BlockInfoForTx `protobuf:"bytes,4,opt,name=block,proto3" json:"block,omitempty"`
-	BindingValue         string          `protobuf:"bytes,5,opt,name=binding_value,json=bindingValue,proto3" json:"binding_value,omitempty"`
-	Vin                  []*Vin          `protobuf:"bytes,6,rep,name=vin,proto3" json:"vin,omitempty"`
-	Vout                 []*CoinbaseVout `protobuf:"bytes,7,rep,name=vout,proto3" json:"vout,omitempty"`
-	Payload              string          `protobuf:"bytes,8,opt,name=payload,proto3" json:"payload,omitempty"`
-	Confirmations        uint64          `protobuf:"varint,9,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
-	TxSize               uint32          `protobuf:"varint,10,opt,name=tx_size,json=txSize,proto3" json:"tx_size,omitempty"`
-	TotalFees            string          `protobuf:"bytes,11,opt,name=total_fees,json=totalFees,proto3" json:"total_fees,omitempty"`
-	Status               int32           `protobuf:"varint,12,opt,name=status,proto3" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+type CapacityPlan_Disk struct {
+	Disk                 string   `protobuf:"bytes,1,opt,name=disk,proto3" json:"disk,omitempty"`
+	Free                 uint64   `protobuf:"varint,2,opt,name=free,proto3" json:"free,omitempty"`
+	Required             uint64   `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	Leftover             int64    `protobuf:"varint,4,opt,name=leftover,proto3" json:"leftover,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetCoinbaseResponse) Reset()         { *m = GetCoinbaseResponse{} }
-func (m *GetCoinbaseResponse) String() string { return proto.CompactTextString(m) }
-func (*GetCoinbaseResponse) ProtoMessage()    {}
-func (*GetCoinbaseResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{59}
+func (m *CapacityPlan_Disk) Reset()         { *m = CapacityPlan_Disk{} }
+func (m *CapacityPlan_Disk) String() string { return proto.CompactTextString(m) }
+func (*CapacityPlan_Disk) ProtoMessage()    {}
+func (*CapacityPlan_Disk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{64, 2}
 }
-func (m *GetCoinbaseResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCoinbaseResponse.Unmarshal(m, b)
+func (m *CapacityPlan_Disk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CapacityPlan_Disk.Unmarshal(m, b)
 }
-func (m *GetCoinbaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCoinbaseResponse.Marshal(b, m, deterministic)
+func (m *CapacityPlan_Disk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CapacityPlan_Disk.Marshal(b, m, deterministic)
 }
-func (m *GetCoinbaseResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCoinbaseResponse.Merge(m, src)
+func (m *CapacityPlan_Disk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CapacityPlan_Disk.Merge(m, src)
 }
-func (m *GetCoinbaseResponse) XXX_Size() int {
-	return xxx_messageInfo_GetCoinbaseResponse.Size(m)
+func (m *CapacityPlan_Disk) XXX_Size() int {
+	return xxx_messageInfo_CapacityPlan_Disk.Size(m)
 }
-func (m *GetCoinbaseResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCoinbaseResponse.DiscardUnknown(m)
+func (m *CapacityPlan_Disk) XXX_DiscardUnknown() {
+	xxx_messageInfo_CapacityPlan_Disk.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCoinbaseResponse proto.InternalMessageInfo
+var xxx_messageInfo_CapacityPlan_Disk proto.InternalMessageInfo
 
-func (m *GetCoinbaseResponse) GetTxId() string {
+func (m *CapacityPlan_Disk) GetDisk() string {
 	if m != nil {
-		return m.TxId
+		return m.Disk
 	}
 	return ""
 }
 
-func (m *GetCoinbaseResponse) GetVersion() uint32 {
+func (m *CapacityPlan_Disk) GetFree() uint64 {
 	if m != nil {
-		return m.Version
+		return m.Free
 	}
 	return 0
 }
 
-func (m *GetCoinbaseResponse) GetLockTime() uint64 {
+func (m *CapacityPlan_Disk) GetRequired() uint64 {
 	if m != nil {
-		return m.LockTime
+		return m.Required
 	}
 	return 0
 }
 
-func (m *GetCoinbaseResponse) GetBlock() *BlockInfoForTx {
+func (m *CapacityPlan_Disk) GetLeftover() int64 {
 	if m != nil {
-		return m.Block
+		return m.Leftover
 	}
-	return nil
+	return 0
 }
 
-func (m *GetCoinbaseResponse) GetBindingValue() string {
-	if m != nil {
-		return m.BindingValue
-	}
-	return ""
+type ApplyCapacityPlanRequest struct {
+	PlanId               string   `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	PayoutAddresses      []string `protobuf:"bytes,2,rep,name=payout_addresses,json=payoutAddresses,proto3" json:"payout_addresses,omitempty"`
+	Passphrase           string   `protobuf:"bytes,3,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetCoinbaseResponse) GetVin() []*Vin {
+func (m *ApplyCapacityPlanRequest) Reset()         { *m = ApplyCapacityPlanRequest{} }
+func (m *ApplyCapacityPlanRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplyCapacityPlanRequest) ProtoMessage()    {}
+func (*ApplyCapacityPlanRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{65}
+}
+func (m *ApplyCapacityPlanRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplyCapacityPlanRequest.Unmarshal(m, b)
+}
+func (m *ApplyCapacityPlanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplyCapacityPlanRequest.Marshal(b, m, deterministic)
+}
+func (m *ApplyCapacityPlanRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyCapacityPlanRequest.Merge(m, src)
+}
+func (m *ApplyCapacityPlanRequest) XXX_Size() int {
+	return xxx_messageInfo_ApplyCapacityPlanRequest.Size(m)
+}
+func (m *ApplyCapacityPlanRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyCapacityPlanRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplyCapacityPlanRequest proto.InternalMessageInfo
+
+func (m *ApplyCapacityPlanRequest) GetPlanId() string {
 	if m != nil {
-		return m.Vin
+		return m.PlanId
 	}
-	return nil
+	return ""
 }
 
-func (m *GetCoinbaseResponse) GetVout() []*CoinbaseVout {
+func (m *ApplyCapacityPlanRequest) GetPayoutAddresses() []string {
 	if m != nil {
-		return m.Vout
+		return m.PayoutAddresses
 	}
 	return nil
 }
 
-func (m *GetCoinbaseResponse) GetPayload() string {
+func (m *ApplyCapacityPlanRequest) GetPassphrase() string {
 	if m != nil {
-		return m.Payload
+		return m.Passphrase
 	}
 	return ""
 }
 
-func (m *GetCoinbaseResponse) GetConfirmations() uint64 {
+// EstimateMiningRevenueRequest estimates plotted spaces if capacity is 0,
+// otherwise capacity plotted in spaces of bit_length.
+type EstimateMiningRevenueRequest struct {
+	Capacity             uint64   `protobuf:"varint,1,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	BitLength            uint32   `protobuf:"varint,2,opt,name=bit_length,json=bitLength,proto3" json:"bit_length,omitempty"`
+	Binding              bool     `protobuf:"varint,3,opt,name=binding,proto3" json:"binding,omitempty"`
+	Blocks               uint32   `protobuf:"varint,4,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EstimateMiningRevenueRequest) Reset()         { *m = EstimateMiningRevenueRequest{} }
+func (m *EstimateMiningRevenueRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateMiningRevenueRequest) ProtoMessage()    {}
+func (*EstimateMiningRevenueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{66}
+}
+func (m *EstimateMiningRevenueRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EstimateMiningRevenueRequest.Unmarshal(m, b)
+}
+func (m *EstimateMiningRevenueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EstimateMiningRevenueRequest.Marshal(b, m, deterministic)
+}
+func (m *EstimateMiningRevenueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateMiningRevenueRequest.Merge(m, src)
+}
+func (m *EstimateMiningRevenueRequest) XXX_Size() int {
+	return xxx_messageInfo_EstimateMiningRevenueRequest.Size(m)
+}
+func (m *EstimateMiningRevenueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateMiningRevenueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateMiningRevenueRequest proto.InternalMessageInfo
+
+func (m *EstimateMiningRevenueRequest) GetCapacity() uint64 {
 	if m != nil {
-		return m.Confirmations
+		return m.Capacity
 	}
 	return 0
 }
 
-func (m *GetCoinbaseResponse) GetTxSize() uint32 {
+func (m *EstimateMiningRevenueRequest) GetBitLength() uint32 {
 	if m != nil {
-		return m.TxSize
+		return m.BitLength
 	}
 	return 0
 }
 
-func (m *GetCoinbaseResponse) GetTotalFees() string {
+func (m *EstimateMiningRevenueRequest) GetBinding() bool {
 	if m != nil {
-		return m.TotalFees
+		return m.Binding
 	}
-	return ""
+	return false
 }
 
-func (m *GetCoinbaseResponse) GetStatus() int32 {
+func (m *EstimateMiningRevenueRequest) GetBlocks() uint32 {
 	if m != nil {
-		return m.Status
+		return m.Blocks
 	}
 	return 0
 }
 
-type ExportKeystoreRequest struct {
-	WalletId             string   `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
-	Passphrase           string   `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
-	ExportPath           string   `protobuf:"bytes,3,opt,name=export_path,json=exportPath,proto3" json:"export_path,omitempty"`
+// ProofLatency is rolling percentiles of recent proof lookups in milliseconds.
+type ProofLatency struct {
+	Samples              uint32   `protobuf:"varint,1,opt,name=samples,proto3" json:"samples,omitempty"`
+	Total                uint64   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	OverBudget           uint32   `protobuf:"varint,3,opt,name=over_budget,json=overBudget,proto3" json:"over_budget,omitempty"`
+	P50                  float64  `protobuf:"fixed64,4,opt,name=p50,proto3" json:"p50,omitempty"`
+	P90                  float64  `protobuf:"fixed64,5,opt,name=p90,proto3" json:"p90,omitempty"`
+	P99                  float64  `protobuf:"fixed64,6,opt,name=p99,proto3" json:"p99,omitempty"`
+	Max                  float64  `protobuf:"fixed64,7,opt,name=max,proto3" json:"max,omitempty"`
+	Last                 float64  `protobuf:"fixed64,8,opt,name=last,proto3" json:"last,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ExportKeystoreRequest) Reset()         { *m = ExportKeystoreRequest{} }
-func (m *ExportKeystoreRequest) String() string { return proto.CompactTextString(m) }
-func (*ExportKeystoreRequest) ProtoMessage()    {}
-func (*ExportKeystoreRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{60}
+func (m *ProofLatency) Reset()         { *m = ProofLatency{} }
+func (m *ProofLatency) String() string { return proto.CompactTextString(m) }
+func (*ProofLatency) ProtoMessage()    {}
+func (*ProofLatency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{67}
 }
-func (m *ExportKeystoreRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ExportKeystoreRequest.Unmarshal(m, b)
+func (m *ProofLatency) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProofLatency.Unmarshal(m, b)
 }
-func (m *ExportKeystoreRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ExportKeystoreRequest.Marshal(b, m, deterministic)
+func (m *ProofLatency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProofLatency.Marshal(b, m, deterministic)
 }
-func (m *ExportKeystoreRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExportKeystoreRequest.Merge(m, src)
+func (m *ProofLatency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProofLatency.Merge(m, src)
 }
-func (m *ExportKeystoreRequest) XXX_Size() int {
-	return xxx_messageInfo_ExportKeystoreRequest.Size(m)
+func (m *ProofLatency) XXX_Size() int {
+	return xxx_messageInfo_ProofLatency.Size(m)
 }
-func (m *ExportKeystoreRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExportKeystoreRequest.DiscardUnknown(m)
+func (m *ProofLatency) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProofLatency.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ExportKeystoreRequest proto.InternalMessageInfo
+var xxx_messageInfo_ProofLatency proto.InternalMessageInfo
 
-func (m *ExportKeystoreRequest) GetWalletId() string {
+func (m *ProofLatency) GetSamples() uint32 {
 	if m != nil {
-		return m.WalletId
+		return m.Samples
 	}
-	return ""
+	return 0
 }
 
-func (m *ExportKeystoreRequest) GetPassphrase() string {
+func (m *ProofLatency) GetTotal() uint64 {
 	if m != nil {
-		return m.Passphrase
+		return m.Total
 	}
-	return ""
+	return 0
 }
 
-func (m *ExportKeystoreRequest) GetExportPath() string {
+func (m *ProofLatency) GetOverBudget() uint32 {
 	if m != nil {
-		return m.ExportPath
+		return m.OverBudget
 	}
-	return ""
+	return 0
 }
 
-type ExportKeystoreResponse struct {
-	Keystore             string       `protobuf:"bytes,1,opt,name=keystore,proto3" json:"keystore,omitempty"`
-	AddrManager          *AddrManager `protobuf:"bytes,2,opt,name=addrManager,proto3" json:"addrManager,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+func (m *ProofLatency) GetP50() float64 {
+	if m != nil {
+		return m.P50
+	}
+	return 0
 }
 
-func (m *ExportKeystoreResponse) Reset()         { *m = ExportKeystoreResponse{} }
-func (m *ExportKeystoreResponse) String() string { return proto.CompactTextString(m) }
-func (*ExportKeystoreResponse) ProtoMessage()    {}
-func (*ExportKeystoreResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{61}
+func (m *ProofLatency) GetP90() float64 {
+	if m != nil {
+		return m.P90
+	}
+	return 0
 }
-func (m *ExportKeystoreResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ExportKeystoreResponse.Unmarshal(m, b)
+
+func (m *ProofLatency) GetP99() float64 {
+	if m != nil {
+		return m.P99
+	}
+	return 0
 }
-func (m *ExportKeystoreResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ExportKeystoreResponse.Marshal(b, m, deterministic)
+
+func (m *ProofLatency) GetMax() float64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
 }
-func (m *ExportKeystoreResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExportKeystoreResponse.Merge(m, src)
+
+func (m *ProofLatency) GetLast() float64 {
+	if m != nil {
+		return m.Last
+	}
+	return 0
 }
-func (m *ExportKeystoreResponse) XXX_Size() int {
-	return xxx_messageInfo_ExportKeystoreResponse.Size(m)
+
+type GetProofLatenciesResponse struct {
+	Budget               float64                                `protobuf:"fixed64,1,opt,name=budget,proto3" json:"budget,omitempty"`
+	Spaces               []*GetProofLatenciesResponse_Space     `protobuf:"bytes,2,rep,name=spaces,proto3" json:"spaces,omitempty"`
+	Directories          []*GetProofLatenciesResponse_Directory `protobuf:"bytes,3,rep,name=directories,proto3" json:"directories,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                               `json:"-"`
+	XXX_unrecognized     []byte                                 `json:"-"`
+	XXX_sizecache        int32                                  `json:"-"`
 }
-func (m *ExportKeystoreResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExportKeystoreResponse.DiscardUnknown(m)
+
+func (m *GetProofLatenciesResponse) Reset()         { *m = GetProofLatenciesResponse{} }
+func (m *GetProofLatenciesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetProofLatenciesResponse) ProtoMessage()    {}
+func (*GetProofLatenciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{68}
+}
+func (m *GetProofLatenciesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProofLatenciesResponse.Unmarshal(m, b)
+}
+func (m *GetProofLatenciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProofLatenciesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetProofLatenciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProofLatenciesResponse.Merge(m, src)
+}
+func (m *GetProofLatenciesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetProofLatenciesResponse.Size(m)
+}
+func (m *GetProofLatenciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProofLatenciesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ExportKeystoreResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetProofLatenciesResponse proto.InternalMessageInfo
 
-func (m *ExportKeystoreResponse) GetKeystore() string {
+func (m *GetProofLatenciesResponse) GetBudget() float64 {
 	if m != nil {
-		return m.Keystore
+		return m.Budget
 	}
-	return ""
+	return 0
 }
 
-func (m *ExportKeystoreResponse) GetAddrManager() *AddrManager {
+func (m *GetProofLatenciesResponse) GetSpaces() []*GetProofLatenciesResponse_Space {
 	if m != nil {
-		return m.AddrManager
+		return m.Spaces
 	}
 	return nil
 }
 
-type ExportKeystoreByDirRequest struct {
-	WalletDir            string   `protobuf:"bytes,1,opt,name=wallet_dir,json=walletDir,proto3" json:"wallet_dir,omitempty"`
-	Passphrase           string   `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
-	WalletPassphrase     string   `protobuf:"bytes,3,opt,name=wallet_passphrase,json=walletPassphrase,proto3" json:"wallet_passphrase,omitempty"`
-	ExportPath           string   `protobuf:"bytes,4,opt,name=export_path,json=exportPath,proto3" json:"export_path,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *GetProofLatenciesResponse) GetDirectories() []*GetProofLatenciesResponse_Directory {
+	if m != nil {
+		return m.Directories
+	}
+	return nil
 }
 
-func (m *ExportKeystoreByDirRequest) Reset()         { *m = ExportKeystoreByDirRequest{} }
-func (m *ExportKeystoreByDirRequest) String() string { return proto.CompactTextString(m) }
-func (*ExportKeystoreByDirRequest) ProtoMessage()    {}
-func (*ExportKeystoreByDirRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{62}
+type GetProofLatenciesResponse_Space struct {
+	SpaceId              string        `protobuf:"bytes,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Directory            string        `protobuf:"bytes,2,opt,name=directory,proto3" json:"directory,omitempty"`
+	Slow                 bool          `protobuf:"varint,3,opt,name=slow,proto3" json:"slow,omitempty"`
+	Demoted              bool          `protobuf:"varint,4,opt,name=demoted,proto3" json:"demoted,omitempty"`
+	Latency              *ProofLatency `protobuf:"bytes,5,opt,name=latency,proto3" json:"latency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
-func (m *ExportKeystoreByDirRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ExportKeystoreByDirRequest.Unmarshal(m, b)
+
+func (m *GetProofLatenciesResponse_Space) Reset()         { *m = GetProofLatenciesResponse_Space{} }
+func (m *GetProofLatenciesResponse_Space) String() string { return proto.CompactTextString(m) }
+func (*GetProofLatenciesResponse_Space) ProtoMessage()    {}
+func (*GetProofLatenciesResponse_Space) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{68, 0}
 }
-func (m *ExportKeystoreByDirRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ExportKeystoreByDirRequest.Marshal(b, m, deterministic)
+func (m *GetProofLatenciesResponse_Space) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProofLatenciesResponse_Space.Unmarshal(m, b)
 }
-func (m *ExportKeystoreByDirRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExportKeystoreByDirRequest.Merge(m, src)
+func (m *GetProofLatenciesResponse_Space) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProofLatenciesResponse_Space.Marshal(b, m, deterministic)
 }
-func (m *ExportKeystoreByDirRequest) XXX_Size() int {
-	return xxx_messageInfo_ExportKeystoreByDirRequest.Size(m)
+func (m *GetProofLatenciesResponse_Space) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProofLatenciesResponse_Space.Merge(m, src)
 }
-func (m *ExportKeystoreByDirRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExportKeystoreByDirRequest.DiscardUnknown(m)
+func (m *GetProofLatenciesResponse_Space) XXX_Size() int {
+	return xxx_messageInfo_GetProofLatenciesResponse_Space.Size(m)
+}
+func (m *GetProofLatenciesResponse_Space) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProofLatenciesResponse_Space.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ExportKeystoreByDirRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetProofLatenciesResponse_Space proto.InternalMessageInfo
 
-func (m *ExportKeystoreByDirRequest) GetWalletDir() string {
+func (m *GetProofLatenciesResponse_Space) GetSpaceId() string {
 	if m != nil {
-		return m.WalletDir
+		return m.SpaceId
 	}
 	return ""
 }
 
-func (m *ExportKeystoreByDirRequest) GetPassphrase() string {
+func (m *GetProofLatenciesResponse_Space) GetDirectory() string {
 	if m != nil {
-		return m.Passphrase
+		return m.Directory
 	}
 	return ""
 }
 
-func (m *ExportKeystoreByDirRequest) GetWalletPassphrase() string {
+func (m *GetProofLatenciesResponse_Space) GetSlow() bool {
 	if m != nil {
-		return m.WalletPassphrase
+		return m.Slow
 	}
-	return ""
+	return false
 }
 
-func (m *ExportKeystoreByDirRequest) GetExportPath() string {
+func (m *GetProofLatenciesResponse_Space) GetDemoted() bool {
 	if m != nil {
-		return m.ExportPath
+		return m.Demoted
 	}
-	return ""
+	return false
 }
 
-type ExportKeystoreByDirResponse struct {
-	Keystores            string         `protobuf:"bytes,1,opt,name=keystores,proto3" json:"keystores,omitempty"`
-	AddrManagers         []*AddrManager `protobuf:"bytes,2,rep,name=addrManagers,proto3" json:"addrManagers,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+func (m *GetProofLatenciesResponse_Space) GetLatency() *ProofLatency {
+	if m != nil {
+		return m.Latency
+	}
+	return nil
 }
 
-func (m *ExportKeystoreByDirResponse) Reset()         { *m = ExportKeystoreByDirResponse{} }
-func (m *ExportKeystoreByDirResponse) String() string { return proto.CompactTextString(m) }
-func (*ExportKeystoreByDirResponse) ProtoMessage()    {}
-func (*ExportKeystoreByDirResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{63}
+type GetProofLatenciesResponse_Directory struct {
+	Directory            string        `protobuf:"bytes,1,opt,name=directory,proto3" json:"directory,omitempty"`
+	Latency              *ProofLatency `protobuf:"bytes,2,opt,name=latency,proto3" json:"latency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
-func (m *ExportKeystoreByDirResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ExportKeystoreByDirResponse.Unmarshal(m, b)
+
+func (m *GetProofLatenciesResponse_Directory) Reset()         { *m = GetProofLatenciesResponse_Directory{} }
+func (m *GetProofLatenciesResponse_Directory) String() string { return proto.CompactTextString(m) }
+func (*GetProofLatenciesResponse_Directory) ProtoMessage()    {}
+func (*GetProofLatenciesResponse_Directory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{68, 1}
 }
-func (m *ExportKeystoreByDirResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ExportKeystoreByDirResponse.Marshal(b, m, deterministic)
+func (m *GetProofLatenciesResponse_Directory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProofLatenciesResponse_Directory.Unmarshal(m, b)
 }
-func (m *ExportKeystoreByDirResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExportKeystoreByDirResponse.Merge(m, src)
+func (m *GetProofLatenciesResponse_Directory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProofLatenciesResponse_Directory.Marshal(b, m, deterministic)
 }
-func (m *ExportKeystoreByDirResponse) XXX_Size() int {
-	return xxx_messageInfo_ExportKeystoreByDirResponse.Size(m)
+func (m *GetProofLatenciesResponse_Directory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProofLatenciesResponse_Directory.Merge(m, src)
 }
-func (m *ExportKeystoreByDirResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExportKeystoreByDirResponse.DiscardUnknown(m)
+func (m *GetProofLatenciesResponse_Directory) XXX_Size() int {
+	return xxx_messageInfo_GetProofLatenciesResponse_Directory.Size(m)
+}
+func (m *GetProofLatenciesResponse_Directory) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProofLatenciesResponse_Directory.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ExportKeystoreByDirResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetProofLatenciesResponse_Directory proto.InternalMessageInfo
 
-func (m *ExportKeystoreByDirResponse) GetKeystores() string {
+func (m *GetProofLatenciesResponse_Directory) GetDirectory() string {
 	if m != nil {
-		return m.Keystores
+		return m.Directory
 	}
 	return ""
 }
 
-func (m *ExportKeystoreByDirResponse) GetAddrManagers() []*AddrManager {
+func (m *GetProofLatenciesResponse_Directory) GetLatency() *ProofLatency {
 	if m != nil {
-		return m.AddrManagers
+		return m.Latency
 	}
 	return nil
 }
 
-type ImportKeystoreRequest struct {
-	ImportPath           string   `protobuf:"bytes,1,opt,name=import_path,json=importPath,proto3" json:"import_path,omitempty"`
-	OldPassphrase        string   `protobuf:"bytes,2,opt,name=old_passphrase,json=oldPassphrase,proto3" json:"old_passphrase,omitempty"`
-	NewPassphrase        string   `protobuf:"bytes,3,opt,name=new_passphrase,json=newPassphrase,proto3" json:"new_passphrase,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *ImportKeystoreRequest) Reset()         { *m = ImportKeystoreRequest{} }
-func (m *ImportKeystoreRequest) String() string { return proto.CompactTextString(m) }
-func (*ImportKeystoreRequest) ProtoMessage()    {}
-func (*ImportKeystoreRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{64}
+type EstimateMiningRevenueResponse struct {
+	Height               uint64                                     `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Blocks               uint32                                     `protobuf:"varint,2,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	BlockInterval        float64                                    `protobuf:"fixed64,3,opt,name=block_interval,json=blockInterval,proto3" json:"block_interval,omitempty"`
+	NetworkWeight        float64                                    `protobuf:"fixed64,4,opt,name=network_weight,json=networkWeight,proto3" json:"network_weight,omitempty"`
+	NetworkSpace         float64                                    `protobuf:"fixed64,5,opt,name=network_space,json=networkSpace,proto3" json:"network_space,omitempty"`
+	NetworkSpaceLower    float64                                    `protobuf:"fixed64,6,opt,name=network_space_lower,json=networkSpaceLower,proto3" json:"network_space_lower,omitempty"`
+	NetworkSpaceUpper    float64                                    `protobuf:"fixed64,7,opt,name=network_space_upper,json=networkSpaceUpper,proto3" json:"network_space_upper,omitempty"`
+	Space                uint64                                     `protobuf:"varint,8,opt,name=space,proto3" json:"space,omitempty"`
+	Share                float64                                    `protobuf:"fixed64,9,opt,name=share,proto3" json:"share,omitempty"`
+	BlocksPerDay         float64                                    `protobuf:"fixed64,10,opt,name=blocks_per_day,json=blocksPerDay,proto3" json:"blocks_per_day,omitempty"`
+	BlocksPerDayLower    uint64                                     `protobuf:"varint,11,opt,name=blocks_per_day_lower,json=blocksPerDayLower,proto3" json:"blocks_per_day_lower,omitempty"`
+	BlocksPerDayUpper    uint64                                     `protobuf:"varint,12,opt,name=blocks_per_day_upper,json=blocksPerDayUpper,proto3" json:"blocks_per_day_upper,omitempty"`
+	SktPerDay            string                                     `protobuf:"bytes,13,opt,name=skt_per_day,json=sktPerDay,proto3" json:"skt_per_day,omitempty"`
+	SktPerDayLower       string                                     `protobuf:"bytes,14,opt,name=skt_per_day_lower,json=sktPerDayLower,proto3" json:"skt_per_day_lower,omitempty"`
+	SktPerDayUpper       string                                     `protobuf:"bytes,15,opt,name=skt_per_day_upper,json=sktPerDayUpper,proto3" json:"skt_per_day_upper,omitempty"`
+	BitLengths           []*EstimateMiningRevenueResponse_BitLength `protobuf:"bytes,16,rep,name=bit_lengths,json=bitLengths,proto3" json:"bit_lengths,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                   `json:"-"`
+	XXX_unrecognized     []byte                                     `json:"-"`
+	XXX_sizecache        int32                                      `json:"-"`
+}
+
+func (m *EstimateMiningRevenueResponse) Reset()         { *m = EstimateMiningRevenueResponse{} }
+func (m *EstimateMiningRevenueResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateMiningRevenueResponse) ProtoMessage()    {}
+func (*EstimateMiningRevenueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{69}
 }
-func (m *ImportKeystoreRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ImportKeystoreRequest.Unmarshal(m, b)
+func (m *EstimateMiningRevenueResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EstimateMiningRevenueResponse.Unmarshal(m, b)
 }
-func (m *ImportKeystoreRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ImportKeystoreRequest.Marshal(b, m, deterministic)
+func (m *EstimateMiningRevenueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EstimateMiningRevenueResponse.Marshal(b, m, deterministic)
 }
-func (m *ImportKeystoreRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ImportKeystoreRequest.Merge(m, src)
+func (m *EstimateMiningRevenueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateMiningRevenueResponse.Merge(m, src)
 }
-func (m *ImportKeystoreRequest) XXX_Size() int {
-	return xxx_messageInfo_ImportKeystoreRequest.Size(m)
+func (m *EstimateMiningRevenueResponse) XXX_Size() int {
+	return xxx_messageInfo_EstimateMiningRevenueResponse.Size(m)
 }
-func (m *ImportKeystoreRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ImportKeystoreRequest.DiscardUnknown(m)
+func (m *EstimateMiningRevenueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateMiningRevenueResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ImportKeystoreRequest proto.InternalMessageInfo
+var xxx_messageInfo_EstimateMiningRevenueResponse proto.InternalMessageInfo
 
-func (m *ImportKeystoreRequest) GetImportPath() string {
+func (m *EstimateMiningRevenueResponse) GetHeight() uint64 {
 	if m != nil {
-		return m.ImportPath
+		return m.Height
 	}
-	return ""
+	return 0
 }
 
-func (m *ImportKeystoreRequest) GetOldPassphrase() string {
+func (m *EstimateMiningRevenueResponse) GetBlocks() uint32 {
 	if m != nil {
-		return m.OldPassphrase
+		return m.Blocks
 	}
-	return ""
+	return 0
 }
 
-func (m *ImportKeystoreRequest) GetNewPassphrase() string {
+func (m *EstimateMiningRevenueResponse) GetBlockInterval() float64 {
 	if m != nil {
-		return m.NewPassphrase
+		return m.BlockInterval
 	}
-	return ""
+	return 0
 }
 
-type ImportKeystoreResponse struct {
-	Status               bool         `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	WalletId             string       `protobuf:"bytes,2,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
-	Remark               string       `protobuf:"bytes,3,opt,name=remark,proto3" json:"remark,omitempty"`
-	AddrManager          *AddrManager `protobuf:"bytes,4,opt,name=addrManager,proto3" json:"addrManager,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+func (m *EstimateMiningRevenueResponse) GetNetworkWeight() float64 {
+	if m != nil {
+		return m.NetworkWeight
+	}
+	return 0
 }
 
-func (m *ImportKeystoreResponse) Reset()         { *m = ImportKeystoreResponse{} }
-func (m *ImportKeystoreResponse) String() string { return proto.CompactTextString(m) }
-func (*ImportKeystoreResponse) ProtoMessage()    {}
-func (*ImportKeystoreResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{65}
+func (m *EstimateMiningRevenueResponse) GetNetworkSpace() float64 {
+	if m != nil {
+		return m.NetworkSpace
+	}
+	return 0
 }
-func (m *ImportKeystoreResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ImportKeystoreResponse.Unmarshal(m, b)
+
+func (m *EstimateMiningRevenueResponse) GetNetworkSpaceLower() float64 {
+	if m != nil {
+		return m.NetworkSpaceLower
+	}
+	return 0
 }
-func (m *ImportKeystoreResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ImportKeystoreResponse.Marshal(b, m, deterministic)
+
+func (m *EstimateMiningRevenueResponse) GetNetworkSpaceUpper() float64 {
+	if m != nil {
+		return m.NetworkSpaceUpper
+	}
+	return 0
 }
-func (m *ImportKeystoreResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ImportKeystoreResponse.Merge(m, src)
+
+func (m *EstimateMiningRevenueResponse) GetSpace() uint64 {
+	if m != nil {
+		return m.Space
+	}
+	return 0
 }
-func (m *ImportKeystoreResponse) XXX_Size() int {
-	return xxx_messageInfo_ImportKeystoreResponse.Size(m)
+
+func (m *EstimateMiningRevenueResponse) GetShare() float64 {
+	if m != nil {
+		return m.Share
+	}
+	return 0
 }
-func (m *ImportKeystoreResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ImportKeystoreResponse.DiscardUnknown(m)
+
+func (m *EstimateMiningRevenueResponse) GetBlocksPerDay() float64 {
+	if m != nil {
+		return m.BlocksPerDay
+	}
+	return 0
 }
 
-var xxx_messageInfo_ImportKeystoreResponse proto.InternalMessageInfo
+func (m *EstimateMiningRevenueResponse) GetBlocksPerDayLower() uint64 {
+	if m != nil {
+		return m.BlocksPerDayLower
+	}
+	return 0
+}
 
-func (m *ImportKeystoreResponse) GetStatus() bool {
+func (m *EstimateMiningRevenueResponse) GetBlocksPerDayUpper() uint64 {
 	if m != nil {
-		return m.Status
+		return m.BlocksPerDayUpper
 	}
-	return false
+	return 0
 }
 
-func (m *ImportKeystoreResponse) GetWalletId() string {
+func (m *EstimateMiningRevenueResponse) GetSktPerDay() string {
 	if m != nil {
-		return m.WalletId
+		return m.SktPerDay
 	}
 	return ""
 }
 
-func (m *ImportKeystoreResponse) GetRemark() string {
+func (m *EstimateMiningRevenueResponse) GetSktPerDayLower() string {
 	if m != nil {
-		return m.Remark
+		return m.SktPerDayLower
 	}
 	return ""
 }
 
-func (m *ImportKeystoreResponse) GetAddrManager() *AddrManager {
+func (m *EstimateMiningRevenueResponse) GetSktPerDayUpper() string {
 	if m != nil {
-		return m.AddrManager
+		return m.SktPerDayUpper
+	}
+	return ""
+}
+
+func (m *EstimateMiningRevenueResponse) GetBitLengths() []*EstimateMiningRevenueResponse_BitLength {
+	if m != nil {
+		return m.BitLengths
 	}
 	return nil
 }
 
-type ImportKeystoreByDirRequest struct {
-	ImportKeystoreDir    string   `protobuf:"bytes,1,opt,name=import_keystore_dir,json=importKeystoreDir,proto3" json:"import_keystore_dir,omitempty"`
-	ImportPubpass        string   `protobuf:"bytes,2,opt,name=import_pubpass,json=importPubpass,proto3" json:"import_pubpass,omitempty"`
-	ImportPrivpass       string   `protobuf:"bytes,3,opt,name=import_privpass,json=importPrivpass,proto3" json:"import_privpass,omitempty"`
-	CurrentPrivpass      string   `protobuf:"bytes,4,opt,name=current_privpass,json=currentPrivpass,proto3" json:"current_privpass,omitempty"`
+type EstimateMiningRevenueResponse_BitLength struct {
+	BitLength            uint32   `protobuf:"varint,1,opt,name=bit_length,json=bitLength,proto3" json:"bit_length,omitempty"`
+	Count                uint32   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Bound                uint32   `protobuf:"varint,3,opt,name=bound,proto3" json:"bound,omitempty"`
+	Bytes                uint64   `protobuf:"varint,4,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Share                float64  `protobuf:"fixed64,5,opt,name=share,proto3" json:"share,omitempty"`
+	BlocksPerDay         float64  `protobuf:"fixed64,6,opt,name=blocks_per_day,json=blocksPerDay,proto3" json:"blocks_per_day,omitempty"`
+	SktPerDay            string   `protobuf:"bytes,7,opt,name=skt_per_day,json=sktPerDay,proto3" json:"skt_per_day,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ImportKeystoreByDirRequest) Reset()         { *m = ImportKeystoreByDirRequest{} }
-func (m *ImportKeystoreByDirRequest) String() string { return proto.CompactTextString(m) }
-func (*ImportKeystoreByDirRequest) ProtoMessage()    {}
-func (*ImportKeystoreByDirRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{66}
+func (m *EstimateMiningRevenueResponse_BitLength) Reset() {
+	*m = EstimateMiningRevenueResponse_BitLength{}
 }
-func (m *ImportKeystoreByDirRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ImportKeystoreByDirRequest.Unmarshal(m, b)
+func (m *EstimateMiningRevenueResponse_BitLength) String() string { return proto.CompactTextString(m) }
+func (*EstimateMiningRevenueResponse_BitLength) ProtoMessage()    {}
+func (*EstimateMiningRevenueResponse_BitLength) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{69, 0}
 }
-func (m *ImportKeystoreByDirRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ImportKeystoreByDirRequest.Marshal(b, m, deterministic)
+func (m *EstimateMiningRevenueResponse_BitLength) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EstimateMiningRevenueResponse_BitLength.Unmarshal(m, b)
 }
-func (m *ImportKeystoreByDirRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ImportKeystoreByDirRequest.Merge(m, src)
+func (m *EstimateMiningRevenueResponse_BitLength) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EstimateMiningRevenueResponse_BitLength.Marshal(b, m, deterministic)
 }
-func (m *ImportKeystoreByDirRequest) XXX_Size() int {
-	return xxx_messageInfo_ImportKeystoreByDirRequest.Size(m)
+func (m *EstimateMiningRevenueResponse_BitLength) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateMiningRevenueResponse_BitLength.Merge(m, src)
 }
-func (m *ImportKeystoreByDirRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ImportKeystoreByDirRequest.DiscardUnknown(m)
+func (m *EstimateMiningRevenueResponse_BitLength) XXX_Size() int {
+	return xxx_messageInfo_EstimateMiningRevenueResponse_BitLength.Size(m)
+}
+func (m *EstimateMiningRevenueResponse_BitLength) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateMiningRevenueResponse_BitLength.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ImportKeystoreByDirRequest proto.InternalMessageInfo
+var xxx_messageInfo_EstimateMiningRevenueResponse_BitLength proto.InternalMessageInfo
 
-func (m *ImportKeystoreByDirRequest) GetImportKeystoreDir() string {
+func (m *EstimateMiningRevenueResponse_BitLength) GetBitLength() uint32 {
 	if m != nil {
-		return m.ImportKeystoreDir
+		return m.BitLength
 	}
-	return ""
+	return 0
 }
 
-func (m *ImportKeystoreByDirRequest) GetImportPubpass() string {
+func (m *EstimateMiningRevenueResponse_BitLength) GetCount() uint32 {
 	if m != nil {
-		return m.ImportPubpass
+		return m.Count
 	}
-	return ""
+	return 0
 }
 
-func (m *ImportKeystoreByDirRequest) GetImportPrivpass() string {
+func (m *EstimateMiningRevenueResponse_BitLength) GetBound() uint32 {
 	if m != nil {
-		return m.ImportPrivpass
+		return m.Bound
 	}
-	return ""
+	return 0
 }
 
-func (m *ImportKeystoreByDirRequest) GetCurrentPrivpass() string {
+func (m *EstimateMiningRevenueResponse_BitLength) GetBytes() uint64 {
 	if m != nil {
-		return m.CurrentPrivpass
+		return m.Bytes
+	}
+	return 0
+}
+
+func (m *EstimateMiningRevenueResponse_BitLength) GetShare() float64 {
+	if m != nil {
+		return m.Share
+	}
+	return 0
+}
+
+func (m *EstimateMiningRevenueResponse_BitLength) GetBlocksPerDay() float64 {
+	if m != nil {
+		return m.BlocksPerDay
+	}
+	return 0
+}
+
+func (m *EstimateMiningRevenueResponse_BitLength) GetSktPerDay() string {
+	if m != nil {
+		return m.SktPerDay
 	}
 	return ""
 }
 
-type ImportKeystoreByDirResponse struct {
-	Keystores            map[string]*PocWallet `protobuf:"bytes,1,rep,name=keystores,proto3" json:"keystores,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	OldAddrManagers      []*AddrManager        `protobuf:"bytes,2,rep,name=old_addr_managers,json=oldAddrManagers,proto3" json:"old_addr_managers,omitempty"`
-	NewAddrManagers      []*AddrManager        `protobuf:"bytes,3,rep,name=new_addr_managers,json=newAddrManagers,proto3" json:"new_addr_managers,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type ConfigureSpaceKeeperByDirsRequest struct {
+	Allocations          []*ConfigureSpaceKeeperByDirsRequest_Allocation `protobuf:"bytes,1,rep,name=allocations,proto3" json:"allocations,omitempty"`
+	PayoutAddresses      []string                                        `protobuf:"bytes,2,rep,name=payout_addresses,json=payoutAddresses,proto3" json:"payout_addresses,omitempty"`
+	Passphrase           string                                          `protobuf:"bytes,3,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	Cointype             uint32                                          `protobuf:"varint,4,opt,name=cointype,proto3" json:"cointype,omitempty"`
+	AutoCreate           int32                                           `protobuf:"varint,5,opt,name=auto_create,json=autoCreate,proto3" json:"auto_create,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                        `json:"-"`
+	XXX_unrecognized     []byte                                          `json:"-"`
+	XXX_sizecache        int32                                           `json:"-"`
 }
 
-func (m *ImportKeystoreByDirResponse) Reset()         { *m = ImportKeystoreByDirResponse{} }
-func (m *ImportKeystoreByDirResponse) String() string { return proto.CompactTextString(m) }
-func (*ImportKeystoreByDirResponse) ProtoMessage()    {}
-func (*ImportKeystoreByDirResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{67}
+func (m *ConfigureSpaceKeeperByDirsRequest) Reset()         { *m = ConfigureSpaceKeeperByDirsRequest{} }
+func (m *ConfigureSpaceKeeperByDirsRequest) String() string { return proto.CompactTextString(m) }
+func (*ConfigureSpaceKeeperByDirsRequest) ProtoMessage()    {}
+func (*ConfigureSpaceKeeperByDirsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{70}
 }
-func (m *ImportKeystoreByDirResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ImportKeystoreByDirResponse.Unmarshal(m, b)
+func (m *ConfigureSpaceKeeperByDirsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfigureSpaceKeeperByDirsRequest.Unmarshal(m, b)
 }
-func (m *ImportKeystoreByDirResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ImportKeystoreByDirResponse.Marshal(b, m, deterministic)
+func (m *ConfigureSpaceKeeperByDirsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfigureSpaceKeeperByDirsRequest.Marshal(b, m, deterministic)
 }
-func (m *ImportKeystoreByDirResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ImportKeystoreByDirResponse.Merge(m, src)
+func (m *ConfigureSpaceKeeperByDirsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigureSpaceKeeperByDirsRequest.Merge(m, src)
 }
-func (m *ImportKeystoreByDirResponse) XXX_Size() int {
-	return xxx_messageInfo_ImportKeystoreByDirResponse.Size(m)
+func (m *ConfigureSpaceKeeperByDirsRequest) XXX_Size() int {
+	return xxx_messageInfo_ConfigureSpaceKeeperByDirsRequest.Size(m)
 }
-func (m *ImportKeystoreByDirResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ImportKeystoreByDirResponse.DiscardUnknown(m)
+func (m *ConfigureSpaceKeeperByDirsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigureSpaceKeeperByDirsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ImportKeystoreByDirResponse proto.InternalMessageInfo
+var xxx_messageInfo_ConfigureSpaceKeeperByDirsRequest proto.InternalMessageInfo
 
-func (m *ImportKeystoreByDirResponse) GetKeystores() map[string]*PocWallet {
+func (m *ConfigureSpaceKeeperByDirsRequest) GetAllocations() []*ConfigureSpaceKeeperByDirsRequest_Allocation {
 	if m != nil {
-		return m.Keystores
+		return m.Allocations
 	}
 	return nil
 }
 
-func (m *ImportKeystoreByDirResponse) GetOldAddrManagers() []*AddrManager {
+func (m *ConfigureSpaceKeeperByDirsRequest) GetPayoutAddresses() []string {
 	if m != nil {
-		return m.OldAddrManagers
+		return m.PayoutAddresses
 	}
 	return nil
 }
 
-func (m *ImportKeystoreByDirResponse) GetNewAddrManagers() []*AddrManager {
+func (m *ConfigureSpaceKeeperByDirsRequest) GetPassphrase() string {
 	if m != nil {
-		return m.NewAddrManagers
+		return m.Passphrase
 	}
-	return nil
-}
-
-type WalletSummary struct {
-	WalletId             string   `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
-	Remark               string   `protobuf:"bytes,2,opt,name=remark,proto3" json:"remark,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	return ""
 }
 
-func (m *WalletSummary) Reset()         { *m = WalletSummary{} }
-func (m *WalletSummary) String() string { return proto.CompactTextString(m) }
-func (*WalletSummary) ProtoMessage()    {}
-func (*WalletSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{68}
-}
-func (m *WalletSummary) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_WalletSummary.Unmarshal(m, b)
-}
-func (m *WalletSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_WalletSummary.Marshal(b, m, deterministic)
-}
-func (m *WalletSummary) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WalletSummary.Merge(m, src)
-}
-func (m *WalletSummary) XXX_Size() int {
-	return xxx_messageInfo_WalletSummary.Size(m)
-}
-func (m *WalletSummary) XXX_DiscardUnknown() {
-	xxx_messageInfo_WalletSummary.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_WalletSummary proto.InternalMessageInfo
-
-func (m *WalletSummary) GetWalletId() string {
+func (m *ConfigureSpaceKeeperByDirsRequest) GetCointype() uint32 {
 	if m != nil {
-		return m.WalletId
+		return m.Cointype
 	}
-	return ""
+	return 0
 }
 
-func (m *WalletSummary) GetRemark() string {
+func (m *ConfigureSpaceKeeperByDirsRequest) GetAutoCreate() int32 {
 	if m != nil {
-		return m.Remark
+		return m.AutoCreate
 	}
-	return ""
+	return 0
 }
 
-type GetKeystoreDetailRequest struct {
-	WalletId             string   `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
-	Passphrase           string   `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+type ConfigureSpaceKeeperByDirsRequest_Allocation struct {
+	Directory            string   `protobuf:"bytes,1,opt,name=directory,proto3" json:"directory,omitempty"`
+	Capacity             uint64   `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetKeystoreDetailRequest) Reset()         { *m = GetKeystoreDetailRequest{} }
-func (m *GetKeystoreDetailRequest) String() string { return proto.CompactTextString(m) }
-func (*GetKeystoreDetailRequest) ProtoMessage()    {}
-func (*GetKeystoreDetailRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{69}
+func (m *ConfigureSpaceKeeperByDirsRequest_Allocation) Reset() {
+	*m = ConfigureSpaceKeeperByDirsRequest_Allocation{}
 }
-func (m *GetKeystoreDetailRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetKeystoreDetailRequest.Unmarshal(m, b)
+func (m *ConfigureSpaceKeeperByDirsRequest_Allocation) String() string {
+	return proto.CompactTextString(m)
 }
-func (m *GetKeystoreDetailRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetKeystoreDetailRequest.Marshal(b, m, deterministic)
+func (*ConfigureSpaceKeeperByDirsRequest_Allocation) ProtoMessage() {}
+func (*ConfigureSpaceKeeperByDirsRequest_Allocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{70, 0}
 }
-func (m *GetKeystoreDetailRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetKeystoreDetailRequest.Merge(m, src)
+func (m *ConfigureSpaceKeeperByDirsRequest_Allocation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfigureSpaceKeeperByDirsRequest_Allocation.Unmarshal(m, b)
 }
-func (m *GetKeystoreDetailRequest) XXX_Size() int {
-	return xxx_messageInfo_GetKeystoreDetailRequest.Size(m)
+func (m *ConfigureSpaceKeeperByDirsRequest_Allocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfigureSpaceKeeperByDirsRequest_Allocation.Marshal(b, m, deterministic)
 }
-func (m *GetKeystoreDetailRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetKeystoreDetailRequest.DiscardUnknown(m)
+func (m *ConfigureSpaceKeeperByDirsRequest_Allocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigureSpaceKeeperByDirsRequest_Allocation.Merge(m, src)
+}
+func (m *ConfigureSpaceKeeperByDirsRequest_Allocation) XXX_Size() int {
+	return xxx_messageInfo_ConfigureSpaceKeeperByDirsRequest_Allocation.Size(m)
+}
+func (m *ConfigureSpaceKeeperByDirsRequest_Allocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigureSpaceKeeperByDirsRequest_Allocation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetKeystoreDetailRequest proto.InternalMessageInfo
+var xxx_messageInfo_ConfigureSpaceKeeperByDirsRequest_Allocation proto.InternalMessageInfo
 
-func (m *GetKeystoreDetailRequest) GetWalletId() string {
+func (m *ConfigureSpaceKeeperByDirsRequest_Allocation) GetDirectory() string {
 	if m != nil {
-		return m.WalletId
+		return m.Directory
 	}
 	return ""
 }
 
-func (m *GetKeystoreDetailRequest) GetPassphrase() string {
+func (m *ConfigureSpaceKeeperByDirsRequest_Allocation) GetCapacity() uint64 {
 	if m != nil {
-		return m.Passphrase
+		return m.Capacity
 	}
-	return ""
+	return 0
 }
 
-type GetKeystoreDetailResponse struct {
-	WalletId             string     `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
-	Wallet               *PocWallet `protobuf:"bytes,2,opt,name=wallet,proto3" json:"wallet,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+type WorkSpacesByDirsResponse struct {
+	DirectoryCount       uint32                                 `protobuf:"varint,1,opt,name=directory_count,json=directoryCount,proto3" json:"directory_count,omitempty"`
+	Allocations          []*WorkSpacesByDirsResponse_Allocation `protobuf:"bytes,2,rep,name=allocations,proto3" json:"allocations,omitempty"`
+	ErrorCode            uint32                                 `protobuf:"varint,3,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	ErrorMessage         string                                 `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                               `json:"-"`
+	XXX_unrecognized     []byte                                 `json:"-"`
+	XXX_sizecache        int32                                  `json:"-"`
 }
 
-func (m *GetKeystoreDetailResponse) Reset()         { *m = GetKeystoreDetailResponse{} }
-func (m *GetKeystoreDetailResponse) String() string { return proto.CompactTextString(m) }
-func (*GetKeystoreDetailResponse) ProtoMessage()    {}
-func (*GetKeystoreDetailResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{70}
+func (m *WorkSpacesByDirsResponse) Reset()         { *m = WorkSpacesByDirsResponse{} }
+func (m *WorkSpacesByDirsResponse) String() string { return proto.CompactTextString(m) }
+func (*WorkSpacesByDirsResponse) ProtoMessage()    {}
+func (*WorkSpacesByDirsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{71}
 }
-func (m *GetKeystoreDetailResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetKeystoreDetailResponse.Unmarshal(m, b)
+func (m *WorkSpacesByDirsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkSpacesByDirsResponse.Unmarshal(m, b)
 }
-func (m *GetKeystoreDetailResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetKeystoreDetailResponse.Marshal(b, m, deterministic)
+func (m *WorkSpacesByDirsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkSpacesByDirsResponse.Marshal(b, m, deterministic)
 }
-func (m *GetKeystoreDetailResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetKeystoreDetailResponse.Merge(m, src)
+func (m *WorkSpacesByDirsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkSpacesByDirsResponse.Merge(m, src)
 }
-func (m *GetKeystoreDetailResponse) XXX_Size() int {
-	return xxx_messageInfo_GetKeystoreDetailResponse.Size(m)
+func (m *WorkSpacesByDirsResponse) XXX_Size() int {
+	return xxx_messageInfo_WorkSpacesByDirsResponse.Size(m)
 }
-func (m *GetKeystoreDetailResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetKeystoreDetailResponse.DiscardUnknown(m)
+func (m *WorkSpacesByDirsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkSpacesByDirsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetKeystoreDetailResponse proto.InternalMessageInfo
+var xxx_messageInfo_WorkSpacesByDirsResponse proto.InternalMessageInfo
 
-func (m *GetKeystoreDetailResponse) GetWalletId() string {
+func (m *WorkSpacesByDirsResponse) GetDirectoryCount() uint32 {
 	if m != nil {
-		return m.WalletId
+		return m.DirectoryCount
 	}
-	return ""
+	return 0
 }
 
-func (m *GetKeystoreDetailResponse) GetWallet() *PocWallet {
+func (m *WorkSpacesByDirsResponse) GetAllocations() []*WorkSpacesByDirsResponse_Allocation {
 	if m != nil {
-		return m.Wallet
+		return m.Allocations
 	}
 	return nil
 }
 
-type GetKeystoreResponse struct {
-	Wallets              []*WalletSummary `protobuf:"bytes,1,rep,name=wallets,proto3" json:"wallets,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
-}
-
-func (m *GetKeystoreResponse) Reset()         { *m = GetKeystoreResponse{} }
-func (m *GetKeystoreResponse) String() string { return proto.CompactTextString(m) }
-func (*GetKeystoreResponse) ProtoMessage()    {}
-func (*GetKeystoreResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{71}
-}
-func (m *GetKeystoreResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetKeystoreResponse.Unmarshal(m, b)
-}
-func (m *GetKeystoreResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetKeystoreResponse.Marshal(b, m, deterministic)
-}
-func (m *GetKeystoreResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetKeystoreResponse.Merge(m, src)
-}
-func (m *GetKeystoreResponse) XXX_Size() int {
-	return xxx_messageInfo_GetKeystoreResponse.Size(m)
-}
-func (m *GetKeystoreResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetKeystoreResponse.DiscardUnknown(m)
+func (m *WorkSpacesByDirsResponse) GetErrorCode() uint32 {
+	if m != nil {
+		return m.ErrorCode
+	}
+	return 0
 }
 
-var xxx_messageInfo_GetKeystoreResponse proto.InternalMessageInfo
-
-func (m *GetKeystoreResponse) GetWallets() []*WalletSummary {
+func (m *WorkSpacesByDirsResponse) GetErrorMessage() string {
 	if m != nil {
-		return m.Wallets
+		return m.ErrorMessage
 	}
-	return nil
+	return ""
 }
 
-type UnlockWalletRequest struct {
-	Passphrase           string   `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type WorkSpacesByDirsResponse_Allocation struct {
+	Directory            string       `protobuf:"bytes,1,opt,name=directory,proto3" json:"directory,omitempty"`
+	Capacity             string       `protobuf:"bytes,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	SpaceCount           uint32       `protobuf:"varint,3,opt,name=space_count,json=spaceCount,proto3" json:"space_count,omitempty"`
+	Spaces               []*WorkSpace `protobuf:"bytes,4,rep,name=spaces,proto3" json:"spaces,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *UnlockWalletRequest) Reset()         { *m = UnlockWalletRequest{} }
-func (m *UnlockWalletRequest) String() string { return proto.CompactTextString(m) }
-func (*UnlockWalletRequest) ProtoMessage()    {}
-func (*UnlockWalletRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{72}
+func (m *WorkSpacesByDirsResponse_Allocation) Reset()         { *m = WorkSpacesByDirsResponse_Allocation{} }
+func (m *WorkSpacesByDirsResponse_Allocation) String() string { return proto.CompactTextString(m) }
+func (*WorkSpacesByDirsResponse_Allocation) ProtoMessage()    {}
+func (*WorkSpacesByDirsResponse_Allocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{71, 0}
 }
-func (m *UnlockWalletRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UnlockWalletRequest.Unmarshal(m, b)
+func (m *WorkSpacesByDirsResponse_Allocation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkSpacesByDirsResponse_Allocation.Unmarshal(m, b)
 }
-func (m *UnlockWalletRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UnlockWalletRequest.Marshal(b, m, deterministic)
+func (m *WorkSpacesByDirsResponse_Allocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkSpacesByDirsResponse_Allocation.Marshal(b, m, deterministic)
 }
-func (m *UnlockWalletRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnlockWalletRequest.Merge(m, src)
+func (m *WorkSpacesByDirsResponse_Allocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkSpacesByDirsResponse_Allocation.Merge(m, src)
 }
-func (m *UnlockWalletRequest) XXX_Size() int {
-	return xxx_messageInfo_UnlockWalletRequest.Size(m)
+func (m *WorkSpacesByDirsResponse_Allocation) XXX_Size() int {
+	return xxx_messageInfo_WorkSpacesByDirsResponse_Allocation.Size(m)
 }
-func (m *UnlockWalletRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnlockWalletRequest.DiscardUnknown(m)
+func (m *WorkSpacesByDirsResponse_Allocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkSpacesByDirsResponse_Allocation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UnlockWalletRequest proto.InternalMessageInfo
+var xxx_messageInfo_WorkSpacesByDirsResponse_Allocation proto.InternalMessageInfo
 
-func (m *UnlockWalletRequest) GetPassphrase() string {
+func (m *WorkSpacesByDirsResponse_Allocation) GetDirectory() string {
 	if m != nil {
-		return m.Passphrase
+		return m.Directory
 	}
 	return ""
 }
 
-type UnlockWalletResponse struct {
-	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Error                string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *WorkSpacesByDirsResponse_Allocation) GetCapacity() string {
+	if m != nil {
+		return m.Capacity
+	}
+	return ""
 }
 
-func (m *UnlockWalletResponse) Reset()         { *m = UnlockWalletResponse{} }
-func (m *UnlockWalletResponse) String() string { return proto.CompactTextString(m) }
-func (*UnlockWalletResponse) ProtoMessage()    {}
-func (*UnlockWalletResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{73}
-}
-func (m *UnlockWalletResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UnlockWalletResponse.Unmarshal(m, b)
-}
-func (m *UnlockWalletResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UnlockWalletResponse.Marshal(b, m, deterministic)
-}
-func (m *UnlockWalletResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnlockWalletResponse.Merge(m, src)
-}
-func (m *UnlockWalletResponse) XXX_Size() int {
-	return xxx_messageInfo_UnlockWalletResponse.Size(m)
-}
-func (m *UnlockWalletResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnlockWalletResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_UnlockWalletResponse proto.InternalMessageInfo
-
-func (m *UnlockWalletResponse) GetSuccess() bool {
-	if m != nil {
-		return m.Success
-	}
-	return false
+func (m *WorkSpacesByDirsResponse_Allocation) GetSpaceCount() uint32 {
+	if m != nil {
+		return m.SpaceCount
+	}
+	return 0
 }
 
-func (m *UnlockWalletResponse) GetError() string {
+func (m *WorkSpacesByDirsResponse_Allocation) GetSpaces() []*WorkSpace {
 	if m != nil {
-		return m.Error
+		return m.Spaces
 	}
-	return ""
+	return nil
 }
 
-type LockWalletResponse struct {
-	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Error                string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type GetClientStatusResponse struct {
+	Version              string                                `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	PeerListening        bool                                  `protobuf:"varint,2,opt,name=peer_listening,json=peerListening,proto3" json:"peer_listening,omitempty"`
+	Syncing              bool                                  `protobuf:"varint,3,opt,name=syncing,proto3" json:"syncing,omitempty"`
+	Mining               bool                                  `protobuf:"varint,4,opt,name=mining,proto3" json:"mining,omitempty"`
+	SpaceKeeping         bool                                  `protobuf:"varint,5,opt,name=space_keeping,json=spaceKeeping,proto3" json:"space_keeping,omitempty"`
+	ChainId              string                                `protobuf:"bytes,6,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	LocalBestHeight      uint64                                `protobuf:"varint,7,opt,name=local_best_height,json=localBestHeight,proto3" json:"local_best_height,omitempty"`
+	KnownBestHeight      uint64                                `protobuf:"varint,8,opt,name=known_best_height,json=knownBestHeight,proto3" json:"known_best_height,omitempty"`
+	P2PId                string                                `protobuf:"bytes,9,opt,name=p2p_id,json=p2pId,proto3" json:"p2p_id,omitempty"`
+	PeerCount            *GetClientStatusResponsePeerCountInfo `protobuf:"bytes,10,opt,name=peer_count,json=peerCount,proto3" json:"peer_count,omitempty"`
+	Peers                *GetClientStatusResponsePeerList      `protobuf:"bytes,11,opt,name=peers,proto3" json:"peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
+	XXX_unrecognized     []byte                                `json:"-"`
+	XXX_sizecache        int32                                 `json:"-"`
 }
 
-func (m *LockWalletResponse) Reset()         { *m = LockWalletResponse{} }
-func (m *LockWalletResponse) String() string { return proto.CompactTextString(m) }
-func (*LockWalletResponse) ProtoMessage()    {}
-func (*LockWalletResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{74}
+func (m *GetClientStatusResponse) Reset()         { *m = GetClientStatusResponse{} }
+func (m *GetClientStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GetClientStatusResponse) ProtoMessage()    {}
+func (*GetClientStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{72}
 }
-func (m *LockWalletResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LockWalletResponse.Unmarshal(m, b)
+func (m *GetClientStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetClientStatusResponse.Unmarshal(m, b)
 }
-func (m *LockWalletResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LockWalletResponse.Marshal(b, m, deterministic)
+func (m *GetClientStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetClientStatusResponse.Marshal(b, m, deterministic)
 }
-func (m *LockWalletResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LockWalletResponse.Merge(m, src)
+func (m *GetClientStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetClientStatusResponse.Merge(m, src)
 }
-func (m *LockWalletResponse) XXX_Size() int {
-	return xxx_messageInfo_LockWalletResponse.Size(m)
+func (m *GetClientStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_GetClientStatusResponse.Size(m)
 }
-func (m *LockWalletResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_LockWalletResponse.DiscardUnknown(m)
+func (m *GetClientStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetClientStatusResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LockWalletResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetClientStatusResponse proto.InternalMessageInfo
 
-func (m *LockWalletResponse) GetSuccess() bool {
+func (m *GetClientStatusResponse) GetVersion() string {
 	if m != nil {
-		return m.Success
+		return m.Version
 	}
-	return false
+	return ""
 }
 
-func (m *LockWalletResponse) GetError() string {
+func (m *GetClientStatusResponse) GetPeerListening() bool {
 	if m != nil {
-		return m.Error
+		return m.PeerListening
 	}
-	return ""
+	return false
 }
 
-type ChangePrivatePassRequest struct {
-	OldPrivpass          string   `protobuf:"bytes,1,opt,name=old_privpass,json=oldPrivpass,proto3" json:"old_privpass,omitempty"`
-	NewPrivpass          string   `protobuf:"bytes,2,opt,name=new_privpass,json=newPrivpass,proto3" json:"new_privpass,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *GetClientStatusResponse) GetSyncing() bool {
+	if m != nil {
+		return m.Syncing
+	}
+	return false
 }
 
-func (m *ChangePrivatePassRequest) Reset()         { *m = ChangePrivatePassRequest{} }
-func (m *ChangePrivatePassRequest) String() string { return proto.CompactTextString(m) }
-func (*ChangePrivatePassRequest) ProtoMessage()    {}
-func (*ChangePrivatePassRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{75}
-}
-func (m *ChangePrivatePassRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChangePrivatePassRequest.Unmarshal(m, b)
-}
-func (m *ChangePrivatePassRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChangePrivatePassRequest.Marshal(b, m, deterministic)
-}
-func (m *ChangePrivatePassRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChangePrivatePassRequest.Merge(m, src)
-}
-func (m *ChangePrivatePassRequest) XXX_Size() int {
-	return xxx_messageInfo_ChangePrivatePassRequest.Size(m)
-}
-func (m *ChangePrivatePassRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChangePrivatePassRequest.DiscardUnknown(m)
+func (m *GetClientStatusResponse) GetMining() bool {
+	if m != nil {
+		return m.Mining
+	}
+	return false
 }
 
-var xxx_messageInfo_ChangePrivatePassRequest proto.InternalMessageInfo
-
-func (m *ChangePrivatePassRequest) GetOldPrivpass() string {
+func (m *GetClientStatusResponse) GetSpaceKeeping() bool {
 	if m != nil {
-		return m.OldPrivpass
+		return m.SpaceKeeping
 	}
-	return ""
+	return false
 }
 
-func (m *ChangePrivatePassRequest) GetNewPrivpass() string {
+func (m *GetClientStatusResponse) GetChainId() string {
 	if m != nil {
-		return m.NewPrivpass
+		return m.ChainId
 	}
 	return ""
 }
 
-type ChangePrivatePassResponse struct {
-	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *GetClientStatusResponse) GetLocalBestHeight() uint64 {
+	if m != nil {
+		return m.LocalBestHeight
+	}
+	return 0
 }
 
-func (m *ChangePrivatePassResponse) Reset()         { *m = ChangePrivatePassResponse{} }
-func (m *ChangePrivatePassResponse) String() string { return proto.CompactTextString(m) }
-func (*ChangePrivatePassResponse) ProtoMessage()    {}
-func (*ChangePrivatePassResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{76}
-}
-func (m *ChangePrivatePassResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChangePrivatePassResponse.Unmarshal(m, b)
-}
-func (m *ChangePrivatePassResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChangePrivatePassResponse.Marshal(b, m, deterministic)
-}
-func (m *ChangePrivatePassResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChangePrivatePassResponse.Merge(m, src)
-}
-func (m *ChangePrivatePassResponse) XXX_Size() int {
-	return xxx_messageInfo_ChangePrivatePassResponse.Size(m)
+func (m *GetClientStatusResponse) GetKnownBestHeight() uint64 {
+	if m != nil {
+		return m.KnownBestHeight
+	}
+	return 0
 }
-func (m *ChangePrivatePassResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChangePrivatePassResponse.DiscardUnknown(m)
+
+func (m *GetClientStatusResponse) GetP2PId() string {
+	if m != nil {
+		return m.P2PId
+	}
+	return ""
 }
 
-var xxx_messageInfo_ChangePrivatePassResponse proto.InternalMessageInfo
+func (m *GetClientStatusResponse) GetPeerCount() *GetClientStatusResponsePeerCountInfo {
+	if m != nil {
+		return m.PeerCount
+	}
+	return nil
+}
 
-func (m *ChangePrivatePassResponse) GetSuccess() bool {
+func (m *GetClientStatusResponse) GetPeers() *GetClientStatusResponsePeerList {
 	if m != nil {
-		return m.Success
+		return m.Peers
 	}
-	return false
+	return nil
 }
 
-type ChangePublicPassRequest struct {
-	OldPubpass           string   `protobuf:"bytes,1,opt,name=old_pubpass,json=oldPubpass,proto3" json:"old_pubpass,omitempty"`
-	NewPubpass           string   `protobuf:"bytes,2,opt,name=new_pubpass,json=newPubpass,proto3" json:"new_pubpass,omitempty"`
+type GetClientStatusResponsePeerCountInfo struct {
+	Total                uint32   `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Outbound             uint32   `protobuf:"varint,2,opt,name=outbound,proto3" json:"outbound,omitempty"`
+	Inbound              uint32   `protobuf:"varint,3,opt,name=inbound,proto3" json:"inbound,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChangePublicPassRequest) Reset()         { *m = ChangePublicPassRequest{} }
-func (m *ChangePublicPassRequest) String() string { return proto.CompactTextString(m) }
-func (*ChangePublicPassRequest) ProtoMessage()    {}
-func (*ChangePublicPassRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{77}
+func (m *GetClientStatusResponsePeerCountInfo) Reset()         { *m = GetClientStatusResponsePeerCountInfo{} }
+func (m *GetClientStatusResponsePeerCountInfo) String() string { return proto.CompactTextString(m) }
+func (*GetClientStatusResponsePeerCountInfo) ProtoMessage()    {}
+func (*GetClientStatusResponsePeerCountInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{72, 0}
 }
-func (m *ChangePublicPassRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChangePublicPassRequest.Unmarshal(m, b)
+func (m *GetClientStatusResponsePeerCountInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetClientStatusResponsePeerCountInfo.Unmarshal(m, b)
 }
-func (m *ChangePublicPassRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChangePublicPassRequest.Marshal(b, m, deterministic)
+func (m *GetClientStatusResponsePeerCountInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetClientStatusResponsePeerCountInfo.Marshal(b, m, deterministic)
 }
-func (m *ChangePublicPassRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChangePublicPassRequest.Merge(m, src)
+func (m *GetClientStatusResponsePeerCountInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetClientStatusResponsePeerCountInfo.Merge(m, src)
 }
-func (m *ChangePublicPassRequest) XXX_Size() int {
-	return xxx_messageInfo_ChangePublicPassRequest.Size(m)
+func (m *GetClientStatusResponsePeerCountInfo) XXX_Size() int {
+	return xxx_messageInfo_GetClientStatusResponsePeerCountInfo.Size(m)
 }
-func (m *ChangePublicPassRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChangePublicPassRequest.DiscardUnknown(m)
+func (m *GetClientStatusResponsePeerCountInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetClientStatusResponsePeerCountInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChangePublicPassRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetClientStatusResponsePeerCountInfo proto.InternalMessageInfo
 
-func (m *ChangePublicPassRequest) GetOldPubpass() string {
+func (m *GetClientStatusResponsePeerCountInfo) GetTotal() uint32 {
 	if m != nil {
-		return m.OldPubpass
+		return m.Total
 	}
-	return ""
+	return 0
 }
 
-func (m *ChangePublicPassRequest) GetNewPubpass() string {
+func (m *GetClientStatusResponsePeerCountInfo) GetOutbound() uint32 {
 	if m != nil {
-		return m.NewPubpass
+		return m.Outbound
 	}
-	return ""
+	return 0
 }
 
-type ChangePublicPassResponse struct {
-	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+func (m *GetClientStatusResponsePeerCountInfo) GetInbound() uint32 {
+	if m != nil {
+		return m.Inbound
+	}
+	return 0
+}
+
+type GetClientStatusResponsePeerInfo struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Direction            string   `protobuf:"bytes,3,opt,name=direction,proto3" json:"direction,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChangePublicPassResponse) Reset()         { *m = ChangePublicPassResponse{} }
-func (m *ChangePublicPassResponse) String() string { return proto.CompactTextString(m) }
-func (*ChangePublicPassResponse) ProtoMessage()    {}
-func (*ChangePublicPassResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{78}
+func (m *GetClientStatusResponsePeerInfo) Reset()         { *m = GetClientStatusResponsePeerInfo{} }
+func (m *GetClientStatusResponsePeerInfo) String() string { return proto.CompactTextString(m) }
+func (*GetClientStatusResponsePeerInfo) ProtoMessage()    {}
+func (*GetClientStatusResponsePeerInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{72, 1}
 }
-func (m *ChangePublicPassResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChangePublicPassResponse.Unmarshal(m, b)
+func (m *GetClientStatusResponsePeerInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetClientStatusResponsePeerInfo.Unmarshal(m, b)
 }
-func (m *ChangePublicPassResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChangePublicPassResponse.Marshal(b, m, deterministic)
+func (m *GetClientStatusResponsePeerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetClientStatusResponsePeerInfo.Marshal(b, m, deterministic)
 }
-func (m *ChangePublicPassResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChangePublicPassResponse.Merge(m, src)
+func (m *GetClientStatusResponsePeerInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetClientStatusResponsePeerInfo.Merge(m, src)
 }
-func (m *ChangePublicPassResponse) XXX_Size() int {
-	return xxx_messageInfo_ChangePublicPassResponse.Size(m)
+func (m *GetClientStatusResponsePeerInfo) XXX_Size() int {
+	return xxx_messageInfo_GetClientStatusResponsePeerInfo.Size(m)
 }
-func (m *ChangePublicPassResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChangePublicPassResponse.DiscardUnknown(m)
+func (m *GetClientStatusResponsePeerInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetClientStatusResponsePeerInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChangePublicPassResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetClientStatusResponsePeerInfo proto.InternalMessageInfo
 
-func (m *ChangePublicPassResponse) GetSuccess() bool {
+func (m *GetClientStatusResponsePeerInfo) GetId() string {
 	if m != nil {
-		return m.Success
+		return m.Id
 	}
-	return false
+	return ""
 }
 
-type GetGovernConfigRequest struct {
-	Id                   uint32   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *GetClientStatusResponsePeerInfo) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *GetGovernConfigRequest) Reset()         { *m = GetGovernConfigRequest{} }
-func (m *GetGovernConfigRequest) String() string { return proto.CompactTextString(m) }
-func (*GetGovernConfigRequest) ProtoMessage()    {}
-func (*GetGovernConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{79}
+func (m *GetClientStatusResponsePeerInfo) GetDirection() string {
+	if m != nil {
+		return m.Direction
+	}
+	return ""
 }
-func (m *GetGovernConfigRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetGovernConfigRequest.Unmarshal(m, b)
+
+type GetClientStatusResponsePeerList struct {
+	Outbound             []*GetClientStatusResponsePeerInfo `protobuf:"bytes,1,rep,name=outbound,proto3" json:"outbound,omitempty"`
+	Inbound              []*GetClientStatusResponsePeerInfo `protobuf:"bytes,2,rep,name=inbound,proto3" json:"inbound,omitempty"`
+	Other                []*GetClientStatusResponsePeerInfo `protobuf:"bytes,3,rep,name=other,proto3" json:"other,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
 }
-func (m *GetGovernConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetGovernConfigRequest.Marshal(b, m, deterministic)
+
+func (m *GetClientStatusResponsePeerList) Reset()         { *m = GetClientStatusResponsePeerList{} }
+func (m *GetClientStatusResponsePeerList) String() string { return proto.CompactTextString(m) }
+func (*GetClientStatusResponsePeerList) ProtoMessage()    {}
+func (*GetClientStatusResponsePeerList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{72, 2}
 }
-func (m *GetGovernConfigRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetGovernConfigRequest.Merge(m, src)
+func (m *GetClientStatusResponsePeerList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetClientStatusResponsePeerList.Unmarshal(m, b)
 }
-func (m *GetGovernConfigRequest) XXX_Size() int {
-	return xxx_messageInfo_GetGovernConfigRequest.Size(m)
+func (m *GetClientStatusResponsePeerList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetClientStatusResponsePeerList.Marshal(b, m, deterministic)
 }
-func (m *GetGovernConfigRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetGovernConfigRequest.DiscardUnknown(m)
+func (m *GetClientStatusResponsePeerList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetClientStatusResponsePeerList.Merge(m, src)
+}
+func (m *GetClientStatusResponsePeerList) XXX_Size() int {
+	return xxx_messageInfo_GetClientStatusResponsePeerList.Size(m)
+}
+func (m *GetClientStatusResponsePeerList) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetClientStatusResponsePeerList.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetGovernConfigRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetClientStatusResponsePeerList proto.InternalMessageInfo
 
-func (m *GetGovernConfigRequest) GetId() uint32 {
+func (m *GetClientStatusResponsePeerList) GetOutbound() []*GetClientStatusResponsePeerInfo {
 	if m != nil {
-		return m.Id
+		return m.Outbound
 	}
-	return 0
+	return nil
 }
 
-type GetGovernConfigHistoryRequest struct {
-	Id                   uint32   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	IncludeShadow        bool     `protobuf:"varint,2,opt,name=include_shadow,json=includeShadow,proto3" json:"include_shadow,omitempty"`
+func (m *GetClientStatusResponsePeerList) GetInbound() []*GetClientStatusResponsePeerInfo {
+	if m != nil {
+		return m.Inbound
+	}
+	return nil
+}
+
+func (m *GetClientStatusResponsePeerList) GetOther() []*GetClientStatusResponsePeerInfo {
+	if m != nil {
+		return m.Other
+	}
+	return nil
+}
+
+type QuitClientResponse struct {
+	ErrorCode            uint32   `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	Msg                  string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetGovernConfigHistoryRequest) Reset()         { *m = GetGovernConfigHistoryRequest{} }
-func (m *GetGovernConfigHistoryRequest) String() string { return proto.CompactTextString(m) }
-func (*GetGovernConfigHistoryRequest) ProtoMessage()    {}
-func (*GetGovernConfigHistoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{80}
+func (m *QuitClientResponse) Reset()         { *m = QuitClientResponse{} }
+func (m *QuitClientResponse) String() string { return proto.CompactTextString(m) }
+func (*QuitClientResponse) ProtoMessage()    {}
+func (*QuitClientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{73}
 }
-func (m *GetGovernConfigHistoryRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetGovernConfigHistoryRequest.Unmarshal(m, b)
+func (m *QuitClientResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuitClientResponse.Unmarshal(m, b)
 }
-func (m *GetGovernConfigHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetGovernConfigHistoryRequest.Marshal(b, m, deterministic)
+func (m *QuitClientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuitClientResponse.Marshal(b, m, deterministic)
 }
-func (m *GetGovernConfigHistoryRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetGovernConfigHistoryRequest.Merge(m, src)
+func (m *QuitClientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuitClientResponse.Merge(m, src)
 }
-func (m *GetGovernConfigHistoryRequest) XXX_Size() int {
-	return xxx_messageInfo_GetGovernConfigHistoryRequest.Size(m)
+func (m *QuitClientResponse) XXX_Size() int {
+	return xxx_messageInfo_QuitClientResponse.Size(m)
 }
-func (m *GetGovernConfigHistoryRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetGovernConfigHistoryRequest.DiscardUnknown(m)
+func (m *QuitClientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuitClientResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetGovernConfigHistoryRequest proto.InternalMessageInfo
+var xxx_messageInfo_QuitClientResponse proto.InternalMessageInfo
 
-func (m *GetGovernConfigHistoryRequest) GetId() uint32 {
+func (m *QuitClientResponse) GetErrorCode() uint32 {
 	if m != nil {
-		return m.Id
+		return m.ErrorCode
 	}
 	return 0
 }
 
-func (m *GetGovernConfigHistoryRequest) GetIncludeShadow() bool {
+func (m *QuitClientResponse) GetMsg() string {
 	if m != nil {
-		return m.IncludeShadow
+		return m.Msg
 	}
-	return false
+	return ""
 }
 
-type GetGovernConfigHistoryResponse struct {
-	Id                   uint32          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Configs              []*GovernConfig `protobuf:"bytes,2,rep,name=configs,proto3" json:"configs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+type GenerateBlocksRequest struct {
+	Count                uint32   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	PayoutAddress        string   `protobuf:"bytes,2,opt,name=payout_address,json=payoutAddress,proto3" json:"payout_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetGovernConfigHistoryResponse) Reset()         { *m = GetGovernConfigHistoryResponse{} }
-func (m *GetGovernConfigHistoryResponse) String() string { return proto.CompactTextString(m) }
-func (*GetGovernConfigHistoryResponse) ProtoMessage()    {}
-func (*GetGovernConfigHistoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{81}
+func (m *GenerateBlocksRequest) Reset()         { *m = GenerateBlocksRequest{} }
+func (m *GenerateBlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*GenerateBlocksRequest) ProtoMessage()    {}
+func (*GenerateBlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{74}
 }
-func (m *GetGovernConfigHistoryResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetGovernConfigHistoryResponse.Unmarshal(m, b)
+func (m *GenerateBlocksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GenerateBlocksRequest.Unmarshal(m, b)
 }
-func (m *GetGovernConfigHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetGovernConfigHistoryResponse.Marshal(b, m, deterministic)
+func (m *GenerateBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GenerateBlocksRequest.Marshal(b, m, deterministic)
 }
-func (m *GetGovernConfigHistoryResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetGovernConfigHistoryResponse.Merge(m, src)
+func (m *GenerateBlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenerateBlocksRequest.Merge(m, src)
 }
-func (m *GetGovernConfigHistoryResponse) XXX_Size() int {
-	return xxx_messageInfo_GetGovernConfigHistoryResponse.Size(m)
+func (m *GenerateBlocksRequest) XXX_Size() int {
+	return xxx_messageInfo_GenerateBlocksRequest.Size(m)
 }
-func (m *GetGovernConfigHistoryResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetGovernConfigHistoryResponse.DiscardUnknown(m)
+func (m *GenerateBlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenerateBlocksRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetGovernConfigHistoryResponse proto.InternalMessageInfo
+var xxx_messageInfo_GenerateBlocksRequest proto.InternalMessageInfo
 
-func (m *GetGovernConfigHistoryResponse) GetId() uint32 {
+func (m *GenerateBlocksRequest) GetCount() uint32 {
 	if m != nil {
-		return m.Id
+		return m.Count
 	}
 	return 0
 }
 
-func (m *GetGovernConfigHistoryResponse) GetConfigs() []*GovernConfig {
+func (m *GenerateBlocksRequest) GetPayoutAddress() string {
 	if m != nil {
-		return m.Configs
+		return m.PayoutAddress
 	}
-	return nil
+	return ""
 }
 
-type GovernSenateNode struct {
-	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Weight               uint64   `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+type GenerateBlocksResponse struct {
+	Hashes               []string `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
+	Height               uint64   `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GovernSenateNode) Reset()         { *m = GovernSenateNode{} }
-func (m *GovernSenateNode) String() string { return proto.CompactTextString(m) }
-func (*GovernSenateNode) ProtoMessage()    {}
-func (*GovernSenateNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{82}
+func (m *GenerateBlocksResponse) Reset()         { *m = GenerateBlocksResponse{} }
+func (m *GenerateBlocksResponse) String() string { return proto.CompactTextString(m) }
+func (*GenerateBlocksResponse) ProtoMessage()    {}
+func (*GenerateBlocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{75}
 }
-func (m *GovernSenateNode) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GovernSenateNode.Unmarshal(m, b)
+func (m *GenerateBlocksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GenerateBlocksResponse.Unmarshal(m, b)
 }
-func (m *GovernSenateNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GovernSenateNode.Marshal(b, m, deterministic)
+func (m *GenerateBlocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GenerateBlocksResponse.Marshal(b, m, deterministic)
 }
-func (m *GovernSenateNode) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GovernSenateNode.Merge(m, src)
+func (m *GenerateBlocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenerateBlocksResponse.Merge(m, src)
 }
-func (m *GovernSenateNode) XXX_Size() int {
-	return xxx_messageInfo_GovernSenateNode.Size(m)
+func (m *GenerateBlocksResponse) XXX_Size() int {
+	return xxx_messageInfo_GenerateBlocksResponse.Size(m)
 }
-func (m *GovernSenateNode) XXX_DiscardUnknown() {
-	xxx_messageInfo_GovernSenateNode.DiscardUnknown(m)
+func (m *GenerateBlocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenerateBlocksResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GovernSenateNode proto.InternalMessageInfo
+var xxx_messageInfo_GenerateBlocksResponse proto.InternalMessageInfo
 
-func (m *GovernSenateNode) GetAddress() string {
+func (m *GenerateBlocksResponse) GetHashes() []string {
 	if m != nil {
-		return m.Address
+		return m.Hashes
 	}
-	return ""
+	return nil
 }
 
-func (m *GovernSenateNode) GetWeight() uint64 {
+func (m *GenerateBlocksResponse) GetHeight() uint64 {
 	if m != nil {
-		return m.Weight
+		return m.Height
 	}
 	return 0
 }
 
-type GovernSenateConfig struct {
-	Nodes                []*GovernSenateNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+type GetShadowReportRequest struct {
+	StartTime            int64    `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              int64    `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GovernSenateConfig) Reset()         { *m = GovernSenateConfig{} }
-func (m *GovernSenateConfig) String() string { return proto.CompactTextString(m) }
-func (*GovernSenateConfig) ProtoMessage()    {}
-func (*GovernSenateConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{83}
+func (m *GetShadowReportRequest) Reset()         { *m = GetShadowReportRequest{} }
+func (m *GetShadowReportRequest) String() string { return proto.CompactTextString(m) }
+func (*GetShadowReportRequest) ProtoMessage()    {}
+func (*GetShadowReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{76}
 }
-func (m *GovernSenateConfig) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GovernSenateConfig.Unmarshal(m, b)
+func (m *GetShadowReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetShadowReportRequest.Unmarshal(m, b)
 }
-func (m *GovernSenateConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GovernSenateConfig.Marshal(b, m, deterministic)
+func (m *GetShadowReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetShadowReportRequest.Marshal(b, m, deterministic)
 }
-func (m *GovernSenateConfig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GovernSenateConfig.Merge(m, src)
+func (m *GetShadowReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetShadowReportRequest.Merge(m, src)
 }
-func (m *GovernSenateConfig) XXX_Size() int {
-	return xxx_messageInfo_GovernSenateConfig.Size(m)
+func (m *GetShadowReportRequest) XXX_Size() int {
+	return xxx_messageInfo_GetShadowReportRequest.Size(m)
 }
-func (m *GovernSenateConfig) XXX_DiscardUnknown() {
-	xxx_messageInfo_GovernSenateConfig.DiscardUnknown(m)
+func (m *GetShadowReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetShadowReportRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GovernSenateConfig proto.InternalMessageInfo
+var xxx_messageInfo_GetShadowReportRequest proto.InternalMessageInfo
 
-func (m *GovernSenateConfig) GetNodes() []*GovernSenateNode {
+func (m *GetShadowReportRequest) GetStartTime() int64 {
 	if m != nil {
-		return m.Nodes
+		return m.StartTime
 	}
-	return nil
-}
-
-type GovernVersionConfig struct {
-	Version              string   `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	return 0
 }
 
-func (m *GovernVersionConfig) Reset()         { *m = GovernVersionConfig{} }
-func (m *GovernVersionConfig) String() string { return proto.CompactTextString(m) }
-func (*GovernVersionConfig) ProtoMessage()    {}
-func (*GovernVersionConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{84}
+func (m *GetShadowReportRequest) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
 }
-func (m *GovernVersionConfig) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GovernVersionConfig.Unmarshal(m, b)
+
+type GetShadowReportResponse struct {
+	Count                uint32                          `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Found                uint32                          `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`
+	Won                  uint32                          `protobuf:"varint,3,opt,name=won,proto3" json:"won,omitempty"`
+	WinRate              float64                         `protobuf:"fixed64,4,opt,name=win_rate,json=winRate,proto3" json:"win_rate,omitempty"`
+	Slots                []*GetShadowReportResponse_Slot `protobuf:"bytes,5,rep,name=slots,proto3" json:"slots,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
 }
-func (m *GovernVersionConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GovernVersionConfig.Marshal(b, m, deterministic)
+
+func (m *GetShadowReportResponse) Reset()         { *m = GetShadowReportResponse{} }
+func (m *GetShadowReportResponse) String() string { return proto.CompactTextString(m) }
+func (*GetShadowReportResponse) ProtoMessage()    {}
+func (*GetShadowReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{77}
 }
-func (m *GovernVersionConfig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GovernVersionConfig.Merge(m, src)
+func (m *GetShadowReportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetShadowReportResponse.Unmarshal(m, b)
 }
-func (m *GovernVersionConfig) XXX_Size() int {
-	return xxx_messageInfo_GovernVersionConfig.Size(m)
+func (m *GetShadowReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetShadowReportResponse.Marshal(b, m, deterministic)
 }
-func (m *GovernVersionConfig) XXX_DiscardUnknown() {
-	xxx_messageInfo_GovernVersionConfig.DiscardUnknown(m)
+func (m *GetShadowReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetShadowReportResponse.Merge(m, src)
+}
+func (m *GetShadowReportResponse) XXX_Size() int {
+	return xxx_messageInfo_GetShadowReportResponse.Size(m)
+}
+func (m *GetShadowReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetShadowReportResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GovernVersionConfig proto.InternalMessageInfo
+var xxx_messageInfo_GetShadowReportResponse proto.InternalMessageInfo
 
-func (m *GovernVersionConfig) GetVersion() string {
+func (m *GetShadowReportResponse) GetCount() uint32 {
 	if m != nil {
-		return m.Version
+		return m.Count
 	}
-	return ""
+	return 0
 }
 
-type GovernSupperAddressInfo struct {
-	Id                   uint32   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+func (m *GetShadowReportResponse) GetFound() uint32 {
+	if m != nil {
+		return m.Found
+	}
+	return 0
+}
+
+func (m *GetShadowReportResponse) GetWon() uint32 {
+	if m != nil {
+		return m.Won
+	}
+	return 0
+}
+
+func (m *GetShadowReportResponse) GetWinRate() float64 {
+	if m != nil {
+		return m.WinRate
+	}
+	return 0
+}
+
+func (m *GetShadowReportResponse) GetSlots() []*GetShadowReportResponse_Slot {
+	if m != nil {
+		return m.Slots
+	}
+	return nil
+}
+
+type GetShadowReportResponse_Slot struct {
+	Height               uint64   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Slot                 uint64   `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Quality              string   `protobuf:"bytes,3,opt,name=quality,proto3" json:"quality,omitempty"`
+	Found                bool     `protobuf:"varint,4,opt,name=found,proto3" json:"found,omitempty"`
+	WinnerHash           string   `protobuf:"bytes,5,opt,name=winner_hash,json=winnerHash,proto3" json:"winner_hash,omitempty"`
+	WinnerSlot           uint64   `protobuf:"varint,6,opt,name=winner_slot,json=winnerSlot,proto3" json:"winner_slot,omitempty"`
+	WinnerQuality        string   `protobuf:"bytes,7,opt,name=winner_quality,json=winnerQuality,proto3" json:"winner_quality,omitempty"`
+	Won                  bool     `protobuf:"varint,8,opt,name=won,proto3" json:"won,omitempty"`
+	Time                 int64    `protobuf:"varint,9,opt,name=time,proto3" json:"time,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GovernSupperAddressInfo) Reset()         { *m = GovernSupperAddressInfo{} }
-func (m *GovernSupperAddressInfo) String() string { return proto.CompactTextString(m) }
-func (*GovernSupperAddressInfo) ProtoMessage()    {}
-func (*GovernSupperAddressInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{85}
+func (m *GetShadowReportResponse_Slot) Reset()         { *m = GetShadowReportResponse_Slot{} }
+func (m *GetShadowReportResponse_Slot) String() string { return proto.CompactTextString(m) }
+func (*GetShadowReportResponse_Slot) ProtoMessage()    {}
+func (*GetShadowReportResponse_Slot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{77, 0}
 }
-func (m *GovernSupperAddressInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GovernSupperAddressInfo.Unmarshal(m, b)
+func (m *GetShadowReportResponse_Slot) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetShadowReportResponse_Slot.Unmarshal(m, b)
 }
-func (m *GovernSupperAddressInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GovernSupperAddressInfo.Marshal(b, m, deterministic)
+func (m *GetShadowReportResponse_Slot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetShadowReportResponse_Slot.Marshal(b, m, deterministic)
 }
-func (m *GovernSupperAddressInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GovernSupperAddressInfo.Merge(m, src)
+func (m *GetShadowReportResponse_Slot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetShadowReportResponse_Slot.Merge(m, src)
 }
-func (m *GovernSupperAddressInfo) XXX_Size() int {
-	return xxx_messageInfo_GovernSupperAddressInfo.Size(m)
+func (m *GetShadowReportResponse_Slot) XXX_Size() int {
+	return xxx_messageInfo_GetShadowReportResponse_Slot.Size(m)
 }
-func (m *GovernSupperAddressInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_GovernSupperAddressInfo.DiscardUnknown(m)
+func (m *GetShadowReportResponse_Slot) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetShadowReportResponse_Slot.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GovernSupperAddressInfo proto.InternalMessageInfo
+var xxx_messageInfo_GetShadowReportResponse_Slot proto.InternalMessageInfo
 
-func (m *GovernSupperAddressInfo) GetId() uint32 {
+func (m *GetShadowReportResponse_Slot) GetHeight() uint64 {
 	if m != nil {
-		return m.Id
+		return m.Height
 	}
 	return 0
 }
 
-func (m *GovernSupperAddressInfo) GetAddress() string {
+func (m *GetShadowReportResponse_Slot) GetSlot() uint64 {
 	if m != nil {
-		return m.Address
+		return m.Slot
 	}
-	return ""
+	return 0
 }
 
-type GovernSupperConfig struct {
-	Address              []*GovernSupperAddressInfo `protobuf:"bytes,1,rep,name=address,proto3" json:"address,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+func (m *GetShadowReportResponse_Slot) GetQuality() string {
+	if m != nil {
+		return m.Quality
+	}
+	return ""
 }
 
-func (m *GovernSupperConfig) Reset()         { *m = GovernSupperConfig{} }
-func (m *GovernSupperConfig) String() string { return proto.CompactTextString(m) }
-func (*GovernSupperConfig) ProtoMessage()    {}
-func (*GovernSupperConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{86}
-}
-func (m *GovernSupperConfig) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GovernSupperConfig.Unmarshal(m, b)
-}
-func (m *GovernSupperConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GovernSupperConfig.Marshal(b, m, deterministic)
+func (m *GetShadowReportResponse_Slot) GetFound() bool {
+	if m != nil {
+		return m.Found
+	}
+	return false
 }
-func (m *GovernSupperConfig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GovernSupperConfig.Merge(m, src)
+
+func (m *GetShadowReportResponse_Slot) GetWinnerHash() string {
+	if m != nil {
+		return m.WinnerHash
+	}
+	return ""
 }
-func (m *GovernSupperConfig) XXX_Size() int {
-	return xxx_messageInfo_GovernSupperConfig.Size(m)
+
+func (m *GetShadowReportResponse_Slot) GetWinnerSlot() uint64 {
+	if m != nil {
+		return m.WinnerSlot
+	}
+	return 0
 }
-func (m *GovernSupperConfig) XXX_DiscardUnknown() {
-	xxx_messageInfo_GovernSupperConfig.DiscardUnknown(m)
+
+func (m *GetShadowReportResponse_Slot) GetWinnerQuality() string {
+	if m != nil {
+		return m.WinnerQuality
+	}
+	return ""
 }
 
-var xxx_messageInfo_GovernSupperConfig proto.InternalMessageInfo
+func (m *GetShadowReportResponse_Slot) GetWon() bool {
+	if m != nil {
+		return m.Won
+	}
+	return false
+}
 
-func (m *GovernSupperConfig) GetAddress() []*GovernSupperAddressInfo {
+func (m *GetShadowReportResponse_Slot) GetTime() int64 {
 	if m != nil {
-		return m.Address
+		return m.Time
 	}
-	return nil
+	return 0
 }
 
-type GovernConfig struct {
-	// Types that are valid to be assigned to Config:
-	//	*GovernConfig_GovernSupperConfig
-	//	*GovernConfig_GovernSenateConfig
-	//	*GovernConfig_GovernVersionConfig
-	Config               isGovernConfig_Config `protobuf_oneof:"config"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type GetMinedBlocksRequest struct {
+	StartTime            int64    `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              int64    `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	PublicKey            string   `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GovernConfig) Reset()         { *m = GovernConfig{} }
-func (m *GovernConfig) String() string { return proto.CompactTextString(m) }
-func (*GovernConfig) ProtoMessage()    {}
-func (*GovernConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{87}
+func (m *GetMinedBlocksRequest) Reset()         { *m = GetMinedBlocksRequest{} }
+func (m *GetMinedBlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMinedBlocksRequest) ProtoMessage()    {}
+func (*GetMinedBlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{78}
 }
-func (m *GovernConfig) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GovernConfig.Unmarshal(m, b)
+func (m *GetMinedBlocksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMinedBlocksRequest.Unmarshal(m, b)
 }
-func (m *GovernConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GovernConfig.Marshal(b, m, deterministic)
+func (m *GetMinedBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMinedBlocksRequest.Marshal(b, m, deterministic)
 }
-func (m *GovernConfig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GovernConfig.Merge(m, src)
+func (m *GetMinedBlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMinedBlocksRequest.Merge(m, src)
 }
-func (m *GovernConfig) XXX_Size() int {
-	return xxx_messageInfo_GovernConfig.Size(m)
+func (m *GetMinedBlocksRequest) XXX_Size() int {
+	return xxx_messageInfo_GetMinedBlocksRequest.Size(m)
 }
-func (m *GovernConfig) XXX_DiscardUnknown() {
-	xxx_messageInfo_GovernConfig.DiscardUnknown(m)
+func (m *GetMinedBlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMinedBlocksRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GovernConfig proto.InternalMessageInfo
+var xxx_messageInfo_GetMinedBlocksRequest proto.InternalMessageInfo
 
-type isGovernConfig_Config interface {
-	isGovernConfig_Config()
+func (m *GetMinedBlocksRequest) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
 }
 
-type GovernConfig_GovernSupperConfig struct {
-	GovernSupperConfig *GovernSupperConfig `protobuf:"bytes,1,opt,name=govern_supper_config,json=governSupperConfig,proto3,oneof" json:"govern_supper_config,omitempty"`
+func (m *GetMinedBlocksRequest) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
 }
-type GovernConfig_GovernSenateConfig struct {
-	GovernSenateConfig *GovernSenateConfig `protobuf:"bytes,2,opt,name=govern_senate_config,json=governSenateConfig,proto3,oneof" json:"govern_senate_config,omitempty"`
+
+func (m *GetMinedBlocksRequest) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
 }
-type GovernConfig_GovernVersionConfig struct {
-	GovernVersionConfig *GovernVersionConfig `protobuf:"bytes,3,opt,name=govern_version_config,json=governVersionConfig,proto3,oneof" json:"govern_version_config,omitempty"`
+
+type GetMinedBlocksResponse struct {
+	Count                uint32                          `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Orphaned             uint32                          `protobuf:"varint,2,opt,name=orphaned,proto3" json:"orphaned,omitempty"`
+	Reward               string                          `protobuf:"bytes,3,opt,name=reward,proto3" json:"reward,omitempty"`
+	Blocks               []*GetMinedBlocksResponse_Block `protobuf:"bytes,4,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
 }
 
-func (*GovernConfig_GovernSupperConfig) isGovernConfig_Config()  {}
-func (*GovernConfig_GovernSenateConfig) isGovernConfig_Config()  {}
-func (*GovernConfig_GovernVersionConfig) isGovernConfig_Config() {}
+func (m *GetMinedBlocksResponse) Reset()         { *m = GetMinedBlocksResponse{} }
+func (m *GetMinedBlocksResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMinedBlocksResponse) ProtoMessage()    {}
+func (*GetMinedBlocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{79}
+}
+func (m *GetMinedBlocksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMinedBlocksResponse.Unmarshal(m, b)
+}
+func (m *GetMinedBlocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMinedBlocksResponse.Marshal(b, m, deterministic)
+}
+func (m *GetMinedBlocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMinedBlocksResponse.Merge(m, src)
+}
+func (m *GetMinedBlocksResponse) XXX_Size() int {
+	return xxx_messageInfo_GetMinedBlocksResponse.Size(m)
+}
+func (m *GetMinedBlocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMinedBlocksResponse.DiscardUnknown(m)
+}
 
-func (m *GovernConfig) GetConfig() isGovernConfig_Config {
+var xxx_messageInfo_GetMinedBlocksResponse proto.InternalMessageInfo
+
+func (m *GetMinedBlocksResponse) GetCount() uint32 {
 	if m != nil {
-		return m.Config
+		return m.Count
 	}
-	return nil
+	return 0
 }
 
-func (m *GovernConfig) GetGovernSupperConfig() *GovernSupperConfig {
-	if x, ok := m.GetConfig().(*GovernConfig_GovernSupperConfig); ok {
-		return x.GovernSupperConfig
+func (m *GetMinedBlocksResponse) GetOrphaned() uint32 {
+	if m != nil {
+		return m.Orphaned
 	}
-	return nil
+	return 0
 }
 
-func (m *GovernConfig) GetGovernSenateConfig() *GovernSenateConfig {
-	if x, ok := m.GetConfig().(*GovernConfig_GovernSenateConfig); ok {
-		return x.GovernSenateConfig
+func (m *GetMinedBlocksResponse) GetReward() string {
+	if m != nil {
+		return m.Reward
 	}
-	return nil
+	return ""
 }
 
-func (m *GovernConfig) GetGovernVersionConfig() *GovernVersionConfig {
-	if x, ok := m.GetConfig().(*GovernConfig_GovernVersionConfig); ok {
-		return x.GovernVersionConfig
+func (m *GetMinedBlocksResponse) GetBlocks() []*GetMinedBlocksResponse_Block {
+	if m != nil {
+		return m.Blocks
 	}
 	return nil
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*GovernConfig) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*GovernConfig_GovernSupperConfig)(nil),
-		(*GovernConfig_GovernSenateConfig)(nil),
-		(*GovernConfig_GovernVersionConfig)(nil),
-	}
-}
-
-type GetGovernConfigResponse struct {
-	Id                   uint32        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	TxId                 string        `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
-	ActivateHeight       uint64        `protobuf:"varint,3,opt,name=activate_height,json=activateHeight,proto3" json:"activate_height,omitempty"`
-	BlockHeight          uint64        `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	Config               *GovernConfig `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+type GetMinedBlocksResponse_Block struct {
+	Height               uint64   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Hash                 string   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Time                 int64    `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	PublicKey            string   `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	BitLength            uint32   `protobuf:"varint,5,opt,name=bit_length,json=bitLength,proto3" json:"bit_length,omitempty"`
+	PayoutAddress        string   `protobuf:"bytes,6,opt,name=payout_address,json=payoutAddress,proto3" json:"payout_address,omitempty"`
+	Reward               string   `protobuf:"bytes,7,opt,name=reward,proto3" json:"reward,omitempty"`
+	MinerSubsidy         string   `protobuf:"bytes,8,opt,name=miner_subsidy,json=minerSubsidy,proto3" json:"miner_subsidy,omitempty"`
+	BindingSubsidy       string   `protobuf:"bytes,9,opt,name=binding_subsidy,json=bindingSubsidy,proto3" json:"binding_subsidy,omitempty"`
+	StakingSubsidy       string   `protobuf:"bytes,10,opt,name=staking_subsidy,json=stakingSubsidy,proto3" json:"staking_subsidy,omitempty"`
+	SenateSubsidy        string   `protobuf:"bytes,11,opt,name=senate_subsidy,json=senateSubsidy,proto3" json:"senate_subsidy,omitempty"`
+	Orphaned             bool     `protobuf:"varint,12,opt,name=orphaned,proto3" json:"orphaned,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetGovernConfigResponse) Reset()         { *m = GetGovernConfigResponse{} }
-func (m *GetGovernConfigResponse) String() string { return proto.CompactTextString(m) }
-func (*GetGovernConfigResponse) ProtoMessage()    {}
-func (*GetGovernConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{88}
+func (m *GetMinedBlocksResponse_Block) Reset()         { *m = GetMinedBlocksResponse_Block{} }
+func (m *GetMinedBlocksResponse_Block) String() string { return proto.CompactTextString(m) }
+func (*GetMinedBlocksResponse_Block) ProtoMessage()    {}
+func (*GetMinedBlocksResponse_Block) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{79, 0}
 }
-func (m *GetGovernConfigResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetGovernConfigResponse.Unmarshal(m, b)
+func (m *GetMinedBlocksResponse_Block) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMinedBlocksResponse_Block.Unmarshal(m, b)
 }
-func (m *GetGovernConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetGovernConfigResponse.Marshal(b, m, deterministic)
+func (m *GetMinedBlocksResponse_Block) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMinedBlocksResponse_Block.Marshal(b, m, deterministic)
 }
-func (m *GetGovernConfigResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetGovernConfigResponse.Merge(m, src)
+func (m *GetMinedBlocksResponse_Block) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMinedBlocksResponse_Block.Merge(m, src)
 }
-func (m *GetGovernConfigResponse) XXX_Size() int {
-	return xxx_messageInfo_GetGovernConfigResponse.Size(m)
+func (m *GetMinedBlocksResponse_Block) XXX_Size() int {
+	return xxx_messageInfo_GetMinedBlocksResponse_Block.Size(m)
 }
-func (m *GetGovernConfigResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetGovernConfigResponse.DiscardUnknown(m)
+func (m *GetMinedBlocksResponse_Block) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMinedBlocksResponse_Block.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetGovernConfigResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetMinedBlocksResponse_Block proto.InternalMessageInfo
 
-func (m *GetGovernConfigResponse) GetId() uint32 {
+func (m *GetMinedBlocksResponse_Block) GetHeight() uint64 {
 	if m != nil {
-		return m.Id
+		return m.Height
 	}
 	return 0
 }
 
-func (m *GetGovernConfigResponse) GetTxId() string {
+func (m *GetMinedBlocksResponse_Block) GetHash() string {
 	if m != nil {
-		return m.TxId
+		return m.Hash
 	}
 	return ""
 }
 
-func (m *GetGovernConfigResponse) GetActivateHeight() uint64 {
+func (m *GetMinedBlocksResponse_Block) GetTime() int64 {
 	if m != nil {
-		return m.ActivateHeight
+		return m.Time
 	}
 	return 0
 }
 
-func (m *GetGovernConfigResponse) GetBlockHeight() uint64 {
+func (m *GetMinedBlocksResponse_Block) GetPublicKey() string {
 	if m != nil {
-		return m.BlockHeight
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *GetMinedBlocksResponse_Block) GetBitLength() uint32 {
+	if m != nil {
+		return m.BitLength
+	}
+	return 0
+}
+
+func (m *GetMinedBlocksResponse_Block) GetPayoutAddress() string {
+	if m != nil {
+		return m.PayoutAddress
+	}
+	return ""
+}
+
+func (m *GetMinedBlocksResponse_Block) GetReward() string {
+	if m != nil {
+		return m.Reward
+	}
+	return ""
+}
+
+func (m *GetMinedBlocksResponse_Block) GetMinerSubsidy() string {
+	if m != nil {
+		return m.MinerSubsidy
+	}
+	return ""
+}
+
+func (m *GetMinedBlocksResponse_Block) GetBindingSubsidy() string {
+	if m != nil {
+		return m.BindingSubsidy
+	}
+	return ""
+}
+
+func (m *GetMinedBlocksResponse_Block) GetStakingSubsidy() string {
+	if m != nil {
+		return m.StakingSubsidy
+	}
+	return ""
+}
+
+func (m *GetMinedBlocksResponse_Block) GetSenateSubsidy() string {
+	if m != nil {
+		return m.SenateSubsidy
+	}
+	return ""
+}
+
+func (m *GetMinedBlocksResponse_Block) GetOrphaned() bool {
+	if m != nil {
+		return m.Orphaned
+	}
+	return false
+}
+
+type PayoutAddress struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Weight               uint32   `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	PublicKey            string   `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PayoutAddress) Reset()         { *m = PayoutAddress{} }
+func (m *PayoutAddress) String() string { return proto.CompactTextString(m) }
+func (*PayoutAddress) ProtoMessage()    {}
+func (*PayoutAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{80}
+}
+func (m *PayoutAddress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PayoutAddress.Unmarshal(m, b)
+}
+func (m *PayoutAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PayoutAddress.Marshal(b, m, deterministic)
+}
+func (m *PayoutAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayoutAddress.Merge(m, src)
+}
+func (m *PayoutAddress) XXX_Size() int {
+	return xxx_messageInfo_PayoutAddress.Size(m)
+}
+func (m *PayoutAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayoutAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayoutAddress proto.InternalMessageInfo
+
+func (m *PayoutAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PayoutAddress) GetWeight() uint32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *PayoutAddress) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+type GetPayoutAddressesResponse struct {
+	Strategy             string           `protobuf:"bytes,1,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	Addresses            []*PayoutAddress `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetPayoutAddressesResponse) Reset()         { *m = GetPayoutAddressesResponse{} }
+func (m *GetPayoutAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPayoutAddressesResponse) ProtoMessage()    {}
+func (*GetPayoutAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{81}
+}
+func (m *GetPayoutAddressesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPayoutAddressesResponse.Unmarshal(m, b)
+}
+func (m *GetPayoutAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPayoutAddressesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPayoutAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPayoutAddressesResponse.Merge(m, src)
+}
+func (m *GetPayoutAddressesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPayoutAddressesResponse.Size(m)
+}
+func (m *GetPayoutAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPayoutAddressesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPayoutAddressesResponse proto.InternalMessageInfo
+
+func (m *GetPayoutAddressesResponse) GetStrategy() string {
+	if m != nil {
+		return m.Strategy
+	}
+	return ""
+}
+
+func (m *GetPayoutAddressesResponse) GetAddresses() []*PayoutAddress {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+type AddPayoutAddressRequest struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Weight               uint32   `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	PublicKey            string   `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddPayoutAddressRequest) Reset()         { *m = AddPayoutAddressRequest{} }
+func (m *AddPayoutAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*AddPayoutAddressRequest) ProtoMessage()    {}
+func (*AddPayoutAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{82}
+}
+func (m *AddPayoutAddressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddPayoutAddressRequest.Unmarshal(m, b)
+}
+func (m *AddPayoutAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddPayoutAddressRequest.Marshal(b, m, deterministic)
+}
+func (m *AddPayoutAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddPayoutAddressRequest.Merge(m, src)
+}
+func (m *AddPayoutAddressRequest) XXX_Size() int {
+	return xxx_messageInfo_AddPayoutAddressRequest.Size(m)
+}
+func (m *AddPayoutAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddPayoutAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddPayoutAddressRequest proto.InternalMessageInfo
+
+func (m *AddPayoutAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AddPayoutAddressRequest) GetWeight() uint32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *AddPayoutAddressRequest) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+type RemovePayoutAddressRequest struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemovePayoutAddressRequest) Reset()         { *m = RemovePayoutAddressRequest{} }
+func (m *RemovePayoutAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*RemovePayoutAddressRequest) ProtoMessage()    {}
+func (*RemovePayoutAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{83}
+}
+func (m *RemovePayoutAddressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemovePayoutAddressRequest.Unmarshal(m, b)
+}
+func (m *RemovePayoutAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemovePayoutAddressRequest.Marshal(b, m, deterministic)
+}
+func (m *RemovePayoutAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovePayoutAddressRequest.Merge(m, src)
+}
+func (m *RemovePayoutAddressRequest) XXX_Size() int {
+	return xxx_messageInfo_RemovePayoutAddressRequest.Size(m)
+}
+func (m *RemovePayoutAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovePayoutAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemovePayoutAddressRequest proto.InternalMessageInfo
+
+func (m *RemovePayoutAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type SetPayoutStrategyRequest struct {
+	Strategy             string   `protobuf:"bytes,1,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetPayoutStrategyRequest) Reset()         { *m = SetPayoutStrategyRequest{} }
+func (m *SetPayoutStrategyRequest) String() string { return proto.CompactTextString(m) }
+func (*SetPayoutStrategyRequest) ProtoMessage()    {}
+func (*SetPayoutStrategyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{84}
+}
+func (m *SetPayoutStrategyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetPayoutStrategyRequest.Unmarshal(m, b)
+}
+func (m *SetPayoutStrategyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetPayoutStrategyRequest.Marshal(b, m, deterministic)
+}
+func (m *SetPayoutStrategyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPayoutStrategyRequest.Merge(m, src)
+}
+func (m *SetPayoutStrategyRequest) XXX_Size() int {
+	return xxx_messageInfo_SetPayoutStrategyRequest.Size(m)
+}
+func (m *SetPayoutStrategyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPayoutStrategyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetPayoutStrategyRequest proto.InternalMessageInfo
+
+func (m *SetPayoutStrategyRequest) GetStrategy() string {
+	if m != nil {
+		return m.Strategy
+	}
+	return ""
+}
+
+type GetBlockHeightByPubKeyRequest struct {
+	PublicKey            string   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBlockHeightByPubKeyRequest) Reset()         { *m = GetBlockHeightByPubKeyRequest{} }
+func (m *GetBlockHeightByPubKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockHeightByPubKeyRequest) ProtoMessage()    {}
+func (*GetBlockHeightByPubKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{85}
+}
+func (m *GetBlockHeightByPubKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBlockHeightByPubKeyRequest.Unmarshal(m, b)
+}
+func (m *GetBlockHeightByPubKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBlockHeightByPubKeyRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBlockHeightByPubKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockHeightByPubKeyRequest.Merge(m, src)
+}
+func (m *GetBlockHeightByPubKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBlockHeightByPubKeyRequest.Size(m)
+}
+func (m *GetBlockHeightByPubKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockHeightByPubKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockHeightByPubKeyRequest proto.InternalMessageInfo
+
+func (m *GetBlockHeightByPubKeyRequest) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+type GetBlockHeightByPubKeyResponse struct {
+	Heights              []uint64 `protobuf:"varint,1,rep,packed,name=heights,proto3" json:"heights,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBlockHeightByPubKeyResponse) Reset()         { *m = GetBlockHeightByPubKeyResponse{} }
+func (m *GetBlockHeightByPubKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBlockHeightByPubKeyResponse) ProtoMessage()    {}
+func (*GetBlockHeightByPubKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{86}
+}
+func (m *GetBlockHeightByPubKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBlockHeightByPubKeyResponse.Unmarshal(m, b)
+}
+func (m *GetBlockHeightByPubKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBlockHeightByPubKeyResponse.Marshal(b, m, deterministic)
+}
+func (m *GetBlockHeightByPubKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockHeightByPubKeyResponse.Merge(m, src)
+}
+func (m *GetBlockHeightByPubKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBlockHeightByPubKeyResponse.Size(m)
+}
+func (m *GetBlockHeightByPubKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockHeightByPubKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockHeightByPubKeyResponse proto.InternalMessageInfo
+
+func (m *GetBlockHeightByPubKeyResponse) GetHeights() []uint64 {
+	if m != nil {
+		return m.Heights
+	}
+	return nil
+}
+
+type GetCoinbaseRequest struct {
+	Height               uint64   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCoinbaseRequest) Reset()         { *m = GetCoinbaseRequest{} }
+func (m *GetCoinbaseRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCoinbaseRequest) ProtoMessage()    {}
+func (*GetCoinbaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{87}
+}
+func (m *GetCoinbaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCoinbaseRequest.Unmarshal(m, b)
+}
+func (m *GetCoinbaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCoinbaseRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCoinbaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCoinbaseRequest.Merge(m, src)
+}
+func (m *GetCoinbaseRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCoinbaseRequest.Size(m)
+}
+func (m *GetCoinbaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCoinbaseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCoinbaseRequest proto.InternalMessageInfo
+
+func (m *GetCoinbaseRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type CoinbaseVout struct {
+	Value                string              `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	N                    uint32              `protobuf:"varint,2,opt,name=n,proto3" json:"n,omitempty"`
+	ScriptPublicKey      *ScriptPubKeyResult `protobuf:"bytes,3,opt,name=script_public_key,json=scriptPublicKey,proto3" json:"script_public_key,omitempty"`
+	Type                 string              `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *CoinbaseVout) Reset()         { *m = CoinbaseVout{} }
+func (m *CoinbaseVout) String() string { return proto.CompactTextString(m) }
+func (*CoinbaseVout) ProtoMessage()    {}
+func (*CoinbaseVout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{88}
+}
+func (m *CoinbaseVout) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CoinbaseVout.Unmarshal(m, b)
+}
+func (m *CoinbaseVout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CoinbaseVout.Marshal(b, m, deterministic)
+}
+func (m *CoinbaseVout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CoinbaseVout.Merge(m, src)
+}
+func (m *CoinbaseVout) XXX_Size() int {
+	return xxx_messageInfo_CoinbaseVout.Size(m)
+}
+func (m *CoinbaseVout) XXX_DiscardUnknown() {
+	xxx_messageInfo_CoinbaseVout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CoinbaseVout proto.InternalMessageInfo
+
+func (m *CoinbaseVout) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *CoinbaseVout) GetN() uint32 {
+	if m != nil {
+		return m.N
+	}
+	return 0
+}
+
+func (m *CoinbaseVout) GetScriptPublicKey() *ScriptPubKeyResult {
+	if m != nil {
+		return m.ScriptPublicKey
+	}
+	return nil
+}
+
+func (m *CoinbaseVout) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+type GetCoinbaseResponse struct {
+	TxId                 string          `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Version              uint32          `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	LockTime             uint64          `protobuf:"varint,3,opt,name=lock_time,json=lockTime,proto3" json:"lock_time,omitempty"`
+	Block                *BlockInfoForTx `protobuf:"bytes,4,opt,name=block,proto3" json:"block,omitempty"`
+	BindingValue         string          `protobuf:"bytes,5,opt,name=binding_value,json=bindingValue,proto3" json:"binding_value,omitempty"`
+	Vin                  []*Vin          `protobuf:"bytes,6,rep,name=vin,proto3" json:"vin,omitempty"`
+	Vout                 []*CoinbaseVout `protobuf:"bytes,7,rep,name=vout,proto3" json:"vout,omitempty"`
+	Payload              string          `protobuf:"bytes,8,opt,name=payload,proto3" json:"payload,omitempty"`
+	Confirmations        uint64          `protobuf:"varint,9,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	TxSize               uint32          `protobuf:"varint,10,opt,name=tx_size,json=txSize,proto3" json:"tx_size,omitempty"`
+	TotalFees            string          `protobuf:"bytes,11,opt,name=total_fees,json=totalFees,proto3" json:"total_fees,omitempty"`
+	Status               int32           `protobuf:"varint,12,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetCoinbaseResponse) Reset()         { *m = GetCoinbaseResponse{} }
+func (m *GetCoinbaseResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCoinbaseResponse) ProtoMessage()    {}
+func (*GetCoinbaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{89}
+}
+func (m *GetCoinbaseResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCoinbaseResponse.Unmarshal(m, b)
+}
+func (m *GetCoinbaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCoinbaseResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCoinbaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCoinbaseResponse.Merge(m, src)
+}
+func (m *GetCoinbaseResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCoinbaseResponse.Size(m)
+}
+func (m *GetCoinbaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCoinbaseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCoinbaseResponse proto.InternalMessageInfo
+
+func (m *GetCoinbaseResponse) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *GetCoinbaseResponse) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *GetCoinbaseResponse) GetLockTime() uint64 {
+	if m != nil {
+		return m.LockTime
+	}
+	return 0
+}
+
+func (m *GetCoinbaseResponse) GetBlock() *BlockInfoForTx {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *GetCoinbaseResponse) GetBindingValue() string {
+	if m != nil {
+		return m.BindingValue
+	}
+	return ""
+}
+
+func (m *GetCoinbaseResponse) GetVin() []*Vin {
+	if m != nil {
+		return m.Vin
+	}
+	return nil
+}
+
+func (m *GetCoinbaseResponse) GetVout() []*CoinbaseVout {
+	if m != nil {
+		return m.Vout
+	}
+	return nil
+}
+
+func (m *GetCoinbaseResponse) GetPayload() string {
+	if m != nil {
+		return m.Payload
+	}
+	return ""
+}
+
+func (m *GetCoinbaseResponse) GetConfirmations() uint64 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+func (m *GetCoinbaseResponse) GetTxSize() uint32 {
+	if m != nil {
+		return m.TxSize
+	}
+	return 0
+}
+
+func (m *GetCoinbaseResponse) GetTotalFees() string {
+	if m != nil {
+		return m.TotalFees
+	}
+	return ""
+}
+
+func (m *GetCoinbaseResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+type ExportKeystoreRequest struct {
+	WalletId             string   `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Passphrase           string   `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	ExportPath           string   `protobuf:"bytes,3,opt,name=export_path,json=exportPath,proto3" json:"export_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportKeystoreRequest) Reset()         { *m = ExportKeystoreRequest{} }
+func (m *ExportKeystoreRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportKeystoreRequest) ProtoMessage()    {}
+func (*ExportKeystoreRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{90}
+}
+func (m *ExportKeystoreRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportKeystoreRequest.Unmarshal(m, b)
+}
+func (m *ExportKeystoreRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportKeystoreRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportKeystoreRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportKeystoreRequest.Merge(m, src)
+}
+func (m *ExportKeystoreRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportKeystoreRequest.Size(m)
+}
+func (m *ExportKeystoreRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportKeystoreRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportKeystoreRequest proto.InternalMessageInfo
+
+func (m *ExportKeystoreRequest) GetWalletId() string {
+	if m != nil {
+		return m.WalletId
+	}
+	return ""
+}
+
+func (m *ExportKeystoreRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+func (m *ExportKeystoreRequest) GetExportPath() string {
+	if m != nil {
+		return m.ExportPath
+	}
+	return ""
+}
+
+type ExportKeystoreResponse struct {
+	Keystore             string       `protobuf:"bytes,1,opt,name=keystore,proto3" json:"keystore,omitempty"`
+	AddrManager          *AddrManager `protobuf:"bytes,2,opt,name=addrManager,proto3" json:"addrManager,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ExportKeystoreResponse) Reset()         { *m = ExportKeystoreResponse{} }
+func (m *ExportKeystoreResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportKeystoreResponse) ProtoMessage()    {}
+func (*ExportKeystoreResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{91}
+}
+func (m *ExportKeystoreResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportKeystoreResponse.Unmarshal(m, b)
+}
+func (m *ExportKeystoreResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportKeystoreResponse.Marshal(b, m, deterministic)
+}
+func (m *ExportKeystoreResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportKeystoreResponse.Merge(m, src)
+}
+func (m *ExportKeystoreResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportKeystoreResponse.Size(m)
+}
+func (m *ExportKeystoreResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportKeystoreResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportKeystoreResponse proto.InternalMessageInfo
+
+func (m *ExportKeystoreResponse) GetKeystore() string {
+	if m != nil {
+		return m.Keystore
+	}
+	return ""
+}
+
+func (m *ExportKeystoreResponse) GetAddrManager() *AddrManager {
+	if m != nil {
+		return m.AddrManager
+	}
+	return nil
+}
+
+type ExportKeystoreByDirRequest struct {
+	WalletDir            string   `protobuf:"bytes,1,opt,name=wallet_dir,json=walletDir,proto3" json:"wallet_dir,omitempty"`
+	Passphrase           string   `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	WalletPassphrase     string   `protobuf:"bytes,3,opt,name=wallet_passphrase,json=walletPassphrase,proto3" json:"wallet_passphrase,omitempty"`
+	ExportPath           string   `protobuf:"bytes,4,opt,name=export_path,json=exportPath,proto3" json:"export_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportKeystoreByDirRequest) Reset()         { *m = ExportKeystoreByDirRequest{} }
+func (m *ExportKeystoreByDirRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportKeystoreByDirRequest) ProtoMessage()    {}
+func (*ExportKeystoreByDirRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{92}
+}
+func (m *ExportKeystoreByDirRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportKeystoreByDirRequest.Unmarshal(m, b)
+}
+func (m *ExportKeystoreByDirRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportKeystoreByDirRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportKeystoreByDirRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportKeystoreByDirRequest.Merge(m, src)
+}
+func (m *ExportKeystoreByDirRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportKeystoreByDirRequest.Size(m)
+}
+func (m *ExportKeystoreByDirRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportKeystoreByDirRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportKeystoreByDirRequest proto.InternalMessageInfo
+
+func (m *ExportKeystoreByDirRequest) GetWalletDir() string {
+	if m != nil {
+		return m.WalletDir
+	}
+	return ""
+}
+
+func (m *ExportKeystoreByDirRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+func (m *ExportKeystoreByDirRequest) GetWalletPassphrase() string {
+	if m != nil {
+		return m.WalletPassphrase
+	}
+	return ""
+}
+
+func (m *ExportKeystoreByDirRequest) GetExportPath() string {
+	if m != nil {
+		return m.ExportPath
+	}
+	return ""
+}
+
+type ExportKeystoreByDirResponse struct {
+	Keystores            string         `protobuf:"bytes,1,opt,name=keystores,proto3" json:"keystores,omitempty"`
+	AddrManagers         []*AddrManager `protobuf:"bytes,2,rep,name=addrManagers,proto3" json:"addrManagers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ExportKeystoreByDirResponse) Reset()         { *m = ExportKeystoreByDirResponse{} }
+func (m *ExportKeystoreByDirResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportKeystoreByDirResponse) ProtoMessage()    {}
+func (*ExportKeystoreByDirResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{93}
+}
+func (m *ExportKeystoreByDirResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportKeystoreByDirResponse.Unmarshal(m, b)
+}
+func (m *ExportKeystoreByDirResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportKeystoreByDirResponse.Marshal(b, m, deterministic)
+}
+func (m *ExportKeystoreByDirResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportKeystoreByDirResponse.Merge(m, src)
+}
+func (m *ExportKeystoreByDirResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportKeystoreByDirResponse.Size(m)
+}
+func (m *ExportKeystoreByDirResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportKeystoreByDirResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportKeystoreByDirResponse proto.InternalMessageInfo
+
+func (m *ExportKeystoreByDirResponse) GetKeystores() string {
+	if m != nil {
+		return m.Keystores
+	}
+	return ""
+}
+
+func (m *ExportKeystoreByDirResponse) GetAddrManagers() []*AddrManager {
+	if m != nil {
+		return m.AddrManagers
+	}
+	return nil
+}
+
+type ImportKeystoreRequest struct {
+	ImportPath           string   `protobuf:"bytes,1,opt,name=import_path,json=importPath,proto3" json:"import_path,omitempty"`
+	OldPassphrase        string   `protobuf:"bytes,2,opt,name=old_passphrase,json=oldPassphrase,proto3" json:"old_passphrase,omitempty"`
+	NewPassphrase        string   `protobuf:"bytes,3,opt,name=new_passphrase,json=newPassphrase,proto3" json:"new_passphrase,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportKeystoreRequest) Reset()         { *m = ImportKeystoreRequest{} }
+func (m *ImportKeystoreRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportKeystoreRequest) ProtoMessage()    {}
+func (*ImportKeystoreRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{94}
+}
+func (m *ImportKeystoreRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportKeystoreRequest.Unmarshal(m, b)
+}
+func (m *ImportKeystoreRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportKeystoreRequest.Marshal(b, m, deterministic)
+}
+func (m *ImportKeystoreRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportKeystoreRequest.Merge(m, src)
+}
+func (m *ImportKeystoreRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportKeystoreRequest.Size(m)
+}
+func (m *ImportKeystoreRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportKeystoreRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportKeystoreRequest proto.InternalMessageInfo
+
+func (m *ImportKeystoreRequest) GetImportPath() string {
+	if m != nil {
+		return m.ImportPath
+	}
+	return ""
+}
+
+func (m *ImportKeystoreRequest) GetOldPassphrase() string {
+	if m != nil {
+		return m.OldPassphrase
+	}
+	return ""
+}
+
+func (m *ImportKeystoreRequest) GetNewPassphrase() string {
+	if m != nil {
+		return m.NewPassphrase
+	}
+	return ""
+}
+
+type ImportKeystoreResponse struct {
+	Status               bool         `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	WalletId             string       `protobuf:"bytes,2,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Remark               string       `protobuf:"bytes,3,opt,name=remark,proto3" json:"remark,omitempty"`
+	AddrManager          *AddrManager `protobuf:"bytes,4,opt,name=addrManager,proto3" json:"addrManager,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ImportKeystoreResponse) Reset()         { *m = ImportKeystoreResponse{} }
+func (m *ImportKeystoreResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportKeystoreResponse) ProtoMessage()    {}
+func (*ImportKeystoreResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{95}
+}
+func (m *ImportKeystoreResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportKeystoreResponse.Unmarshal(m, b)
+}
+func (m *ImportKeystoreResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportKeystoreResponse.Marshal(b, m, deterministic)
+}
+func (m *ImportKeystoreResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportKeystoreResponse.Merge(m, src)
+}
+func (m *ImportKeystoreResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportKeystoreResponse.Size(m)
+}
+func (m *ImportKeystoreResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportKeystoreResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportKeystoreResponse proto.InternalMessageInfo
+
+func (m *ImportKeystoreResponse) GetStatus() bool {
+	if m != nil {
+		return m.Status
+	}
+	return false
+}
+
+func (m *ImportKeystoreResponse) GetWalletId() string {
+	if m != nil {
+		return m.WalletId
+	}
+	return ""
+}
+
+func (m *ImportKeystoreResponse) GetRemark() string {
+	if m != nil {
+		return m.Remark
+	}
+	return ""
+}
+
+func (m *ImportKeystoreResponse) GetAddrManager() *AddrManager {
+	if m != nil {
+		return m.AddrManager
+	}
+	return nil
+}
+
+type ImportKeystoreByDirRequest struct {
+	ImportKeystoreDir    string   `protobuf:"bytes,1,opt,name=import_keystore_dir,json=importKeystoreDir,proto3" json:"import_keystore_dir,omitempty"`
+	ImportPubpass        string   `protobuf:"bytes,2,opt,name=import_pubpass,json=importPubpass,proto3" json:"import_pubpass,omitempty"`
+	ImportPrivpass       string   `protobuf:"bytes,3,opt,name=import_privpass,json=importPrivpass,proto3" json:"import_privpass,omitempty"`
+	CurrentPrivpass      string   `protobuf:"bytes,4,opt,name=current_privpass,json=currentPrivpass,proto3" json:"current_privpass,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportKeystoreByDirRequest) Reset()         { *m = ImportKeystoreByDirRequest{} }
+func (m *ImportKeystoreByDirRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportKeystoreByDirRequest) ProtoMessage()    {}
+func (*ImportKeystoreByDirRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{96}
+}
+func (m *ImportKeystoreByDirRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportKeystoreByDirRequest.Unmarshal(m, b)
+}
+func (m *ImportKeystoreByDirRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportKeystoreByDirRequest.Marshal(b, m, deterministic)
+}
+func (m *ImportKeystoreByDirRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportKeystoreByDirRequest.Merge(m, src)
+}
+func (m *ImportKeystoreByDirRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportKeystoreByDirRequest.Size(m)
+}
+func (m *ImportKeystoreByDirRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportKeystoreByDirRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportKeystoreByDirRequest proto.InternalMessageInfo
+
+func (m *ImportKeystoreByDirRequest) GetImportKeystoreDir() string {
+	if m != nil {
+		return m.ImportKeystoreDir
+	}
+	return ""
+}
+
+func (m *ImportKeystoreByDirRequest) GetImportPubpass() string {
+	if m != nil {
+		return m.ImportPubpass
+	}
+	return ""
+}
+
+func (m *ImportKeystoreByDirRequest) GetImportPrivpass() string {
+	if m != nil {
+		return m.ImportPrivpass
+	}
+	return ""
+}
+
+func (m *ImportKeystoreByDirRequest) GetCurrentPrivpass() string {
+	if m != nil {
+		return m.CurrentPrivpass
+	}
+	return ""
+}
+
+type ImportKeystoreByDirResponse struct {
+	Keystores            map[string]*PocWallet `protobuf:"bytes,1,rep,name=keystores,proto3" json:"keystores,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	OldAddrManagers      []*AddrManager        `protobuf:"bytes,2,rep,name=old_addr_managers,json=oldAddrManagers,proto3" json:"old_addr_managers,omitempty"`
+	NewAddrManagers      []*AddrManager        `protobuf:"bytes,3,rep,name=new_addr_managers,json=newAddrManagers,proto3" json:"new_addr_managers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ImportKeystoreByDirResponse) Reset()         { *m = ImportKeystoreByDirResponse{} }
+func (m *ImportKeystoreByDirResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportKeystoreByDirResponse) ProtoMessage()    {}
+func (*ImportKeystoreByDirResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{97}
+}
+func (m *ImportKeystoreByDirResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportKeystoreByDirResponse.Unmarshal(m, b)
+}
+func (m *ImportKeystoreByDirResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportKeystoreByDirResponse.Marshal(b, m, deterministic)
+}
+func (m *ImportKeystoreByDirResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportKeystoreByDirResponse.Merge(m, src)
+}
+func (m *ImportKeystoreByDirResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportKeystoreByDirResponse.Size(m)
+}
+func (m *ImportKeystoreByDirResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportKeystoreByDirResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportKeystoreByDirResponse proto.InternalMessageInfo
+
+func (m *ImportKeystoreByDirResponse) GetKeystores() map[string]*PocWallet {
+	if m != nil {
+		return m.Keystores
+	}
+	return nil
+}
+
+func (m *ImportKeystoreByDirResponse) GetOldAddrManagers() []*AddrManager {
+	if m != nil {
+		return m.OldAddrManagers
+	}
+	return nil
+}
+
+func (m *ImportKeystoreByDirResponse) GetNewAddrManagers() []*AddrManager {
+	if m != nil {
+		return m.NewAddrManagers
+	}
+	return nil
+}
+
+type WalletSummary struct {
+	WalletId             string   `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Remark               string   `protobuf:"bytes,2,opt,name=remark,proto3" json:"remark,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WalletSummary) Reset()         { *m = WalletSummary{} }
+func (m *WalletSummary) String() string { return proto.CompactTextString(m) }
+func (*WalletSummary) ProtoMessage()    {}
+func (*WalletSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{98}
+}
+func (m *WalletSummary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletSummary.Unmarshal(m, b)
+}
+func (m *WalletSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletSummary.Marshal(b, m, deterministic)
+}
+func (m *WalletSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletSummary.Merge(m, src)
+}
+func (m *WalletSummary) XXX_Size() int {
+	return xxx_messageInfo_WalletSummary.Size(m)
+}
+func (m *WalletSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalletSummary proto.InternalMessageInfo
+
+func (m *WalletSummary) GetWalletId() string {
+	if m != nil {
+		return m.WalletId
+	}
+	return ""
+}
+
+func (m *WalletSummary) GetRemark() string {
+	if m != nil {
+		return m.Remark
+	}
+	return ""
+}
+
+type GetKeystoreDetailRequest struct {
+	WalletId             string   `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Passphrase           string   `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetKeystoreDetailRequest) Reset()         { *m = GetKeystoreDetailRequest{} }
+func (m *GetKeystoreDetailRequest) String() string { return proto.CompactTextString(m) }
+func (*GetKeystoreDetailRequest) ProtoMessage()    {}
+func (*GetKeystoreDetailRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{99}
+}
+func (m *GetKeystoreDetailRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetKeystoreDetailRequest.Unmarshal(m, b)
+}
+func (m *GetKeystoreDetailRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetKeystoreDetailRequest.Marshal(b, m, deterministic)
+}
+func (m *GetKeystoreDetailRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetKeystoreDetailRequest.Merge(m, src)
+}
+func (m *GetKeystoreDetailRequest) XXX_Size() int {
+	return xxx_messageInfo_GetKeystoreDetailRequest.Size(m)
+}
+func (m *GetKeystoreDetailRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetKeystoreDetailRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetKeystoreDetailRequest proto.InternalMessageInfo
+
+func (m *GetKeystoreDetailRequest) GetWalletId() string {
+	if m != nil {
+		return m.WalletId
+	}
+	return ""
+}
+
+func (m *GetKeystoreDetailRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+type GetKeystoreDetailResponse struct {
+	WalletId             string     `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Wallet               *PocWallet `protobuf:"bytes,2,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GetKeystoreDetailResponse) Reset()         { *m = GetKeystoreDetailResponse{} }
+func (m *GetKeystoreDetailResponse) String() string { return proto.CompactTextString(m) }
+func (*GetKeystoreDetailResponse) ProtoMessage()    {}
+func (*GetKeystoreDetailResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{100}
+}
+func (m *GetKeystoreDetailResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetKeystoreDetailResponse.Unmarshal(m, b)
+}
+func (m *GetKeystoreDetailResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetKeystoreDetailResponse.Marshal(b, m, deterministic)
+}
+func (m *GetKeystoreDetailResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetKeystoreDetailResponse.Merge(m, src)
+}
+func (m *GetKeystoreDetailResponse) XXX_Size() int {
+	return xxx_messageInfo_GetKeystoreDetailResponse.Size(m)
+}
+func (m *GetKeystoreDetailResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetKeystoreDetailResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetKeystoreDetailResponse proto.InternalMessageInfo
+
+func (m *GetKeystoreDetailResponse) GetWalletId() string {
+	if m != nil {
+		return m.WalletId
+	}
+	return ""
+}
+
+func (m *GetKeystoreDetailResponse) GetWallet() *PocWallet {
+	if m != nil {
+		return m.Wallet
+	}
+	return nil
+}
+
+type GetKeystoreResponse struct {
+	Wallets              []*WalletSummary `protobuf:"bytes,1,rep,name=wallets,proto3" json:"wallets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetKeystoreResponse) Reset()         { *m = GetKeystoreResponse{} }
+func (m *GetKeystoreResponse) String() string { return proto.CompactTextString(m) }
+func (*GetKeystoreResponse) ProtoMessage()    {}
+func (*GetKeystoreResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{101}
+}
+func (m *GetKeystoreResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetKeystoreResponse.Unmarshal(m, b)
+}
+func (m *GetKeystoreResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetKeystoreResponse.Marshal(b, m, deterministic)
+}
+func (m *GetKeystoreResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetKeystoreResponse.Merge(m, src)
+}
+func (m *GetKeystoreResponse) XXX_Size() int {
+	return xxx_messageInfo_GetKeystoreResponse.Size(m)
+}
+func (m *GetKeystoreResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetKeystoreResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetKeystoreResponse proto.InternalMessageInfo
+
+func (m *GetKeystoreResponse) GetWallets() []*WalletSummary {
+	if m != nil {
+		return m.Wallets
+	}
+	return nil
+}
+
+type UnlockWalletRequest struct {
+	Passphrase           string   `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnlockWalletRequest) Reset()         { *m = UnlockWalletRequest{} }
+func (m *UnlockWalletRequest) String() string { return proto.CompactTextString(m) }
+func (*UnlockWalletRequest) ProtoMessage()    {}
+func (*UnlockWalletRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{102}
+}
+func (m *UnlockWalletRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnlockWalletRequest.Unmarshal(m, b)
+}
+func (m *UnlockWalletRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnlockWalletRequest.Marshal(b, m, deterministic)
+}
+func (m *UnlockWalletRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockWalletRequest.Merge(m, src)
+}
+func (m *UnlockWalletRequest) XXX_Size() int {
+	return xxx_messageInfo_UnlockWalletRequest.Size(m)
+}
+func (m *UnlockWalletRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockWalletRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnlockWalletRequest proto.InternalMessageInfo
+
+func (m *UnlockWalletRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+type UnlockWalletResponse struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error                string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnlockWalletResponse) Reset()         { *m = UnlockWalletResponse{} }
+func (m *UnlockWalletResponse) String() string { return proto.CompactTextString(m) }
+func (*UnlockWalletResponse) ProtoMessage()    {}
+func (*UnlockWalletResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{103}
+}
+func (m *UnlockWalletResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnlockWalletResponse.Unmarshal(m, b)
+}
+func (m *UnlockWalletResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnlockWalletResponse.Marshal(b, m, deterministic)
+}
+func (m *UnlockWalletResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockWalletResponse.Merge(m, src)
+}
+func (m *UnlockWalletResponse) XXX_Size() int {
+	return xxx_messageInfo_UnlockWalletResponse.Size(m)
+}
+func (m *UnlockWalletResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockWalletResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnlockWalletResponse proto.InternalMessageInfo
+
+func (m *UnlockWalletResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *UnlockWalletResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type LockWalletResponse struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error                string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LockWalletResponse) Reset()         { *m = LockWalletResponse{} }
+func (m *LockWalletResponse) String() string { return proto.CompactTextString(m) }
+func (*LockWalletResponse) ProtoMessage()    {}
+func (*LockWalletResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{104}
+}
+func (m *LockWalletResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LockWalletResponse.Unmarshal(m, b)
+}
+func (m *LockWalletResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LockWalletResponse.Marshal(b, m, deterministic)
+}
+func (m *LockWalletResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockWalletResponse.Merge(m, src)
+}
+func (m *LockWalletResponse) XXX_Size() int {
+	return xxx_messageInfo_LockWalletResponse.Size(m)
+}
+func (m *LockWalletResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockWalletResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockWalletResponse proto.InternalMessageInfo
+
+func (m *LockWalletResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *LockWalletResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ChangePrivatePassRequest struct {
+	OldPrivpass          string   `protobuf:"bytes,1,opt,name=old_privpass,json=oldPrivpass,proto3" json:"old_privpass,omitempty"`
+	NewPrivpass          string   `protobuf:"bytes,2,opt,name=new_privpass,json=newPrivpass,proto3" json:"new_privpass,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChangePrivatePassRequest) Reset()         { *m = ChangePrivatePassRequest{} }
+func (m *ChangePrivatePassRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangePrivatePassRequest) ProtoMessage()    {}
+func (*ChangePrivatePassRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{105}
+}
+func (m *ChangePrivatePassRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangePrivatePassRequest.Unmarshal(m, b)
+}
+func (m *ChangePrivatePassRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangePrivatePassRequest.Marshal(b, m, deterministic)
+}
+func (m *ChangePrivatePassRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePrivatePassRequest.Merge(m, src)
+}
+func (m *ChangePrivatePassRequest) XXX_Size() int {
+	return xxx_messageInfo_ChangePrivatePassRequest.Size(m)
+}
+func (m *ChangePrivatePassRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePrivatePassRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangePrivatePassRequest proto.InternalMessageInfo
+
+func (m *ChangePrivatePassRequest) GetOldPrivpass() string {
+	if m != nil {
+		return m.OldPrivpass
+	}
+	return ""
+}
+
+func (m *ChangePrivatePassRequest) GetNewPrivpass() string {
+	if m != nil {
+		return m.NewPrivpass
+	}
+	return ""
+}
+
+type ChangePrivatePassResponse struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChangePrivatePassResponse) Reset()         { *m = ChangePrivatePassResponse{} }
+func (m *ChangePrivatePassResponse) String() string { return proto.CompactTextString(m) }
+func (*ChangePrivatePassResponse) ProtoMessage()    {}
+func (*ChangePrivatePassResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{106}
+}
+func (m *ChangePrivatePassResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangePrivatePassResponse.Unmarshal(m, b)
+}
+func (m *ChangePrivatePassResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangePrivatePassResponse.Marshal(b, m, deterministic)
+}
+func (m *ChangePrivatePassResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePrivatePassResponse.Merge(m, src)
+}
+func (m *ChangePrivatePassResponse) XXX_Size() int {
+	return xxx_messageInfo_ChangePrivatePassResponse.Size(m)
+}
+func (m *ChangePrivatePassResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePrivatePassResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangePrivatePassResponse proto.InternalMessageInfo
+
+func (m *ChangePrivatePassResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+type ChangePublicPassRequest struct {
+	OldPubpass           string   `protobuf:"bytes,1,opt,name=old_pubpass,json=oldPubpass,proto3" json:"old_pubpass,omitempty"`
+	NewPubpass           string   `protobuf:"bytes,2,opt,name=new_pubpass,json=newPubpass,proto3" json:"new_pubpass,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChangePublicPassRequest) Reset()         { *m = ChangePublicPassRequest{} }
+func (m *ChangePublicPassRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangePublicPassRequest) ProtoMessage()    {}
+func (*ChangePublicPassRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{107}
+}
+func (m *ChangePublicPassRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangePublicPassRequest.Unmarshal(m, b)
+}
+func (m *ChangePublicPassRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangePublicPassRequest.Marshal(b, m, deterministic)
+}
+func (m *ChangePublicPassRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePublicPassRequest.Merge(m, src)
+}
+func (m *ChangePublicPassRequest) XXX_Size() int {
+	return xxx_messageInfo_ChangePublicPassRequest.Size(m)
+}
+func (m *ChangePublicPassRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePublicPassRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangePublicPassRequest proto.InternalMessageInfo
+
+func (m *ChangePublicPassRequest) GetOldPubpass() string {
+	if m != nil {
+		return m.OldPubpass
+	}
+	return ""
+}
+
+func (m *ChangePublicPassRequest) GetNewPubpass() string {
+	if m != nil {
+		return m.NewPubpass
+	}
+	return ""
+}
+
+type ChangePublicPassResponse struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChangePublicPassResponse) Reset()         { *m = ChangePublicPassResponse{} }
+func (m *ChangePublicPassResponse) String() string { return proto.CompactTextString(m) }
+func (*ChangePublicPassResponse) ProtoMessage()    {}
+func (*ChangePublicPassResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{108}
+}
+func (m *ChangePublicPassResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangePublicPassResponse.Unmarshal(m, b)
+}
+func (m *ChangePublicPassResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangePublicPassResponse.Marshal(b, m, deterministic)
+}
+func (m *ChangePublicPassResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePublicPassResponse.Merge(m, src)
+}
+func (m *ChangePublicPassResponse) XXX_Size() int {
+	return xxx_messageInfo_ChangePublicPassResponse.Size(m)
+}
+func (m *ChangePublicPassResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePublicPassResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangePublicPassResponse proto.InternalMessageInfo
+
+func (m *ChangePublicPassResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+type GetGovernConfigRequest struct {
+	Id                   uint32   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetGovernConfigRequest) Reset()         { *m = GetGovernConfigRequest{} }
+func (m *GetGovernConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*GetGovernConfigRequest) ProtoMessage()    {}
+func (*GetGovernConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{109}
+}
+func (m *GetGovernConfigRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGovernConfigRequest.Unmarshal(m, b)
+}
+func (m *GetGovernConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGovernConfigRequest.Marshal(b, m, deterministic)
+}
+func (m *GetGovernConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGovernConfigRequest.Merge(m, src)
+}
+func (m *GetGovernConfigRequest) XXX_Size() int {
+	return xxx_messageInfo_GetGovernConfigRequest.Size(m)
+}
+func (m *GetGovernConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGovernConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGovernConfigRequest proto.InternalMessageInfo
+
+func (m *GetGovernConfigRequest) GetId() uint32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetGovernConfigHistoryRequest struct {
+	Id                   uint32   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeShadow        bool     `protobuf:"varint,2,opt,name=include_shadow,json=includeShadow,proto3" json:"include_shadow,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetGovernConfigHistoryRequest) Reset()         { *m = GetGovernConfigHistoryRequest{} }
+func (m *GetGovernConfigHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetGovernConfigHistoryRequest) ProtoMessage()    {}
+func (*GetGovernConfigHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{110}
+}
+func (m *GetGovernConfigHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGovernConfigHistoryRequest.Unmarshal(m, b)
+}
+func (m *GetGovernConfigHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGovernConfigHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *GetGovernConfigHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGovernConfigHistoryRequest.Merge(m, src)
+}
+func (m *GetGovernConfigHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetGovernConfigHistoryRequest.Size(m)
+}
+func (m *GetGovernConfigHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGovernConfigHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGovernConfigHistoryRequest proto.InternalMessageInfo
+
+func (m *GetGovernConfigHistoryRequest) GetId() uint32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *GetGovernConfigHistoryRequest) GetIncludeShadow() bool {
+	if m != nil {
+		return m.IncludeShadow
+	}
+	return false
+}
+
+type GetGovernConfigHistoryResponse struct {
+	Id                   uint32          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Configs              []*GovernConfig `protobuf:"bytes,2,rep,name=configs,proto3" json:"configs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetGovernConfigHistoryResponse) Reset()         { *m = GetGovernConfigHistoryResponse{} }
+func (m *GetGovernConfigHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetGovernConfigHistoryResponse) ProtoMessage()    {}
+func (*GetGovernConfigHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{111}
+}
+func (m *GetGovernConfigHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGovernConfigHistoryResponse.Unmarshal(m, b)
+}
+func (m *GetGovernConfigHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGovernConfigHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *GetGovernConfigHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGovernConfigHistoryResponse.Merge(m, src)
+}
+func (m *GetGovernConfigHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetGovernConfigHistoryResponse.Size(m)
+}
+func (m *GetGovernConfigHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGovernConfigHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGovernConfigHistoryResponse proto.InternalMessageInfo
+
+func (m *GetGovernConfigHistoryResponse) GetId() uint32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *GetGovernConfigHistoryResponse) GetConfigs() []*GovernConfig {
+	if m != nil {
+		return m.Configs
+	}
+	return nil
+}
+
+type GovernSenateNode struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Weight               uint64   `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GovernSenateNode) Reset()         { *m = GovernSenateNode{} }
+func (m *GovernSenateNode) String() string { return proto.CompactTextString(m) }
+func (*GovernSenateNode) ProtoMessage()    {}
+func (*GovernSenateNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{112}
+}
+func (m *GovernSenateNode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GovernSenateNode.Unmarshal(m, b)
+}
+func (m *GovernSenateNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GovernSenateNode.Marshal(b, m, deterministic)
+}
+func (m *GovernSenateNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernSenateNode.Merge(m, src)
+}
+func (m *GovernSenateNode) XXX_Size() int {
+	return xxx_messageInfo_GovernSenateNode.Size(m)
+}
+func (m *GovernSenateNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernSenateNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernSenateNode proto.InternalMessageInfo
+
+func (m *GovernSenateNode) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GovernSenateNode) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+type GovernSenateConfig struct {
+	Nodes                []*GovernSenateNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *GovernSenateConfig) Reset()         { *m = GovernSenateConfig{} }
+func (m *GovernSenateConfig) String() string { return proto.CompactTextString(m) }
+func (*GovernSenateConfig) ProtoMessage()    {}
+func (*GovernSenateConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{113}
+}
+func (m *GovernSenateConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GovernSenateConfig.Unmarshal(m, b)
+}
+func (m *GovernSenateConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GovernSenateConfig.Marshal(b, m, deterministic)
+}
+func (m *GovernSenateConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernSenateConfig.Merge(m, src)
+}
+func (m *GovernSenateConfig) XXX_Size() int {
+	return xxx_messageInfo_GovernSenateConfig.Size(m)
+}
+func (m *GovernSenateConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernSenateConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernSenateConfig proto.InternalMessageInfo
+
+func (m *GovernSenateConfig) GetNodes() []*GovernSenateNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+type GovernVersionConfig struct {
+	Version              string   `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GovernVersionConfig) Reset()         { *m = GovernVersionConfig{} }
+func (m *GovernVersionConfig) String() string { return proto.CompactTextString(m) }
+func (*GovernVersionConfig) ProtoMessage()    {}
+func (*GovernVersionConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{114}
+}
+func (m *GovernVersionConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GovernVersionConfig.Unmarshal(m, b)
+}
+func (m *GovernVersionConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GovernVersionConfig.Marshal(b, m, deterministic)
+}
+func (m *GovernVersionConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernVersionConfig.Merge(m, src)
+}
+func (m *GovernVersionConfig) XXX_Size() int {
+	return xxx_messageInfo_GovernVersionConfig.Size(m)
+}
+func (m *GovernVersionConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernVersionConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernVersionConfig proto.InternalMessageInfo
+
+func (m *GovernVersionConfig) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+type GovernSupperAddressInfo struct {
+	Id                   uint32   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GovernSupperAddressInfo) Reset()         { *m = GovernSupperAddressInfo{} }
+func (m *GovernSupperAddressInfo) String() string { return proto.CompactTextString(m) }
+func (*GovernSupperAddressInfo) ProtoMessage()    {}
+func (*GovernSupperAddressInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{115}
+}
+func (m *GovernSupperAddressInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GovernSupperAddressInfo.Unmarshal(m, b)
+}
+func (m *GovernSupperAddressInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GovernSupperAddressInfo.Marshal(b, m, deterministic)
+}
+func (m *GovernSupperAddressInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernSupperAddressInfo.Merge(m, src)
+}
+func (m *GovernSupperAddressInfo) XXX_Size() int {
+	return xxx_messageInfo_GovernSupperAddressInfo.Size(m)
+}
+func (m *GovernSupperAddressInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernSupperAddressInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernSupperAddressInfo proto.InternalMessageInfo
+
+func (m *GovernSupperAddressInfo) GetId() uint32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *GovernSupperAddressInfo) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type GovernSupperConfig struct {
+	Address              []*GovernSupperAddressInfo `protobuf:"bytes,1,rep,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *GovernSupperConfig) Reset()         { *m = GovernSupperConfig{} }
+func (m *GovernSupperConfig) String() string { return proto.CompactTextString(m) }
+func (*GovernSupperConfig) ProtoMessage()    {}
+func (*GovernSupperConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{116}
+}
+func (m *GovernSupperConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GovernSupperConfig.Unmarshal(m, b)
+}
+func (m *GovernSupperConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GovernSupperConfig.Marshal(b, m, deterministic)
+}
+func (m *GovernSupperConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernSupperConfig.Merge(m, src)
+}
+func (m *GovernSupperConfig) XXX_Size() int {
+	return xxx_messageInfo_GovernSupperConfig.Size(m)
+}
+func (m *GovernSupperConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernSupperConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernSupperConfig proto.InternalMessageInfo
+
+func (m *GovernSupperConfig) GetAddress() []*GovernSupperAddressInfo {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+type GovernConfig struct {
+	// Types that are valid to be assigned to Config:
+	//	*GovernConfig_GovernSupperConfig
+	//	*GovernConfig_GovernSenateConfig
+	//	*GovernConfig_GovernVersionConfig
+	Config               isGovernConfig_Config `protobuf_oneof:"config"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GovernConfig) Reset()         { *m = GovernConfig{} }
+func (m *GovernConfig) String() string { return proto.CompactTextString(m) }
+func (*GovernConfig) ProtoMessage()    {}
+func (*GovernConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{117}
+}
+func (m *GovernConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GovernConfig.Unmarshal(m, b)
+}
+func (m *GovernConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GovernConfig.Marshal(b, m, deterministic)
+}
+func (m *GovernConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernConfig.Merge(m, src)
+}
+func (m *GovernConfig) XXX_Size() int {
+	return xxx_messageInfo_GovernConfig.Size(m)
+}
+func (m *GovernConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernConfig proto.InternalMessageInfo
+
+type isGovernConfig_Config interface {
+	isGovernConfig_Config()
+}
+
+type GovernConfig_GovernSupperConfig struct {
+	GovernSupperConfig *GovernSupperConfig `protobuf:"bytes,1,opt,name=govern_supper_config,json=governSupperConfig,proto3,oneof" json:"govern_supper_config,omitempty"`
+}
+type GovernConfig_GovernSenateConfig struct {
+	GovernSenateConfig *GovernSenateConfig `protobuf:"bytes,2,opt,name=govern_senate_config,json=governSenateConfig,proto3,oneof" json:"govern_senate_config,omitempty"`
+}
+type GovernConfig_GovernVersionConfig struct {
+	GovernVersionConfig *GovernVersionConfig `protobuf:"bytes,3,opt,name=govern_version_config,json=governVersionConfig,proto3,oneof" json:"govern_version_config,omitempty"`
+}
+
+func (*GovernConfig_GovernSupperConfig) isGovernConfig_Config()  {}
+func (*GovernConfig_GovernSenateConfig) isGovernConfig_Config()  {}
+func (*GovernConfig_GovernVersionConfig) isGovernConfig_Config() {}
+
+func (m *GovernConfig) GetConfig() isGovernConfig_Config {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *GovernConfig) GetGovernSupperConfig() *GovernSupperConfig {
+	if x, ok := m.GetConfig().(*GovernConfig_GovernSupperConfig); ok {
+		return x.GovernSupperConfig
+	}
+	return nil
+}
+
+func (m *GovernConfig) GetGovernSenateConfig() *GovernSenateConfig {
+	if x, ok := m.GetConfig().(*GovernConfig_GovernSenateConfig); ok {
+		return x.GovernSenateConfig
+	}
+	return nil
+}
+
+func (m *GovernConfig) GetGovernVersionConfig() *GovernVersionConfig {
+	if x, ok := m.GetConfig().(*GovernConfig_GovernVersionConfig); ok {
+		return x.GovernVersionConfig
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*GovernConfig) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*GovernConfig_GovernSupperConfig)(nil),
+		(*GovernConfig_GovernSenateConfig)(nil),
+		(*GovernConfig_GovernVersionConfig)(nil),
+	}
+}
+
+type GetGovernConfigResponse struct {
+	Id                   uint32        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TxId                 string        `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	ActivateHeight       uint64        `protobuf:"varint,3,opt,name=activate_height,json=activateHeight,proto3" json:"activate_height,omitempty"`
+	BlockHeight          uint64        `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Config               *GovernConfig `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetGovernConfigResponse) Reset()         { *m = GetGovernConfigResponse{} }
+func (m *GetGovernConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*GetGovernConfigResponse) ProtoMessage()    {}
+func (*GetGovernConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{118}
+}
+func (m *GetGovernConfigResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGovernConfigResponse.Unmarshal(m, b)
+}
+func (m *GetGovernConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGovernConfigResponse.Marshal(b, m, deterministic)
+}
+func (m *GetGovernConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGovernConfigResponse.Merge(m, src)
+}
+func (m *GetGovernConfigResponse) XXX_Size() int {
+	return xxx_messageInfo_GetGovernConfigResponse.Size(m)
+}
+func (m *GetGovernConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGovernConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGovernConfigResponse proto.InternalMessageInfo
+
+func (m *GetGovernConfigResponse) GetId() uint32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *GetGovernConfigResponse) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *GetGovernConfigResponse) GetActivateHeight() uint64 {
+	if m != nil {
+		return m.ActivateHeight
+	}
+	return 0
+}
+
+func (m *GetGovernConfigResponse) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *GetGovernConfigResponse) GetConfig() *GovernConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+// BlockEvent reports a block connected to or disconnected from the main chain,
+// a reorganization is reported as disconnected blocks followed by connected blocks.
+type BlockEvent struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Hash                 string   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Height               uint64   `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Previous             string   `protobuf:"bytes,4,opt,name=previous,proto3" json:"previous,omitempty"`
+	Timestamp            int64    `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	TxCount              uint32   `protobuf:"varint,6,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	PublicKey            string   `protobuf:"bytes,7,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	BitLength            uint32   `protobuf:"varint,8,opt,name=bit_length,json=bitLength,proto3" json:"bit_length,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockEvent) Reset()         { *m = BlockEvent{} }
+func (m *BlockEvent) String() string { return proto.CompactTextString(m) }
+func (*BlockEvent) ProtoMessage()    {}
+func (*BlockEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{119}
+}
+func (m *BlockEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockEvent.Unmarshal(m, b)
+}
+func (m *BlockEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockEvent.Marshal(b, m, deterministic)
+}
+func (m *BlockEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockEvent.Merge(m, src)
+}
+func (m *BlockEvent) XXX_Size() int {
+	return xxx_messageInfo_BlockEvent.Size(m)
+}
+func (m *BlockEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockEvent proto.InternalMessageInfo
+
+func (m *BlockEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *BlockEvent) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *BlockEvent) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockEvent) GetPrevious() string {
+	if m != nil {
+		return m.Previous
+	}
+	return ""
+}
+
+func (m *BlockEvent) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *BlockEvent) GetTxCount() uint32 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *BlockEvent) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *BlockEvent) GetBitLength() uint32 {
+	if m != nil {
+		return m.BitLength
+	}
+	return 0
+}
+
+// TxPoolEvent reports a transaction accepted by txpool.
+type TxPoolEvent struct {
+	TxId                 string   `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Size                uint32   `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Time                 int64    `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxPoolEvent) Reset()         { *m = TxPoolEvent{} }
+func (m *TxPoolEvent) String() string { return proto.CompactTextString(m) }
+func (*TxPoolEvent) ProtoMessage()    {}
+func (*TxPoolEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{120}
+}
+func (m *TxPoolEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxPoolEvent.Unmarshal(m, b)
+}
+func (m *TxPoolEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxPoolEvent.Marshal(b, m, deterministic)
+}
+func (m *TxPoolEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxPoolEvent.Merge(m, src)
+}
+func (m *TxPoolEvent) XXX_Size() int {
+	return xxx_messageInfo_TxPoolEvent.Size(m)
+}
+func (m *TxPoolEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxPoolEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxPoolEvent proto.InternalMessageInfo
+
+func (m *TxPoolEvent) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *TxPoolEvent) GetSize() uint32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *TxPoolEvent) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+// WorkSpaceEvent reports a workspace added, removed, changing state or making progress,
+// or a plot file found, becoming unavailable or available in proof_dir, or a workspace
+// demoted from mining for slow proof lookups.
+type WorkSpaceEvent struct {
+	Type                 string     `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	SpaceId              string     `protobuf:"bytes,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Space                *WorkSpace `protobuf:"bytes,3,opt,name=space,proto3" json:"space,omitempty"`
+	PreviousState        string     `protobuf:"bytes,4,opt,name=previous_state,json=previousState,proto3" json:"previous_state,omitempty"`
+	Dir                  string     `protobuf:"bytes,5,opt,name=dir,proto3" json:"dir,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *WorkSpaceEvent) Reset()         { *m = WorkSpaceEvent{} }
+func (m *WorkSpaceEvent) String() string { return proto.CompactTextString(m) }
+func (*WorkSpaceEvent) ProtoMessage()    {}
+func (*WorkSpaceEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{121}
+}
+func (m *WorkSpaceEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkSpaceEvent.Unmarshal(m, b)
+}
+func (m *WorkSpaceEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkSpaceEvent.Marshal(b, m, deterministic)
+}
+func (m *WorkSpaceEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkSpaceEvent.Merge(m, src)
+}
+func (m *WorkSpaceEvent) XXX_Size() int {
+	return xxx_messageInfo_WorkSpaceEvent.Size(m)
+}
+func (m *WorkSpaceEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkSpaceEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkSpaceEvent proto.InternalMessageInfo
+
+func (m *WorkSpaceEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *WorkSpaceEvent) GetSpaceId() string {
+	if m != nil {
+		return m.SpaceId
+	}
+	return ""
+}
+
+func (m *WorkSpaceEvent) GetSpace() *WorkSpace {
+	if m != nil {
+		return m.Space
+	}
+	return nil
+}
+
+func (m *WorkSpaceEvent) GetPreviousState() string {
+	if m != nil {
+		return m.PreviousState
+	}
+	return ""
+}
+
+func (m *WorkSpaceEvent) GetDir() string {
+	if m != nil {
+		return m.Dir
+	}
+	return ""
+}
+
+// MiningEvent reports the best proof found for a height, or a mined block submitted to chain.
+type MiningEvent struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Height               uint64   `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	SpaceId              string   `protobuf:"bytes,3,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	PublicKey            string   `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	BitLength            uint32   `protobuf:"varint,5,opt,name=bit_length,json=bitLength,proto3" json:"bit_length,omitempty"`
+	Quality              string   `protobuf:"bytes,6,opt,name=quality,proto3" json:"quality,omitempty"`
+	BlockHash            string   `protobuf:"bytes,7,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Accepted             bool     `protobuf:"varint,8,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	RejectReason         string   `protobuf:"bytes,9,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"`
+	Reward               string   `protobuf:"bytes,10,opt,name=reward,proto3" json:"reward,omitempty"`
+	Time                 int64    `protobuf:"varint,11,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MiningEvent) Reset()         { *m = MiningEvent{} }
+func (m *MiningEvent) String() string { return proto.CompactTextString(m) }
+func (*MiningEvent) ProtoMessage()    {}
+func (*MiningEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{122}
+}
+func (m *MiningEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MiningEvent.Unmarshal(m, b)
+}
+func (m *MiningEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MiningEvent.Marshal(b, m, deterministic)
+}
+func (m *MiningEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MiningEvent.Merge(m, src)
+}
+func (m *MiningEvent) XXX_Size() int {
+	return xxx_messageInfo_MiningEvent.Size(m)
+}
+func (m *MiningEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MiningEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MiningEvent proto.InternalMessageInfo
+
+func (m *MiningEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *MiningEvent) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
 	return 0
 }
 
-func (m *GetGovernConfigResponse) GetConfig() *GovernConfig {
+func (m *MiningEvent) GetSpaceId() string {
 	if m != nil {
-		return m.Config
+		return m.SpaceId
 	}
-	return nil
+	return ""
+}
+
+func (m *MiningEvent) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *MiningEvent) GetBitLength() uint32 {
+	if m != nil {
+		return m.BitLength
+	}
+	return 0
+}
+
+func (m *MiningEvent) GetQuality() string {
+	if m != nil {
+		return m.Quality
+	}
+	return ""
+}
+
+func (m *MiningEvent) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *MiningEvent) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
+	}
+	return false
+}
+
+func (m *MiningEvent) GetRejectReason() string {
+	if m != nil {
+		return m.RejectReason
+	}
+	return ""
+}
+
+func (m *MiningEvent) GetReward() string {
+	if m != nil {
+		return m.Reward
+	}
+	return ""
+}
+
+func (m *MiningEvent) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
 }
 
 func init() {
@@ -6133,6 +9035,10 @@ func init() {
 	proto.RegisterType((*TxOutPoint)(nil), "rpcprotobuf.TxOutPoint")
 	proto.RegisterType((*GetRawTransactionRequest)(nil), "rpcprotobuf.GetRawTransactionRequest")
 	proto.RegisterType((*GetRawTransactionResponse)(nil), "rpcprotobuf.GetRawTransactionResponse")
+	proto.RegisterType((*SendRawTransactionRequest)(nil), "rpcprotobuf.SendRawTransactionRequest")
+	proto.RegisterType((*SendRawTransactionResponse)(nil), "rpcprotobuf.SendRawTransactionResponse")
+	proto.RegisterType((*TestMempoolAcceptRequest)(nil), "rpcprotobuf.TestMempoolAcceptRequest")
+	proto.RegisterType((*TestMempoolAcceptResponse)(nil), "rpcprotobuf.TestMempoolAcceptResponse")
 	proto.RegisterType((*GetTxDescVerbose0Response)(nil), "rpcprotobuf.GetTxDescVerbose0Response")
 	proto.RegisterType((*GetTxDescVerbose1Response)(nil), "rpcprotobuf.GetTxDescVerbose1Response")
 	proto.RegisterType((*GetOrphanTxDescResponse)(nil), "rpcprotobuf.GetOrphanTxDescResponse")
@@ -6149,6 +9055,29 @@ func init() {
 	proto.RegisterType((*WorkSpaceResponse)(nil), "rpcprotobuf.WorkSpaceResponse")
 	proto.RegisterType((*WorkSpacesResponse)(nil), "rpcprotobuf.WorkSpacesResponse")
 	proto.RegisterType((*ActOnSpaceKeeperResponse)(nil), "rpcprotobuf.ActOnSpaceKeeperResponse")
+	proto.RegisterType((*PlotWorkSpaceRequest)(nil), "rpcprotobuf.PlotWorkSpaceRequest")
+	proto.RegisterType((*VerifyWorkSpaceRequest)(nil), "rpcprotobuf.VerifyWorkSpaceRequest")
+	proto.RegisterType((*VerifyWorkSpaceResponse)(nil), "rpcprotobuf.VerifyWorkSpaceResponse")
+	proto.RegisterType((*VerifyWorkSpaceResponse_CorruptRange)(nil), "rpcprotobuf.VerifyWorkSpaceResponse.CorruptRange")
+	proto.RegisterType((*GetPlotQueueResponse)(nil), "rpcprotobuf.GetPlotQueueResponse")
+	proto.RegisterType((*GetPlotQueueResponse_Plot)(nil), "rpcprotobuf.GetPlotQueueResponse.Plot")
+	proto.RegisterType((*MoveCapacitySpaceRequest)(nil), "rpcprotobuf.MoveCapacitySpaceRequest")
+	proto.RegisterType((*RebalanceCapacitySpacesRequest)(nil), "rpcprotobuf.RebalanceCapacitySpacesRequest")
+	proto.RegisterType((*SpaceMove)(nil), "rpcprotobuf.SpaceMove")
+	proto.RegisterType((*GetCapacitySpaceMovesResponse)(nil), "rpcprotobuf.GetCapacitySpaceMovesResponse")
+	proto.RegisterType((*PlanCapacityRequest)(nil), "rpcprotobuf.PlanCapacityRequest")
+	proto.RegisterType((*CapacityPlan)(nil), "rpcprotobuf.CapacityPlan")
+	proto.RegisterType((*CapacityPlan_Space)(nil), "rpcprotobuf.CapacityPlan.Space")
+	proto.RegisterType((*CapacityPlan_Directory)(nil), "rpcprotobuf.CapacityPlan.Directory")
+	proto.RegisterType((*CapacityPlan_Disk)(nil), "rpcprotobuf.CapacityPlan.Disk")
+	proto.RegisterType((*ApplyCapacityPlanRequest)(nil), "rpcprotobuf.ApplyCapacityPlanRequest")
+	proto.RegisterType((*EstimateMiningRevenueRequest)(nil), "rpcprotobuf.EstimateMiningRevenueRequest")
+	proto.RegisterType((*ProofLatency)(nil), "rpcprotobuf.ProofLatency")
+	proto.RegisterType((*GetProofLatenciesResponse)(nil), "rpcprotobuf.GetProofLatenciesResponse")
+	proto.RegisterType((*GetProofLatenciesResponse_Space)(nil), "rpcprotobuf.GetProofLatenciesResponse.Space")
+	proto.RegisterType((*GetProofLatenciesResponse_Directory)(nil), "rpcprotobuf.GetProofLatenciesResponse.Directory")
+	proto.RegisterType((*EstimateMiningRevenueResponse)(nil), "rpcprotobuf.EstimateMiningRevenueResponse")
+	proto.RegisterType((*EstimateMiningRevenueResponse_BitLength)(nil), "rpcprotobuf.EstimateMiningRevenueResponse.BitLength")
 	proto.RegisterType((*ConfigureSpaceKeeperByDirsRequest)(nil), "rpcprotobuf.ConfigureSpaceKeeperByDirsRequest")
 	proto.RegisterType((*ConfigureSpaceKeeperByDirsRequest_Allocation)(nil), "rpcprotobuf.ConfigureSpaceKeeperByDirsRequest.Allocation")
 	proto.RegisterType((*WorkSpacesByDirsResponse)(nil), "rpcprotobuf.WorkSpacesByDirsResponse")
@@ -6158,6 +9087,19 @@ func init() {
 	proto.RegisterType((*GetClientStatusResponsePeerInfo)(nil), "rpcprotobuf.GetClientStatusResponse.peerInfo")
 	proto.RegisterType((*GetClientStatusResponsePeerList)(nil), "rpcprotobuf.GetClientStatusResponse.peerList")
 	proto.RegisterType((*QuitClientResponse)(nil), "rpcprotobuf.QuitClientResponse")
+	proto.RegisterType((*GenerateBlocksRequest)(nil), "rpcprotobuf.GenerateBlocksRequest")
+	proto.RegisterType((*GenerateBlocksResponse)(nil), "rpcprotobuf.GenerateBlocksResponse")
+	proto.RegisterType((*GetShadowReportRequest)(nil), "rpcprotobuf.GetShadowReportRequest")
+	proto.RegisterType((*GetShadowReportResponse)(nil), "rpcprotobuf.GetShadowReportResponse")
+	proto.RegisterType((*GetShadowReportResponse_Slot)(nil), "rpcprotobuf.GetShadowReportResponse.Slot")
+	proto.RegisterType((*GetMinedBlocksRequest)(nil), "rpcprotobuf.GetMinedBlocksRequest")
+	proto.RegisterType((*GetMinedBlocksResponse)(nil), "rpcprotobuf.GetMinedBlocksResponse")
+	proto.RegisterType((*GetMinedBlocksResponse_Block)(nil), "rpcprotobuf.GetMinedBlocksResponse.Block")
+	proto.RegisterType((*PayoutAddress)(nil), "rpcprotobuf.PayoutAddress")
+	proto.RegisterType((*GetPayoutAddressesResponse)(nil), "rpcprotobuf.GetPayoutAddressesResponse")
+	proto.RegisterType((*AddPayoutAddressRequest)(nil), "rpcprotobuf.AddPayoutAddressRequest")
+	proto.RegisterType((*RemovePayoutAddressRequest)(nil), "rpcprotobuf.RemovePayoutAddressRequest")
+	proto.RegisterType((*SetPayoutStrategyRequest)(nil), "rpcprotobuf.SetPayoutStrategyRequest")
 	proto.RegisterType((*GetBlockHeightByPubKeyRequest)(nil), "rpcprotobuf.GetBlockHeightByPubKeyRequest")
 	proto.RegisterType((*GetBlockHeightByPubKeyResponse)(nil), "rpcprotobuf.GetBlockHeightByPubKeyResponse")
 	proto.RegisterType((*GetCoinbaseRequest)(nil), "rpcprotobuf.GetCoinbaseRequest")
@@ -6193,374 +9135,537 @@ func init() {
 	proto.RegisterType((*GovernSupperConfig)(nil), "rpcprotobuf.GovernSupperConfig")
 	proto.RegisterType((*GovernConfig)(nil), "rpcprotobuf.GovernConfig")
 	proto.RegisterType((*GetGovernConfigResponse)(nil), "rpcprotobuf.GetGovernConfigResponse")
+	proto.RegisterType((*BlockEvent)(nil), "rpcprotobuf.BlockEvent")
+	proto.RegisterType((*TxPoolEvent)(nil), "rpcprotobuf.TxPoolEvent")
+	proto.RegisterType((*WorkSpaceEvent)(nil), "rpcprotobuf.WorkSpaceEvent")
+	proto.RegisterType((*MiningEvent)(nil), "rpcprotobuf.MiningEvent")
 }
 
 func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
 
 var fileDescriptor_00212fb1f9d3bf1c = []byte{
-	// 5784 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7c, 0x4d, 0x6c, 0x23, 0xc9,
-	0x75, 0xb0, 0x9b, 0xa4, 0x28, 0xf1, 0x91, 0xfa, 0x2b, 0x69, 0x34, 0x1c, 0xce, 0x8f, 0x34, 0xbd,
-	0x33, 0xbb, 0xe3, 0x99, 0x1d, 0x71, 0xa5, 0xdd, 0xc5, 0x7e, 0xdf, 0x20, 0x31, 0x32, 0x23, 0xed,
-	0xee, 0x4c, 0x66, 0x7f, 0xe4, 0x96, 0x2c, 0x07, 0xb0, 0x11, 0xba, 0xd9, 0xac, 0x21, 0xdb, 0x22,
-	0xbb, 0x7b, 0xfb, 0x47, 0xa2, 0x76, 0xbd, 0x41, 0xe2, 0xc4, 0x81, 0x81, 0xc4, 0x08, 0x1c, 0x03,
-	0x31, 0x62, 0x04, 0x41, 0x0c, 0xf8, 0xe2, 0x43, 0x90, 0x5c, 0x72, 0x09, 0x90, 0x43, 0x4e, 0xb9,
-	0x06, 0x08, 0x90, 0x7b, 0x10, 0x9f, 0x72, 0x0d, 0x72, 0x08, 0x90, 0x04, 0x41, 0xbd, 0xaa, 0xea,
-	0xee, 0x6a, 0x76, 0x53, 0xdc, 0x3f, 0xc7, 0x41, 0xf6, 0x32, 0xc3, 0x7a, 0xfd, 0xfa, 0xfd, 0xd5,
-	0x7b, 0xaf, 0x5e, 0xbd, 0xaa, 0x16, 0xd4, 0x4c, 0xcf, 0xde, 0xf6, 0x7c, 0x37, 0x74, 0x49, 0xdd,
-	0xf7, 0x2c, 0xfc, 0xd5, 0x8d, 0x9e, 0xb5, 0xae, 0xf5, 0x5d, 0xb7, 0x3f, 0xa4, 0x6d, 0xd3, 0xb3,
-	0xdb, 0xa6, 0xe3, 0xb8, 0xa1, 0x19, 0xda, 0xae, 0x13, 0x70, 0xd4, 0xd6, 0x8b, 0xf8, 0x9f, 0x75,
-	0xbf, 0x4f, 0x9d, 0xfb, 0xc1, 0x99, 0xd9, 0xef, 0x53, 0xbf, 0xed, 0x7a, 0x88, 0x91, 0x83, 0x7d,
-	0x55, 0xd0, 0x92, 0xc4, 0xdb, 0x74, 0xe4, 0x85, 0xe7, 0xfc, 0xa1, 0xfe, 0xd7, 0x1a, 0x34, 0x1e,
-	0xef, 0x7f, 0xd5, 0x1c, 0x0e, 0x69, 0x78, 0x60, 0x86, 0x03, 0xd2, 0x84, 0x79, 0x2f, 0xf2, 0x3d,
-	0x37, 0xa0, 0x4d, 0x6d, 0x4b, 0xbb, 0xb3, 0x68, 0xc8, 0x21, 0x69, 0xc1, 0x82, 0xe5, 0xda, 0x4e,
-	0x78, 0xee, 0xd1, 0x66, 0x09, 0x1f, 0xc5, 0x63, 0xf6, 0x96, 0x69, 0x59, 0x6e, 0xe4, 0x84, 0xcd,
-	0x32, 0x7f, 0x4b, 0x0c, 0xc9, 0x8b, 0x40, 0xe8, 0x38, 0xa4, 0xbe, 0x63, 0x0e, 0x3b, 0xd6, 0xc0,
-	0x1e, 0xf6, 0x3a, 0x4e, 0x34, 0x6a, 0x56, 0x10, 0x69, 0x45, 0x3e, 0xd9, 0x63, 0x0f, 0xde, 0x89,
-	0x46, 0x0c, 0xdb, 0x76, 0x26, 0xb0, 0xe7, 0x38, 0xb6, 0x7c, 0x22, 0xb1, 0xf5, 0xdf, 0x2b, 0x41,
-	0x83, 0x8b, 0xbe, 0xe7, 0x9f, 0x7b, 0xa1, 0x4b, 0x36, 0xa0, 0x6a, 0xd9, 0xde, 0x80, 0xfa, 0x28,
-	0x7b, 0xcd, 0x10, 0x23, 0xf2, 0x32, 0x5c, 0x1e, 0x99, 0x41, 0x48, 0xfd, 0xce, 0xa0, 0xd3, 0xeb,
-	0x78, 0xbe, 0x7d, 0xda, 0x39, 0xa1, 0xe7, 0x1d, 0xea, 0x58, 0xa8, 0x49, 0xcd, 0x20, 0xfc, 0xf1,
-	0xe3, 0xfd, 0x03, 0xdf, 0x3e, 0x7d, 0x4a, 0xcf, 0x5f, 0x77, 0x2c, 0x42, 0x60, 0xee, 0xa4, 0xd3,
-	0xeb, 0x3c, 0x43, 0x8d, 0x6a, 0x46, 0xf9, 0x64, 0xff, 0x0d, 0x72, 0x1d, 0xc0, 0x8b, 0xba, 0x1d,
-	0xcf, 0xf4, 0xcd, 0x51, 0x80, 0x5a, 0xd4, 0x8c, 0x9a, 0x17, 0x75, 0x0f, 0x10, 0x40, 0x36, 0xa1,
-	0x8e, 0xc4, 0xc5, 0xf3, 0x39, 0x7c, 0x0e, 0x0c, 0x24, 0x10, 0xee, 0x01, 0xb1, 0x50, 0x54, 0xe4,
-	0xcf, 0x48, 0x31, 0x19, 0xaa, 0x88, 0xb7, 0xcc, 0x9f, 0x3c, 0xa5, 0xe7, 0x07, 0x51, 0x97, 0x09,
-	0x70, 0x1f, 0xd6, 0xd2, 0xc8, 0x8c, 0x30, 0xc3, 0x9e, 0x47, 0xec, 0x95, 0x04, 0xdb, 0xb7, 0x4f,
-	0x5f, 0x77, 0x2c, 0xfd, 0x67, 0x1a, 0xd4, 0x0e, 0x5c, 0x8b, 0x1b, 0x84, 0x5c, 0x85, 0xda, 0x19,
-	0xfe, 0xea, 0xd8, 0x3d, 0x61, 0x8d, 0x05, 0x0e, 0x78, 0xd2, 0x63, 0x76, 0xf2, 0xe9, 0xc8, 0xf4,
-	0x4f, 0x84, 0xfa, 0x62, 0x44, 0x76, 0xa0, 0xca, 0xc9, 0xa2, 0xce, 0xf5, 0xdd, 0x2b, 0xdb, 0x29,
-	0xa7, 0xdc, 0x4e, 0x9b, 0xda, 0x10, 0x88, 0xe4, 0x65, 0x58, 0x40, 0x9b, 0x9a, 0xe1, 0x00, 0xed,
-	0x91, 0x7d, 0x29, 0xed, 0x5c, 0x46, 0x75, 0xb0, 0x8f, 0x4e, 0xf6, 0x00, 0xea, 0x66, 0xaf, 0xe7,
-	0xbf, 0x6d, 0x3a, 0x66, 0x9f, 0xfa, 0x68, 0xa7, 0xfa, 0x6e, 0x53, 0x79, 0xef, 0x61, 0xf2, 0xdc,
-	0x48, 0x23, 0xeb, 0xbf, 0x06, 0x4b, 0xfb, 0xd4, 0xb7, 0x4f, 0xd1, 0xc7, 0xa5, 0xcb, 0x4a, 0xe7,
-	0xd3, 0x54, 0xe7, 0xdb, 0x80, 0x6a, 0xd7, 0x37, 0x1d, 0x6b, 0x20, 0x1c, 0x56, 0x8c, 0xc8, 0x3a,
-	0xcc, 0xd9, 0x4e, 0x8f, 0x8e, 0x85, 0xb3, 0xf2, 0x81, 0xfe, 0xb7, 0x1a, 0xc0, 0x81, 0x6b, 0x31,
-	0xce, 0x34, 0x08, 0xc8, 0x65, 0x16, 0x09, 0x5d, 0x66, 0x7b, 0xe9, 0x4d, 0x5e, 0xd4, 0x7d, 0x4a,
-	0xcf, 0xc9, 0x15, 0x58, 0x90, 0x2e, 0x24, 0xec, 0x37, 0xef, 0x71, 0xb7, 0x61, 0x0e, 0x10, 0x58,
-	0xbe, 0xed, 0x85, 0x9d, 0x81, 0x19, 0x0c, 0x84, 0xe7, 0x00, 0x07, 0x3d, 0x36, 0x03, 0x2e, 0x2b,
-	0xa7, 0x2f, 0xbc, 0x47, 0x0e, 0xc9, 0x3e, 0x2c, 0xf7, 0x62, 0xbd, 0xb8, 0x3d, 0xb9, 0x5d, 0xae,
-	0x2a, 0x76, 0x51, 0x75, 0x37, 0x96, 0x7a, 0xca, 0x58, 0xff, 0x73, 0x0d, 0xea, 0x29, 0xd3, 0x91,
-	0xe7, 0x60, 0xf1, 0x84, 0x9e, 0x07, 0xa1, 0xeb, 0xd3, 0x8e, 0x63, 0x8e, 0xa8, 0x50, 0xa5, 0x21,
-	0x81, 0xef, 0x98, 0x23, 0x5a, 0xe8, 0x0e, 0x4d, 0x98, 0xa7, 0x63, 0xcf, 0xf6, 0x69, 0x80, 0x9a,
-	0x54, 0x0c, 0x39, 0x24, 0xaf, 0x42, 0x4d, 0xc8, 0x4d, 0x99, 0x22, 0xe5, 0x3b, 0xf5, 0xdd, 0xcb,
-	0x8a, 0x98, 0x89, 0x1d, 0x8d, 0x04, 0x93, 0xac, 0x40, 0x39, 0x0a, 0xa8, 0x88, 0x67, 0xf6, 0x53,
-	0x7f, 0x15, 0xae, 0xbe, 0x49, 0xc3, 0x47, 0x43, 0xd7, 0x3a, 0x61, 0xf6, 0x79, 0x74, 0xfe, 0x98,
-	0xda, 0xfd, 0x41, 0x68, 0xd0, 0xf7, 0x22, 0x1a, 0xe0, 0x04, 0x0e, 0x10, 0x80, 0x72, 0x57, 0x0c,
-	0x31, 0xd2, 0x77, 0xe1, 0x5a, 0xfe, 0x6b, 0x81, 0xe7, 0x3a, 0x01, 0x25, 0x04, 0x2a, 0x38, 0x01,
-	0x5c, 0x5b, 0xfc, 0xad, 0x3f, 0x82, 0x75, 0xf6, 0x0e, 0x0d, 0xf8, 0x7b, 0xd3, 0x70, 0x53, 0x7c,
-	0x4b, 0x0a, 0xdf, 0x6d, 0x68, 0xa6, 0x69, 0x30, 0xde, 0x53, 0x79, 0xde, 0x86, 0x65, 0x29, 0xa7,
-	0x54, 0x29, 0x0f, 0x6d, 0x07, 0x2e, 0x4b, 0xb4, 0x59, 0x2d, 0xf0, 0x36, 0xcc, 0x1d, 0xf8, 0xae,
-	0xfb, 0x8c, 0x34, 0x40, 0x1b, 0x0b, 0x62, 0xda, 0x98, 0x39, 0xed, 0x98, 0xa5, 0x8a, 0x11, 0x95,
-	0x73, 0x39, 0x3e, 0x60, 0x23, 0x96, 0xb9, 0xba, 0x76, 0xd8, 0x19, 0x52, 0xa7, 0x1f, 0x0e, 0x84,
-	0xdf, 0xd7, 0xba, 0x76, 0xf8, 0x16, 0x02, 0xf4, 0xbb, 0xd0, 0x38, 0x70, 0xf7, 0x0e, 0xed, 0xbe,
-	0x63, 0x86, 0x91, 0x4f, 0x19, 0x55, 0x99, 0x44, 0x35, 0x9f, 0x8d, 0x02, 0x41, 0x4f, 0x0b, 0x74,
-	0x0a, 0x4b, 0x28, 0xea, 0x13, 0xe7, 0x99, 0xfb, 0x86, 0xeb, 0x1f, 0x8d, 0x8b, 0x84, 0x44, 0xa6,
-	0x0c, 0x93, 0x47, 0x03, 0x27, 0x50, 0xeb, 0x4a, 0xcb, 0x91, 0x6b, 0x50, 0x0b, 0xed, 0x11, 0x0d,
-	0x42, 0x73, 0xe4, 0xa1, 0x48, 0x65, 0x23, 0x01, 0xe8, 0x4f, 0xa1, 0x71, 0xc8, 0x8c, 0xe0, 0x58,
-	0xf4, 0x2d, 0xd7, 0x42, 0x6f, 0x0c, 0xa8, 0xe5, 0x3a, 0xbd, 0x00, 0xb9, 0x94, 0x0d, 0x39, 0x24,
-	0x37, 0xa1, 0x21, 0xd8, 0xa4, 0xe7, 0xac, 0xce, 0x19, 0x71, 0x73, 0xfd, 0x44, 0x83, 0xf2, 0xb1,
-	0xed, 0x90, 0x35, 0x98, 0x0b, 0xc7, 0x49, 0x4a, 0xac, 0x84, 0xe3, 0x27, 0x3d, 0x36, 0x25, 0xa7,
-	0x6e, 0x14, 0x8a, 0x24, 0x81, 0xbf, 0xd9, 0x6a, 0x17, 0x08, 0xee, 0xc2, 0xf9, 0xe3, 0x31, 0x93,
-	0xe4, 0xcc, 0x0e, 0x1d, 0x1e, 0xc4, 0x65, 0x16, 0xc4, 0x62, 0x48, 0xbe, 0x04, 0x8b, 0x12, 0xab,
-	0xc3, 0xb8, 0x8b, 0x10, 0x56, 0x53, 0x62, 0x5a, 0x2b, 0xa3, 0x11, 0xa4, 0x46, 0xfa, 0x31, 0x2c,
-	0x1d, 0xb9, 0x22, 0x70, 0xb8, 0x69, 0xb7, 0x93, 0x84, 0xa1, 0x61, 0x9c, 0xad, 0x4f, 0xa4, 0x49,
-	0x16, 0x64, 0x71, 0x1a, 0x59, 0x87, 0xb9, 0x53, 0x73, 0x18, 0xc9, 0xe9, 0xe7, 0x03, 0xbd, 0x0f,
-	0xf0, 0xc4, 0xf1, 0xa2, 0x30, 0x78, 0xe2, 0x1c, 0x8d, 0xf3, 0x8d, 0x10, 0xe7, 0xc4, 0x52, 0x2a,
-	0x27, 0xa6, 0xf3, 0x55, 0x99, 0xab, 0x3a, 0xc1, 0xa8, 0x92, 0x66, 0xf4, 0xab, 0x30, 0x2f, 0xf3,
-	0x67, 0x33, 0x2d, 0xb9, 0x92, 0xea, 0x6e, 0xc3, 0x92, 0xc8, 0x92, 0x12, 0x81, 0x0b, 0xbb, 0xc8,
-	0xa1, 0x82, 0x80, 0xfe, 0xdd, 0x12, 0x90, 0x43, 0x84, 0x1c, 0x60, 0xe2, 0x35, 0x68, 0x10, 0x0d,
-	0x43, 0x96, 0x44, 0xcc, 0x60, 0x24, 0x68, 0xb2, 0x9f, 0x0c, 0x32, 0x10, 0x82, 0xd7, 0x0c, 0xf6,
-	0x93, 0xa5, 0x68, 0x9f, 0xbe, 0xd7, 0x09, 0xec, 0x7e, 0x20, 0x0b, 0x12, 0x9f, 0xbe, 0x77, 0x68,
-	0xf7, 0x03, 0x36, 0xd9, 0x58, 0xc2, 0x54, 0x84, 0xee, 0xac, 0x7c, 0x79, 0x0e, 0x16, 0x9f, 0xf9,
-	0xee, 0xfb, 0xd4, 0xe9, 0x78, 0xd4, 0xb7, 0xdd, 0x9e, 0xc8, 0x50, 0x0d, 0x0e, 0x3c, 0x40, 0x18,
-	0x93, 0xda, 0xa7, 0x67, 0xa6, 0xdf, 0x8b, 0xa5, 0xe6, 0xeb, 0xf6, 0x22, 0x87, 0x4a, 0xb5, 0x77,
-	0xd3, 0xa9, 0x71, 0x7e, 0xca, 0x94, 0xa5, 0xf2, 0x22, 0xab, 0x1b, 0xa2, 0xee, 0xd0, 0xb6, 0xd8,
-	0x9a, 0x12, 0x34, 0x17, 0xd0, 0xd2, 0xc0, 0x41, 0x4f, 0xe9, 0x79, 0xa0, 0x9f, 0x41, 0xe5, 0x98,
-	0x79, 0x65, 0x6c, 0x74, 0x2d, 0x65, 0x74, 0x16, 0x9e, 0x8e, 0x98, 0x36, 0xcd, 0x21, 0x4f, 0x61,
-	0x55, 0x58, 0x37, 0xa1, 0x29, 0xd6, 0xf3, 0x4d, 0xd5, 0x0f, 0x27, 0x6c, 0x6b, 0x2c, 0x07, 0x12,
-	0xc6, 0x39, 0xeb, 0xff, 0x59, 0x81, 0xfa, 0xd1, 0xd8, 0x30, 0xcf, 0x12, 0xe3, 0x33, 0x53, 0x6b,
-	0x89, 0xa9, 0x63, 0x67, 0x2a, 0xa5, 0x9c, 0xa9, 0x09, 0xf3, 0xa7, 0xd4, 0x0f, 0x6c, 0xd7, 0x91,
-	0xe6, 0x17, 0x43, 0x56, 0x97, 0x60, 0xa8, 0xb2, 0x38, 0xc7, 0x39, 0xa8, 0x18, 0x0b, 0x0c, 0x70,
-	0xc4, 0x92, 0xd4, 0x0e, 0xcc, 0x75, 0x53, 0x61, 0xa3, 0xae, 0x7c, 0x6a, 0xce, 0x31, 0x38, 0x26,
-	0xd1, 0xa1, 0x7c, 0x6a, 0x3b, 0xcd, 0x2a, 0x1a, 0x7a, 0x45, 0x79, 0xe1, 0xd8, 0x76, 0x0c, 0xf6,
-	0x90, 0xdc, 0x16, 0xf1, 0xcd, 0x67, 0x63, 0x55, 0x45, 0x72, 0xa3, 0x50, 0x84, 0xfc, 0x4d, 0x60,
-	0x13, 0x3e, 0x8a, 0xa7, 0x97, 0x4f, 0x43, 0x9d, 0xc1, 0xe4, 0xe4, 0xde, 0x83, 0x52, 0xe8, 0x36,
-	0x6b, 0x48, 0x47, 0x95, 0x4e, 0x0d, 0x5b, 0xa3, 0x14, 0xba, 0xa4, 0x0d, 0x55, 0x1b, 0x83, 0xae,
-	0x09, 0x39, 0x2b, 0x64, 0x12, 0x8f, 0x86, 0x40, 0xc3, 0xda, 0xdb, 0x3c, 0x1f, 0xba, 0x66, 0xaf,
-	0x59, 0xdf, 0xd2, 0xee, 0x34, 0x0c, 0x39, 0x24, 0xb7, 0x60, 0xd1, 0x72, 0x9d, 0x67, 0xb6, 0x3f,
-	0xe2, 0xa5, 0x7d, 0xb3, 0x81, 0x96, 0x53, 0x81, 0x2c, 0xf9, 0x87, 0xe3, 0x4e, 0x60, 0xbf, 0x4f,
-	0x9b, 0x8b, 0xbc, 0xde, 0x09, 0xc7, 0x87, 0xf6, 0xfb, 0x94, 0xcd, 0xda, 0x33, 0x4a, 0x9b, 0x4b,
-	0x7c, 0xd6, 0x9e, 0x51, 0x84, 0xf4, 0xcd, 0xa0, 0xb9, 0xcc, 0x21, 0x7d, 0x33, 0x60, 0x39, 0x3c,
-	0x08, 0xcd, 0x30, 0x0a, 0x9a, 0x2b, 0x5b, 0xda, 0x9d, 0x39, 0x43, 0x8c, 0xe2, 0x78, 0x59, 0x45,
-	0x28, 0x8f, 0x17, 0xb1, 0x15, 0xe8, 0x9a, 0x01, 0x6d, 0x92, 0x2d, 0xed, 0xce, 0x82, 0x11, 0x8f,
-	0xc9, 0x2d, 0x58, 0x0a, 0xdd, 0xd0, 0x1c, 0x76, 0x6c, 0xa7, 0xc3, 0x7d, 0x75, 0x0d, 0xb3, 0x75,
-	0x03, 0xa1, 0x4f, 0x9c, 0x63, 0x74, 0xd9, 0xe7, 0x61, 0x99, 0x63, 0xb9, 0x51, 0x28, 0xd0, 0xd6,
-	0x11, 0x6d, 0x11, 0xc1, 0xef, 0x46, 0x21, 0xe2, 0xe9, 0xff, 0x5a, 0x86, 0xea, 0x63, 0x6a, 0xf6,
-	0xa8, 0x9f, 0xbb, 0x4e, 0x5f, 0x81, 0x05, 0x6b, 0x60, 0xda, 0x4e, 0xe2, 0x7f, 0xf3, 0x38, 0x9e,
-	0x74, 0xc1, 0x4a, 0xe2, 0x82, 0xc9, 0x6a, 0x55, 0x51, 0x56, 0x2b, 0xa6, 0x29, 0xf3, 0xca, 0x39,
-	0x14, 0x04, 0x7f, 0xb3, 0xcc, 0xe0, 0xf9, 0xf4, 0xd4, 0x76, 0xa3, 0x80, 0x2f, 0x62, 0x3c, 0xe6,
-	0x1b, 0x12, 0x88, 0xeb, 0xd8, 0x17, 0x61, 0x25, 0xf4, 0x4d, 0x27, 0x30, 0x2d, 0xac, 0xdd, 0x7c,
-	0xd7, 0x0d, 0x45, 0x95, 0xbe, 0x9c, 0x82, 0x1b, 0xae, 0x8b, 0x3e, 0x26, 0xd6, 0x0a, 0x8e, 0xb6,
-	0x80, 0x68, 0x75, 0x01, 0x43, 0x14, 0x64, 0xe9, 0x7a, 0x6e, 0x60, 0x0e, 0x39, 0x4e, 0x4d, 0xb2,
-	0xe4, 0x40, 0x44, 0xda, 0x80, 0x6a, 0x68, 0xfa, 0x7d, 0x1a, 0x36, 0x81, 0x2f, 0xf3, 0x7c, 0xc4,
-	0x96, 0x54, 0x6b, 0xc0, 0x0a, 0x6e, 0xa7, 0x4f, 0xd1, 0x89, 0x6a, 0x46, 0x02, 0x10, 0xdb, 0x17,
-	0x99, 0x13, 0x1a, 0xf1, 0xf6, 0x85, 0x07, 0x3b, 0xb9, 0x03, 0x73, 0x1e, 0xab, 0x29, 0xd0, 0x7b,
-	0xea, 0xbb, 0x44, 0xad, 0xe8, 0xd8, 0x13, 0x83, 0x23, 0x90, 0x47, 0xb0, 0xcc, 0x57, 0xdc, 0x40,
-	0x56, 0x0c, 0xe8, 0x5c, 0xd9, 0x95, 0x2e, 0x5d, 0x52, 0x18, 0x4b, 0xf8, 0x46, 0x52, 0x62, 0x5c,
-	0x81, 0x85, 0xae, 0xe9, 0x74, 0x86, 0x76, 0x10, 0x36, 0x97, 0xf9, 0xda, 0xd2, 0x35, 0x9d, 0xb7,
-	0xec, 0x20, 0xd4, 0xff, 0x54, 0x83, 0xfa, 0x1b, 0x66, 0x34, 0x14, 0xc9, 0x29, 0x3d, 0x97, 0x9a,
-	0x9a, 0x4e, 0xd2, 0xc6, 0x4a, 0xed, 0x4c, 0x63, 0x63, 0x1d, 0x31, 0x77, 0x55, 0xd5, 0x2e, 0x67,
-	0xd5, 0xde, 0x81, 0x5a, 0x48, 0x83, 0xd0, 0x1e, 0xb9, 0xce, 0xb9, 0x28, 0x66, 0xd7, 0xd4, 0x3d,
-	0x0c, 0x3a, 0xa0, 0x91, 0x60, 0xe9, 0x16, 0x2c, 0xbd, 0xe3, 0xfa, 0x23, 0x73, 0x78, 0x20, 0xf8,
-	0x7c, 0x52, 0x11, 0x09, 0x54, 0x7a, 0x66, 0x68, 0x0a, 0xe1, 0xf0, 0xb7, 0xfe, 0x3d, 0x0d, 0x1a,
-	0x92, 0xfe, 0x43, 0x9f, 0x9a, 0xe4, 0x21, 0x2c, 0x7b, 0x91, 0x63, 0x07, 0x83, 0x11, 0x75, 0xc2,
-	0x8e, 0xe9, 0x53, 0x53, 0xd4, 0x04, 0xea, 0xd6, 0x29, 0x65, 0x39, 0x63, 0x29, 0x79, 0x01, 0x49,
-	0x3c, 0x00, 0x70, 0xc3, 0x01, 0xf5, 0xf9, 0xdb, 0xa5, 0x9c, 0x44, 0xa6, 0xea, 0x65, 0xd4, 0x10,
-	0x9d, 0xbd, 0xab, 0xff, 0x65, 0x15, 0x56, 0x92, 0x6a, 0x76, 0x4a, 0xf5, 0xfc, 0xa9, 0x46, 0xe5,
-	0x44, 0xea, 0x9b, 0xcb, 0x4b, 0x7d, 0x32, 0x76, 0xab, 0xd3, 0x62, 0x77, 0x3e, 0x27, 0x76, 0xaf,
-	0x42, 0xcd, 0xa1, 0x63, 0xb1, 0x5f, 0xe3, 0xd1, 0xb8, 0xc0, 0x00, 0x85, 0x81, 0x5d, 0x9b, 0x2d,
-	0xb0, 0x61, 0x86, 0xc0, 0xae, 0x4f, 0x0d, 0xec, 0x86, 0x12, 0xd8, 0x4d, 0x98, 0x7f, 0x2f, 0x32,
-	0x87, 0x76, 0x78, 0x8e, 0xd1, 0x59, 0x33, 0xe4, 0x50, 0x0d, 0xf9, 0xa5, 0xe9, 0x21, 0xbf, 0x5c,
-	0x18, 0xf2, 0x2b, 0x1f, 0x23, 0xe4, 0x57, 0x3f, 0x49, 0xc8, 0x13, 0x25, 0xe4, 0x59, 0xe5, 0x1c,
-	0x1b, 0x07, 0x7d, 0x73, 0x2d, 0x8f, 0x78, 0x2a, 0x1a, 0x12, 0xbb, 0xa1, 0x63, 0x2f, 0x41, 0x29,
-	0x1c, 0x37, 0xd7, 0x91, 0x68, 0x29, 0x1c, 0xb3, 0xc5, 0xd7, 0x37, 0xcf, 0x3a, 0xe1, 0xb8, 0x79,
-	0x29, 0x27, 0x44, 0x52, 0x25, 0x8d, 0x31, 0xe7, 0x9b, 0x67, 0x47, 0xe3, 0x64, 0xaf, 0x82, 0xeb,
-	0xe7, 0x86, 0xd8, 0x20, 0x71, 0xf9, 0xdf, 0x47, 0xd1, 0x99, 0x53, 0x75, 0xa2, 0xd0, 0x6a, 0x5e,
-	0xe6, 0x13, 0xc0, 0xc6, 0x5f, 0x09, 0x2d, 0x7c, 0x34, 0xee, 0xf0, 0x06, 0x44, 0x93, 0xc7, 0x7e,
-	0x38, 0xde, 0x63, 0x43, 0xfd, 0x1e, 0x5c, 0x8a, 0xf7, 0xa9, 0x3c, 0x89, 0x4c, 0xd9, 0x05, 0x7e,
-	0x67, 0x0e, 0x36, 0xb2, 0xd8, 0xbf, 0x58, 0x51, 0xa6, 0x6c, 0xd8, 0xaa, 0x99, 0x0d, 0xdb, 0xe7,
-	0xf1, 0xf6, 0xbf, 0x29, 0xde, 0xd2, 0xfe, 0xbc, 0xa6, 0xf8, 0xb3, 0xfe, 0x1c, 0xac, 0x66, 0x9a,
-	0x16, 0xc7, 0xbb, 0x2c, 0xbe, 0xe2, 0x0d, 0x63, 0xc9, 0xee, 0xe9, 0x7f, 0x50, 0x05, 0x92, 0x5d,
-	0x0c, 0x8e, 0x77, 0x59, 0x65, 0x28, 0xa7, 0x5b, 0x76, 0x1d, 0xe5, 0x98, 0x39, 0x31, 0x9b, 0x69,
-	0xb9, 0x51, 0x60, 0xbf, 0x27, 0xfd, 0xae, 0x9c, 0xe7, 0x77, 0xcc, 0xa8, 0x43, 0xe6, 0xea, 0x18,
-	0x9b, 0xbc, 0x79, 0x5c, 0x43, 0x08, 0xc6, 0x26, 0xdb, 0x3e, 0x99, 0xd6, 0x09, 0x0d, 0xf9, 0x73,
-	0xbe, 0x79, 0x03, 0x0e, 0x42, 0x04, 0x19, 0x3e, 0xd5, 0x82, 0xf0, 0x99, 0x2f, 0x0c, 0x9f, 0x85,
-	0xa2, 0xf0, 0xa9, 0x29, 0xe1, 0xa3, 0x04, 0x06, 0x64, 0x03, 0x23, 0x6d, 0xeb, 0xba, 0x9a, 0x3b,
-	0xf2, 0x3c, 0xbe, 0x31, 0x9b, 0xc7, 0x2f, 0xce, 0xe0, 0xf1, 0x4b, 0x53, 0x3d, 0x7e, 0xb9, 0xc8,
-	0xe3, 0x57, 0xa6, 0x78, 0xfc, 0xea, 0x74, 0x8f, 0x27, 0x85, 0x1e, 0xbf, 0x76, 0x91, 0xc7, 0xbf,
-	0x06, 0xb5, 0xc4, 0xd7, 0xd7, 0x2f, 0xf2, 0xf5, 0x04, 0x57, 0x71, 0xf3, 0x4b, 0xaa, 0x9b, 0xbf,
-	0x06, 0x35, 0xa9, 0x7c, 0x80, 0x49, 0x7d, 0xea, 0x92, 0x92, 0xe0, 0x2a, 0x49, 0xfd, 0xb2, 0x92,
-	0xd4, 0xc9, 0x25, 0xa8, 0xe2, 0x8e, 0x37, 0x68, 0x36, 0x91, 0xd9, 0x1c, 0xdb, 0xf2, 0x06, 0xfa,
-	0x6b, 0x00, 0x47, 0xe3, 0x77, 0xa3, 0xf0, 0xc0, 0xb5, 0x9d, 0xf0, 0x23, 0xf4, 0x58, 0xf4, 0x36,
-	0x36, 0x15, 0x0d, 0xf3, 0xec, 0x28, 0x35, 0xe3, 0x62, 0x9d, 0xc8, 0x23, 0xa3, 0xff, 0x51, 0x19,
-	0xae, 0xe4, 0xbc, 0x21, 0xd6, 0x8a, 0x8f, 0xb7, 0x45, 0x9f, 0x9b, 0xb2, 0x45, 0x2f, 0xff, 0xc2,
-	0x6c, 0xd1, 0x53, 0x3b, 0xe4, 0x05, 0xd1, 0x79, 0x2f, 0xda, 0x21, 0xd7, 0x2e, 0xd8, 0x21, 0x43,
-	0xde, 0x0e, 0xb9, 0x9e, 0xec, 0x90, 0x93, 0xfd, 0x70, 0x43, 0xd9, 0x0f, 0xa7, 0xf7, 0xbe, 0x8b,
-	0xea, 0xde, 0x57, 0xff, 0xed, 0x12, 0x4e, 0xcc, 0xd1, 0x78, 0x9f, 0x06, 0xd6, 0x31, 0xf5, 0xbb,
-	0x6e, 0x40, 0x5f, 0x8a, 0x27, 0x26, 0xd7, 0x25, 0xd4, 0xd4, 0x56, 0xba, 0x20, 0xb5, 0x95, 0xf3,
-	0x52, 0x5b, 0x6a, 0x9e, 0x78, 0xe1, 0x9b, 0x64, 0xa9, 0x39, 0x25, 0x4b, 0x09, 0x25, 0xab, 0x89,
-	0x92, 0xf7, 0x60, 0x35, 0x08, 0x4d, 0x3f, 0xb4, 0x9d, 0x7e, 0xc7, 0xf3, 0x6d, 0xd7, 0x67, 0xd1,
-	0xcf, 0xb2, 0xa1, 0x66, 0xac, 0xc8, 0x07, 0x07, 0x02, 0x9e, 0xec, 0xd9, 0xb1, 0x5d, 0xd1, 0x31,
-	0xfb, 0x14, 0x27, 0x41, 0xee, 0xd9, 0xb1, 0xa1, 0xf1, 0xb0, 0x4f, 0xf5, 0x7f, 0xca, 0xb1, 0xc2,
-	0xce, 0xff, 0x35, 0x2b, 0xb0, 0xcc, 0x6e, 0x45, 0xbe, 0xcf, 0x76, 0x6a, 0x31, 0xcd, 0x1a, 0xd2,
-	0x5c, 0x16, 0xf0, 0x98, 0xe4, 0x0e, 0xcc, 0xf7, 0xa8, 0x47, 0x9d, 0x5e, 0x7e, 0xa7, 0x28, 0xc9,
-	0x2a, 0x86, 0xc4, 0xd3, 0x7f, 0xac, 0xe1, 0x91, 0xc1, 0xbb, 0xbe, 0x37, 0x30, 0x1d, 0x6e, 0xe9,
-	0xcf, 0xd6, 0xc2, 0x29, 0x19, 0x2b, 0xb3, 0xca, 0x58, 0xc2, 0x42, 0xe2, 0x68, 0x7c, 0xe0, 0xba,
-	0xc3, 0x58, 0xba, 0x74, 0x62, 0xd5, 0xd4, 0xc4, 0x7a, 0x13, 0x1a, 0x2e, 0x2a, 0x24, 0x1e, 0x73,
-	0x29, 0xeb, 0x1c, 0xc6, 0x51, 0x74, 0x58, 0x0c, 0xc7, 0x9d, 0x94, 0x26, 0xbc, 0x5e, 0xa8, 0x87,
-	0xe3, 0x83, 0x58, 0x97, 0x5b, 0xb0, 0xc4, 0x70, 0x52, 0xea, 0xf0, 0x5a, 0xb7, 0x11, 0x8e, 0x0f,
-	0x12, 0x85, 0xee, 0xc2, 0xaa, 0x60, 0x96, 0xa2, 0xc6, 0x3d, 0x65, 0x99, 0x3f, 0x48, 0x28, 0xbe,
-	0x08, 0x44, 0xe2, 0xa6, 0xa8, 0x56, 0x11, 0x79, 0x45, 0x20, 0x27, 0x94, 0x57, 0xa0, 0x1c, 0x8e,
-	0x79, 0xef, 0xb7, 0x66, 0xb0, 0x9f, 0x2c, 0x6d, 0x71, 0x2c, 0xd9, 0x54, 0x94, 0x43, 0xfd, 0xaf,
-	0xca, 0x22, 0x56, 0x98, 0x8d, 0x26, 0x32, 0xc6, 0xe7, 0xb6, 0x4a, 0xd9, 0x8a, 0x3c, 0x44, 0x6b,
-	0xf4, 0x68, 0x60, 0x05, 0xa2, 0x05, 0xfb, 0xbc, 0xe2, 0x83, 0x85, 0x99, 0x97, 0x59, 0x8d, 0xc1,
-	0x03, 0xf2, 0x66, 0x6c, 0x35, 0x4e, 0x86, 0x87, 0xdb, 0xad, 0x2c, 0x99, 0xbc, 0xb0, 0x92, 0xb6,
-	0x45, 0x42, 0xb9, 0xf3, 0xb6, 0xf3, 0xf9, 0xbc, 0x7d, 0x2a, 0xf3, 0xb6, 0xf3, 0x19, 0xce, 0xdb,
-	0x7f, 0x68, 0x78, 0x72, 0x7c, 0x18, 0x9a, 0x27, 0xb6, 0xd3, 0xe7, 0xd3, 0xc7, 0x0a, 0x96, 0x78,
-	0xea, 0xd6, 0x61, 0x0e, 0xf3, 0xb8, 0x38, 0xc9, 0xe4, 0x03, 0x72, 0x1b, 0x96, 0x46, 0xac, 0xd6,
-	0xb4, 0xc3, 0xf3, 0x4e, 0x72, 0xbc, 0x56, 0x31, 0x16, 0x25, 0x94, 0x77, 0xb5, 0xbf, 0x08, 0x2b,
-	0xf6, 0x28, 0x83, 0xc8, 0x27, 0x6f, 0x39, 0x81, 0x73, 0xd4, 0x4d, 0xa8, 0x9b, 0x78, 0x98, 0x94,
-	0x1c, 0xa2, 0x55, 0x0c, 0x40, 0x10, 0x47, 0x28, 0x5a, 0xbe, 0xd4, 0x33, 0xd5, 0xea, 0xd4, 0x33,
-	0xd5, 0x79, 0x7c, 0x33, 0x75, 0xa6, 0xfa, 0xcb, 0x70, 0x3d, 0xd1, 0xde, 0xc0, 0x73, 0x2b, 0x83,
-	0x5a, 0x2e, 0xfb, 0x97, 0xd7, 0x9b, 0xca, 0xeb, 0x5a, 0xf6, 0xf5, 0x33, 0x58, 0xcb, 0x79, 0x37,
-	0x7f, 0xc1, 0xb9, 0x09, 0x0d, 0xd4, 0x86, 0xf6, 0x78, 0x21, 0x29, 0x0e, 0x65, 0x05, 0x0c, 0x6b,
-	0xc9, 0x3b, 0xd8, 0xb3, 0x29, 0xe7, 0xdc, 0xfe, 0x48, 0xf7, 0x67, 0x4a, 0xe1, 0x58, 0xff, 0x3a,
-	0xdc, 0x28, 0x92, 0x5b, 0xcc, 0xdb, 0x03, 0x98, 0xf7, 0x11, 0x22, 0xcf, 0x49, 0xb7, 0xd4, 0xb3,
-	0xae, 0x9c, 0x57, 0xe5, 0x0b, 0xfa, 0x9f, 0x69, 0x70, 0x75, 0x8f, 0xd5, 0x89, 0xfd, 0xc8, 0xa7,
-	0x87, 0x9e, 0x69, 0xd1, 0xa7, 0x94, 0x7a, 0x49, 0xb3, 0x86, 0x95, 0x7c, 0xa6, 0x67, 0x5a, 0x6c,
-	0x09, 0xe7, 0x36, 0x89, 0xc7, 0x6c, 0xca, 0x3d, 0xf3, 0xdc, 0x8d, 0xe2, 0xb3, 0x4c, 0x1a, 0x60,
-	0x5b, 0xb5, 0x66, 0x2c, 0x73, 0xf8, 0xc3, 0xf8, 0x94, 0xef, 0x06, 0x80, 0x67, 0x06, 0x81, 0x37,
-	0xf0, 0x59, 0xed, 0x28, 0xee, 0x86, 0x24, 0x10, 0xe5, 0x82, 0x55, 0x45, 0xbd, 0x60, 0xa5, 0xff,
-	0x85, 0x06, 0xb5, 0xaf, 0xba, 0xfe, 0x09, 0x4a, 0xc7, 0x63, 0xad, 0x67, 0x3b, 0xc2, 0x4d, 0xcb,
-	0x86, 0x1c, 0x66, 0x36, 0x63, 0xa5, 0xec, 0x66, 0x4c, 0x39, 0xce, 0x55, 0xce, 0x64, 0xd5, 0xfb,
-	0x01, 0x95, 0xcc, 0xfd, 0x00, 0x16, 0x16, 0xac, 0xfe, 0xa5, 0xe2, 0x4e, 0x13, 0x1f, 0xf0, 0xdd,
-	0xbe, 0xdb, 0x8f, 0x0f, 0x43, 0x35, 0x23, 0x1e, 0xeb, 0xf7, 0x61, 0x25, 0x16, 0x58, 0x1a, 0xf2,
-	0x0a, 0x2c, 0x04, 0x6c, 0x9c, 0xf8, 0xca, 0x3c, 0x8e, 0x9f, 0xf4, 0xf4, 0xef, 0x68, 0xb0, 0x9a,
-	0xc2, 0x17, 0xb3, 0xfa, 0x22, 0xcc, 0x21, 0x02, 0x62, 0xd7, 0x77, 0x37, 0xd4, 0xfb, 0x48, 0x31,
-	0x3a, 0x47, 0x62, 0x3a, 0x50, 0xdf, 0x77, 0xfd, 0x8e, 0xe5, 0xf6, 0xe2, 0x1a, 0x07, 0x21, 0x7b,
-	0x6e, 0x0f, 0xfb, 0xc1, 0xfc, 0xf1, 0x88, 0x06, 0x01, 0xab, 0xdb, 0xb8, 0x09, 0x1a, 0x08, 0x7c,
-	0x9b, 0xc3, 0xf4, 0x9f, 0x6a, 0x40, 0x62, 0xc2, 0x41, 0x2c, 0xc8, 0x26, 0xd4, 0xb9, 0xe4, 0xe9,
-	0xa4, 0x0e, 0x08, 0xe2, 0x49, 0x7b, 0x1b, 0xaa, 0x38, 0x0a, 0x44, 0x53, 0xbd, 0x48, 0x54, 0x81,
-	0x95, 0x91, 0xb5, 0x7c, 0xa1, 0xac, 0x95, 0x1c, 0x59, 0x7f, 0x1d, 0x9a, 0x0f, 0xad, 0xf0, 0x5d,
-	0x47, 0x71, 0x59, 0x21, 0xb0, 0x4a, 0x5f, 0xbb, 0x90, 0x7e, 0x29, 0x87, 0xfe, 0xdf, 0x97, 0xe0,
-	0x66, 0x5e, 0x5c, 0x3c, 0x3a, 0xdf, 0xb7, 0xfd, 0x40, 0x4e, 0xea, 0xd7, 0xa0, 0x6e, 0x0e, 0x87,
-	0xae, 0x25, 0xf6, 0x5d, 0x3c, 0xfa, 0xfe, 0xbf, 0xa2, 0xfe, 0x85, 0x44, 0xb6, 0x1f, 0xc6, 0x14,
-	0x8c, 0x34, 0xb5, 0x9f, 0x53, 0x78, 0x61, 0x36, 0x8e, 0x42, 0xb7, 0x63, 0xf9, 0x54, 0x3a, 0xf9,
-	0x9c, 0x01, 0x0c, 0xb4, 0x87, 0x90, 0xd6, 0x1b, 0x00, 0x89, 0x88, 0x2c, 0x4b, 0xf6, 0x6c, 0x9f,
-	0x5a, 0xa1, 0xeb, 0xcb, 0xcb, 0x61, 0x09, 0x40, 0x49, 0x17, 0x25, 0x35, 0x5d, 0xe8, 0xff, 0x56,
-	0x82, 0x66, 0xe2, 0x5e, 0xd2, 0x06, 0x62, 0xce, 0x5e, 0x80, 0xe5, 0x98, 0x8a, 0xe2, 0x68, 0x4b,
-	0x31, 0x98, 0x3b, 0x9b, 0xa1, 0x9a, 0x9c, 0x7b, 0xdc, 0x4b, 0xf9, 0x1e, 0x97, 0x61, 0x52, 0x68,
-	0xe9, 0x4f, 0xc1, 0x21, 0x5b, 0x3f, 0xd4, 0x3e, 0x81, 0x99, 0x6a, 0xa9, 0xac, 0x9a, 0x09, 0xb7,
-	0xf2, 0x94, 0x70, 0xab, 0xcc, 0x12, 0x6e, 0xfa, 0xbf, 0x54, 0x71, 0xbf, 0xb4, 0x37, 0xb4, 0xa9,
-	0xc3, 0xd6, 0x91, 0x30, 0x4a, 0xcc, 0x9e, 0x39, 0xba, 0xab, 0x25, 0x9d, 0x90, 0xdb, 0xb0, 0xe4,
-	0x51, 0xea, 0x63, 0x67, 0x89, 0x3a, 0xb6, 0xd3, 0x47, 0x41, 0x17, 0x8c, 0x45, 0x06, 0x7d, 0x4b,
-	0x02, 0xf1, 0x66, 0xd2, 0xb9, 0x63, 0xb1, 0xe7, 0x65, 0x7c, 0x2e, 0x87, 0x6c, 0x11, 0x1f, 0xd9,
-	0xf8, 0x62, 0x05, 0x1f, 0x88, 0x11, 0xb3, 0x26, 0xd7, 0xef, 0x84, 0x52, 0x8f, 0x3d, 0x9e, 0xc3,
-	0xc7, 0x8d, 0x40, 0xc6, 0x07, 0x43, 0x4a, 0x77, 0x28, 0xab, 0x6a, 0x87, 0xf2, 0x2e, 0xac, 0x32,
-	0x2b, 0x0f, 0x3b, 0x5d, 0x1a, 0x84, 0xf2, 0xda, 0x13, 0x5f, 0xed, 0x97, 0xf1, 0xc1, 0x23, 0x1a,
-	0x84, 0xfc, 0xea, 0x13, 0xc3, 0x3d, 0x71, 0xdc, 0x33, 0x47, 0xc1, 0xe5, 0x7d, 0xcd, 0x65, 0x7c,
-	0x90, 0xc2, 0xbd, 0x04, 0x55, 0x6f, 0xd7, 0x63, 0x0c, 0x79, 0xdb, 0x7d, 0xce, 0xdb, 0xf5, 0x9e,
-	0xf4, 0xc8, 0x97, 0x01, 0xd0, 0x0e, 0x7c, 0x36, 0x00, 0x73, 0xf1, 0x6e, 0xb6, 0xf8, 0xca, 0xb3,
-	0xed, 0x36, 0x7b, 0x0d, 0x67, 0x0c, 0x8b, 0xac, 0x5a, 0x3c, 0x24, 0x7b, 0x30, 0xc7, 0x06, 0x01,
-	0xb6, 0x5c, 0xea, 0xbb, 0xf7, 0x67, 0xa6, 0xc6, 0xcc, 0x6e, 0xf0, 0x77, 0x5b, 0x5f, 0x83, 0x45,
-	0x85, 0x81, 0x5a, 0xbd, 0x2d, 0xca, 0xea, 0xad, 0x05, 0x0b, 0x6e, 0x14, 0x76, 0xdd, 0xc8, 0xe9,
-	0xc9, 0x9b, 0xcb, 0x72, 0xcc, 0xe6, 0xce, 0x76, 0xf8, 0x23, 0x71, 0x53, 0x45, 0x0c, 0x5b, 0x06,
-	0x2c, 0x30, 0xe2, 0x48, 0x37, 0xd3, 0xfd, 0x4e, 0xaf, 0xa3, 0x25, 0x75, 0x1d, 0x8d, 0x7d, 0x5e,
-	0x36, 0xd6, 0x62, 0x9f, 0xb7, 0x5d, 0xa7, 0xf5, 0xcf, 0x1a, 0x27, 0x8a, 0xbd, 0xc9, 0x27, 0x29,
-	0xb1, 0x78, 0xd6, 0x9c, 0xdd, 0x0a, 0x68, 0xce, 0x44, 0x8b, 0x37, 0x13, 0x2d, 0x4a, 0x1f, 0x87,
-	0x92, 0x7c, 0x9b, 0x4d, 0x0b, 0x1e, 0xf8, 0xe2, 0x6d, 0xaf, 0x8f, 0x4c, 0x86, 0xbf, 0xab, 0xbf,
-	0x0e, 0xe4, 0xcb, 0x91, 0x2d, 0x70, 0x67, 0x5d, 0x91, 0x56, 0xa0, 0x3c, 0x0a, 0xfa, 0xf2, 0x12,
-	0xd7, 0x28, 0xe8, 0xeb, 0x5f, 0xc2, 0x62, 0xf5, 0x51, 0x72, 0x8b, 0xef, 0xd1, 0xb9, 0xbc, 0xac,
-	0xc4, 0x57, 0x1e, 0xb5, 0xd8, 0xd1, 0x32, 0xc5, 0x8e, 0xfe, 0x00, 0x8b, 0xc6, 0xdc, 0xf7, 0x93,
-	0xc8, 0xe7, 0xf1, 0xc0, 0x97, 0xad, 0x8a, 0x21, 0x87, 0xfa, 0x8b, 0x78, 0xba, 0xb1, 0x27, 0x1a,
-	0x7b, 0x17, 0x5d, 0xc6, 0xfc, 0x81, 0x06, 0x0d, 0x89, 0xfb, 0x3f, 0x72, 0x4f, 0x2b, 0xef, 0x56,
-	0x9b, 0xfe, 0x27, 0x65, 0x58, 0x53, 0x94, 0x98, 0xd6, 0x1f, 0x4a, 0x25, 0xc1, 0xd2, 0x94, 0x1b,
-	0x5b, 0xe5, 0xa2, 0x1b, 0x5b, 0x95, 0x99, 0xdb, 0xc1, 0xcf, 0xc1, 0x62, 0xd7, 0x76, 0x7a, 0xb6,
-	0xd3, 0x17, 0x7b, 0x1f, 0x5e, 0x52, 0x36, 0x04, 0x90, 0xef, 0x7e, 0x66, 0xe9, 0x19, 0xdf, 0x57,
-	0x7a, 0xc6, 0x57, 0x32, 0x15, 0x47, 0x32, 0x1b, 0x9f, 0x75, 0xef, 0xf8, 0x3a, 0x00, 0xef, 0x08,
-	0x3e, 0xa3, 0x34, 0x90, 0x97, 0x6e, 0x10, 0xf2, 0x06, 0xa5, 0x41, 0x51, 0x23, 0x59, 0x8f, 0xe0,
-	0xd2, 0xeb, 0x63, 0xcf, 0xf5, 0xc3, 0xa7, 0xe2, 0x2e, 0xb6, 0xf4, 0xb2, 0xa9, 0x57, 0xf7, 0xd5,
-	0x2a, 0xa7, 0x34, 0x51, 0xe5, 0x6c, 0x42, 0x9d, 0x22, 0x55, 0x7e, 0x85, 0x5c, 0x94, 0x41, 0x1c,
-	0x84, 0x37, 0xc4, 0x3d, 0xd8, 0xc8, 0xb2, 0x15, 0x7e, 0xd1, 0x82, 0x05, 0x79, 0x2d, 0x5c, 0xb2,
-	0x95, 0xe3, 0xec, 0x8d, 0xfd, 0xd2, 0x47, 0xb9, 0xb1, 0xff, 0x53, 0x0d, 0x5a, 0x2a, 0x4b, 0x2c,
-	0x49, 0x52, 0x51, 0x2c, 0xd4, 0xed, 0xd9, 0xf2, 0xce, 0xb1, 0x30, 0xc0, 0xbe, 0xed, 0x5f, 0xa8,
-	0xf0, 0x3d, 0x58, 0x15, 0xaf, 0x4f, 0x54, 0x7f, 0x2b, 0x67, 0xe2, 0xd3, 0x83, 0x22, 0xeb, 0x54,
-	0x26, 0xac, 0x73, 0x0e, 0x57, 0x73, 0x45, 0x15, 0x26, 0xba, 0x06, 0x35, 0x69, 0x12, 0x79, 0xbe,
-	0x99, 0x00, 0xc8, 0x2f, 0x41, 0x23, 0xa5, 0xb7, 0xac, 0xcb, 0x8a, 0xad, 0xa4, 0x60, 0xeb, 0xbf,
-	0xab, 0xc1, 0xa5, 0x27, 0xa3, 0x3c, 0x87, 0xd8, 0x84, 0xba, 0x3d, 0x4a, 0xa4, 0xe6, 0x7c, 0x81,
-	0x83, 0xf0, 0x0b, 0x88, 0xdb, 0xb0, 0xe4, 0x0e, 0x7b, 0x9d, 0x09, 0x3b, 0x2d, 0xba, 0xc3, 0x5e,
-	0x4a, 0xfb, 0xdb, 0xb0, 0xe4, 0xd0, 0xb3, 0x49, 0x3b, 0x2d, 0x3a, 0xf4, 0x2c, 0x41, 0xd3, 0x7f,
-	0xac, 0xc1, 0x46, 0x56, 0x10, 0xa1, 0x7f, 0xe2, 0xcb, 0x1a, 0xaf, 0x67, 0xc4, 0xa1, 0x88, 0xe2,
-	0xb2, 0xa5, 0xc2, 0xaf, 0x4d, 0xca, 0xca, 0xe7, 0x05, 0x19, 0x9f, 0xaa, 0x7c, 0x14, 0x9f, 0xfa,
-	0x3b, 0x0d, 0x5a, 0xaa, 0x8c, 0x8a, 0x4f, 0x6d, 0xc3, 0x9a, 0xb0, 0x58, 0xfc, 0xf5, 0x43, 0xe2,
-	0x5c, 0xab, 0xb6, 0xf2, 0x22, 0x73, 0xb2, 0xdb, 0xb0, 0x24, 0x2d, 0x1c, 0x75, 0x99, 0x7d, 0xa4,
-	0x01, 0x85, 0x91, 0x39, 0x90, 0x15, 0xe8, 0x12, 0xcd, 0xb7, 0x4f, 0x11, 0x8f, 0xab, 0x24, 0xde,
-	0x3e, 0x10, 0xd0, 0x4c, 0xf3, 0x9f, 0x63, 0x56, 0xc4, 0x57, 0x3e, 0x71, 0xf3, 0x1f, 0xc1, 0xfa,
-	0x3f, 0x96, 0xe0, 0x6a, 0xae, 0x26, 0xc2, 0xe4, 0x5f, 0x51, 0x5d, 0x8e, 0x79, 0xd4, 0x6b, 0xea,
-	0x45, 0xd2, 0xe2, 0x97, 0xb7, 0x25, 0x34, 0x78, 0xdd, 0x09, 0xfd, 0xf3, 0xb4, 0xaf, 0xee, 0xc3,
-	0x2a, 0x73, 0x19, 0x66, 0xd3, 0xce, 0x68, 0x56, 0x87, 0x5d, 0x76, 0x87, 0xbd, 0xd4, 0x18, 0xa9,
-	0x30, 0x8f, 0x52, 0xa9, 0x94, 0x2f, 0xa2, 0xe2, 0xd0, 0xb3, 0x34, 0x95, 0xd6, 0x11, 0x2c, 0xa9,
-	0x82, 0xb2, 0x62, 0x20, 0x59, 0xd2, 0xd9, 0x4f, 0xf2, 0x62, 0xfa, 0x5e, 0x7b, 0xb6, 0xde, 0x8f,
-	0x3f, 0x7b, 0x12, 0x2b, 0xed, 0x83, 0xd2, 0xff, 0xd3, 0xf4, 0x7d, 0x58, 0xe4, 0xc0, 0xc3, 0x68,
-	0x34, 0x32, 0xfd, 0xf3, 0x8f, 0xf5, 0x49, 0x94, 0xfe, 0x55, 0x3c, 0x9c, 0x8d, 0x7d, 0x85, 0x86,
-	0xa6, 0x3d, 0xfc, 0x34, 0x12, 0xb5, 0x3e, 0xc0, 0x06, 0x72, 0x96, 0xb0, 0x98, 0xf4, 0xa9, 0x94,
-	0xb7, 0xa1, 0xca, 0x7f, 0x5f, 0x60, 0x0b, 0x81, 0xa5, 0x3f, 0xc5, 0x32, 0x60, 0x22, 0x96, 0x5f,
-	0x81, 0x79, 0x8e, 0x20, 0xdd, 0xaa, 0x95, 0xf3, 0xb5, 0x97, 0xb0, 0x9d, 0x21, 0x51, 0xf5, 0x57,
-	0x61, 0xed, 0x2b, 0x0e, 0x5b, 0xc7, 0x05, 0x13, 0x61, 0x0a, 0x55, 0x5b, 0x6d, 0x42, 0xdb, 0x37,
-	0x60, 0x5d, 0x7d, 0x2d, 0xa9, 0xc0, 0x82, 0xc8, 0xb2, 0xe4, 0x47, 0x02, 0x6c, 0xeb, 0xc4, 0x87,
-	0xac, 0x84, 0xc2, 0xe2, 0x50, 0x7e, 0xc8, 0x80, 0x03, 0x7d, 0x1f, 0xc8, 0x5b, 0x9f, 0x9c, 0xca,
-	0x37, 0xa0, 0xb9, 0x37, 0x30, 0x9d, 0x3e, 0x3d, 0xc0, 0x8f, 0xa7, 0x28, 0x4b, 0x7e, 0x52, 0x93,
-	0x9b, 0xd0, 0xc0, 0x5c, 0x2a, 0xc3, 0x96, 0xeb, 0x52, 0x67, 0x99, 0x54, 0x46, 0xf7, 0x4d, 0x68,
-	0x60, 0x1e, 0x95, 0x28, 0x9c, 0x76, 0x9d, 0x65, 0x51, 0x19, 0xd5, 0xaf, 0xc2, 0x95, 0x1c, 0x0e,
-	0x17, 0x89, 0xab, 0x7f, 0x0d, 0x2e, 0x8b, 0xd7, 0xb0, 0xb2, 0x4b, 0xcb, 0xb5, 0x09, 0x75, 0x94,
-	0x4b, 0xe4, 0x27, 0x61, 0x62, 0x26, 0x96, 0x48, 0x4e, 0x9b, 0x50, 0x47, 0xa9, 0x94, 0x04, 0x06,
-	0x4c, 0x28, 0x0e, 0xd1, 0x5f, 0x89, 0xb5, 0x4e, 0x11, 0xbf, 0x50, 0xa4, 0x3b, 0x78, 0x29, 0xed,
-	0x4d, 0xf7, 0x94, 0xfa, 0x0e, 0xef, 0xe3, 0x48, 0x89, 0x92, 0x4d, 0xd1, 0x22, 0x5e, 0x09, 0x3a,
-	0xc6, 0x7a, 0x3d, 0x8d, 0xf9, 0xd8, 0x66, 0x2e, 0x77, 0x5e, 0xf0, 0x02, 0x66, 0x5d, 0xc7, 0x1a,
-	0x46, 0x3d, 0xda, 0x09, 0x06, 0x66, 0xcf, 0x3d, 0x93, 0xdb, 0x6b, 0x01, 0x3d, 0x44, 0xa0, 0x4e,
-	0xb1, 0x8e, 0xcf, 0xa5, 0x2b, 0xa4, 0xcf, 0x12, 0x7e, 0x19, 0xe6, 0xb1, 0x76, 0xeb, 0xcb, 0x94,
-	0xa6, 0x16, 0x87, 0x8a, 0x32, 0x12, 0x53, 0xdf, 0x87, 0x15, 0xfe, 0xe0, 0x90, 0x3a, 0x66, 0x48,
-	0xdf, 0x61, 0x9b, 0x92, 0xe2, 0x6f, 0x58, 0x36, 0xa0, 0x7a, 0xa6, 0x7c, 0x21, 0xc6, 0x47, 0xfa,
-	0x13, 0x20, 0x69, 0x2a, 0x9c, 0x09, 0x79, 0x19, 0xe6, 0x1c, 0xb7, 0x17, 0x27, 0xf0, 0xeb, 0x39,
-	0xe2, 0x24, 0x5c, 0x0d, 0x8e, 0xab, 0xb7, 0x61, 0x8d, 0x3f, 0x3a, 0xe6, 0x95, 0xb8, 0xa0, 0x55,
-	0xd8, 0xae, 0xd0, 0xf7, 0xe0, 0xb2, 0xa0, 0x15, 0x79, 0x1e, 0xf5, 0x45, 0x6b, 0x2c, 0xb3, 0x81,
-	0x5d, 0x9c, 0xbe, 0x81, 0xd5, 0x8f, 0x62, 0x05, 0x90, 0x88, 0x60, 0xfa, 0xa5, 0xec, 0x67, 0x48,
-	0xb7, 0xf2, 0x54, 0xc8, 0xb2, 0x4d, 0xa8, 0xfe, 0xa8, 0x04, 0x8d, 0xb4, 0xd9, 0xc9, 0x21, 0xac,
-	0xf7, 0x71, 0xdc, 0x09, 0xf0, 0xad, 0x0e, 0x9f, 0x06, 0xd1, 0xe8, 0xdd, 0x2c, 0xa4, 0x2e, 0x1c,
-	0xe0, 0x0b, 0x06, 0xe9, 0x4f, 0x4a, 0x99, 0x22, 0x8a, 0xd6, 0x94, 0x44, 0x4b, 0xc5, 0x44, 0x53,
-	0xb3, 0x94, 0x22, 0x9a, 0x9e, 0xbb, 0x63, 0xb8, 0x24, 0x88, 0x0a, 0x3b, 0x4b, 0xaa, 0x7c, 0xaf,
-	0xb6, 0x95, 0x43, 0x55, 0x99, 0xb0, 0xc7, 0x5f, 0x30, 0xd6, 0xfa, 0x93, 0xe0, 0x47, 0x0b, 0x50,
-	0xe5, 0x84, 0xf4, 0xbf, 0xe1, 0x87, 0xf9, 0x6a, 0x8c, 0x15, 0xb8, 0x76, 0xee, 0x5d, 0x9e, 0x17,
-	0x60, 0xd9, 0xb4, 0x42, 0x4c, 0x34, 0xb2, 0xc1, 0xc3, 0x37, 0x6a, 0x4b, 0x12, 0x2c, 0xfa, 0x3b,
-	0xd9, 0x2f, 0xe5, 0x2a, 0x13, 0x5f, 0xca, 0xe1, 0x37, 0xc0, 0x5c, 0xbf, 0xbc, 0x6f, 0xd7, 0x14,
-	0x19, 0x05, 0xe2, 0xee, 0xbf, 0xbf, 0x00, 0xf0, 0xd0, 0xb3, 0x0f, 0xa9, 0x7f, 0x6a, 0x5b, 0x94,
-	0x74, 0xa1, 0x91, 0xfe, 0x48, 0x92, 0x6c, 0x6c, 0xf3, 0x2f, 0xd0, 0xb7, 0x63, 0x2a, 0xaf, 0x8f,
-	0xbc, 0xf0, 0xbc, 0x75, 0x33, 0xdb, 0x5c, 0x98, 0xf8, 0x36, 0x53, 0xbf, 0xfc, 0xed, 0x7f, 0xf8,
-	0xd9, 0x0f, 0x4a, 0xab, 0x64, 0xb9, 0x7d, 0xba, 0xd3, 0x46, 0x29, 0x83, 0x76, 0x97, 0xa5, 0x92,
-	0x2e, 0x2c, 0xc8, 0xbd, 0x3d, 0xb9, 0x36, 0x41, 0x27, 0x75, 0x75, 0xb1, 0x75, 0xbd, 0xe0, 0xa9,
-	0xe0, 0x70, 0x05, 0x39, 0xac, 0x91, 0xd5, 0x14, 0x87, 0x0f, 0x06, 0x66, 0x30, 0xf8, 0x90, 0x7c,
-	0x4f, 0xe3, 0x5f, 0x8c, 0x66, 0xbf, 0x32, 0x25, 0x77, 0x72, 0x49, 0xe6, 0x7c, 0xbf, 0xda, 0xfa,
-	0xe2, 0x0c, 0x98, 0x42, 0x90, 0x2d, 0x14, 0xa4, 0x45, 0x9a, 0x29, 0x41, 0x98, 0x1c, 0xed, 0x0f,
-	0xf8, 0x5c, 0x7d, 0x48, 0x3e, 0x48, 0xae, 0xdf, 0xc7, 0xa2, 0xdc, 0xca, 0x65, 0x90, 0x15, 0xe3,
-	0x02, 0x1b, 0xe8, 0xc8, 0xfa, 0x1a, 0x69, 0xa5, 0x59, 0x23, 0x81, 0x34, 0xf3, 0x25, 0xf5, 0x6e,
-	0x32, 0xd1, 0xf3, 0x75, 0x4b, 0x5f, 0x73, 0x6e, 0x3d, 0x37, 0x15, 0x67, 0x8a, 0xe6, 0x7c, 0x0a,
-	0xda, 0x03, 0xce, 0xea, 0x8f, 0xb5, 0xf4, 0xcd, 0xe8, 0x74, 0x2b, 0x87, 0xdc, 0x2d, 0xe0, 0x90,
-	0xd3, 0x2f, 0x6a, 0xdd, 0x9b, 0x09, 0x57, 0x48, 0xf5, 0x3c, 0x4a, 0xb5, 0x45, 0x6e, 0xa4, 0xa4,
-	0xf2, 0xa2, 0xee, 0x09, 0x3d, 0x6f, 0x7f, 0x90, 0x34, 0x6c, 0x3e, 0x24, 0xcf, 0x00, 0x24, 0xa5,
-	0xe3, 0x5d, 0x72, 0x63, 0x9a, 0x2f, 0x1e, 0xef, 0xb6, 0x36, 0xa7, 0xce, 0xc4, 0xf1, 0x6e, 0xda,
-	0xe3, 0x77, 0x63, 0x63, 0xd8, 0xbd, 0x0f, 0xc9, 0x59, 0x32, 0xfb, 0xdc, 0x7e, 0x33, 0x70, 0x9b,
-	0xc9, 0xfc, 0x37, 0x90, 0x63, 0x93, 0x6c, 0x64, 0x38, 0x4a, 0xe3, 0x9f, 0x26, 0x17, 0x7d, 0xe5,
-	0x01, 0xfd, 0x0c, 0xac, 0x2f, 0x70, 0xb9, 0x9b, 0xc8, 0xf4, 0x2a, 0xb9, 0x92, 0x65, 0x7a, 0xca,
-	0x59, 0xb4, 0x77, 0xc8, 0xb7, 0xa0, 0x9e, 0xea, 0x5e, 0x91, 0x09, 0xcb, 0x65, 0x9a, 0x73, 0xad,
-	0xad, 0x62, 0x04, 0xc1, 0xf4, 0x2e, 0x32, 0xbd, 0x45, 0x74, 0x36, 0xa5, 0xa9, 0xeb, 0xb5, 0x41,
-	0x5b, 0xde, 0xe0, 0x4b, 0xfc, 0xbd, 0x0b, 0xb5, 0xf8, 0x7e, 0x47, 0x61, 0x06, 0xbb, 0x31, 0x79,
-	0x8f, 0x21, 0x7d, 0xd7, 0x49, 0xbf, 0x8e, 0x0c, 0x2f, 0x93, 0x4b, 0x13, 0x0c, 0x3d, 0x46, 0xf6,
-	0x5b, 0xa9, 0xfb, 0x51, 0xf2, 0xce, 0x4a, 0x21, 0xaf, 0xe7, 0xf3, 0x79, 0x65, 0xef, 0xba, 0xe8,
-	0x2f, 0x20, 0xcf, 0x9b, 0x64, 0x33, 0x97, 0x67, 0x6c, 0xdf, 0x97, 0xf2, 0xb8, 0xef, 0x7c, 0x4c,
-	0xee, 0x3b, 0x1f, 0x95, 0xfb, 0x0e, 0xf9, 0x0e, 0x4f, 0xae, 0x13, 0x17, 0x31, 0x0a, 0x25, 0x98,
-	0x48, 0xa5, 0x85, 0x77, 0x38, 0xa6, 0xcc, 0x73, 0xc0, 0xdf, 0xe1, 0xc2, 0xd8, 0x8c, 0xdd, 0x4f,
-	0x78, 0x6a, 0xc9, 0xbb, 0xd6, 0x70, 0xb7, 0x80, 0x63, 0xce, 0xbd, 0x89, 0xc9, 0xd4, 0x32, 0xe5,
-	0xae, 0x82, 0xbe, 0x83, 0xf2, 0xdd, 0xd3, 0x9f, 0x2f, 0x94, 0x8f, 0x7f, 0x52, 0xdc, 0xe6, 0x17,
-	0x14, 0x1e, 0x68, 0x77, 0xc9, 0x6f, 0xe0, 0x64, 0xa9, 0x37, 0x7e, 0xc9, 0xed, 0x2c, 0xd3, 0xdc,
-	0x3b, 0xc4, 0xad, 0xc2, 0xab, 0x15, 0xfa, 0x1d, 0x14, 0x44, 0x27, 0x5b, 0x13, 0x82, 0x7c, 0x80,
-	0x45, 0xc6, 0x87, 0xed, 0x1e, 0xee, 0x4b, 0x03, 0xf2, 0x0d, 0xe4, 0xbf, 0x27, 0xce, 0xe7, 0xf8,
-	0xc1, 0x62, 0xe1, 0x54, 0x6d, 0x16, 0x9c, 0x44, 0xc6, 0x06, 0x20, 0xc8, 0xb7, 0x41, 0x80, 0xf1,
-	0x15, 0x07, 0xe2, 0x11, 0xac, 0xc6, 0xc7, 0xc4, 0x92, 0x4f, 0x66, 0xa5, 0x9d, 0x72, 0x47, 0xe3,
-	0x62, 0x9e, 0x97, 0x90, 0xe7, 0xb2, 0x9e, 0xe2, 0xc9, 0x0c, 0x7b, 0xca, 0xcf, 0x05, 0x15, 0xc5,
-	0xf8, 0x89, 0x69, 0xa1, 0x7a, 0xb7, 0x67, 0x3a, 0x68, 0xd5, 0xaf, 0x21, 0xc3, 0x0d, 0xb2, 0x9e,
-	0x30, 0x6c, 0x27, 0xa7, 0x9f, 0xdf, 0xd7, 0xe0, 0xf2, 0x84, 0xbe, 0x82, 0xf1, 0xf6, 0x47, 0x3b,
-	0x3c, 0x9f, 0x55, 0xa0, 0x4d, 0x14, 0xe8, 0x8a, 0x9e, 0x2b, 0x10, 0xb3, 0x85, 0x87, 0x4b, 0x8c,
-	0x62, 0x0b, 0x72, 0xbd, 0xe0, 0x60, 0x55, 0xb0, 0xbe, 0x51, 0xf4, 0x38, 0x2f, 0x03, 0x0a, 0x9e,
-	0x1f, 0xc8, 0x3b, 0x20, 0x1f, 0x12, 0x17, 0xc8, 0xc1, 0xd0, 0x9d, 0xd5, 0xaf, 0x54, 0x3d, 0x8b,
-	0xae, 0x3e, 0xe8, 0x2d, 0xe4, 0xb9, 0xae, 0x2f, 0xa7, 0x78, 0x7a, 0x43, 0x37, 0x64, 0x2a, 0xfe,
-	0xa6, 0x06, 0xab, 0x13, 0x1c, 0x2f, 0x52, 0x72, 0x46, 0xbe, 0xb7, 0x91, 0xef, 0xa6, 0xde, 0xca,
-	0xd5, 0x35, 0x16, 0xc1, 0x05, 0xf2, 0xb6, 0xed, 0xd0, 0xcf, 0x5e, 0xe7, 0x91, 0xed, 0x50, 0xa9,
-	0xf3, 0x04, 0xc7, 0x9f, 0x8f, 0xce, 0x52, 0x04, 0x17, 0xc8, 0x61, 0xe8, 0x7a, 0x9f, 0xbd, 0xce,
-	0x41, 0xe8, 0x7a, 0x52, 0xe7, 0x09, 0x8e, 0x3f, 0x1f, 0x9d, 0xa5, 0x08, 0xdf, 0xc4, 0xbf, 0xfd,
-	0x92, 0x3e, 0x30, 0x2d, 0x54, 0xf8, 0xd6, 0x2c, 0xc7, 0xac, 0xea, 0x56, 0xc5, 0x42, 0x8c, 0xb6,
-	0xe8, 0xbf, 0x9b, 0x00, 0xc9, 0x89, 0xeb, 0x8c, 0x79, 0x79, 0xf2, 0x88, 0x56, 0xb5, 0xa8, 0xe0,
-	0xf0, 0x5e, 0x64, 0xa3, 0xdb, 0x8e, 0x61, 0x49, 0x3d, 0x19, 0xc9, 0x6c, 0x00, 0x72, 0xcf, 0xb2,
-	0x32, 0x15, 0x68, 0xfe, 0xc1, 0x93, 0x4c, 0x12, 0x3a, 0x61, 0x6c, 0x45, 0xa3, 0xb1, 0xcd, 0x0f,
-	0x65, 0x18, 0xe7, 0xef, 0x6b, 0xb0, 0x96, 0x73, 0x28, 0x43, 0x5e, 0x98, 0x42, 0x3b, 0x7d, 0x1a,
-	0xd0, 0xba, 0x73, 0x31, 0xa2, 0x5a, 0xbe, 0xe8, 0xd7, 0x26, 0x25, 0x51, 0x53, 0xe5, 0x18, 0x96,
-	0xd4, 0xbe, 0x7b, 0xc6, 0x1a, 0xb9, 0x07, 0x39, 0x19, 0x6b, 0xe4, 0x9f, 0xb1, 0xe4, 0x5b, 0x83,
-	0x9f, 0x2f, 0x48, 0x6b, 0xe4, 0xb4, 0xfc, 0x33, 0xd6, 0x28, 0x3e, 0x1b, 0xc9, 0x58, 0x63, 0xca,
-	0xe9, 0x41, 0xbe, 0x35, 0xb8, 0x24, 0xaa, 0x35, 0xbe, 0x8e, 0xa5, 0x7a, 0x6c, 0x8a, 0x22, 0xff,
-	0x9b, 0xa8, 0xd0, 0x27, 0x74, 0x5f, 0x43, 0x8e, 0x8b, 0xa4, 0x9e, 0xe2, 0x48, 0x7e, 0x4b, 0xc3,
-	0xe2, 0x43, 0x6d, 0x95, 0x4f, 0x16, 0x3f, 0xb9, 0x3d, 0xfa, 0xc9, 0xc2, 0x35, 0xbf, 0xe3, 0x9e,
-	0x6f, 0x75, 0x5e, 0xfd, 0x30, 0x0d, 0x23, 0x68, 0xa4, 0xfb, 0xd7, 0x44, 0x55, 0x25, 0xa7, 0x23,
-	0x9e, 0xe9, 0x6e, 0xe4, 0x35, 0xbf, 0xe5, 0xc6, 0x57, 0xbf, 0x94, 0xe6, 0x19, 0x21, 0xa6, 0xed,
-	0xf4, 0x19, 0x5b, 0x0a, 0x90, 0xb4, 0xbb, 0x67, 0x8c, 0xeb, 0xc9, 0xfe, 0xb8, 0xdc, 0xe2, 0xe9,
-	0x6b, 0x69, 0x46, 0x29, 0x36, 0xbf, 0xaf, 0xc1, 0xea, 0x44, 0xbb, 0x3a, 0x63, 0xe1, 0xa2, 0x86,
-	0x79, 0xc6, 0xc2, 0x85, 0x5d, 0x6f, 0x59, 0x6c, 0xea, 0xd7, 0xd3, 0x42, 0xc8, 0x1e, 0x7a, 0xdb,
-	0x62, 0xef, 0x09, 0x71, 0xbe, 0xab, 0xc1, 0x4a, 0xb6, 0x53, 0x9d, 0xe9, 0x74, 0x14, 0x74, 0xc9,
-	0x5b, 0xb7, 0x2f, 0xc0, 0x9a, 0xe6, 0xd9, 0xa2, 0x73, 0xae, 0x88, 0xf2, 0x3b, 0x1a, 0x66, 0x71,
-	0xa5, 0x75, 0x39, 0xb1, 0xab, 0xce, 0x69, 0x8e, 0x4f, 0xa6, 0xf4, 0xbc, 0xee, 0x9e, 0xda, 0x64,
-	0xe0, 0x4d, 0xc2, 0x36, 0x6f, 0xaa, 0xb5, 0xc5, 0x39, 0x21, 0xdf, 0xfc, 0xff, 0x50, 0x9b, 0xe8,
-	0xc2, 0x8b, 0x1e, 0xf8, 0xe4, 0x2e, 0xa5, 0xb8, 0x01, 0x3f, 0xb9, 0x4b, 0x99, 0xd2, 0x54, 0xd7,
-	0x6f, 0xa1, 0x6c, 0x37, 0xf4, 0x2b, 0x93, 0xb2, 0x0d, 0x38, 0xea, 0x03, 0xed, 0xee, 0xa3, 0x6f,
-	0x97, 0xfe, 0xf0, 0xe1, 0x7f, 0x69, 0xc4, 0x80, 0xc5, 0xc3, 0xa7, 0x47, 0xf7, 0x59, 0x99, 0xe1,
-	0x6f, 0x3d, 0x3c, 0x78, 0xa2, 0x3f, 0x80, 0xfa, 0xe1, 0xd3, 0xa3, 0x2d, 0xcf, 0x77, 0xbf, 0x49,
-	0xad, 0x90, 0x5c, 0x1a, 0x84, 0xa1, 0x17, 0x3c, 0x68, 0xb7, 0x83, 0xe8, 0x64, 0x60, 0x9e, 0x9a,
-	0xa1, 0xbd, 0x6d, 0xbb, 0xed, 0xd6, 0xba, 0xe5, 0x3a, 0xa1, 0x69, 0x85, 0xbf, 0x92, 0x06, 0xdf,
-	0xfd, 0xc2, 0x6e, 0x79, 0x67, 0xfb, 0xa5, 0xbb, 0x9a, 0xb6, 0xbb, 0x62, 0x7a, 0xde, 0xd0, 0xe6,
-	0xd7, 0x09, 0xdb, 0xdf, 0x0c, 0x5c, 0x67, 0x77, 0x23, 0x0d, 0x19, 0xdf, 0x7f, 0xe6, 0xba, 0xf7,
-	0x47, 0xf6, 0x88, 0x3e, 0x98, 0xc0, 0x7c, 0x50, 0x80, 0x69, 0x5c, 0x85, 0xf2, 0x2b, 0x2f, 0xbd,
-	0x42, 0xd6, 0x01, 0xde, 0x71, 0xc3, 0xad, 0x67, 0x6e, 0xe4, 0xf4, 0xb6, 0x49, 0x15, 0x2a, 0x3f,
-	0x2a, 0x69, 0xf3, 0xfe, 0x2b, 0x70, 0x55, 0xd1, 0x63, 0x6b, 0xdf, 0xb5, 0xa2, 0x11, 0x75, 0xf8,
-	0x9f, 0xd4, 0x2c, 0x50, 0xa3, 0x5b, 0x45, 0x9b, 0xbe, 0xfc, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x58, 0x8e, 0x1b, 0xbf, 0xcf, 0x53, 0x00, 0x00,
+	// 8331 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5b, 0x6c, 0x24, 0x59,
+	0x96, 0xd0, 0x44, 0x3e, 0x6c, 0xe7, 0xc9, 0xf4, 0xeb, 0xba, 0xca, 0x95, 0xce, 0x7a, 0xb9, 0xa2,
+	0x1e, 0x5d, 0x5d, 0xd5, 0x65, 0x97, 0xdd, 0xdd, 0xdb, 0x3b, 0xa5, 0x65, 0x96, 0xaa, 0x72, 0xf7,
+	0x94, 0xb7, 0xba, 0x7a, 0x3c, 0x61, 0x77, 0x0d, 0xd2, 0x8c, 0x36, 0x27, 0x32, 0xf3, 0x3a, 0x1d,
+	0xed, 0xcc, 0x88, 0xe8, 0x88, 0x48, 0x3b, 0x3d, 0xbd, 0xbd, 0x82, 0x5d, 0x66, 0x19, 0x34, 0x8c,
+	0x56, 0x30, 0x88, 0x11, 0x23, 0x84, 0x40, 0xac, 0x16, 0xed, 0x07, 0x12, 0x3f, 0x48, 0x3c, 0x04,
+	0x12, 0x5f, 0x20, 0xf1, 0x83, 0xb4, 0x12, 0xbf, 0x08, 0xb1, 0x08, 0x89, 0x0f, 0x24, 0x84, 0x10,
+	0x3f, 0xb0, 0x42, 0xe7, 0x3e, 0x22, 0xee, 0x8d, 0x47, 0x3a, 0xab, 0xbb, 0x1a, 0x16, 0xed, 0x7c,
+	0x39, 0xef, 0x89, 0x13, 0xe7, 0x9c, 0x7b, 0xee, 0xb9, 0xe7, 0x9e, 0x7b, 0xee, 0xb9, 0x61, 0xa8,
+	0xd9, 0xbe, 0xb3, 0xe1, 0x07, 0x5e, 0xe4, 0x91, 0x7a, 0xe0, 0x77, 0xd9, 0xaf, 0xce, 0xe8, 0xb0,
+	0x75, 0xa5, 0xef, 0x79, 0xfd, 0x01, 0xdd, 0xb4, 0x7d, 0x67, 0xd3, 0x76, 0x5d, 0x2f, 0xb2, 0x23,
+	0xc7, 0x73, 0x43, 0x8e, 0xda, 0x7a, 0x8b, 0xfd, 0xe9, 0x3e, 0xe8, 0x53, 0xf7, 0x41, 0x78, 0x6a,
+	0xf7, 0xfb, 0x34, 0xd8, 0xf4, 0x7c, 0x86, 0x91, 0x83, 0x7d, 0x59, 0xd0, 0x92, 0xc4, 0x37, 0xe9,
+	0xd0, 0x8f, 0xce, 0xf8, 0x43, 0xf3, 0x9f, 0x1a, 0xd0, 0x78, 0xb6, 0xf3, 0x1d, 0x7b, 0x30, 0xa0,
+	0xd1, 0x9e, 0x1d, 0x1d, 0x91, 0x26, 0xcc, 0xfa, 0xa3, 0xc0, 0xf7, 0x42, 0xda, 0x34, 0xd6, 0x8d,
+	0xbb, 0xf3, 0x96, 0x6c, 0x92, 0x16, 0xcc, 0x75, 0x3d, 0xc7, 0x8d, 0xce, 0x7c, 0xda, 0x2c, 0xb1,
+	0x47, 0x71, 0x1b, 0xdf, 0xb2, 0xbb, 0x5d, 0x6f, 0xe4, 0x46, 0xcd, 0x32, 0x7f, 0x4b, 0x34, 0xc9,
+	0x5b, 0x40, 0xe8, 0x38, 0xa2, 0x81, 0x6b, 0x0f, 0xda, 0xdd, 0x23, 0x67, 0xd0, 0x6b, 0xbb, 0xa3,
+	0x61, 0xb3, 0xc2, 0x90, 0x96, 0xe4, 0x93, 0xa7, 0xf8, 0xe0, 0xa3, 0xd1, 0x10, 0xb1, 0x1d, 0x37,
+	0x83, 0x5d, 0xe5, 0xd8, 0x8e, 0xab, 0x63, 0x9b, 0x3f, 0x2e, 0x41, 0x83, 0x8b, 0xfe, 0x34, 0x38,
+	0xf3, 0x23, 0x8f, 0xac, 0xc2, 0x4c, 0xd7, 0xf1, 0x8f, 0x68, 0xc0, 0x64, 0xaf, 0x59, 0xa2, 0x45,
+	0xde, 0x86, 0x4b, 0x43, 0x3b, 0x8c, 0x68, 0xd0, 0x3e, 0x6a, 0xf7, 0xda, 0x7e, 0xe0, 0x9c, 0xb4,
+	0x8f, 0xe9, 0x59, 0x9b, 0xba, 0x5d, 0xd6, 0x93, 0x9a, 0x45, 0xf8, 0xe3, 0x67, 0x3b, 0x7b, 0x81,
+	0x73, 0xf2, 0x9c, 0x9e, 0xbd, 0xef, 0x76, 0x09, 0x81, 0xea, 0x71, 0xbb, 0xd7, 0x3e, 0x64, 0x3d,
+	0xaa, 0x59, 0xe5, 0xe3, 0x9d, 0x0f, 0xc8, 0x55, 0x00, 0x7f, 0xd4, 0x69, 0xfb, 0x76, 0x60, 0x0f,
+	0x43, 0xd6, 0x8b, 0x9a, 0x55, 0xf3, 0x47, 0x9d, 0x3d, 0x06, 0x20, 0xd7, 0xa1, 0xce, 0x88, 0x8b,
+	0xe7, 0x55, 0xf6, 0x1c, 0x10, 0x24, 0x10, 0xee, 0x03, 0xe9, 0x32, 0x51, 0x19, 0x7f, 0x24, 0x85,
+	0x32, 0xcc, 0x30, 0xbc, 0x45, 0xfe, 0xe4, 0x39, 0x3d, 0xdb, 0x1b, 0x75, 0x50, 0x80, 0x07, 0xb0,
+	0xa2, 0x22, 0x23, 0x61, 0xc4, 0x9e, 0x65, 0xd8, 0x4b, 0x09, 0x76, 0xe0, 0x9c, 0xbc, 0xef, 0x76,
+	0xcd, 0x3f, 0x32, 0xa0, 0xb6, 0xe7, 0x75, 0xb9, 0x42, 0xc8, 0x65, 0xa8, 0x9d, 0xb2, 0x5f, 0x6d,
+	0xa7, 0x27, 0xb4, 0x31, 0xc7, 0x01, 0xbb, 0x3d, 0xd4, 0x53, 0x40, 0x87, 0x76, 0x70, 0x2c, 0xba,
+	0x2f, 0x5a, 0x64, 0x0b, 0x66, 0x38, 0x59, 0xd6, 0xe7, 0xfa, 0xf6, 0xda, 0x86, 0x62, 0x94, 0x1b,
+	0xaa, 0xaa, 0x2d, 0x81, 0x48, 0xde, 0x86, 0x39, 0xa6, 0x53, 0x3b, 0x3a, 0x6a, 0x56, 0x72, 0x5e,
+	0x52, 0x8d, 0xcb, 0x9a, 0x39, 0xda, 0xc1, 0xbf, 0xe4, 0x11, 0xd4, 0xed, 0x5e, 0x2f, 0x78, 0x61,
+	0xbb, 0x76, 0x9f, 0x06, 0x4c, 0x4f, 0xf5, 0xed, 0xa6, 0xf6, 0xde, 0xe3, 0xe4, 0xb9, 0xa5, 0x22,
+	0x9b, 0x7f, 0x0e, 0x16, 0x76, 0x68, 0xe0, 0x9c, 0x30, 0x1b, 0x97, 0x26, 0x2b, 0x8d, 0xcf, 0xd0,
+	0x8d, 0x6f, 0x15, 0x66, 0x3a, 0x81, 0xed, 0x76, 0x8f, 0x84, 0xc1, 0x8a, 0x16, 0xb9, 0x00, 0x55,
+	0xc7, 0xed, 0xd1, 0xb1, 0x30, 0x56, 0xde, 0x30, 0xff, 0xa5, 0x01, 0xb0, 0xe7, 0x75, 0x91, 0x33,
+	0x0d, 0x43, 0x72, 0x09, 0x67, 0x42, 0x07, 0x75, 0x2f, 0xad, 0xc9, 0x1f, 0x75, 0x9e, 0xd3, 0x33,
+	0xb2, 0x06, 0x73, 0xd2, 0x84, 0x84, 0xfe, 0x66, 0x7d, 0x6e, 0x36, 0x68, 0x00, 0x61, 0x37, 0x70,
+	0xfc, 0xa8, 0x7d, 0x64, 0x87, 0x47, 0xc2, 0x72, 0x80, 0x83, 0x9e, 0xd9, 0x21, 0x97, 0x95, 0xd3,
+	0x17, 0xd6, 0x23, 0x9b, 0x64, 0x07, 0x16, 0x7b, 0x71, 0xbf, 0xb8, 0x3e, 0xb9, 0x5e, 0x2e, 0x6b,
+	0x7a, 0xd1, 0xfb, 0x6e, 0x2d, 0xf4, 0xb4, 0xb6, 0xf9, 0x0f, 0x0c, 0xa8, 0x2b, 0xaa, 0x23, 0x37,
+	0x61, 0xfe, 0x98, 0x9e, 0x85, 0x91, 0x17, 0xd0, 0xb6, 0x6b, 0x0f, 0xa9, 0xe8, 0x4a, 0x43, 0x02,
+	0x3f, 0xb2, 0x87, 0xb4, 0xd0, 0x1c, 0x9a, 0x30, 0x4b, 0xc7, 0xbe, 0x13, 0xd0, 0x90, 0xf5, 0xa4,
+	0x62, 0xc9, 0x26, 0x79, 0x17, 0x6a, 0x42, 0x6e, 0x8a, 0x1d, 0x29, 0xdf, 0xad, 0x6f, 0x5f, 0xd2,
+	0xc4, 0x4c, 0xf4, 0x68, 0x25, 0x98, 0x64, 0x09, 0xca, 0xa3, 0x90, 0x8a, 0xf9, 0x8c, 0x3f, 0xcd,
+	0x77, 0xe1, 0xf2, 0x37, 0x69, 0xf4, 0x64, 0xe0, 0x75, 0x8f, 0x51, 0x3f, 0x4f, 0xce, 0x9e, 0x51,
+	0xa7, 0x7f, 0x14, 0x59, 0xf4, 0xd3, 0x11, 0x0d, 0xd9, 0x00, 0x1e, 0x31, 0x00, 0x93, 0xbb, 0x62,
+	0x89, 0x96, 0xb9, 0x0d, 0x57, 0xf2, 0x5f, 0x0b, 0x7d, 0xcf, 0x0d, 0x29, 0x21, 0x50, 0x61, 0x03,
+	0xc0, 0x7b, 0xcb, 0x7e, 0x9b, 0x4f, 0xe0, 0x02, 0xbe, 0x43, 0x43, 0xfe, 0xde, 0x24, 0x5c, 0x85,
+	0x6f, 0x49, 0xe3, 0xbb, 0x01, 0x4d, 0x95, 0x06, 0xf2, 0x9e, 0xc8, 0xf3, 0x36, 0x2c, 0x4a, 0x39,
+	0x65, 0x97, 0xf2, 0xd0, 0xb6, 0xe0, 0x92, 0x44, 0x9b, 0x56, 0x03, 0x2f, 0xa0, 0xba, 0x17, 0x78,
+	0xde, 0x21, 0x69, 0x80, 0x31, 0x16, 0xc4, 0x8c, 0x31, 0x1a, 0xed, 0x18, 0x5d, 0xc5, 0x90, 0xca,
+	0xb1, 0x1c, 0xef, 0x61, 0x0b, 0x3d, 0x57, 0xc7, 0x89, 0xda, 0x03, 0xea, 0xf6, 0xa3, 0x23, 0x61,
+	0xf7, 0xb5, 0x8e, 0x13, 0x7d, 0xc8, 0x00, 0xe6, 0x3d, 0x68, 0xec, 0x79, 0x4f, 0xf7, 0x9d, 0xbe,
+	0x6b, 0x47, 0xa3, 0x80, 0x22, 0x55, 0xe9, 0x44, 0x8d, 0x00, 0x5b, 0xa1, 0xa0, 0x67, 0x84, 0x26,
+	0x85, 0x05, 0x26, 0xea, 0xae, 0x7b, 0xe8, 0x7d, 0xe0, 0x05, 0x07, 0xe3, 0x22, 0x21, 0x19, 0x53,
+	0xc4, 0xe4, 0xb3, 0x81, 0x13, 0xa8, 0x75, 0xa4, 0xe6, 0xc8, 0x15, 0xa8, 0x45, 0xce, 0x90, 0x86,
+	0x91, 0x3d, 0xf4, 0x99, 0x48, 0x65, 0x2b, 0x01, 0x98, 0xcf, 0xa1, 0xb1, 0x8f, 0x4a, 0x70, 0xbb,
+	0xf4, 0x43, 0xaf, 0xcb, 0xac, 0x31, 0xa4, 0x5d, 0xcf, 0xed, 0x85, 0x8c, 0x4b, 0xd9, 0x92, 0x4d,
+	0x72, 0x03, 0x1a, 0x82, 0x8d, 0x3a, 0x66, 0x75, 0xce, 0x88, 0xab, 0xeb, 0xf7, 0x0c, 0x28, 0xbf,
+	0x74, 0x5c, 0xb2, 0x02, 0xd5, 0x68, 0x9c, 0xb8, 0xc4, 0x4a, 0x34, 0xde, 0xed, 0xe1, 0x90, 0x9c,
+	0x78, 0xa3, 0x48, 0x38, 0x09, 0xf6, 0x1b, 0x57, 0xbb, 0x50, 0x70, 0x17, 0xc6, 0x1f, 0xb7, 0x51,
+	0x92, 0x53, 0x27, 0x72, 0xf9, 0x24, 0x2e, 0xe3, 0x24, 0x16, 0x4d, 0xf2, 0x0d, 0x98, 0x97, 0x58,
+	0x6d, 0xe4, 0xde, 0xac, 0xe6, 0xb8, 0x44, 0xb5, 0x57, 0x56, 0x23, 0x54, 0x5a, 0xe6, 0x4b, 0x58,
+	0x38, 0xf0, 0xc4, 0xc4, 0xe1, 0xaa, 0xdd, 0x48, 0x1c, 0x86, 0xc1, 0xe6, 0xd9, 0x85, 0x8c, 0x9b,
+	0xc4, 0x49, 0x26, 0x91, 0xd0, 0xb5, 0x9d, 0xd8, 0x83, 0x91, 0x1c, 0x7e, 0xde, 0x30, 0xfb, 0x00,
+	0xbb, 0xae, 0x3f, 0x8a, 0xc2, 0x5d, 0xf7, 0x60, 0x9c, 0xaf, 0x84, 0xd8, 0x27, 0x96, 0x14, 0x9f,
+	0xa8, 0xfa, 0xab, 0x32, 0xef, 0x6a, 0x86, 0x51, 0x45, 0x65, 0xf4, 0x6b, 0x30, 0x2b, 0xfd, 0x67,
+	0x53, 0x95, 0x5c, 0x73, 0x75, 0xb7, 0x61, 0x41, 0x78, 0x49, 0x89, 0xc0, 0x85, 0x9d, 0xe7, 0x50,
+	0x41, 0xc0, 0xfc, 0x51, 0x09, 0xc8, 0x3e, 0x83, 0xec, 0x31, 0xc7, 0x6b, 0xd1, 0x70, 0x34, 0x88,
+	0xd0, 0x89, 0xd8, 0xe1, 0x50, 0xd0, 0xc4, 0x9f, 0x08, 0x39, 0x12, 0x82, 0xd7, 0x2c, 0xfc, 0x89,
+	0x2e, 0x3a, 0xa0, 0x9f, 0xb6, 0x43, 0xa7, 0x1f, 0xca, 0x80, 0x24, 0xa0, 0x9f, 0xee, 0x3b, 0xfd,
+	0x10, 0x07, 0x9b, 0x85, 0x30, 0x15, 0xd1, 0x77, 0x0c, 0x5f, 0x6e, 0xc2, 0xfc, 0x61, 0xe0, 0xfd,
+	0x80, 0xba, 0x6d, 0x9f, 0x06, 0x8e, 0xd7, 0x13, 0x1e, 0xaa, 0xc1, 0x81, 0x7b, 0x0c, 0x86, 0x52,
+	0x07, 0xf4, 0xd4, 0x0e, 0x7a, 0xb1, 0xd4, 0x7c, 0xdd, 0x9e, 0xe7, 0x50, 0xd9, 0xed, 0x6d, 0xd5,
+	0x35, 0xce, 0x4e, 0x18, 0xb2, 0x04, 0x8d, 0xc5, 0x0d, 0xa3, 0xce, 0xc0, 0xe9, 0xe2, 0x9a, 0x12,
+	0x36, 0xe7, 0x98, 0xa6, 0x81, 0x83, 0x9e, 0xd3, 0xb3, 0xd0, 0x3c, 0x85, 0xca, 0x4b, 0xb4, 0xca,
+	0x58, 0xe9, 0x86, 0xa2, 0x74, 0x9c, 0x9e, 0xae, 0x18, 0x36, 0xc3, 0x25, 0xcf, 0x61, 0x59, 0x68,
+	0x37, 0xa1, 0x29, 0xd6, 0xf3, 0xeb, 0xba, 0x1d, 0x66, 0x74, 0x6b, 0x2d, 0x86, 0x12, 0xc6, 0x39,
+	0x9b, 0xff, 0xbb, 0x02, 0xf5, 0x83, 0xb1, 0x65, 0x9f, 0x26, 0xca, 0x47, 0x55, 0x1b, 0x89, 0xaa,
+	0x63, 0x63, 0x2a, 0x29, 0xc6, 0xd4, 0x84, 0xd9, 0x13, 0x1a, 0x84, 0x8e, 0xe7, 0x4a, 0xf5, 0x8b,
+	0x26, 0xc6, 0x25, 0x6c, 0xaa, 0xe2, 0x3c, 0x67, 0x63, 0x50, 0xb1, 0xe6, 0x10, 0x70, 0x80, 0x4e,
+	0x6a, 0x0b, 0xaa, 0x1d, 0x65, 0xda, 0xe8, 0x2b, 0x9f, 0xee, 0x73, 0x2c, 0x8e, 0x49, 0x4c, 0x28,
+	0x9f, 0x38, 0x6e, 0x73, 0x86, 0x29, 0x7a, 0x49, 0x7b, 0xe1, 0xa5, 0xe3, 0x5a, 0xf8, 0x90, 0xdc,
+	0x16, 0xf3, 0x9b, 0x8f, 0xc6, 0xb2, 0x8e, 0xe4, 0x8d, 0x22, 0x31, 0xe5, 0x6f, 0x00, 0x0e, 0xf8,
+	0x30, 0x1e, 0x5e, 0x3e, 0x0c, 0x75, 0x84, 0xc9, 0xc1, 0xbd, 0x0f, 0xa5, 0xc8, 0x6b, 0xd6, 0xd6,
+	0xcb, 0x19, 0xe9, 0xf4, 0x69, 0x6b, 0x95, 0x22, 0x8f, 0x6c, 0xc2, 0x8c, 0xc3, 0x26, 0x5d, 0x13,
+	0x72, 0x56, 0xc8, 0x64, 0x3e, 0x5a, 0x02, 0x8d, 0xc5, 0xde, 0xf6, 0xd9, 0xc0, 0xb3, 0x7b, 0xcd,
+	0xfa, 0xba, 0x71, 0xb7, 0x61, 0xc9, 0x26, 0xb9, 0x05, 0xf3, 0x5d, 0xcf, 0x3d, 0x74, 0x82, 0x21,
+	0x0f, 0xed, 0x9b, 0x0d, 0xa6, 0x39, 0x1d, 0x88, 0xce, 0x3f, 0x1a, 0xb7, 0x43, 0xe7, 0x07, 0xb4,
+	0x39, 0xcf, 0xe3, 0x9d, 0x68, 0xbc, 0xef, 0xfc, 0x80, 0xe2, 0xa8, 0x1d, 0x52, 0xda, 0x5c, 0xe0,
+	0xa3, 0x76, 0x48, 0x19, 0xa4, 0x6f, 0x87, 0xcd, 0x45, 0x0e, 0xe9, 0xdb, 0x21, 0xfa, 0xf0, 0x30,
+	0xb2, 0xa3, 0x51, 0xd8, 0x5c, 0x5a, 0x37, 0xee, 0x56, 0x2d, 0xd1, 0x8a, 0xe7, 0xcb, 0x32, 0x83,
+	0xb2, 0xdf, 0x72, 0x2b, 0xd0, 0xb1, 0x43, 0xda, 0x24, 0xeb, 0xc6, 0xdd, 0x39, 0x2b, 0x6e, 0x93,
+	0x5b, 0xb0, 0x10, 0x79, 0x91, 0x3d, 0x68, 0x3b, 0x6e, 0x9b, 0xdb, 0xea, 0x0a, 0xf3, 0xd6, 0x0d,
+	0x06, 0xdd, 0x75, 0x5f, 0x22, 0x8c, 0xdc, 0x81, 0x45, 0x8e, 0xe5, 0x8d, 0x22, 0x81, 0x76, 0x81,
+	0xa1, 0xcd, 0x33, 0xf0, 0xb7, 0x46, 0x11, 0xc3, 0x33, 0xff, 0x7b, 0x19, 0x66, 0x9e, 0x51, 0xbb,
+	0x47, 0x83, 0xdc, 0x75, 0x7a, 0x0d, 0xe6, 0xba, 0x47, 0xb6, 0xe3, 0x26, 0xf6, 0x37, 0xcb, 0xda,
+	0x59, 0x13, 0xac, 0x24, 0x26, 0x98, 0xac, 0x56, 0x15, 0x6d, 0xb5, 0xc2, 0x9e, 0xa2, 0x55, 0x56,
+	0x99, 0x20, 0xec, 0x37, 0x7a, 0x06, 0x3f, 0xa0, 0x27, 0x8e, 0x37, 0x0a, 0xf9, 0x22, 0xc6, 0xe7,
+	0x7c, 0x43, 0x02, 0xd9, 0x3a, 0xf6, 0x26, 0x2c, 0x45, 0x81, 0xed, 0x86, 0x76, 0x97, 0xc5, 0x6e,
+	0x81, 0xe7, 0x45, 0x22, 0x4a, 0x5f, 0x54, 0xe0, 0x96, 0xe7, 0x31, 0x1b, 0x13, 0x6b, 0x05, 0x47,
+	0x9b, 0x63, 0x68, 0x75, 0x01, 0x63, 0x28, 0x8c, 0xa5, 0xe7, 0x7b, 0xa1, 0x3d, 0xe0, 0x38, 0x35,
+	0xc9, 0x92, 0x03, 0x19, 0xd2, 0x2a, 0xcc, 0x44, 0x76, 0xd0, 0xa7, 0x51, 0x13, 0xf8, 0x32, 0xcf,
+	0x5b, 0xb8, 0xa4, 0x76, 0x8f, 0x30, 0xe0, 0x76, 0xfb, 0x94, 0x19, 0x51, 0xcd, 0x4a, 0x00, 0x62,
+	0xfb, 0x22, 0x7d, 0x42, 0x23, 0xde, 0xbe, 0xf0, 0xc9, 0x4e, 0xee, 0x42, 0xd5, 0xc7, 0x98, 0x82,
+	0x59, 0x4f, 0x7d, 0x9b, 0xe8, 0x11, 0x1d, 0x3e, 0xb1, 0x38, 0x02, 0x79, 0x02, 0x8b, 0x7c, 0xc5,
+	0x0d, 0x65, 0xc4, 0xd0, 0x5c, 0xc8, 0x59, 0xe9, 0xd4, 0x90, 0xc2, 0x5a, 0x60, 0x6f, 0xc4, 0x6d,
+	0x1c, 0xbb, 0x8e, 0xed, 0xb6, 0x07, 0x4e, 0x18, 0x35, 0x17, 0xf9, 0xda, 0xd2, 0xb1, 0xdd, 0x0f,
+	0x9d, 0x30, 0x32, 0xff, 0xb6, 0x01, 0xf5, 0x0f, 0xec, 0xd1, 0x40, 0x38, 0x27, 0x75, 0x2c, 0x0d,
+	0xdd, 0x9d, 0xa8, 0xca, 0x52, 0x76, 0xa6, 0xb1, 0xb2, 0x0e, 0xce, 0xfc, 0x74, 0xb7, 0xcb, 0xe9,
+	0x6e, 0x6f, 0x41, 0x2d, 0xa2, 0x61, 0xe4, 0x0c, 0x3d, 0xf7, 0x4c, 0x04, 0xb3, 0x2b, 0xfa, 0x1e,
+	0x86, 0x19, 0xa0, 0x95, 0x60, 0x99, 0x5d, 0x58, 0xf8, 0xc8, 0x0b, 0x86, 0xf6, 0x60, 0x4f, 0xf0,
+	0xf9, 0xb2, 0x22, 0x12, 0xa8, 0xf4, 0xec, 0xc8, 0x16, 0xc2, 0xb1, 0xdf, 0xe6, 0x4f, 0x0c, 0x68,
+	0x48, 0xfa, 0x8f, 0x03, 0x6a, 0x93, 0xc7, 0xb0, 0xe8, 0x8f, 0x5c, 0x27, 0x3c, 0x1a, 0x52, 0x37,
+	0x6a, 0xdb, 0x01, 0xb5, 0x45, 0x4c, 0xa0, 0x6f, 0x9d, 0x14, 0xcd, 0x59, 0x0b, 0xc9, 0x0b, 0x8c,
+	0xc4, 0x23, 0x00, 0x2f, 0x3a, 0xa2, 0x01, 0x7f, 0xbb, 0x94, 0xe3, 0xc8, 0xf4, 0x7e, 0x59, 0x35,
+	0x86, 0x8e, 0xef, 0x9a, 0xff, 0x70, 0x06, 0x96, 0x92, 0x68, 0x76, 0x42, 0xf4, 0xfc, 0x5a, 0x67,
+	0x65, 0xc6, 0xf5, 0x55, 0xf3, 0x5c, 0x9f, 0x9c, 0xbb, 0x33, 0x93, 0xe6, 0xee, 0x6c, 0xce, 0xdc,
+	0xbd, 0x0c, 0x35, 0x97, 0x8e, 0xc5, 0x7e, 0x8d, 0xcf, 0xc6, 0x39, 0x04, 0x14, 0x4e, 0xec, 0xda,
+	0x74, 0x13, 0x1b, 0xa6, 0x98, 0xd8, 0xf5, 0x89, 0x13, 0xbb, 0xa1, 0x4d, 0xec, 0x26, 0xcc, 0x7e,
+	0x3a, 0xb2, 0x07, 0x4e, 0x74, 0xc6, 0x66, 0x67, 0xcd, 0x92, 0x4d, 0x7d, 0xca, 0x2f, 0x4c, 0x9e,
+	0xf2, 0x8b, 0x85, 0x53, 0x7e, 0xe9, 0x0b, 0x4c, 0xf9, 0xe5, 0x2f, 0x33, 0xe5, 0x89, 0x36, 0xe5,
+	0x31, 0x72, 0x8e, 0x95, 0xc3, 0x6c, 0x73, 0x25, 0x8f, 0xb8, 0x32, 0x1b, 0x12, 0xbd, 0x61, 0x8b,
+	0x2c, 0x40, 0x29, 0x1a, 0x37, 0x2f, 0x30, 0xa2, 0xa5, 0x68, 0x8c, 0x8b, 0x6f, 0x60, 0x9f, 0xb6,
+	0xa3, 0x71, 0xf3, 0x62, 0xce, 0x14, 0x51, 0x42, 0x1a, 0xab, 0x1a, 0xd8, 0xa7, 0x07, 0xe3, 0x64,
+	0xaf, 0xc2, 0xd6, 0xcf, 0x55, 0xb1, 0x41, 0xe2, 0xf2, 0xff, 0x80, 0x89, 0x8e, 0x46, 0xd5, 0x1e,
+	0x45, 0xdd, 0xe6, 0x25, 0x3e, 0x00, 0xd8, 0xfe, 0x38, 0xea, 0xb2, 0x47, 0xe3, 0x36, 0x4f, 0x40,
+	0x34, 0xf9, 0xdc, 0x8f, 0xc6, 0x4f, 0xb1, 0x69, 0xde, 0x87, 0x8b, 0xf1, 0x3e, 0x95, 0x3b, 0x91,
+	0x09, 0xbb, 0xc0, 0x1f, 0x56, 0x61, 0x35, 0x8d, 0xfd, 0x27, 0x6b, 0x96, 0x69, 0x1b, 0xb6, 0x99,
+	0xd4, 0x86, 0xed, 0x17, 0xf3, 0xed, 0xff, 0xa7, 0xf9, 0xa6, 0xda, 0xf3, 0x8a, 0x66, 0xcf, 0xe6,
+	0x4d, 0x58, 0x4e, 0x25, 0x2d, 0x5e, 0x6e, 0xe3, 0xfc, 0x8a, 0x37, 0x8c, 0x25, 0xa7, 0x67, 0xfe,
+	0xee, 0x0c, 0x90, 0xf4, 0x62, 0xf0, 0x72, 0x1b, 0x23, 0x43, 0x39, 0xdc, 0x32, 0xeb, 0x28, 0xdb,
+	0x68, 0xc4, 0x38, 0xd2, 0x72, 0xa3, 0x80, 0xbf, 0xb3, 0x76, 0x57, 0xce, 0xb3, 0x3b, 0x54, 0xea,
+	0x00, 0x4d, 0x9d, 0xcd, 0x4d, 0x9e, 0x3c, 0xae, 0x31, 0x08, 0x9b, 0x9b, 0xb8, 0x7d, 0xb2, 0xbb,
+	0xc7, 0x34, 0xe2, 0xcf, 0xf9, 0xe6, 0x0d, 0x38, 0x88, 0x21, 0xc8, 0xe9, 0x33, 0x53, 0x30, 0x7d,
+	0x66, 0x0b, 0xa7, 0xcf, 0x5c, 0xd1, 0xf4, 0xa9, 0x69, 0xd3, 0x47, 0x9b, 0x18, 0x90, 0x9e, 0x18,
+	0xaa, 0xae, 0xeb, 0xba, 0xef, 0xc8, 0xb3, 0xf8, 0xc6, 0x74, 0x16, 0x3f, 0x3f, 0x85, 0xc5, 0x2f,
+	0x4c, 0xb4, 0xf8, 0xc5, 0x22, 0x8b, 0x5f, 0x9a, 0x60, 0xf1, 0xcb, 0x93, 0x2d, 0x9e, 0x14, 0x5a,
+	0xfc, 0xca, 0x79, 0x16, 0xff, 0x1e, 0xd4, 0x12, 0x5b, 0xbf, 0x70, 0x9e, 0xad, 0x27, 0xb8, 0x9a,
+	0x99, 0x5f, 0xd4, 0xcd, 0xfc, 0x3d, 0xa8, 0xc9, 0xce, 0x87, 0xcd, 0xd5, 0x3c, 0x9a, 0xea, 0x92,
+	0x92, 0xe0, 0x6a, 0x4e, 0xfd, 0x92, 0xe6, 0xd4, 0xc9, 0x45, 0x98, 0x61, 0x3b, 0xde, 0xb0, 0xd9,
+	0x64, 0xcc, 0xaa, 0xb8, 0xe5, 0x0d, 0xcd, 0xf7, 0x00, 0x0e, 0xc6, 0xdf, 0x1a, 0x45, 0x7b, 0x9e,
+	0xe3, 0x46, 0xaf, 0x90, 0x63, 0x31, 0x37, 0x59, 0x52, 0xd1, 0xb2, 0x4f, 0x0f, 0x94, 0x11, 0x17,
+	0xeb, 0x44, 0x1e, 0x19, 0xf3, 0x6f, 0x94, 0x61, 0x2d, 0xe7, 0x0d, 0xb1, 0x56, 0x7c, 0xb1, 0x2d,
+	0x7a, 0x75, 0xc2, 0x16, 0xbd, 0xfc, 0x27, 0x66, 0x8b, 0xae, 0xec, 0x90, 0xe7, 0x44, 0xe6, 0xbd,
+	0x68, 0x87, 0x5c, 0x3b, 0x67, 0x87, 0x0c, 0x79, 0x3b, 0xe4, 0x7a, 0xb2, 0x43, 0x4e, 0xf6, 0xc3,
+	0x0d, 0x6d, 0x3f, 0xac, 0xee, 0x7d, 0xe7, 0xf5, 0xbd, 0xaf, 0xf9, 0x00, 0xd6, 0xf6, 0xa9, 0xdb,
+	0xcb, 0x1f, 0xca, 0xcc, 0xb8, 0x98, 0x5b, 0xd0, 0xca, 0x43, 0x17, 0xe3, 0x98, 0x3b, 0xf4, 0x6f,
+	0x41, 0xf3, 0x80, 0x86, 0xd1, 0x0b, 0x3a, 0xf4, 0x3d, 0x6f, 0xf0, 0xb8, 0xdb, 0xa5, 0x7e, 0x54,
+	0xcc, 0xe0, 0xdf, 0x18, 0xb0, 0x96, 0x83, 0x3e, 0x81, 0x01, 0xcb, 0xda, 0x0d, 0x06, 0xde, 0x29,
+	0xe5, 0xd6, 0x32, 0x67, 0xc9, 0x26, 0x7a, 0xd9, 0x80, 0x7e, 0x42, 0xbb, 0x51, 0xbb, 0xeb, 0xf5,
+	0xa8, 0x3c, 0xdb, 0xe0, 0xa0, 0xa7, 0x5e, 0x8f, 0xc5, 0xdb, 0x02, 0x21, 0xa0, 0x76, 0xe8, 0xb9,
+	0x22, 0xc5, 0xd6, 0xe0, 0x40, 0x8b, 0xc1, 0xa4, 0xa2, 0xab, 0x89, 0xa2, 0xdf, 0x80, 0xc5, 0xa1,
+	0x13, 0x86, 0x8e, 0xdb, 0xc7, 0x73, 0x33, 0xea, 0x46, 0x21, 0x33, 0x95, 0x9a, 0xb5, 0x20, 0xc0,
+	0x7b, 0x1c, 0x6a, 0xfe, 0x76, 0x89, 0x99, 0xfd, 0xc1, 0x78, 0x87, 0x86, 0xdd, 0x97, 0x34, 0xe8,
+	0x78, 0x21, 0x7d, 0x38, 0xb9, 0x37, 0xfa, 0xc2, 0x51, 0x3a, 0x67, 0xe1, 0x28, 0xe7, 0x2d, 0x1c,
+	0xca, 0x2c, 0x60, 0xbf, 0x95, 0x35, 0xa0, 0xaa, 0xad, 0x01, 0xa2, 0x67, 0x33, 0x49, 0xcf, 0xee,
+	0xc3, 0x72, 0x18, 0xd9, 0x41, 0xc4, 0xba, 0x16, 0x38, 0x5e, 0x80, 0xbe, 0x15, 0xd7, 0x1a, 0xc3,
+	0x5a, 0x92, 0x0f, 0xf6, 0x04, 0x3c, 0xc9, 0x88, 0xb0, 0x64, 0x50, 0xdb, 0xee, 0xd3, 0xe6, 0x9c,
+	0x92, 0x11, 0x61, 0xe9, 0xa2, 0xc7, 0x7d, 0x6a, 0xfe, 0x87, 0x1c, 0x2d, 0x6c, 0xfd, 0x69, 0xd3,
+	0x02, 0xae, 0x9b, 0xdd, 0x51, 0x80, 0x76, 0x91, 0xd0, 0xac, 0x31, 0x9a, 0x8b, 0x02, 0x1e, 0x93,
+	0xdc, 0x82, 0xd9, 0x1e, 0xf5, 0xa9, 0xdb, 0xcb, 0xcf, 0xc3, 0x25, 0x3e, 0xdb, 0x92, 0x78, 0xe6,
+	0xdf, 0x35, 0xd8, 0x81, 0xcc, 0xb7, 0x02, 0xff, 0xc8, 0x76, 0xb9, 0xa6, 0xbf, 0x5a, 0x0d, 0x2b,
+	0x32, 0x56, 0xa6, 0x95, 0xb1, 0xc4, 0xc2, 0xb4, 0x83, 0xf1, 0x9e, 0xe7, 0x0d, 0x62, 0xe9, 0xd4,
+	0x65, 0xcb, 0xd0, 0x97, 0xad, 0x1b, 0xd0, 0xf0, 0x58, 0x87, 0xc4, 0x63, 0x2e, 0x65, 0x9d, 0xc3,
+	0x38, 0x8a, 0x09, 0xf3, 0xd1, 0xb8, 0xad, 0xf4, 0x84, 0x47, 0x63, 0xf5, 0x68, 0xbc, 0x17, 0xf7,
+	0x05, 0xf3, 0x7b, 0xe3, 0xb6, 0xda, 0x1d, 0xbe, 0x93, 0x68, 0x44, 0xe3, 0xbd, 0xa4, 0x43, 0xf7,
+	0x60, 0x59, 0x30, 0x53, 0xa8, 0x71, 0x4b, 0x59, 0xe4, 0x0f, 0x12, 0x8a, 0x6f, 0x01, 0x91, 0xb8,
+	0x0a, 0xd5, 0x19, 0x86, 0xbc, 0x24, 0x90, 0x13, 0xca, 0x4b, 0x50, 0x8e, 0xc6, 0x3c, 0xb3, 0x5e,
+	0xb3, 0xf0, 0x27, 0xba, 0x2c, 0x8e, 0x25, 0x53, 0xb6, 0xb2, 0x69, 0xfe, 0xa3, 0x32, 0xac, 0xc5,
+	0x3a, 0xca, 0x78, 0x8c, 0x5f, 0xe8, 0x4a, 0xd1, 0x15, 0x79, 0xcc, 0xb4, 0xd1, 0xa3, 0x61, 0x37,
+	0x14, 0x09, 0xee, 0x3b, 0x9a, 0x0d, 0x16, 0x7a, 0x5e, 0xd4, 0x1a, 0xc2, 0x43, 0xf2, 0xcd, 0x58,
+	0x6b, 0x9c, 0x0c, 0x9f, 0x6e, 0xb7, 0xd2, 0x64, 0xf2, 0xa6, 0x95, 0xd4, 0x2d, 0x23, 0x94, 0x3b,
+	0x6e, 0x5b, 0xbf, 0x18, 0xb7, 0xd7, 0x32, 0x6e, 0x5b, 0x5f, 0xe1, 0xb8, 0xfd, 0x2f, 0x83, 0x9d,
+	0xcb, 0xef, 0x47, 0xf6, 0xb1, 0xe3, 0xf6, 0xf9, 0xf0, 0x61, 0x38, 0x18, 0x0f, 0xdd, 0x05, 0xa8,
+	0x32, 0x3f, 0x2e, 0xce, 0x89, 0x79, 0x03, 0x4f, 0xd6, 0x86, 0x18, 0xc9, 0x3b, 0xd1, 0x59, 0x3b,
+	0x39, 0xbc, 0xac, 0x58, 0xf3, 0x12, 0xca, 0xcf, 0x0c, 0xde, 0x84, 0x25, 0x67, 0x98, 0x42, 0xe4,
+	0x83, 0xb7, 0xe8, 0x0c, 0x75, 0xd4, 0xeb, 0x50, 0xb7, 0xd9, 0x51, 0x5d, 0x72, 0x44, 0x59, 0xb1,
+	0x80, 0x81, 0x38, 0x42, 0xd1, 0xf2, 0xa5, 0x9f, 0x58, 0xcf, 0x4c, 0x3c, 0xb1, 0x9e, 0x65, 0x6f,
+	0x26, 0x00, 0xf3, 0xcf, 0xc0, 0xd5, 0xa4, 0xf7, 0x16, 0x3b, 0x15, 0xb4, 0x68, 0xd7, 0x0b, 0x7a,
+	0x32, 0x42, 0xd3, 0x5e, 0x37, 0xd2, 0xaf, 0x9f, 0xc2, 0x4a, 0xce, 0xbb, 0xf9, 0x0b, 0xce, 0x0d,
+	0x68, 0xb0, 0xde, 0xd0, 0x1e, 0x0f, 0xd3, 0xc5, 0x91, 0xb7, 0x80, 0xb1, 0x48, 0xfd, 0x2e, 0xcb,
+	0x88, 0x95, 0xd7, 0x8d, 0x89, 0xd9, 0xaf, 0x52, 0x34, 0x36, 0xbf, 0x07, 0xd7, 0x8a, 0xe4, 0x16,
+	0xe3, 0xf6, 0x08, 0x66, 0x03, 0x06, 0x91, 0xa7, 0xd0, 0xeb, 0xfa, 0x49, 0x62, 0xce, 0xab, 0xf2,
+	0x05, 0xf3, 0xef, 0x18, 0x70, 0xf9, 0x29, 0x46, 0xe1, 0xfd, 0x51, 0x40, 0xf7, 0x7d, 0xbb, 0x4b,
+	0x9f, 0x53, 0xea, 0x27, 0xa9, 0x30, 0x0c, 0xa8, 0x6d, 0xdf, 0xee, 0xe2, 0x12, 0xce, 0x75, 0x12,
+	0xb7, 0x71, 0xc8, 0x7d, 0xfb, 0x0c, 0xcf, 0x88, 0x92, 0x33, 0xd5, 0x12, 0xb3, 0xff, 0x45, 0x0e,
+	0x7f, 0x2c, 0xc1, 0xe4, 0x1a, 0x80, 0x6f, 0x87, 0xa1, 0x7f, 0x14, 0x60, 0x64, 0x2e, 0xa2, 0xd3,
+	0x04, 0xa2, 0x95, 0xaf, 0x55, 0xf4, 0xf2, 0x35, 0xf3, 0x3f, 0x19, 0x50, 0xfb, 0x8e, 0x17, 0x1c,
+	0x33, 0xe9, 0xf8, 0x5c, 0xeb, 0x39, 0xae, 0x30, 0xd3, 0xb2, 0x25, 0x9b, 0xa9, 0xad, 0x6e, 0x29,
+	0xbd, 0xd5, 0xd5, 0x0e, 0xcb, 0xb5, 0x13, 0x6f, 0xbd, 0xfa, 0xa2, 0x92, 0xaa, 0xbe, 0xc0, 0x69,
+	0x11, 0x46, 0x76, 0x24, 0xc3, 0x62, 0xde, 0xe0, 0xb9, 0x14, 0xaf, 0x1f, 0x1f, 0x35, 0x1b, 0x56,
+	0xdc, 0x26, 0xeb, 0x50, 0x1f, 0xb9, 0xf6, 0x89, 0xed, 0x0c, 0xec, 0xce, 0x80, 0x32, 0x53, 0x9c,
+	0xb3, 0x54, 0x10, 0x06, 0x6d, 0xe1, 0xc0, 0x3b, 0x65, 0xe1, 0xd3, 0x9c, 0xc5, 0x7e, 0x9b, 0x0f,
+	0x60, 0x29, 0xee, 0xa6, 0x54, 0xff, 0x1a, 0xcc, 0x85, 0xd8, 0x4e, 0x2c, 0x6c, 0x96, 0xb5, 0x77,
+	0x7b, 0xe6, 0x0f, 0x0d, 0x58, 0x56, 0xf0, 0x85, 0x2d, 0xbc, 0x05, 0x55, 0x86, 0xc0, 0xb0, 0xeb,
+	0xdb, 0xab, 0x7a, 0x8d, 0x58, 0x8c, 0xce, 0x91, 0xb0, 0xe7, 0x34, 0x08, 0xbc, 0x80, 0x6f, 0x1a,
+	0x44, 0x64, 0xc4, 0x20, 0x72, 0xcf, 0xc0, 0x1f, 0x0f, 0x69, 0x18, 0x62, 0xb4, 0xc7, 0x15, 0xd7,
+	0x60, 0xc0, 0x17, 0x1c, 0x66, 0xfe, 0x81, 0x01, 0x24, 0x26, 0x1c, 0xc6, 0x82, 0x60, 0xb1, 0x15,
+	0x93, 0x5c, 0x5d, 0x0a, 0x80, 0x81, 0xb8, 0xab, 0xdf, 0x80, 0x19, 0xd6, 0x0a, 0xc5, 0x41, 0x47,
+	0x91, 0xa8, 0x02, 0x2b, 0x25, 0x6b, 0xf9, 0x5c, 0x59, 0x2b, 0x39, 0xb2, 0xfe, 0x3a, 0x34, 0x1f,
+	0x77, 0xa3, 0x6f, 0xb9, 0x9a, 0xa1, 0x0b, 0x81, 0x75, 0xfa, 0xc6, 0xb9, 0xf4, 0x4b, 0x39, 0xf4,
+	0x9f, 0xc3, 0x85, 0xbd, 0x81, 0x17, 0xbd, 0xc2, 0x30, 0xa2, 0x59, 0x46, 0x47, 0x01, 0xb5, 0x7b,
+	0xa1, 0xd0, 0xbf, 0x6c, 0x9a, 0x2f, 0x60, 0xf5, 0x25, 0x0d, 0x9c, 0xc3, 0xb3, 0x57, 0x24, 0x17,
+	0xda, 0x43, 0x7f, 0x40, 0x63, 0x72, 0xa2, 0x69, 0xfe, 0xcf, 0x12, 0x5c, 0xca, 0xd0, 0x4b, 0x16,
+	0xed, 0x22, 0x82, 0x97, 0xa1, 0x76, 0xe8, 0x0c, 0x28, 0xaf, 0x79, 0xe3, 0x7d, 0x9e, 0x43, 0x00,
+	0x2b, 0xee, 0x9b, 0x5c, 0xb7, 0x94, 0x08, 0xd3, 0x13, 0x4e, 0x5e, 0x36, 0x45, 0xa9, 0x84, 0xd3,
+	0x13, 0x0e, 0x9e, 0x37, 0x10, 0xca, 0xca, 0x5f, 0xc5, 0xd2, 0xcb, 0x1b, 0x2c, 0xc1, 0xe5, 0x05,
+	0xc1, 0xc8, 0x8f, 0x68, 0x4f, 0xba, 0xf5, 0x18, 0xc0, 0xd7, 0x0a, 0x7b, 0x10, 0xf1, 0x7c, 0xb5,
+	0x61, 0x89, 0x16, 0xd9, 0xc5, 0x23, 0x06, 0xb7, 0x4f, 0xe5, 0xba, 0xbb, 0xa5, 0x67, 0x2d, 0xf2,
+	0x15, 0xb1, 0xf1, 0x94, 0xd3, 0xb5, 0xf0, 0x4d, 0x4b, 0x10, 0x68, 0x7d, 0x03, 0x1a, 0x2a, 0x1c,
+	0x59, 0x7a, 0x87, 0x87, 0x21, 0x8d, 0x84, 0x07, 0x12, 0x2d, 0x84, 0x0b, 0x4d, 0x94, 0x38, 0x9c,
+	0xb7, 0xcc, 0x7f, 0x51, 0x62, 0xc5, 0x6d, 0x68, 0x19, 0xdf, 0x1e, 0xd1, 0x51, 0xa2, 0xf6, 0x5f,
+	0x81, 0xaa, 0x3f, 0xf0, 0x22, 0xe9, 0xb6, 0x33, 0xa1, 0x41, 0xe6, 0x8d, 0x0d, 0x84, 0x58, 0xfc,
+	0xa5, 0xd6, 0x7f, 0x36, 0xa0, 0x82, 0xed, 0x49, 0xa3, 0x17, 0xfb, 0xae, 0x52, 0xda, 0x77, 0x79,
+	0xa1, 0x13, 0x25, 0x15, 0x20, 0x71, 0x5b, 0xf3, 0x6b, 0x95, 0x94, 0x5f, 0x53, 0x6c, 0xb5, 0xaa,
+	0xd9, 0x2a, 0x76, 0x7d, 0x48, 0x87, 0x5e, 0x20, 0x87, 0x4e, 0xb4, 0xd8, 0xc9, 0xa9, 0x13, 0x1e,
+	0x8b, 0x1c, 0x2e, 0xfb, 0x8d, 0x6b, 0x41, 0x74, 0x14, 0x78, 0xa3, 0xfe, 0x91, 0x3f, 0x8a, 0xc4,
+	0xa8, 0x29, 0x10, 0x8c, 0xaf, 0x68, 0x64, 0xb3, 0x0d, 0x63, 0xd9, 0xc2, 0x9f, 0xe6, 0x01, 0x34,
+	0x5f, 0x78, 0x27, 0xf4, 0xa9, 0x58, 0x78, 0xa6, 0x9d, 0x0b, 0x57, 0x01, 0x78, 0xf6, 0xb4, 0xdd,
+	0x73, 0x02, 0xb9, 0x20, 0x70, 0xc8, 0x8e, 0x13, 0x98, 0xef, 0xc0, 0x35, 0x8b, 0x76, 0xec, 0x81,
+	0xed, 0x76, 0x75, 0xd2, 0xa1, 0x72, 0x0e, 0xd4, 0x73, 0x02, 0x3e, 0x3c, 0x4c, 0xfa, 0x20, 0xc4,
+	0x5a, 0xb5, 0x1a, 0xc3, 0x42, 0x89, 0x26, 0x71, 0x27, 0x50, 0xc1, 0xe2, 0x14, 0x99, 0xd6, 0xc3,
+	0xdf, 0xec, 0x1c, 0xcc, 0x13, 0x5e, 0x14, 0x8b, 0x50, 0xe2, 0xe1, 0xa9, 0xa8, 0xc3, 0x73, 0x01,
+	0xaa, 0x9d, 0xb3, 0x88, 0xca, 0x63, 0x1e, 0xde, 0x40, 0x15, 0x77, 0x3d, 0xdf, 0xa1, 0x3d, 0xa9,
+	0x62, 0xde, 0x42, 0x6c, 0xe6, 0x83, 0x84, 0x8e, 0x79, 0xc3, 0x7c, 0xc1, 0xa2, 0x1d, 0xad, 0x5b,
+	0x28, 0x70, 0xa8, 0x2e, 0x14, 0x43, 0x04, 0x34, 0x8d, 0x1c, 0xef, 0x1b, 0xe3, 0x5b, 0x1c, 0x09,
+	0x73, 0x55, 0x2b, 0x7b, 0x03, 0xdb, 0x95, 0x04, 0xa7, 0x09, 0x0f, 0xbe, 0x0b, 0x75, 0xcc, 0x4e,
+	0x75, 0x45, 0xca, 0x8f, 0x7b, 0xf9, 0xaf, 0x6b, 0x7c, 0xf2, 0x22, 0x8f, 0x27, 0x67, 0x3b, 0x4e,
+	0x20, 0x87, 0x60, 0xe3, 0x71, 0x4c, 0xc1, 0x52, 0xa9, 0x69, 0x01, 0x43, 0x39, 0x55, 0xef, 0x8e,
+	0xf1, 0xe5, 0x28, 0xf2, 0xda, 0xdd, 0x80, 0x4a, 0xdd, 0x56, 0x2d, 0x40, 0xd0, 0x53, 0x06, 0x31,
+	0xff, 0x49, 0x15, 0x1a, 0xb2, 0x27, 0xd8, 0x2b, 0x56, 0x4d, 0x3c, 0xb0, 0xdd, 0x64, 0x14, 0x67,
+	0xb0, 0xc9, 0x6b, 0xb1, 0x87, 0x34, 0x3a, 0xf2, 0x64, 0x76, 0x56, 0xb4, 0xc8, 0xfb, 0x50, 0xef,
+	0x39, 0x01, 0xed, 0x46, 0x5e, 0xe0, 0x50, 0x5e, 0x7d, 0x57, 0xdf, 0xbe, 0xa9, 0xf7, 0x4d, 0x61,
+	0xb0, 0xb1, 0x23, 0x90, 0xcf, 0x2c, 0xf5, 0x3d, 0xf2, 0x0e, 0x54, 0x71, 0x4a, 0xc8, 0xbc, 0xc2,
+	0xb5, 0x49, 0x04, 0xc2, 0x63, 0x8b, 0x23, 0x17, 0xd8, 0x07, 0xcb, 0x72, 0x78, 0x51, 0x9b, 0x3f,
+	0xe2, 0x36, 0x52, 0x43, 0xc8, 0x13, 0xf6, 0xf8, 0x32, 0xb0, 0x06, 0x0f, 0x48, 0x67, 0x79, 0xde,
+	0x18, 0x01, 0x2c, 0x1a, 0x6d, 0xc2, 0x2c, 0x57, 0x56, 0x4f, 0x24, 0x74, 0x64, 0xb3, 0xf5, 0xbb,
+	0x06, 0x54, 0x79, 0xe0, 0x35, 0x79, 0xd1, 0x91, 0x31, 0x59, 0x29, 0x13, 0x93, 0x4d, 0x5a, 0x20,
+	0x58, 0x6d, 0xf3, 0x28, 0x14, 0xeb, 0xc3, 0x9c, 0x25, 0x5a, 0x9a, 0x13, 0xaa, 0xea, 0x4e, 0xa8,
+	0xf5, 0xd7, 0x0d, 0xa8, 0xc5, 0xea, 0xc4, 0xc5, 0x41, 0x2a, 0x54, 0x56, 0x82, 0x27, 0x00, 0xcd,
+	0x3c, 0x4b, 0x29, 0xf3, 0x8c, 0xb5, 0x58, 0x56, 0xb5, 0xf8, 0x5e, 0x1c, 0x95, 0xf0, 0x21, 0xb9,
+	0x5e, 0x3c, 0x24, 0x5a, 0x78, 0xd2, 0x3a, 0x84, 0x0a, 0x8e, 0x51, 0xec, 0xf1, 0x0c, 0xc5, 0xe3,
+	0x31, 0x57, 0x40, 0xe5, 0x3e, 0x80, 0xfd, 0x46, 0xd1, 0x02, 0xfa, 0xe9, 0xc8, 0x09, 0x68, 0x4f,
+	0x96, 0xb0, 0xca, 0x36, 0x3e, 0x1b, 0xd0, 0xc3, 0xc8, 0x3b, 0xa1, 0x41, 0x9c, 0xe2, 0x17, 0x6d,
+	0xf3, 0x37, 0xa1, 0xf9, 0xd8, 0xf7, 0x07, 0x67, 0xaa, 0x28, 0x72, 0x36, 0x16, 0x9a, 0xf1, 0xeb,
+	0x8b, 0xd4, 0xcd, 0x1f, 0x1b, 0x70, 0xe5, 0x7d, 0xac, 0xb4, 0xb1, 0x23, 0xfa, 0xc2, 0x71, 0xd9,
+	0xc6, 0xe2, 0x84, 0xba, 0x23, 0x3a, 0x8d, 0x4b, 0xd0, 0xcd, 0xa1, 0x94, 0x13, 0x2f, 0x74, 0x1c,
+	0xb7, 0xe7, 0xb8, 0x7d, 0xc6, 0x78, 0xce, 0x92, 0x4d, 0x76, 0x57, 0x00, 0xf7, 0x79, 0xa1, 0x08,
+	0xcf, 0x45, 0xcb, 0xfc, 0x67, 0xbc, 0x0a, 0xc7, 0x3b, 0xfc, 0xd0, 0x8e, 0xa8, 0xdb, 0x3d, 0x53,
+	0xe3, 0x1f, 0x43, 0x8b, 0x7f, 0x92, 0xdd, 0x6d, 0x49, 0xdd, 0xdd, 0x5e, 0x87, 0x3a, 0xaa, 0xb5,
+	0xdd, 0x19, 0xf5, 0xf0, 0xd0, 0x4d, 0x24, 0xff, 0x10, 0xf4, 0x84, 0x41, 0x70, 0x35, 0xf2, 0xdf,
+	0x7d, 0x28, 0x96, 0x42, 0xfc, 0xc9, 0x20, 0x5f, 0x7f, 0x28, 0xec, 0x12, 0x7f, 0x72, 0xc8, 0xd7,
+	0xc5, 0x36, 0x00, 0x7f, 0x22, 0x64, 0x68, 0x8f, 0x45, 0x22, 0x15, 0x7f, 0xa2, 0x0d, 0x0c, 0xec,
+	0x50, 0xae, 0x77, 0xec, 0xb7, 0xf9, 0x87, 0x3c, 0x93, 0xa2, 0x74, 0xc0, 0x51, 0x3c, 0x34, 0xf6,
+	0x99, 0x4b, 0x65, 0xf0, 0xc8, 0x86, 0xb7, 0xc8, 0x4e, 0x2a, 0x70, 0x7e, 0x2b, 0x13, 0x36, 0xe4,
+	0xd2, 0xd3, 0xed, 0x95, 0x58, 0x79, 0x1e, 0xec, 0xe1, 0x94, 0xa4, 0xf2, 0xdd, 0x59, 0xeb, 0xef,
+	0x4d, 0xe3, 0x2c, 0xb4, 0x19, 0x5b, 0x4a, 0xcf, 0x58, 0xb9, 0x31, 0x2a, 0x27, 0x1b, 0x23, 0x1c,
+	0xd3, 0x1e, 0x1d, 0x7a, 0x51, 0xec, 0x26, 0x64, 0x93, 0xbc, 0x0d, 0xb3, 0x03, 0x3e, 0xf0, 0xb9,
+	0xb5, 0xdc, 0xaa, 0x65, 0x58, 0x12, 0xb3, 0xf5, 0xeb, 0xd3, 0xfb, 0x0f, 0x85, 0x7e, 0x69, 0x5a,
+	0xfa, 0xe6, 0x7f, 0x9d, 0x81, 0xab, 0x05, 0x33, 0x24, 0x19, 0xd9, 0xdc, 0x8a, 0xfc, 0xc4, 0xca,
+	0x4b, 0xaa, 0x95, 0x63, 0x0a, 0x86, 0xfd, 0x6a, 0xb3, 0x4b, 0x56, 0x27, 0xf6, 0x80, 0xa9, 0xc7,
+	0xb0, 0xe6, 0x3b, 0xfc, 0x48, 0x8f, 0x03, 0x11, 0xcd, 0xa5, 0xd1, 0xa9, 0x17, 0x1c, 0xb7, 0x4f,
+	0x93, 0x32, 0x12, 0xc3, 0x9a, 0x17, 0xd0, 0xef, 0x70, 0x2e, 0x37, 0x41, 0x02, 0xda, 0x7c, 0xab,
+	0xc8, 0x2d, 0xb9, 0x21, 0x80, 0x7c, 0x00, 0x37, 0x60, 0x45, 0x43, 0x6a, 0xe3, 0x31, 0x53, 0x20,
+	0x4c, 0x7c, 0x59, 0x45, 0xfd, 0x10, 0x1f, 0x64, 0xf1, 0x47, 0xbe, 0x4f, 0x83, 0xe6, 0x6c, 0x16,
+	0xff, 0x63, 0x7c, 0xc0, 0x22, 0x1f, 0xc6, 0x9c, 0x9f, 0xe1, 0xf3, 0x06, 0x83, 0x1e, 0xd9, 0x01,
+	0x15, 0xa7, 0x05, 0xbc, 0x81, 0x09, 0x3f, 0xae, 0x08, 0x2c, 0x00, 0x6f, 0xf7, 0xec, 0x33, 0x76,
+	0x3c, 0x68, 0x58, 0xfc, 0x5e, 0x41, 0xb8, 0x47, 0x83, 0x1d, 0xfb, 0x8c, 0x6c, 0xc2, 0x05, 0x1d,
+	0x4b, 0x88, 0x5c, 0x67, 0x0c, 0x96, 0x55, 0x5c, 0x2e, 0x72, 0xf6, 0x05, 0x2e, 0x73, 0x23, 0xfb,
+	0x02, 0x97, 0xf9, 0x1a, 0xd4, 0xc3, 0xe3, 0x28, 0x16, 0x82, 0x1f, 0xf1, 0xd7, 0xc2, 0xe3, 0x48,
+	0x48, 0xf0, 0x26, 0x2c, 0x2b, 0xcf, 0x05, 0x7b, 0x7e, 0xc8, 0xbf, 0x10, 0x63, 0x71, 0xde, 0x29,
+	0x54, 0xce, 0x78, 0x31, 0x85, 0xca, 0xb9, 0x7e, 0x0c, 0xf5, 0xc4, 0x67, 0x62, 0xfd, 0x2f, 0x4e,
+	0xd4, 0x77, 0x34, 0x3b, 0x9c, 0x68, 0x6d, 0x1b, 0x4f, 0xa4, 0x7f, 0xb5, 0x20, 0x76, 0xb5, 0x61,
+	0xeb, 0x5f, 0x1b, 0x50, 0x8b, 0x9f, 0xa4, 0x1c, 0xb3, 0x91, 0x93, 0x02, 0x51, 0x53, 0xb6, 0xbc,
+	0x81, 0xd0, 0x8e, 0x37, 0x72, 0x7b, 0xf2, 0xa2, 0x16, 0x6b, 0x24, 0xeb, 0x6a, 0x45, 0x5d, 0x57,
+	0xe3, 0x91, 0xad, 0x4e, 0x1e, 0xd9, 0x99, 0x9c, 0x91, 0x4d, 0xe9, 0x7d, 0x36, 0xa5, 0x77, 0xf3,
+	0xdf, 0x96, 0xe0, 0xc6, 0xb9, 0x91, 0x64, 0x3a, 0x1c, 0x35, 0x5e, 0x6b, 0x38, 0xfa, 0x7f, 0x27,
+	0x15, 0x96, 0x8e, 0x6c, 0xab, 0xe9, 0xc8, 0xb6, 0xf5, 0x01, 0x40, 0x22, 0xe2, 0x17, 0x0f, 0x8e,
+	0xcc, 0xff, 0x51, 0x82, 0x66, 0x92, 0xd4, 0x91, 0x3a, 0x10, 0xee, 0xeb, 0x0d, 0x58, 0x8c, 0xa9,
+	0x68, 0xe9, 0x9d, 0x85, 0x18, 0xcc, 0x53, 0x3c, 0x56, 0xde, 0x0e, 0xe0, 0x61, 0x7e, 0x9e, 0x27,
+	0xc5, 0xa4, 0x50, 0xd3, 0xaf, 0x21, 0x0d, 0xd4, 0xfa, 0x99, 0xf1, 0x25, 0xd4, 0x54, 0x53, 0xe2,
+	0x99, 0x54, 0x92, 0xab, 0x3c, 0x21, 0xc9, 0x55, 0x99, 0x26, 0xc9, 0x65, 0xfe, 0x97, 0x19, 0x76,
+	0xb6, 0xf9, 0x74, 0xe0, 0x50, 0x17, 0x73, 0xbe, 0xd1, 0x28, 0x51, 0x7b, 0xaa, 0x88, 0xb9, 0x96,
+	0xd4, 0x84, 0xdc, 0x86, 0x05, 0x9f, 0xd2, 0x80, 0xd5, 0xd8, 0x50, 0x74, 0x01, 0xa2, 0x3a, 0x60,
+	0x1e, 0xa1, 0x1f, 0x4a, 0x20, 0x12, 0x08, 0xcf, 0xdc, 0xae, 0x12, 0x5e, 0x89, 0x26, 0xdb, 0xe6,
+	0x30, 0xdf, 0x21, 0xe3, 0x70, 0xde, 0x42, 0x6d, 0xf2, 0xfe, 0x1d, 0x53, 0xea, 0xe3, 0xe3, 0x2a,
+	0x7b, 0xdc, 0x08, 0xe5, 0xfc, 0x40, 0x24, 0xb5, 0x56, 0x6b, 0x46, 0xaf, 0xd5, 0xba, 0x07, 0xcb,
+	0xa8, 0xe5, 0x41, 0xbb, 0x43, 0xc3, 0x48, 0x5e, 0x00, 0xe3, 0x29, 0x9c, 0x45, 0xf6, 0x00, 0x2f,
+	0xeb, 0xf1, 0x4b, 0x60, 0x88, 0x7b, 0xec, 0x7a, 0xa7, 0xae, 0x86, 0xcb, 0x57, 0x87, 0x45, 0xf6,
+	0x40, 0xc1, 0xbd, 0x08, 0x33, 0xfe, 0xb6, 0x8f, 0x0c, 0x79, 0x01, 0x62, 0xd5, 0xdf, 0xf6, 0x77,
+	0x7b, 0xe4, 0xdb, 0x00, 0x4c, 0x0f, 0x7c, 0x34, 0x80, 0xad, 0xd8, 0xdb, 0xe9, 0x90, 0x26, 0x4f,
+	0xb7, 0x1b, 0xf8, 0x1a, 0x1b, 0x31, 0x76, 0x20, 0x52, 0x8b, 0x9b, 0xe4, 0x29, 0x54, 0xb1, 0x11,
+	0xb2, 0x65, 0xa4, 0xbe, 0xfd, 0x60, 0x6a, 0x6a, 0xa8, 0x76, 0x8b, 0xbf, 0xdb, 0xfa, 0x2e, 0xcc,
+	0x6b, 0x0c, 0xf4, 0x93, 0x96, 0x79, 0x19, 0x8b, 0xb6, 0x60, 0xce, 0x1b, 0x45, 0xdc, 0xa5, 0x8a,
+	0x3b, 0xdc, 0xb2, 0x8d, 0x63, 0xe7, 0xb8, 0xaa, 0xb7, 0x95, 0xcd, 0x96, 0x05, 0x73, 0x48, 0x9c,
+	0xd1, 0x4d, 0xd5, 0x01, 0xaa, 0x39, 0xef, 0x92, 0x9e, 0xf3, 0x8e, 0x6d, 0x5e, 0xe6, 0x80, 0x62,
+	0x9b, 0x77, 0x3c, 0xb7, 0xf5, 0x1f, 0x0d, 0x98, 0x93, 0x9d, 0x20, 0xbb, 0x8a, 0x58, 0xdc, 0x6b,
+	0x4e, 0xaf, 0x05, 0xa6, 0xce, 0xa4, 0x17, 0xdf, 0x4c, 0x7a, 0x51, 0xfa, 0x22, 0x94, 0xe4, 0xdb,
+	0x38, 0x2c, 0xac, 0xf4, 0xbd, 0x59, 0xfe, 0x22, 0x64, 0xf8, 0xbb, 0xe6, 0xfb, 0x40, 0xbe, 0x3d,
+	0x72, 0x04, 0xee, 0xb4, 0x79, 0x60, 0x8c, 0xec, 0xc3, 0xbe, 0xbc, 0xce, 0x36, 0x0c, 0xfb, 0xe6,
+	0x01, 0x96, 0x11, 0xbb, 0x34, 0xb0, 0x23, 0xca, 0x4a, 0xac, 0xe2, 0x15, 0x27, 0x5e, 0x35, 0x0d,
+	0x75, 0xd5, 0xc4, 0xc9, 0xaa, 0x2d, 0x15, 0xf2, 0x7e, 0x9d, 0xb6, 0x50, 0x98, 0xcf, 0x60, 0x35,
+	0x4d, 0x55, 0x89, 0x1e, 0xed, 0xf0, 0x88, 0xca, 0xbc, 0x94, 0x68, 0x15, 0x5e, 0x8b, 0xb5, 0x90,
+	0x52, 0xb4, 0x7f, 0x64, 0xf7, 0xbc, 0x53, 0x8b, 0xfa, 0x5e, 0x10, 0xd7, 0x24, 0x5d, 0x05, 0x60,
+	0x35, 0x1e, 0x3c, 0x29, 0xc0, 0x93, 0x99, 0x35, 0x06, 0x61, 0x59, 0x81, 0x35, 0x98, 0xa3, 0xae,
+	0x72, 0x84, 0x55, 0xb6, 0x66, 0xa9, 0xcb, 0x8e, 0xaf, 0xcc, 0xbf, 0x5f, 0x86, 0x4b, 0x19, 0xa2,
+	0xc9, 0x31, 0x62, 0x4e, 0xb7, 0x2f, 0x40, 0xf5, 0x50, 0xb1, 0x6c, 0xde, 0x40, 0x6d, 0x9e, 0xc6,
+	0x49, 0x48, 0xfc, 0x89, 0x4c, 0x4f, 0x1d, 0xb7, 0x1d, 0xc8, 0xcc, 0x8d, 0x81, 0xf7, 0x37, 0x5d,
+	0xcb, 0x8e, 0x28, 0xf9, 0x55, 0xa8, 0x86, 0x2c, 0x5d, 0x5a, 0x65, 0x83, 0xfe, 0x66, 0x7a, 0xd0,
+	0xf3, 0xa4, 0xd9, 0xd8, 0x67, 0x19, 0x53, 0xf6, 0x5e, 0xeb, 0xbf, 0x19, 0x50, 0xc1, 0x76, 0x61,
+	0x00, 0xce, 0x77, 0x1f, 0x52, 0x81, 0xec, 0xb7, 0x5a, 0x79, 0x59, 0xd6, 0x2b, 0x2f, 0xe3, 0x2e,
+	0x71, 0xa7, 0x29, 0xba, 0x74, 0x1d, 0xea, 0xa7, 0x8e, 0xeb, 0xd2, 0x80, 0x9f, 0x52, 0x8a, 0xcf,
+	0x0c, 0x70, 0x10, 0x3b, 0xa6, 0x4c, 0x10, 0x18, 0x2f, 0x9e, 0xa9, 0x11, 0x08, 0x4c, 0xba, 0xdb,
+	0xb0, 0x20, 0x10, 0x24, 0x63, 0x1e, 0xf2, 0xcc, 0x73, 0xe8, 0xb7, 0x05, 0x7b, 0xa1, 0x3b, 0x7e,
+	0x84, 0x84, 0x3f, 0xe3, 0x52, 0xa0, 0x5a, 0x52, 0x0a, 0x64, 0xfa, 0x68, 0x9d, 0xd1, 0x0b, 0xc7,
+	0xa5, 0x3d, 0xdd, 0x3a, 0xbf, 0xf0, 0xe0, 0x9f, 0x73, 0x63, 0xc7, 0xfc, 0xfd, 0x0a, 0xac, 0xa6,
+	0x59, 0x4e, 0x34, 0x0d, 0xf4, 0x7b, 0xec, 0x9c, 0x9a, 0x26, 0x7e, 0x4f, 0xb4, 0x79, 0x86, 0x08,
+	0x0f, 0x2e, 0x05, 0x1f, 0xd1, 0x22, 0x8f, 0x95, 0x84, 0x40, 0xae, 0x31, 0xe4, 0xb0, 0xe7, 0x15,
+	0x90, 0x72, 0x57, 0xd5, 0xfa, 0xe3, 0x12, 0x54, 0x19, 0x64, 0x92, 0x39, 0x28, 0x77, 0xa3, 0xd9,
+	0xef, 0x58, 0xc7, 0xe5, 0x44, 0xc7, 0x29, 0x85, 0x54, 0xd2, 0x27, 0x8f, 0x7a, 0x70, 0x5d, 0x4d,
+	0x07, 0xd7, 0x59, 0x87, 0x30, 0x93, 0xe3, 0x10, 0x14, 0x4d, 0xcc, 0x6a, 0x9a, 0xb8, 0x09, 0xf3,
+	0x43, 0x87, 0x59, 0xd3, 0xa8, 0x13, 0x3a, 0xbd, 0x33, 0x51, 0x7b, 0xd9, 0x60, 0xc0, 0x7d, 0x0e,
+	0xc3, 0x90, 0x4d, 0xa4, 0x52, 0x62, 0x34, 0xbe, 0x72, 0x2e, 0x08, 0xb0, 0x82, 0x18, 0xf2, 0xf3,
+	0xe2, 0x18, 0x91, 0x17, 0xef, 0x2f, 0x08, 0xb0, 0x44, 0xc4, 0x6b, 0xc2, 0xd4, 0xb5, 0x23, 0x1a,
+	0xe3, 0xf1, 0xf2, 0xcc, 0x79, 0x0e, 0x95, 0x68, 0xea, 0xd8, 0x36, 0x78, 0x41, 0xa6, 0x6c, 0x9b,
+	0xdf, 0x87, 0xf9, 0x3d, 0xad, 0x8b, 0xc5, 0x97, 0x92, 0x57, 0x61, 0xe6, 0x34, 0xf1, 0x6d, 0xf3,
+	0x96, 0x68, 0x9d, 0x67, 0x8a, 0x01, 0xb4, 0x30, 0x89, 0xa1, 0x47, 0xe0, 0xb1, 0x35, 0xe2, 0x2d,
+	0xf2, 0x08, 0x1d, 0x4d, 0x5f, 0x86, 0x7d, 0x71, 0x9b, 0xfc, 0xb2, 0x7a, 0x51, 0x98, 0xaf, 0x55,
+	0x2d, 0x7d, 0xef, 0xaf, 0x12, 0x55, 0xae, 0x0b, 0x9b, 0x9f, 0xc0, 0xa5, 0xc7, 0xbd, 0x9e, 0xfe,
+	0x58, 0x4c, 0xb9, 0xd7, 0xde, 0xbf, 0x5f, 0x82, 0x96, 0x45, 0x31, 0x43, 0xff, 0x6a, 0xec, 0xcc,
+	0x5f, 0x82, 0xe6, 0xbe, 0xd4, 0xcb, 0xbe, 0xe8, 0xb2, 0x92, 0xbf, 0x2b, 0xd2, 0x8a, 0xf9, 0x0d,
+	0x76, 0xaa, 0xf0, 0x24, 0xb9, 0xba, 0xff, 0xe4, 0x4c, 0xde, 0x50, 0x8e, 0x9d, 0x8a, 0x22, 0xaf,
+	0x91, 0x96, 0xf7, 0x11, 0x5c, 0x2b, 0x7a, 0x3f, 0x09, 0x72, 0xf9, 0xe4, 0xe3, 0xab, 0x5b, 0xc5,
+	0x92, 0x4d, 0xf3, 0x2d, 0x76, 0xa5, 0xe1, 0xa9, 0xa8, 0xe6, 0x3d, 0xef, 0x0b, 0x0c, 0x3f, 0x35,
+	0xa0, 0x21, 0x71, 0xff, 0x9f, 0x5c, 0xce, 0xce, 0xbb, 0xca, 0x6e, 0xfe, 0xad, 0x32, 0xac, 0x68,
+	0x9d, 0x38, 0xa7, 0xd8, 0x57, 0xc6, 0xfb, 0xa5, 0x09, 0xd7, 0xb4, 0xcb, 0x45, 0xd7, 0xb4, 0x2b,
+	0x53, 0xd7, 0x80, 0xdf, 0x84, 0x79, 0xe9, 0x1d, 0xb8, 0x8e, 0xf8, 0xa2, 0xd5, 0x10, 0x40, 0x5e,
+	0x94, 0x33, 0x4d, 0xa1, 0xf8, 0x03, 0xad, 0x50, 0x7c, 0x2d, 0xb5, 0xb9, 0x4e, 0x46, 0xe3, 0xab,
+	0x2e, 0x18, 0xc7, 0x93, 0x3f, 0x56, 0xa8, 0x7a, 0x48, 0x69, 0x28, 0x6f, 0xda, 0x32, 0xc8, 0x07,
+	0x94, 0x86, 0x45, 0xd5, 0xe3, 0xe6, 0x08, 0x2e, 0xbe, 0x3f, 0xf6, 0xbd, 0x20, 0x7a, 0x2e, 0x3e,
+	0xc0, 0x22, 0xad, 0x6c, 0xe2, 0xf7, 0x7a, 0xf4, 0x0d, 0x7d, 0x29, 0xb3, 0xa1, 0xbf, 0x0e, 0x75,
+	0xca, 0xa8, 0xf2, 0x33, 0x74, 0xb1, 0xe3, 0xe7, 0x20, 0xf6, 0x59, 0x18, 0x1f, 0x56, 0xd3, 0x6c,
+	0x13, 0x0f, 0x25, 0xbf, 0x05, 0x23, 0xd9, 0xca, 0x76, 0xfa, 0x33, 0x3d, 0xa5, 0x57, 0xf9, 0x4c,
+	0xcf, 0x1f, 0x18, 0xd0, 0xd2, 0x59, 0xb2, 0xdd, 0xb7, 0x32, 0x8b, 0x45, 0x77, 0xf1, 0xe0, 0x54,
+	0xcc, 0x62, 0x0e, 0xd9, 0x71, 0x82, 0x73, 0x3b, 0x7c, 0x1f, 0x96, 0xc5, 0xeb, 0x99, 0x44, 0xc7,
+	0xd2, 0xa9, 0xf8, 0xde, 0x50, 0x91, 0x76, 0x2a, 0x19, 0xed, 0x9c, 0xc1, 0xe5, 0x5c, 0x51, 0x85,
+	0x8a, 0xae, 0x40, 0x4d, 0xaa, 0x44, 0xba, 0xb9, 0x04, 0x40, 0x7e, 0x05, 0x1a, 0x4a, 0xbf, 0xa5,
+	0x27, 0x2f, 0xd6, 0x92, 0x86, 0x6d, 0xfe, 0x8e, 0x01, 0x17, 0x77, 0x87, 0x79, 0x06, 0x71, 0x1d,
+	0xea, 0xce, 0x30, 0x91, 0x9a, 0xf3, 0x05, 0x67, 0x28, 0xa5, 0xc6, 0xe5, 0xd1, 0x1b, 0xf4, 0xda,
+	0x19, 0x3d, 0xcd, 0x7b, 0x83, 0x9e, 0xd2, 0x7b, 0x96, 0xb2, 0x3d, 0xcd, 0xea, 0x69, 0xde, 0xa5,
+	0xa7, 0x09, 0x1a, 0x96, 0x3c, 0xaf, 0xa6, 0x05, 0x49, 0x76, 0x03, 0xc2, 0x96, 0x0d, 0xbe, 0x75,
+	0xe7, 0x2d, 0xdd, 0x64, 0x4b, 0x85, 0x9f, 0x98, 0x2a, 0x6b, 0xdf, 0x14, 0x4a, 0xd9, 0x54, 0xe5,
+	0x55, 0x6c, 0xea, 0x5f, 0x19, 0xd0, 0xda, 0x1d, 0xe6, 0x0c, 0x14, 0xd7, 0xd8, 0x06, 0xac, 0x08,
+	0x8d, 0xc5, 0x9f, 0x3c, 0x4a, 0x8c, 0x6b, 0xd9, 0xd1, 0x5e, 0x44, 0x23, 0xbb, 0x0d, 0x0b, 0x52,
+	0xc3, 0xa3, 0x0e, 0xea, 0x47, 0x2a, 0x50, 0x28, 0x99, 0x03, 0x31, 0x5e, 0x91, 0x68, 0x81, 0x73,
+	0xc2, 0xf0, 0x78, 0x97, 0xc4, 0xdb, 0x7b, 0x02, 0x9a, 0xaa, 0x49, 0xe7, 0x98, 0x15, 0xf1, 0x69,
+	0xaf, 0xb8, 0x26, 0x9d, 0x81, 0xcd, 0x7f, 0x57, 0x82, 0xcb, 0xb9, 0x3d, 0x11, 0x2a, 0xff, 0x58,
+	0x37, 0x39, 0xb4, 0xa8, 0xf7, 0xf4, 0xaf, 0x47, 0x14, 0xbf, 0xbc, 0x21, 0xa1, 0xe1, 0xfb, 0x6e,
+	0x14, 0x9c, 0xa9, 0xb6, 0xba, 0x03, 0xcb, 0x68, 0x32, 0xa8, 0xd3, 0xf6, 0x70, 0x5a, 0x83, 0x5d,
+	0xf4, 0x06, 0x3d, 0xa5, 0xcd, 0xa8, 0xa0, 0x45, 0xe9, 0x54, 0xca, 0xe7, 0x51, 0x71, 0xe9, 0xa9,
+	0x4a, 0xa5, 0x75, 0x00, 0x0b, 0xba, 0xa0, 0xb8, 0xdb, 0x48, 0x96, 0x74, 0xfc, 0x89, 0x15, 0x04,
+	0x49, 0x3d, 0x68, 0x3a, 0xb5, 0x15, 0x7f, 0xeb, 0x4c, 0xac, 0xb4, 0x8f, 0x4a, 0xbf, 0x6c, 0x98,
+	0x3b, 0x30, 0xcf, 0x81, 0xfb, 0xa3, 0xe1, 0xd0, 0x0e, 0xce, 0xbe, 0xd0, 0x77, 0xd0, 0xcc, 0xef,
+	0xb0, 0x1b, 0x59, 0xb1, 0xad, 0xd0, 0xc8, 0x76, 0x06, 0xaf, 0xc3, 0x51, 0x9b, 0x47, 0xb0, 0x96,
+	0x43, 0x58, 0x0c, 0xfa, 0x44, 0xca, 0x1b, 0x30, 0xc3, 0x7f, 0x9f, 0xa3, 0x0b, 0x81, 0x65, 0x3e,
+	0x87, 0x15, 0x85, 0x53, 0xcc, 0xe3, 0x1d, 0x98, 0xe5, 0x08, 0xd2, 0xac, 0x5a, 0x39, 0x9f, 0x78,
+	0x13, 0xba, 0xb3, 0x24, 0xaa, 0xf9, 0x2e, 0xac, 0x7c, 0xec, 0xe2, 0x3a, 0x2e, 0x98, 0x08, 0x55,
+	0xe8, 0xbd, 0x35, 0x32, 0xbd, 0xfd, 0x00, 0x2e, 0xe8, 0xaf, 0x25, 0x11, 0x58, 0x38, 0xea, 0x76,
+	0x65, 0xd4, 0x38, 0x67, 0xc9, 0x66, 0x52, 0x69, 0x52, 0x52, 0x2b, 0x4d, 0x76, 0x80, 0x7c, 0xf8,
+	0xe5, 0xa9, 0x7c, 0x1f, 0x9a, 0x4f, 0x8f, 0xb0, 0xba, 0x6a, 0x8f, 0x7d, 0x31, 0x8d, 0xa2, 0xf3,
+	0x93, 0x3d, 0xc1, 0xba, 0xf1, 0x41, 0x2f, 0x99, 0xb6, 0xbc, 0x2f, 0x75, 0xf4, 0xa4, 0x02, 0x84,
+	0x28, 0xcc, 0x8f, 0x4a, 0x14, 0x4e, 0xbb, 0x8e, 0x5e, 0x54, 0xce, 0xea, 0x77, 0x61, 0x2d, 0x87,
+	0xc3, 0x79, 0xe2, 0x9a, 0xdf, 0x85, 0x4b, 0xe2, 0x35, 0x16, 0xd9, 0xa9, 0x72, 0xe1, 0xd9, 0x31,
+	0xca, 0x25, 0xfc, 0x93, 0x50, 0x31, 0x8a, 0xc5, 0x21, 0x88, 0xc0, 0xa4, 0xd2, 0x1c, 0x18, 0xa0,
+	0x50, 0x1c, 0x62, 0xbe, 0x03, 0xcd, 0x2c, 0xf1, 0x73, 0x45, 0xba, 0xcb, 0xf6, 0xd7, 0xdf, 0xc4,
+	0x43, 0x6a, 0x97, 0x1f, 0x59, 0x48, 0x89, 0x92, 0xfc, 0xdf, 0x3c, 0xbb, 0x07, 0xfc, 0x12, 0xae,
+	0xa6, 0x30, 0x9f, 0x39, 0x21, 0x3b, 0xb7, 0xcd, 0x7f, 0x81, 0x79, 0x5d, 0xb7, 0x3b, 0x18, 0xf5,
+	0x68, 0x3b, 0x64, 0xd9, 0x14, 0x99, 0x49, 0x16, 0x50, 0x9e, 0x62, 0x31, 0x29, 0x5c, 0x2b, 0xa2,
+	0x2b, 0xa4, 0x4f, 0x13, 0x7e, 0x1b, 0x66, 0x59, 0xec, 0xd6, 0x97, 0x2e, 0x4d, 0x0f, 0x0e, 0xb5,
+	0xce, 0x48, 0x4c, 0x73, 0x07, 0x96, 0xf8, 0x83, 0x7d, 0xb6, 0xa7, 0xfc, 0x08, 0xf3, 0x6f, 0xd3,
+	0xee, 0xa1, 0x2a, 0x72, 0x0f, 0x65, 0xee, 0x02, 0x51, 0xa9, 0x70, 0x26, 0xe4, 0x6d, 0xa8, 0xba,
+	0x5e, 0x2f, 0x76, 0xe0, 0x57, 0x73, 0xc4, 0x49, 0xb8, 0x5a, 0x1c, 0xd7, 0xdc, 0x84, 0x15, 0xfe,
+	0xe8, 0x25, 0x8f, 0xc4, 0x05, 0xad, 0xc2, 0xcc, 0xbc, 0xf9, 0x14, 0x2e, 0x09, 0x5a, 0xec, 0x88,
+	0x4f, 0x6c, 0xd0, 0x52, 0xb9, 0xda, 0xf9, 0xc9, 0xb9, 0x5a, 0xf3, 0x00, 0x88, 0x4a, 0x44, 0x30,
+	0xfd, 0x46, 0xfa, 0xdb, 0x63, 0xb7, 0xf2, 0xba, 0x90, 0x66, 0x9b, 0x50, 0xfd, 0x79, 0x09, 0x1a,
+	0xaa, 0xda, 0xc9, 0x3e, 0x5c, 0xe8, 0xb3, 0x76, 0x3b, 0x64, 0x6f, 0xb5, 0xf9, 0x30, 0x34, 0x8d,
+	0x9c, 0x0d, 0x50, 0x56, 0x9e, 0x67, 0x5f, 0xb3, 0x48, 0x3f, 0x2b, 0xa5, 0x42, 0x94, 0x67, 0x0b,
+	0x04, 0xd1, 0x52, 0x31, 0x51, 0x65, 0x94, 0x14, 0xa2, 0xea, 0xd8, 0xbd, 0x84, 0x8b, 0x82, 0xa8,
+	0xd0, 0xb3, 0xa4, 0xca, 0xf7, 0x6a, 0xeb, 0x39, 0x54, 0xb5, 0x01, 0x7b, 0xf6, 0x35, 0x6b, 0xa5,
+	0x9f, 0x05, 0x3f, 0x99, 0xc3, 0x12, 0x3b, 0xfc, 0x65, 0xfe, 0x73, 0x7e, 0xc7, 0x4c, 0x9f, 0x63,
+	0x05, 0xa6, 0x9d, 0x7b, 0x81, 0xf7, 0x0d, 0x58, 0xb4, 0xbb, 0x11, 0x73, 0x34, 0xf2, 0x2c, 0x83,
+	0x6f, 0xd4, 0x16, 0x24, 0x58, 0x1c, 0x65, 0xa4, 0x3f, 0x8f, 0x57, 0xc9, 0x7c, 0x1e, 0x8f, 0x7d,
+	0xf8, 0x93, 0xf7, 0x2f, 0xaf, 0xc8, 0x41, 0x93, 0x51, 0xca, 0xff, 0xef, 0x0d, 0x00, 0xb6, 0xd7,
+	0x7b, 0xff, 0x84, 0xba, 0x51, 0xbc, 0x17, 0x35, 0x94, 0xcf, 0xaa, 0xe5, 0x25, 0xb7, 0x92, 0xdd,
+	0x74, 0x59, 0x4b, 0x84, 0xa9, 0x1f, 0x0e, 0xa8, 0xa4, 0x3e, 0x1c, 0xa0, 0x5d, 0x9b, 0xa8, 0xe6,
+	0xdd, 0xae, 0x97, 0xd7, 0x81, 0x66, 0xf4, 0xeb, 0x40, 0x7a, 0xae, 0x60, 0x76, 0x72, 0xd6, 0x6c,
+	0x2e, 0xfd, 0x4d, 0xc4, 0x5f, 0x83, 0x3a, 0xbf, 0xc2, 0xc2, 0x7b, 0x58, 0xf4, 0xe9, 0x40, 0xe5,
+	0xca, 0x1f, 0xfb, 0x9d, 0x97, 0xbf, 0x33, 0x7f, 0xdf, 0x80, 0x85, 0xf8, 0x2c, 0xae, 0x58, 0x63,
+	0x6a, 0x51, 0x4b, 0x49, 0x2f, 0x6a, 0x89, 0xcb, 0xee, 0xcb, 0xd3, 0x94, 0xdd, 0x63, 0xc6, 0x4f,
+	0x7e, 0x8b, 0x43, 0xad, 0xff, 0x8c, 0xbf, 0xd0, 0x81, 0x07, 0x17, 0xec, 0xa8, 0x01, 0x43, 0x64,
+	0x71, 0x1b, 0xb7, 0xe7, 0x04, 0xe6, 0x3f, 0x2e, 0x41, 0x9d, 0x1f, 0xf2, 0x17, 0x4b, 0x59, 0x70,
+	0x0c, 0xa0, 0x49, 0x5f, 0xce, 0x14, 0xca, 0x7e, 0x89, 0xfc, 0xa5, 0x92, 0x20, 0x9f, 0xd1, 0x13,
+	0xe4, 0xfa, 0x7d, 0x9d, 0xd9, 0xf4, 0x7d, 0x9d, 0x16, 0xcc, 0xd9, 0xec, 0xd2, 0xb3, 0x28, 0x3b,
+	0x9c, 0xb3, 0xe2, 0x76, 0xf6, 0xba, 0x72, 0x2d, 0xe7, 0xba, 0x72, 0x92, 0x12, 0x05, 0x2d, 0x25,
+	0x2a, 0xc7, 0xb8, 0x9e, 0x8c, 0xf1, 0xf6, 0xcf, 0x7f, 0x15, 0xe0, 0xb1, 0xef, 0xec, 0xd3, 0xe0,
+	0xc4, 0xe9, 0x52, 0xd2, 0x81, 0x86, 0xfa, 0xad, 0x50, 0xb2, 0xba, 0xc1, 0x3f, 0xc4, 0xbc, 0x91,
+	0x14, 0x55, 0x60, 0xf1, 0x79, 0xeb, 0x46, 0x3a, 0xaf, 0x9c, 0xf9, 0x44, 0xa9, 0x79, 0xe9, 0xb7,
+	0xfe, 0xf0, 0x8f, 0x7e, 0x5a, 0x5a, 0x26, 0x8b, 0x9b, 0x27, 0x5b, 0x9b, 0xac, 0x77, 0xe1, 0x66,
+	0x07, 0x17, 0xd7, 0x0e, 0xcc, 0xc9, 0x6c, 0x17, 0xb9, 0x92, 0xa1, 0xa3, 0x7c, 0xc1, 0xa3, 0x75,
+	0xb5, 0xe0, 0xa9, 0xe0, 0xb0, 0xc6, 0x38, 0xac, 0x90, 0x65, 0x85, 0xc3, 0x67, 0xa8, 0xd3, 0xcf,
+	0xc9, 0x4f, 0x0c, 0xfe, 0xe1, 0xd4, 0xf4, 0xc7, 0x56, 0xc9, 0xdd, 0x5c, 0x92, 0x39, 0x9f, 0x71,
+	0x6d, 0xbd, 0x39, 0x05, 0xa6, 0x10, 0x64, 0x9d, 0x09, 0xd2, 0x22, 0x4d, 0x45, 0x10, 0x94, 0x63,
+	0xf3, 0x33, 0x6e, 0x64, 0x9f, 0x93, 0xcf, 0x92, 0xaf, 0x50, 0xc5, 0xa2, 0xdc, 0xca, 0x65, 0x90,
+	0x16, 0xe3, 0x1c, 0x1d, 0x98, 0x8c, 0xf5, 0x15, 0xd2, 0x52, 0x59, 0x33, 0x02, 0x2a, 0xf3, 0x05,
+	0xfd, 0x13, 0x3d, 0xc4, 0xcc, 0xef, 0x9b, 0xfa, 0xb5, 0x9f, 0xd6, 0xcd, 0x89, 0x38, 0x13, 0x7a,
+	0xce, 0x87, 0x60, 0xf3, 0x88, 0xb3, 0xfa, 0x9b, 0x86, 0xfa, 0x81, 0x20, 0x35, 0xb9, 0x49, 0xee,
+	0x15, 0x70, 0xc8, 0xc9, 0xa0, 0xb6, 0xee, 0x4f, 0x85, 0x2b, 0xa4, 0xba, 0xc3, 0xa4, 0x5a, 0x27,
+	0xd7, 0x14, 0xa9, 0xfc, 0x51, 0xe7, 0x98, 0x9e, 0x6d, 0x7e, 0x96, 0xcc, 0xe8, 0xcf, 0xc9, 0x21,
+	0x80, 0xa4, 0xf4, 0x72, 0x9b, 0x5c, 0x9b, 0x64, 0x8b, 0x2f, 0xb7, 0x5b, 0xd7, 0x27, 0x8e, 0xc4,
+	0xcb, 0x6d, 0xd5, 0xe2, 0xb7, 0x63, 0x65, 0x38, 0xbd, 0xcf, 0xc9, 0x29, 0x2c, 0xe9, 0xfa, 0x9b,
+	0x82, 0xdb, 0x54, 0xea, 0xbf, 0xc6, 0x38, 0x36, 0xc9, 0x6a, 0x8a, 0xa3, 0x54, 0xfe, 0x49, 0xf2,
+	0xbd, 0x1b, 0x79, 0x93, 0x72, 0x0a, 0xd6, 0xe7, 0x98, 0xdc, 0x0d, 0xc6, 0xf4, 0x32, 0x59, 0x4b,
+	0x33, 0x3d, 0xe1, 0x2c, 0x36, 0xb7, 0xc8, 0x6f, 0x40, 0x5d, 0xc9, 0xe7, 0x92, 0x8c, 0xe6, 0x52,
+	0xe9, 0xea, 0xd6, 0x7a, 0x31, 0x82, 0x60, 0x7a, 0x8f, 0x31, 0xbd, 0x45, 0x4c, 0x1c, 0x52, 0xe5,
+	0x2b, 0x33, 0xe1, 0xa6, 0xfc, 0x90, 0x45, 0x62, 0xef, 0x1d, 0xa8, 0xc5, 0x17, 0x71, 0x0b, 0x3d,
+	0xd8, 0xb5, 0xec, 0x85, 0x53, 0xf5, 0x52, 0xba, 0x79, 0x95, 0x31, 0xbc, 0x44, 0x2e, 0x66, 0x18,
+	0xfa, 0x48, 0xf6, 0x37, 0x94, 0x8b, 0xec, 0xf2, 0x72, 0x71, 0x21, 0xaf, 0x3b, 0xf9, 0xbc, 0xd2,
+	0x97, 0x92, 0xcd, 0x37, 0x18, 0xcf, 0x1b, 0xe4, 0x7a, 0x2e, 0xcf, 0x58, 0xbf, 0x0f, 0xf3, 0xb8,
+	0x6f, 0x7d, 0x41, 0xee, 0x5b, 0xaf, 0xca, 0x7d, 0x8b, 0xfc, 0x90, 0x3b, 0xd7, 0xcc, 0x8d, 0xd9,
+	0x42, 0x09, 0xb2, 0x47, 0xd2, 0x45, 0x97, 0x6d, 0x27, 0x8c, 0xb3, 0x38, 0x68, 0xe3, 0xc2, 0x38,
+	0xc8, 0xee, 0xf7, 0xb8, 0x6b, 0xc9, 0xbb, 0x7f, 0x7a, 0xaf, 0x80, 0x63, 0xce, 0x05, 0xd7, 0xd6,
+	0xfd, 0xa9, 0x70, 0x85, 0x7c, 0x5b, 0x4c, 0xbe, 0xfb, 0xe6, 0x9d, 0x42, 0xf9, 0xf8, 0x62, 0xbb,
+	0xc9, 0x6f, 0x92, 0x3e, 0x32, 0xee, 0x91, 0xdf, 0x64, 0x83, 0xa5, 0x7f, 0x30, 0x85, 0xdc, 0x4e,
+	0x33, 0xcd, 0xfd, 0xfe, 0x4a, 0xab, 0xf0, 0x0e, 0xac, 0x79, 0x97, 0x09, 0x62, 0x92, 0xf5, 0x8c,
+	0x20, 0x9f, 0xb1, 0x88, 0xef, 0xf3, 0xcd, 0x1e, 0xcb, 0xd4, 0x84, 0xe4, 0x2f, 0x19, 0x40, 0xb2,
+	0x9f, 0x6c, 0x21, 0x77, 0x52, 0xdf, 0x77, 0x2e, 0xf8, 0x04, 0x4c, 0xeb, 0x8d, 0x73, 0xf1, 0xf4,
+	0xb5, 0xc0, 0xcc, 0xce, 0x98, 0x90, 0xba, 0x4c, 0x13, 0x7f, 0xd1, 0x80, 0xe5, 0xcc, 0xa7, 0x5d,
+	0x52, 0xaa, 0x28, 0xfa, 0x52, 0x4c, 0xeb, 0xce, 0x79, 0x68, 0xba, 0x18, 0x8f, 0x8c, 0x7b, 0x39,
+	0x92, 0x44, 0x34, 0x8c, 0xc8, 0xf7, 0xd9, 0x80, 0xe8, 0x77, 0x9b, 0x0a, 0x6d, 0xf7, 0x7a, 0x41,
+	0x5d, 0x5e, 0xcc, 0x8f, 0x30, 0x7e, 0x0d, 0x02, 0xc8, 0x4c, 0x54, 0x91, 0x8f, 0x60, 0x39, 0x2e,
+	0x9a, 0x94, 0x7c, 0x52, 0xa1, 0xc7, 0x84, 0xdb, 0xc5, 0xe7, 0xf3, 0xbc, 0xc8, 0x78, 0x2e, 0x9a,
+	0x0a, 0x4f, 0xd4, 0xef, 0x09, 0xaf, 0x92, 0xd3, 0x3a, 0xc6, 0xeb, 0x07, 0x0b, 0xbb, 0x77, 0x7b,
+	0xaa, 0xb2, 0x43, 0xf3, 0x0a, 0x63, 0xb8, 0x4a, 0x2e, 0x24, 0x0c, 0x37, 0x93, 0x5a, 0xc0, 0xbf,
+	0x6a, 0xc0, 0xa5, 0x4c, 0x7f, 0x05, 0xe3, 0x8d, 0x57, 0x2b, 0x25, 0x9d, 0x56, 0xa0, 0xeb, 0x4c,
+	0xa0, 0x35, 0x33, 0x57, 0x20, 0xd4, 0x85, 0xcf, 0xd6, 0x5c, 0x4d, 0x17, 0xe4, 0x6a, 0x3e, 0x6d,
+	0xc9, 0xfa, 0x5a, 0xd1, 0xe3, 0xbc, 0x25, 0x41, 0xf0, 0xfc, 0x4c, 0x6e, 0x1e, 0x3e, 0x27, 0x1e,
+	0x10, 0xbc, 0x77, 0x38, 0xa5, 0x5d, 0xe9, 0xfd, 0x2c, 0xba, 0x7e, 0x6b, 0xb6, 0x18, 0xcf, 0x0b,
+	0xe6, 0xa2, 0xc2, 0xd3, 0x1f, 0x78, 0x91, 0x9c, 0x4e, 0x19, 0x8e, 0x44, 0x0f, 0xcd, 0xf3, 0xee,
+	0xdd, 0x4e, 0xcb, 0xfb, 0x36, 0xe3, 0x7d, 0xdd, 0x6c, 0xe5, 0xf6, 0x37, 0x16, 0xc3, 0x03, 0x82,
+	0x55, 0x25, 0x5f, 0x49, 0xbf, 0x71, 0x16, 0xab, 0x5d, 0xc7, 0xea, 0x0d, 0xf2, 0xe7, 0x0d, 0x58,
+	0xce, 0x70, 0x3c, 0x6f, 0x70, 0x5f, 0xad, 0xcf, 0xc8, 0xb7, 0xa0, 0xdb, 0x4c, 0x04, 0x0f, 0xc8,
+	0x7e, 0xe4, 0xf9, 0x5f, 0xfd, 0x58, 0x87, 0x91, 0xe7, 0xa3, 0x92, 0xb1, 0xcf, 0x19, 0x8e, 0xaf,
+	0xb7, 0xcf, 0x45, 0x1d, 0x96, 0x22, 0xfc, 0x65, 0x03, 0x56, 0xf8, 0x05, 0x61, 0x5d, 0x88, 0x9b,
+	0x93, 0xaf, 0x10, 0x73, 0x51, 0x6e, 0x4d, 0x73, 0xcf, 0x58, 0x86, 0x20, 0xe6, 0x95, 0x7c, 0x49,
+	0x4e, 0xd8, 0x6b, 0x28, 0xcb, 0xf7, 0xd8, 0x3e, 0x35, 0xbe, 0x08, 0x3c, 0xfd, 0x3e, 0x35, 0x73,
+	0x77, 0xd8, 0x5c, 0x66, 0x3c, 0xeb, 0xa4, 0x86, 0x3c, 0xd1, 0xa6, 0x43, 0xf2, 0x3b, 0x06, 0xac,
+	0xee, 0xd9, 0xa3, 0x90, 0x66, 0x67, 0xd7, 0xeb, 0xd1, 0xb8, 0xd8, 0xa0, 0x98, 0x97, 0x0b, 0x66,
+	0x16, 0xf2, 0xc6, 0x6e, 0xfe, 0xc8, 0x80, 0x4b, 0xb8, 0xde, 0x0f, 0xbf, 0x32, 0x49, 0x84, 0xc6,
+	0xd1, 0xde, 0x0b, 0x94, 0x1e, 0x30, 0xfe, 0xe4, 0x0c, 0x96, 0x33, 0x97, 0x8d, 0x53, 0x4b, 0x77,
+	0xd1, 0x65, 0xe4, 0x56, 0xc1, 0x2d, 0xda, 0x69, 0x26, 0x1b, 0xde, 0x26, 0xfe, 0x29, 0xd3, 0x42,
+	0xee, 0x95, 0x64, 0xa2, 0x07, 0x6f, 0x93, 0x2f, 0x2e, 0xb7, 0x32, 0x51, 0x61, 0xf1, 0x45, 0xe0,
+	0xdc, 0x05, 0x26, 0x90, 0xe4, 0x71, 0x6c, 0x5c, 0xb8, 0x98, 0x4b, 0xa1, 0xd0, 0x16, 0x5f, 0x85,
+	0xbb, 0x66, 0x94, 0x43, 0x46, 0xb6, 0x0f, 0x0d, 0xf5, 0xaa, 0x31, 0x59, 0x4f, 0xf9, 0xf9, 0xcc,
+	0x2d, 0xe4, 0xd6, 0x5a, 0xe1, 0x25, 0xcd, 0x82, 0x65, 0xc5, 0x76, 0xb1, 0x63, 0x3f, 0x31, 0x60,
+	0x39, 0x73, 0x97, 0x32, 0x35, 0xd4, 0x45, 0x77, 0x2d, 0xa7, 0x5d, 0xbe, 0x45, 0x98, 0x6f, 0x5e,
+	0x4f, 0xf1, 0xdf, 0xfc, 0x4c, 0xdc, 0xd4, 0xfc, 0x7c, 0xd3, 0x46, 0x16, 0x28, 0xcf, 0x6f, 0x1b,
+	0x70, 0x31, 0xf7, 0x2a, 0x0f, 0x79, 0x73, 0x9a, 0xeb, 0x3e, 0x79, 0x43, 0x3f, 0xf1, 0x66, 0x90,
+	0xb9, 0xc2, 0x84, 0x9b, 0x27, 0x75, 0x14, 0x2e, 0x10, 0xbc, 0x3e, 0x61, 0x41, 0xa3, 0x7e, 0xf1,
+	0x6f, 0xfa, 0x2d, 0x57, 0xfe, 0x85, 0x41, 0x19, 0xc7, 0x91, 0x79, 0xe4, 0x34, 0x88, 0xc9, 0x7e,
+	0xc2, 0xfe, 0x03, 0x8f, 0x5a, 0xac, 0x5d, 0xc8, 0xe9, 0xd6, 0x34, 0x25, 0xde, 0x7a, 0xa6, 0xac,
+	0xcb, 0x30, 0x36, 0x45, 0x41, 0x84, 0x0d, 0x90, 0x54, 0x7b, 0x4f, 0x19, 0x05, 0x67, 0xcb, 0xc3,
+	0x33, 0xeb, 0xb5, 0x60, 0xf2, 0xe9, 0xc8, 0x89, 0xc8, 0x19, 0xe6, 0x9f, 0xd4, 0x9a, 0xed, 0x4c,
+	0xfe, 0x29, 0xa7, 0x4c, 0xbc, 0x75, 0x73, 0x22, 0x8e, 0x9e, 0x00, 0x31, 0x57, 0x94, 0x4c, 0x4f,
+	0x5f, 0xa0, 0xa2, 0xed, 0x9c, 0x32, 0x4d, 0xaa, 0x15, 0xd0, 0xe4, 0xe6, 0xe4, 0xfa, 0xe8, 0xbc,
+	0xe5, 0xaa, 0xa0, 0x88, 0x5a, 0x57, 0x2b, 0xbf, 0x1b, 0xb2, 0xc9, 0xcf, 0x07, 0x49, 0x04, 0x0b,
+	0x7a, 0xb5, 0x6d, 0xa6, 0xcf, 0x39, 0xc5, 0xc7, 0xad, 0x9b, 0x13, 0x71, 0x26, 0x70, 0xe5, 0x5d,
+	0x27, 0x9f, 0xb2, 0x7c, 0x4f, 0xaa, 0xb0, 0xb3, 0x70, 0x50, 0xdf, 0xc8, 0x58, 0x69, 0x7e, 0x45,
+	0xa8, 0x1c, 0x5c, 0x42, 0x14, 0x8e, 0xbc, 0x0a, 0x37, 0xc4, 0xc0, 0x64, 0x29, 0x5d, 0xd8, 0x99,
+	0x4a, 0x6d, 0x16, 0xd4, 0x7d, 0x4e, 0xcf, 0x5f, 0x04, 0xde, 0x68, 0x5c, 0x79, 0x22, 0xfc, 0xcc,
+	0x80, 0x95, 0x9c, 0x7a, 0x4f, 0xf2, 0x46, 0x6a, 0x6d, 0x28, 0xaa, 0x08, 0x9d, 0x5e, 0x90, 0x0d,
+	0x26, 0xc8, 0x5d, 0xf3, 0x66, 0x56, 0x8a, 0xcd, 0xcf, 0xc4, 0x09, 0x22, 0xae, 0x98, 0xc8, 0x09,
+	0xcd, 0xef, 0xc7, 0x18, 0xb5, 0xa5, 0x2b, 0x4a, 0x53, 0xae, 0xb4, 0xa8, 0xe2, 0x74, 0x7a, 0xa9,
+	0xb4, 0x68, 0x22, 0x25, 0x95, 0xac, 0x51, 0x45, 0x69, 0xc6, 0xb0, 0xa0, 0x97, 0x8c, 0xa5, 0x6c,
+	0x32, 0xb7, 0xc8, 0xaf, 0x75, 0x73, 0x22, 0x4e, 0xc1, 0x08, 0x89, 0x22, 0x8c, 0x4d, 0x5e, 0xb0,
+	0x86, 0x1b, 0xc4, 0x95, 0x9c, 0x6a, 0xb5, 0xd4, 0x08, 0x15, 0x97, 0xde, 0xb5, 0xee, 0x9e, 0x8f,
+	0x58, 0x10, 0xd0, 0xe8, 0x92, 0x28, 0x9b, 0xd6, 0x31, 0x2c, 0xec, 0x0e, 0x27, 0x68, 0x63, 0x77,
+	0x78, 0xbe, 0x36, 0x76, 0x87, 0x93, 0xb4, 0xa1, 0xab, 0x82, 0x17, 0x5e, 0xe1, 0x38, 0xa0, 0x36,
+	0x76, 0x87, 0xe7, 0x69, 0x63, 0x77, 0x38, 0xa5, 0x36, 0x76, 0x87, 0xe7, 0x6a, 0xc3, 0xbc, 0x92,
+	0x95, 0x44, 0xdf, 0x2e, 0x7f, 0x8f, 0x65, 0x6c, 0x63, 0x55, 0x14, 0xb9, 0x8c, 0x4c, 0xa2, 0x36,
+	0xd3, 0x77, 0x6d, 0xf1, 0x14, 0x1c, 0xc9, 0x5f, 0x30, 0x60, 0x59, 0x41, 0xe6, 0x35, 0x44, 0xd9,
+	0x1c, 0x58, 0x6e, 0xf1, 0x52, 0xeb, 0xce, 0x79, 0x68, 0x93, 0xb4, 0xce, 0x93, 0x60, 0xd8, 0xc3,
+	0x11, 0x34, 0xd4, 0xc2, 0x9e, 0x54, 0xfc, 0x94, 0x53, 0x2a, 0xd4, 0xba, 0x31, 0x01, 0xa3, 0x20,
+	0xd9, 0x24, 0xd9, 0x8e, 0x18, 0x32, 0x5e, 0x03, 0xa4, 0x00, 0x49, 0x1d, 0xd0, 0x94, 0xeb, 0x6b,
+	0xb6, 0x70, 0x48, 0x5f, 0xe8, 0x24, 0x17, 0xc1, 0x03, 0x7b, 0xf7, 0x57, 0x0c, 0x58, 0xce, 0xd4,
+	0xf1, 0xa4, 0x34, 0x5c, 0x54, 0x49, 0xd4, 0xba, 0x73, 0x1e, 0x9a, 0x10, 0x42, 0xe4, 0x1c, 0xb1,
+	0xb7, 0x57, 0x55, 0x39, 0x64, 0x7d, 0xd1, 0x66, 0x17, 0x5f, 0xc5, 0x5e, 0xff, 0xc8, 0x80, 0xa5,
+	0x74, 0x09, 0x4f, 0x6a, 0x55, 0x28, 0x28, 0x1f, 0x6a, 0xdd, 0x3e, 0x07, 0x6b, 0x92, 0x65, 0x8b,
+	0x92, 0xa2, 0x58, 0x0e, 0x91, 0x25, 0x59, 0x4c, 0xd5, 0x2c, 0x64, 0x63, 0x80, 0x9c, 0xaa, 0xa1,
+	0xd6, 0xad, 0xc9, 0x48, 0x79, 0x67, 0x4d, 0xbc, 0x7a, 0x62, 0x93, 0x57, 0x1b, 0x6c, 0x8a, 0x02,
+	0x4a, 0x7e, 0x06, 0xf4, 0x33, 0x03, 0x56, 0xf3, 0x8b, 0x83, 0xb2, 0xc9, 0xea, 0xe2, 0xca, 0xa4,
+	0xd6, 0xfd, 0xa9, 0x70, 0x85, 0x6c, 0xb7, 0x98, 0x6c, 0xd7, 0x70, 0xbc, 0xd6, 0xb2, 0xe2, 0x1d,
+	0x09, 0xf6, 0x1d, 0x58, 0xc4, 0x6b, 0x29, 0xdd, 0xc0, 0xe9, 0xc8, 0xf8, 0xac, 0xc8, 0x4c, 0x2f,
+	0x65, 0xab, 0xe6, 0xd9, 0x89, 0x7b, 0x2a, 0x3f, 0x28, 0xa9, 0x89, 0xb0, 0xe4, 0xa1, 0x41, 0xba,
+	0x0a, 0x8f, 0x73, 0x0e, 0x66, 0xd2, 0x29, 0xef, 0xb8, 0x98, 0xa1, 0x88, 0x49, 0x34, 0xc6, 0x2c,
+	0xf0, 0x43, 0x83, 0x7c, 0x02, 0x2b, 0x31, 0x93, 0x64, 0xe7, 0x51, 0xc8, 0xe8, 0x72, 0xfe, 0x56,
+	0x65, 0x22, 0x2f, 0xbe, 0x55, 0x49, 0x75, 0x88, 0xef, 0x22, 0xa6, 0xec, 0x90, 0x52, 0xa7, 0x50,
+	0xc4, 0x84, 0x2f, 0xe1, 0x0f, 0x8d, 0x27, 0xbf, 0x55, 0xfa, 0x6b, 0x8f, 0xff, 0xd8, 0xb0, 0x2e,
+	0x43, 0xf9, 0x9d, 0x87, 0xef, 0x90, 0x0b, 0x00, 0x1f, 0x79, 0xd1, 0x3a, 0xbb, 0x4e, 0xb7, 0x41,
+	0x66, 0xa0, 0xf2, 0xf3, 0x92, 0x31, 0x4b, 0x2c, 0x98, 0xdf, 0x7f, 0x7e, 0xf0, 0x00, 0x03, 0xc5,
+	0x60, 0xfd, 0xf1, 0xde, 0xee, 0x76, 0x79, 0x6b, 0xe3, 0xa1, 0xf9, 0x08, 0xea, 0xfb, 0xcf, 0x0f,
+	0xd6, 0xfd, 0xc0, 0xc3, 0xba, 0x00, 0x72, 0xf1, 0x28, 0x8a, 0xfc, 0xf0, 0xd1, 0xe6, 0x66, 0x38,
+	0x3a, 0x3e, 0xb2, 0xf1, 0x5f, 0xaf, 0x6e, 0x38, 0xde, 0x66, 0xeb, 0x42, 0xd7, 0x73, 0x23, 0xbb,
+	0x1b, 0xfd, 0x59, 0x15, 0x7c, 0xef, 0x6b, 0xc1, 0x3b, 0x05, 0x2f, 0xc0, 0x65, 0x8d, 0xd5, 0xfa,
+	0x8e, 0xd7, 0x1d, 0x0d, 0xa9, 0xcb, 0xff, 0x75, 0xf3, 0x3d, 0xc3, 0xd8, 0x5e, 0xc2, 0x4d, 0x9b,
+	0xc3, 0xef, 0x8f, 0x6f, 0x7e, 0x12, 0x7a, 0xee, 0xf6, 0xaa, 0x0a, 0x19, 0x3f, 0x38, 0xf4, 0xbc,
+	0x07, 0x43, 0x67, 0x48, 0x1f, 0x65, 0x30, 0x1f, 0x15, 0x60, 0x76, 0x66, 0x98, 0xea, 0xde, 0xfe,
+	0x3f, 0x03, 0x00, 0xfd, 0x5f, 0x9f, 0x0d, 0x77, 0x7a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -6592,19 +9697,43 @@ type ApiServiceClient interface {
 	GetStakingRewardRecord(ctx context.Context, in *GetStakingRewardRecordRequest, opts ...grpc.CallOption) (*GetStakingRewardRecordResponse, error)
 	//get tx from chain db or mempool
 	GetRawTransaction(ctx context.Context, in *GetRawTransactionRequest, opts ...grpc.CallOption) (*TxRawResult, error)
+	// send raw tx to mempool and relay it to peers
+	SendRawTransaction(ctx context.Context, in *SendRawTransactionRequest, opts ...grpc.CallOption) (*SendRawTransactionResponse, error)
+	// check raw tx against mempool policy and consensus rules without changing the mempool
+	TestMempoolAccept(ctx context.Context, in *TestMempoolAcceptRequest, opts ...grpc.CallOption) (*TestMempoolAcceptResponse, error)
 	GetCapacitySpaces(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*WorkSpacesResponse, error)
 	ConfigureCapacity(ctx context.Context, in *ConfigureSpaceKeeperRequest, opts ...grpc.CallOption) (*WorkSpacesResponse, error)
 	GetCapacitySpacesByDirs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*WorkSpacesByDirsResponse, error)
 	ConfigureCapacityByDirs(ctx context.Context, in *ConfigureSpaceKeeperByDirsRequest, opts ...grpc.CallOption) (*WorkSpacesByDirsResponse, error)
 	GetCapacitySpace(ctx context.Context, in *WorkSpaceRequest, opts ...grpc.CallOption) (*WorkSpaceResponse, error)
 	PlotCapacitySpaces(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ActOnSpaceKeeperResponse, error)
-	PlotCapacitySpace(ctx context.Context, in *WorkSpaceRequest, opts ...grpc.CallOption) (*ActOnSpaceKeeperResponse, error)
+	PlotCapacitySpace(ctx context.Context, in *PlotWorkSpaceRequest, opts ...grpc.CallOption) (*ActOnSpaceKeeperResponse, error)
 	MineCapacitySpaces(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ActOnSpaceKeeperResponse, error)
 	MineCapacitySpace(ctx context.Context, in *WorkSpaceRequest, opts ...grpc.CallOption) (*ActOnSpaceKeeperResponse, error)
 	StopCapacitySpaces(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ActOnSpaceKeeperResponse, error)
 	StopCapacitySpace(ctx context.Context, in *WorkSpaceRequest, opts ...grpc.CallOption) (*ActOnSpaceKeeperResponse, error)
+	VerifyCapacitySpace(ctx context.Context, in *VerifyWorkSpaceRequest, opts ...grpc.CallOption) (*VerifyWorkSpaceResponse, error)
+	GetPlotQueue(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetPlotQueueResponse, error)
+	PausePlotCapacitySpace(ctx context.Context, in *WorkSpaceRequest, opts ...grpc.CallOption) (*ActOnSpaceKeeperResponse, error)
+	ResumePlotCapacitySpace(ctx context.Context, in *WorkSpaceRequest, opts ...grpc.CallOption) (*ActOnSpaceKeeperResponse, error)
+	MoveCapacitySpace(ctx context.Context, in *MoveCapacitySpaceRequest, opts ...grpc.CallOption) (*SpaceMove, error)
+	RebalanceCapacitySpaces(ctx context.Context, in *RebalanceCapacitySpacesRequest, opts ...grpc.CallOption) (*GetCapacitySpaceMovesResponse, error)
+	GetCapacitySpaceMoves(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetCapacitySpaceMovesResponse, error)
+	PlanCapacity(ctx context.Context, in *PlanCapacityRequest, opts ...grpc.CallOption) (*CapacityPlan, error)
+	ApplyCapacityPlan(ctx context.Context, in *ApplyCapacityPlanRequest, opts ...grpc.CallOption) (*WorkSpacesByDirsResponse, error)
+	EstimateMiningRevenue(ctx context.Context, in *EstimateMiningRevenueRequest, opts ...grpc.CallOption) (*EstimateMiningRevenueResponse, error)
+	GetProofLatencies(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetProofLatenciesResponse, error)
 	GetClientStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetClientStatusResponse, error)
 	QuitClient(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*QuitClientResponse, error)
+	// GenerateBlocks is only available on regtest network
+	GenerateBlocks(ctx context.Context, in *GenerateBlocksRequest, opts ...grpc.CallOption) (*GenerateBlocksResponse, error)
+	// GetShadowReport is only available when miner runs in shadow mode
+	GetShadowReport(ctx context.Context, in *GetShadowReportRequest, opts ...grpc.CallOption) (*GetShadowReportResponse, error)
+	GetMinedBlocks(ctx context.Context, in *GetMinedBlocksRequest, opts ...grpc.CallOption) (*GetMinedBlocksResponse, error)
+	GetPayoutAddresses(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetPayoutAddressesResponse, error)
+	AddPayoutAddress(ctx context.Context, in *AddPayoutAddressRequest, opts ...grpc.CallOption) (*GetPayoutAddressesResponse, error)
+	RemovePayoutAddress(ctx context.Context, in *RemovePayoutAddressRequest, opts ...grpc.CallOption) (*GetPayoutAddressesResponse, error)
+	SetPayoutStrategy(ctx context.Context, in *SetPayoutStrategyRequest, opts ...grpc.CallOption) (*GetPayoutAddressesResponse, error)
 	ExportKeystore(ctx context.Context, in *ExportKeystoreRequest, opts ...grpc.CallOption) (*ExportKeystoreResponse, error)
 	ExportKeystoreByDir(ctx context.Context, in *ExportKeystoreByDirRequest, opts ...grpc.CallOption) (*ExportKeystoreByDirResponse, error)
 	ImportKeystore(ctx context.Context, in *ImportKeystoreRequest, opts ...grpc.CallOption) (*ImportKeystoreResponse, error)
@@ -6617,6 +9746,12 @@ type ApiServiceClient interface {
 	ChangePublicPass(ctx context.Context, in *ChangePublicPassRequest, opts ...grpc.CallOption) (*ChangePublicPassResponse, error)
 	GetGovernConfig(ctx context.Context, in *GetGovernConfigRequest, opts ...grpc.CallOption) (*GetGovernConfigResponse, error)
 	GetGovernConfigHistory(ctx context.Context, in *GetGovernConfigHistoryRequest, opts ...grpc.CallOption) (*GetGovernConfigHistoryResponse, error)
+	// Subscribe* stream events as they happen, each subscriber has a bounded
+	// buffer and is closed with RESOURCE_EXHAUSTED once the buffer is full.
+	SubscribeBlocks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (ApiService_SubscribeBlocksClient, error)
+	SubscribeTxPool(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (ApiService_SubscribeTxPoolClient, error)
+	SubscribeWorkSpaces(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (ApiService_SubscribeWorkSpacesClient, error)
+	SubscribeMining(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (ApiService_SubscribeMiningClient, error)
 }
 
 type apiServiceClient struct {
@@ -6771,6 +9906,24 @@ func (c *apiServiceClient) GetRawTransaction(ctx context.Context, in *GetRawTran
 	return out, nil
 }
 
+func (c *apiServiceClient) SendRawTransaction(ctx context.Context, in *SendRawTransactionRequest, opts ...grpc.CallOption) (*SendRawTransactionResponse, error) {
+	out := new(SendRawTransactionResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/SendRawTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) TestMempoolAccept(ctx context.Context, in *TestMempoolAcceptRequest, opts ...grpc.CallOption) (*TestMempoolAcceptResponse, error) {
+	out := new(TestMempoolAcceptResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/TestMempoolAccept", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) GetCapacitySpaces(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*WorkSpacesResponse, error) {
 	out := new(WorkSpacesResponse)
 	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/GetCapacitySpaces", in, out, opts...)
@@ -6825,7 +9978,7 @@ func (c *apiServiceClient) PlotCapacitySpaces(ctx context.Context, in *emptypb.E
 	return out, nil
 }
 
-func (c *apiServiceClient) PlotCapacitySpace(ctx context.Context, in *WorkSpaceRequest, opts ...grpc.CallOption) (*ActOnSpaceKeeperResponse, error) {
+func (c *apiServiceClient) PlotCapacitySpace(ctx context.Context, in *PlotWorkSpaceRequest, opts ...grpc.CallOption) (*ActOnSpaceKeeperResponse, error) {
 	out := new(ActOnSpaceKeeperResponse)
 	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/PlotCapacitySpace", in, out, opts...)
 	if err != nil {
@@ -6870,6 +10023,105 @@ func (c *apiServiceClient) StopCapacitySpace(ctx context.Context, in *WorkSpaceR
 	return out, nil
 }
 
+func (c *apiServiceClient) VerifyCapacitySpace(ctx context.Context, in *VerifyWorkSpaceRequest, opts ...grpc.CallOption) (*VerifyWorkSpaceResponse, error) {
+	out := new(VerifyWorkSpaceResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/VerifyCapacitySpace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetPlotQueue(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetPlotQueueResponse, error) {
+	out := new(GetPlotQueueResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/GetPlotQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) PausePlotCapacitySpace(ctx context.Context, in *WorkSpaceRequest, opts ...grpc.CallOption) (*ActOnSpaceKeeperResponse, error) {
+	out := new(ActOnSpaceKeeperResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/PausePlotCapacitySpace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) ResumePlotCapacitySpace(ctx context.Context, in *WorkSpaceRequest, opts ...grpc.CallOption) (*ActOnSpaceKeeperResponse, error) {
+	out := new(ActOnSpaceKeeperResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/ResumePlotCapacitySpace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) MoveCapacitySpace(ctx context.Context, in *MoveCapacitySpaceRequest, opts ...grpc.CallOption) (*SpaceMove, error) {
+	out := new(SpaceMove)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/MoveCapacitySpace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) RebalanceCapacitySpaces(ctx context.Context, in *RebalanceCapacitySpacesRequest, opts ...grpc.CallOption) (*GetCapacitySpaceMovesResponse, error) {
+	out := new(GetCapacitySpaceMovesResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/RebalanceCapacitySpaces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetCapacitySpaceMoves(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetCapacitySpaceMovesResponse, error) {
+	out := new(GetCapacitySpaceMovesResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/GetCapacitySpaceMoves", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) PlanCapacity(ctx context.Context, in *PlanCapacityRequest, opts ...grpc.CallOption) (*CapacityPlan, error) {
+	out := new(CapacityPlan)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/PlanCapacity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) ApplyCapacityPlan(ctx context.Context, in *ApplyCapacityPlanRequest, opts ...grpc.CallOption) (*WorkSpacesByDirsResponse, error) {
+	out := new(WorkSpacesByDirsResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/ApplyCapacityPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) EstimateMiningRevenue(ctx context.Context, in *EstimateMiningRevenueRequest, opts ...grpc.CallOption) (*EstimateMiningRevenueResponse, error) {
+	out := new(EstimateMiningRevenueResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/EstimateMiningRevenue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetProofLatencies(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetProofLatenciesResponse, error) {
+	out := new(GetProofLatenciesResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/GetProofLatencies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) GetClientStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetClientStatusResponse, error) {
 	out := new(GetClientStatusResponse)
 	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/GetClientStatus", in, out, opts...)
@@ -6888,6 +10140,69 @@ func (c *apiServiceClient) QuitClient(ctx context.Context, in *emptypb.Empty, op
 	return out, nil
 }
 
+func (c *apiServiceClient) GenerateBlocks(ctx context.Context, in *GenerateBlocksRequest, opts ...grpc.CallOption) (*GenerateBlocksResponse, error) {
+	out := new(GenerateBlocksResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/GenerateBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetShadowReport(ctx context.Context, in *GetShadowReportRequest, opts ...grpc.CallOption) (*GetShadowReportResponse, error) {
+	out := new(GetShadowReportResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/GetShadowReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetMinedBlocks(ctx context.Context, in *GetMinedBlocksRequest, opts ...grpc.CallOption) (*GetMinedBlocksResponse, error) {
+	out := new(GetMinedBlocksResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/GetMinedBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetPayoutAddresses(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetPayoutAddressesResponse, error) {
+	out := new(GetPayoutAddressesResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/GetPayoutAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) AddPayoutAddress(ctx context.Context, in *AddPayoutAddressRequest, opts ...grpc.CallOption) (*GetPayoutAddressesResponse, error) {
+	out := new(GetPayoutAddressesResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/AddPayoutAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) RemovePayoutAddress(ctx context.Context, in *RemovePayoutAddressRequest, opts ...grpc.CallOption) (*GetPayoutAddressesResponse, error) {
+	out := new(GetPayoutAddressesResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/RemovePayoutAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) SetPayoutStrategy(ctx context.Context, in *SetPayoutStrategyRequest, opts ...grpc.CallOption) (*GetPayoutAddressesResponse, error) {
+	out := new(GetPayoutAddressesResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/SetPayoutStrategy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) ExportKeystore(ctx context.Context, in *ExportKeystoreRequest, opts ...grpc.CallOption) (*ExportKeystoreResponse, error) {
 	out := new(ExportKeystoreResponse)
 	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/ExportKeystore", in, out, opts...)
@@ -6921,79 +10236,207 @@ func (c *apiServiceClient) ImportKeystoreByDir(ctx context.Context, in *ImportKe
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *apiServiceClient) GetKeystore(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetKeystoreResponse, error) {
+	out := new(GetKeystoreResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/GetKeystore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetKeystoreDetail(ctx context.Context, in *GetKeystoreDetailRequest, opts ...grpc.CallOption) (*GetKeystoreDetailResponse, error) {
+	out := new(GetKeystoreDetailResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/GetKeystoreDetail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) UnlockWallet(ctx context.Context, in *UnlockWalletRequest, opts ...grpc.CallOption) (*UnlockWalletResponse, error) {
+	out := new(UnlockWalletResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/UnlockWallet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) LockWallet(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*LockWalletResponse, error) {
+	out := new(LockWalletResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/LockWallet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) ChangePrivatePass(ctx context.Context, in *ChangePrivatePassRequest, opts ...grpc.CallOption) (*ChangePrivatePassResponse, error) {
+	out := new(ChangePrivatePassResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/ChangePrivatePass", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) ChangePublicPass(ctx context.Context, in *ChangePublicPassRequest, opts ...grpc.CallOption) (*ChangePublicPassResponse, error) {
+	out := new(ChangePublicPassResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/ChangePublicPass", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetGovernConfig(ctx context.Context, in *GetGovernConfigRequest, opts ...grpc.CallOption) (*GetGovernConfigResponse, error) {
+	out := new(GetGovernConfigResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/GetGovernConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetGovernConfigHistory(ctx context.Context, in *GetGovernConfigHistoryRequest, opts ...grpc.CallOption) (*GetGovernConfigHistoryResponse, error) {
+	out := new(GetGovernConfigHistoryResponse)
+	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/GetGovernConfigHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) SubscribeBlocks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (ApiService_SubscribeBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ApiService_serviceDesc.Streams[0], "/rpcprotobuf.ApiService/SubscribeBlocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiServiceSubscribeBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *apiServiceClient) GetKeystore(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetKeystoreResponse, error) {
-	out := new(GetKeystoreResponse)
-	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/GetKeystore", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ApiService_SubscribeBlocksClient interface {
+	Recv() (*BlockEvent, error)
+	grpc.ClientStream
 }
 
-func (c *apiServiceClient) GetKeystoreDetail(ctx context.Context, in *GetKeystoreDetailRequest, opts ...grpc.CallOption) (*GetKeystoreDetailResponse, error) {
-	out := new(GetKeystoreDetailResponse)
-	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/GetKeystoreDetail", in, out, opts...)
-	if err != nil {
+type apiServiceSubscribeBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiServiceSubscribeBlocksClient) Recv() (*BlockEvent, error) {
+	m := new(BlockEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-func (c *apiServiceClient) UnlockWallet(ctx context.Context, in *UnlockWalletRequest, opts ...grpc.CallOption) (*UnlockWalletResponse, error) {
-	out := new(UnlockWalletResponse)
-	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/UnlockWallet", in, out, opts...)
+func (c *apiServiceClient) SubscribeTxPool(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (ApiService_SubscribeTxPoolClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ApiService_serviceDesc.Streams[1], "/rpcprotobuf.ApiService/SubscribeTxPool", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &apiServiceSubscribeTxPoolClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *apiServiceClient) LockWallet(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*LockWalletResponse, error) {
-	out := new(LockWalletResponse)
-	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/LockWallet", in, out, opts...)
-	if err != nil {
+type ApiService_SubscribeTxPoolClient interface {
+	Recv() (*TxPoolEvent, error)
+	grpc.ClientStream
+}
+
+type apiServiceSubscribeTxPoolClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiServiceSubscribeTxPoolClient) Recv() (*TxPoolEvent, error) {
+	m := new(TxPoolEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-func (c *apiServiceClient) ChangePrivatePass(ctx context.Context, in *ChangePrivatePassRequest, opts ...grpc.CallOption) (*ChangePrivatePassResponse, error) {
-	out := new(ChangePrivatePassResponse)
-	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/ChangePrivatePass", in, out, opts...)
+func (c *apiServiceClient) SubscribeWorkSpaces(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (ApiService_SubscribeWorkSpacesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ApiService_serviceDesc.Streams[2], "/rpcprotobuf.ApiService/SubscribeWorkSpaces", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &apiServiceSubscribeWorkSpacesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *apiServiceClient) ChangePublicPass(ctx context.Context, in *ChangePublicPassRequest, opts ...grpc.CallOption) (*ChangePublicPassResponse, error) {
-	out := new(ChangePublicPassResponse)
-	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/ChangePublicPass", in, out, opts...)
-	if err != nil {
+type ApiService_SubscribeWorkSpacesClient interface {
+	Recv() (*WorkSpaceEvent, error)
+	grpc.ClientStream
+}
+
+type apiServiceSubscribeWorkSpacesClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiServiceSubscribeWorkSpacesClient) Recv() (*WorkSpaceEvent, error) {
+	m := new(WorkSpaceEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-func (c *apiServiceClient) GetGovernConfig(ctx context.Context, in *GetGovernConfigRequest, opts ...grpc.CallOption) (*GetGovernConfigResponse, error) {
-	out := new(GetGovernConfigResponse)
-	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/GetGovernConfig", in, out, opts...)
+func (c *apiServiceClient) SubscribeMining(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (ApiService_SubscribeMiningClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ApiService_serviceDesc.Streams[3], "/rpcprotobuf.ApiService/SubscribeMining", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &apiServiceSubscribeMiningClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *apiServiceClient) GetGovernConfigHistory(ctx context.Context, in *GetGovernConfigHistoryRequest, opts ...grpc.CallOption) (*GetGovernConfigHistoryResponse, error) {
-	out := new(GetGovernConfigHistoryResponse)
-	err := c.cc.Invoke(ctx, "/rpcprotobuf.ApiService/GetGovernConfigHistory", in, out, opts...)
-	if err != nil {
+type ApiService_SubscribeMiningClient interface {
+	Recv() (*MiningEvent, error)
+	grpc.ClientStream
+}
+
+type apiServiceSubscribeMiningClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiServiceSubscribeMiningClient) Recv() (*MiningEvent, error) {
+	m := new(MiningEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
 // ApiServiceServer is the server API for ApiService service.
@@ -7015,19 +10458,43 @@ type ApiServiceServer interface {
 	GetStakingRewardRecord(context.Context, *GetStakingRewardRecordRequest) (*GetStakingRewardRecordResponse, error)
 	//get tx from chain db or mempool
 	GetRawTransaction(context.Context, *GetRawTransactionRequest) (*TxRawResult, error)
+	// send raw tx to mempool and relay it to peers
+	SendRawTransaction(context.Context, *SendRawTransactionRequest) (*SendRawTransactionResponse, error)
+	// check raw tx against mempool policy and consensus rules without changing the mempool
+	TestMempoolAccept(context.Context, *TestMempoolAcceptRequest) (*TestMempoolAcceptResponse, error)
 	GetCapacitySpaces(context.Context, *emptypb.Empty) (*WorkSpacesResponse, error)
 	ConfigureCapacity(context.Context, *ConfigureSpaceKeeperRequest) (*WorkSpacesResponse, error)
 	GetCapacitySpacesByDirs(context.Context, *emptypb.Empty) (*WorkSpacesByDirsResponse, error)
 	ConfigureCapacityByDirs(context.Context, *ConfigureSpaceKeeperByDirsRequest) (*WorkSpacesByDirsResponse, error)
 	GetCapacitySpace(context.Context, *WorkSpaceRequest) (*WorkSpaceResponse, error)
 	PlotCapacitySpaces(context.Context, *emptypb.Empty) (*ActOnSpaceKeeperResponse, error)
-	PlotCapacitySpace(context.Context, *WorkSpaceRequest) (*ActOnSpaceKeeperResponse, error)
+	PlotCapacitySpace(context.Context, *PlotWorkSpaceRequest) (*ActOnSpaceKeeperResponse, error)
 	MineCapacitySpaces(context.Context, *emptypb.Empty) (*ActOnSpaceKeeperResponse, error)
 	MineCapacitySpace(context.Context, *WorkSpaceRequest) (*ActOnSpaceKeeperResponse, error)
 	StopCapacitySpaces(context.Context, *emptypb.Empty) (*ActOnSpaceKeeperResponse, error)
 	StopCapacitySpace(context.Context, *WorkSpaceRequest) (*ActOnSpaceKeeperResponse, error)
+	VerifyCapacitySpace(context.Context, *VerifyWorkSpaceRequest) (*VerifyWorkSpaceResponse, error)
+	GetPlotQueue(context.Context, *emptypb.Empty) (*GetPlotQueueResponse, error)
+	PausePlotCapacitySpace(context.Context, *WorkSpaceRequest) (*ActOnSpaceKeeperResponse, error)
+	ResumePlotCapacitySpace(context.Context, *WorkSpaceRequest) (*ActOnSpaceKeeperResponse, error)
+	MoveCapacitySpace(context.Context, *MoveCapacitySpaceRequest) (*SpaceMove, error)
+	RebalanceCapacitySpaces(context.Context, *RebalanceCapacitySpacesRequest) (*GetCapacitySpaceMovesResponse, error)
+	GetCapacitySpaceMoves(context.Context, *emptypb.Empty) (*GetCapacitySpaceMovesResponse, error)
+	PlanCapacity(context.Context, *PlanCapacityRequest) (*CapacityPlan, error)
+	ApplyCapacityPlan(context.Context, *ApplyCapacityPlanRequest) (*WorkSpacesByDirsResponse, error)
+	EstimateMiningRevenue(context.Context, *EstimateMiningRevenueRequest) (*EstimateMiningRevenueResponse, error)
+	GetProofLatencies(context.Context, *emptypb.Empty) (*GetProofLatenciesResponse, error)
 	GetClientStatus(context.Context, *emptypb.Empty) (*GetClientStatusResponse, error)
 	QuitClient(context.Context, *emptypb.Empty) (*QuitClientResponse, error)
+	// GenerateBlocks is only available on regtest network
+	GenerateBlocks(context.Context, *GenerateBlocksRequest) (*GenerateBlocksResponse, error)
+	// GetShadowReport is only available when miner runs in shadow mode
+	GetShadowReport(context.Context, *GetShadowReportRequest) (*GetShadowReportResponse, error)
+	GetMinedBlocks(context.Context, *GetMinedBlocksRequest) (*GetMinedBlocksResponse, error)
+	GetPayoutAddresses(context.Context, *emptypb.Empty) (*GetPayoutAddressesResponse, error)
+	AddPayoutAddress(context.Context, *AddPayoutAddressRequest) (*GetPayoutAddressesResponse, error)
+	RemovePayoutAddress(context.Context, *RemovePayoutAddressRequest) (*GetPayoutAddressesResponse, error)
+	SetPayoutStrategy(context.Context, *SetPayoutStrategyRequest) (*GetPayoutAddressesResponse, error)
 	ExportKeystore(context.Context, *ExportKeystoreRequest) (*ExportKeystoreResponse, error)
 	ExportKeystoreByDir(context.Context, *ExportKeystoreByDirRequest) (*ExportKeystoreByDirResponse, error)
 	ImportKeystore(context.Context, *ImportKeystoreRequest) (*ImportKeystoreResponse, error)
@@ -7040,6 +10507,12 @@ type ApiServiceServer interface {
 	ChangePublicPass(context.Context, *ChangePublicPassRequest) (*ChangePublicPassResponse, error)
 	GetGovernConfig(context.Context, *GetGovernConfigRequest) (*GetGovernConfigResponse, error)
 	GetGovernConfigHistory(context.Context, *GetGovernConfigHistoryRequest) (*GetGovernConfigHistoryResponse, error)
+	// Subscribe* stream events as they happen, each subscriber has a bounded
+	// buffer and is closed with RESOURCE_EXHAUSTED once the buffer is full.
+	SubscribeBlocks(*emptypb.Empty, ApiService_SubscribeBlocksServer) error
+	SubscribeTxPool(*emptypb.Empty, ApiService_SubscribeTxPoolServer) error
+	SubscribeWorkSpaces(*emptypb.Empty, ApiService_SubscribeWorkSpacesServer) error
+	SubscribeMining(*emptypb.Empty, ApiService_SubscribeMiningServer) error
 }
 
 // UnimplementedApiServiceServer can be embedded to have forward compatible implementations.
@@ -7094,6 +10567,12 @@ func (*UnimplementedApiServiceServer) GetStakingRewardRecord(ctx context.Context
 func (*UnimplementedApiServiceServer) GetRawTransaction(ctx context.Context, req *GetRawTransactionRequest) (*TxRawResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRawTransaction not implemented")
 }
+func (*UnimplementedApiServiceServer) SendRawTransaction(ctx context.Context, req *SendRawTransactionRequest) (*SendRawTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendRawTransaction not implemented")
+}
+func (*UnimplementedApiServiceServer) TestMempoolAccept(ctx context.Context, req *TestMempoolAcceptRequest) (*TestMempoolAcceptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestMempoolAccept not implemented")
+}
 func (*UnimplementedApiServiceServer) GetCapacitySpaces(ctx context.Context, req *emptypb.Empty) (*WorkSpacesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCapacitySpaces not implemented")
 }
@@ -7112,7 +10591,7 @@ func (*UnimplementedApiServiceServer) GetCapacitySpace(ctx context.Context, req
 func (*UnimplementedApiServiceServer) PlotCapacitySpaces(ctx context.Context, req *emptypb.Empty) (*ActOnSpaceKeeperResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PlotCapacitySpaces not implemented")
 }
-func (*UnimplementedApiServiceServer) PlotCapacitySpace(ctx context.Context, req *WorkSpaceRequest) (*ActOnSpaceKeeperResponse, error) {
+func (*UnimplementedApiServiceServer) PlotCapacitySpace(ctx context.Context, req *PlotWorkSpaceRequest) (*ActOnSpaceKeeperResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PlotCapacitySpace not implemented")
 }
 func (*UnimplementedApiServiceServer) MineCapacitySpaces(ctx context.Context, req *emptypb.Empty) (*ActOnSpaceKeeperResponse, error) {
@@ -7127,571 +10606,997 @@ func (*UnimplementedApiServiceServer) StopCapacitySpaces(ctx context.Context, re
 func (*UnimplementedApiServiceServer) StopCapacitySpace(ctx context.Context, req *WorkSpaceRequest) (*ActOnSpaceKeeperResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StopCapacitySpace not implemented")
 }
+func (*UnimplementedApiServiceServer) VerifyCapacitySpace(ctx context.Context, req *VerifyWorkSpaceRequest) (*VerifyWorkSpaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyCapacitySpace not implemented")
+}
+func (*UnimplementedApiServiceServer) GetPlotQueue(ctx context.Context, req *emptypb.Empty) (*GetPlotQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlotQueue not implemented")
+}
+func (*UnimplementedApiServiceServer) PausePlotCapacitySpace(ctx context.Context, req *WorkSpaceRequest) (*ActOnSpaceKeeperResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PausePlotCapacitySpace not implemented")
+}
+func (*UnimplementedApiServiceServer) ResumePlotCapacitySpace(ctx context.Context, req *WorkSpaceRequest) (*ActOnSpaceKeeperResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumePlotCapacitySpace not implemented")
+}
+func (*UnimplementedApiServiceServer) MoveCapacitySpace(ctx context.Context, req *MoveCapacitySpaceRequest) (*SpaceMove, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveCapacitySpace not implemented")
+}
+func (*UnimplementedApiServiceServer) RebalanceCapacitySpaces(ctx context.Context, req *RebalanceCapacitySpacesRequest) (*GetCapacitySpaceMovesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebalanceCapacitySpaces not implemented")
+}
+func (*UnimplementedApiServiceServer) GetCapacitySpaceMoves(ctx context.Context, req *emptypb.Empty) (*GetCapacitySpaceMovesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapacitySpaceMoves not implemented")
+}
+func (*UnimplementedApiServiceServer) PlanCapacity(ctx context.Context, req *PlanCapacityRequest) (*CapacityPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanCapacity not implemented")
+}
+func (*UnimplementedApiServiceServer) ApplyCapacityPlan(ctx context.Context, req *ApplyCapacityPlanRequest) (*WorkSpacesByDirsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyCapacityPlan not implemented")
+}
+func (*UnimplementedApiServiceServer) EstimateMiningRevenue(ctx context.Context, req *EstimateMiningRevenueRequest) (*EstimateMiningRevenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateMiningRevenue not implemented")
+}
+func (*UnimplementedApiServiceServer) GetProofLatencies(ctx context.Context, req *emptypb.Empty) (*GetProofLatenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProofLatencies not implemented")
+}
 func (*UnimplementedApiServiceServer) GetClientStatus(ctx context.Context, req *emptypb.Empty) (*GetClientStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetClientStatus not implemented")
 }
 func (*UnimplementedApiServiceServer) QuitClient(ctx context.Context, req *emptypb.Empty) (*QuitClientResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QuitClient not implemented")
 }
+func (*UnimplementedApiServiceServer) GenerateBlocks(ctx context.Context, req *GenerateBlocksRequest) (*GenerateBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateBlocks not implemented")
+}
+func (*UnimplementedApiServiceServer) GetShadowReport(ctx context.Context, req *GetShadowReportRequest) (*GetShadowReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShadowReport not implemented")
+}
+func (*UnimplementedApiServiceServer) GetMinedBlocks(ctx context.Context, req *GetMinedBlocksRequest) (*GetMinedBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMinedBlocks not implemented")
+}
+func (*UnimplementedApiServiceServer) GetPayoutAddresses(ctx context.Context, req *emptypb.Empty) (*GetPayoutAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayoutAddresses not implemented")
+}
+func (*UnimplementedApiServiceServer) AddPayoutAddress(ctx context.Context, req *AddPayoutAddressRequest) (*GetPayoutAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPayoutAddress not implemented")
+}
+func (*UnimplementedApiServiceServer) RemovePayoutAddress(ctx context.Context, req *RemovePayoutAddressRequest) (*GetPayoutAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePayoutAddress not implemented")
+}
+func (*UnimplementedApiServiceServer) SetPayoutStrategy(ctx context.Context, req *SetPayoutStrategyRequest) (*GetPayoutAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPayoutStrategy not implemented")
+}
 func (*UnimplementedApiServiceServer) ExportKeystore(ctx context.Context, req *ExportKeystoreRequest) (*ExportKeystoreResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExportKeystore not implemented")
 }
-func (*UnimplementedApiServiceServer) ExportKeystoreByDir(ctx context.Context, req *ExportKeystoreByDirRequest) (*ExportKeystoreByDirResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExportKeystoreByDir not implemented")
+func (*UnimplementedApiServiceServer) ExportKeystoreByDir(ctx context.Context, req *ExportKeystoreByDirRequest) (*ExportKeystoreByDirResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportKeystoreByDir not implemented")
+}
+func (*UnimplementedApiServiceServer) ImportKeystore(ctx context.Context, req *ImportKeystoreRequest) (*ImportKeystoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportKeystore not implemented")
+}
+func (*UnimplementedApiServiceServer) ImportKeystoreByDir(ctx context.Context, req *ImportKeystoreByDirRequest) (*ImportKeystoreByDirResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportKeystoreByDir not implemented")
+}
+func (*UnimplementedApiServiceServer) GetKeystore(ctx context.Context, req *emptypb.Empty) (*GetKeystoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKeystore not implemented")
+}
+func (*UnimplementedApiServiceServer) GetKeystoreDetail(ctx context.Context, req *GetKeystoreDetailRequest) (*GetKeystoreDetailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKeystoreDetail not implemented")
+}
+func (*UnimplementedApiServiceServer) UnlockWallet(ctx context.Context, req *UnlockWalletRequest) (*UnlockWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockWallet not implemented")
+}
+func (*UnimplementedApiServiceServer) LockWallet(ctx context.Context, req *emptypb.Empty) (*LockWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockWallet not implemented")
+}
+func (*UnimplementedApiServiceServer) ChangePrivatePass(ctx context.Context, req *ChangePrivatePassRequest) (*ChangePrivatePassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePrivatePass not implemented")
+}
+func (*UnimplementedApiServiceServer) ChangePublicPass(ctx context.Context, req *ChangePublicPassRequest) (*ChangePublicPassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePublicPass not implemented")
+}
+func (*UnimplementedApiServiceServer) GetGovernConfig(ctx context.Context, req *GetGovernConfigRequest) (*GetGovernConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGovernConfig not implemented")
+}
+func (*UnimplementedApiServiceServer) GetGovernConfigHistory(ctx context.Context, req *GetGovernConfigHistoryRequest) (*GetGovernConfigHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGovernConfigHistory not implemented")
+}
+func (*UnimplementedApiServiceServer) SubscribeBlocks(req *emptypb.Empty, srv ApiService_SubscribeBlocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeBlocks not implemented")
+}
+func (*UnimplementedApiServiceServer) SubscribeTxPool(req *emptypb.Empty, srv ApiService_SubscribeTxPoolServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeTxPool not implemented")
+}
+func (*UnimplementedApiServiceServer) SubscribeWorkSpaces(req *emptypb.Empty, srv ApiService_SubscribeWorkSpacesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeWorkSpaces not implemented")
+}
+func (*UnimplementedApiServiceServer) SubscribeMining(req *emptypb.Empty, srv ApiService_SubscribeMiningServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeMining not implemented")
+}
+
+func RegisterApiServiceServer(s *grpc.Server, srv ApiServiceServer) {
+	s.RegisterService(&_ApiService_serviceDesc, srv)
+}
+
+func _ApiService_GetBestBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetBestBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetBestBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetBestBlock(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetBlock(ctx, req.(*GetBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetBlockHashByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockHashByHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetBlockHashByHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetBlockHashByHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetBlockHashByHeight(ctx, req.(*GetBlockHashByHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetBlockByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockByHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetBlockByHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetBlockByHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetBlockByHeight(ctx, req.(*GetBlockByHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetBlockHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockHeaderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetBlockHeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetBlockHeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetBlockHeader(ctx, req.(*GetBlockHeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetBlockHeightByPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockHeightByPubKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetBlockHeightByPubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetBlockHeightByPubKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetBlockHeightByPubKey(ctx, req.(*GetBlockHeightByPubKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetBlockV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockRequestV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetBlockV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetBlockV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetBlockV2(ctx, req.(*GetBlockRequestV2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetBlockHeaderV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockRequestV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetBlockHeaderV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetBlockHeaderV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetBlockHeaderV2(ctx, req.(*GetBlockRequestV2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetBlockVerbose1V2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockRequestV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetBlockVerbose1V2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetBlockVerbose1V2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetBlockVerbose1V2(ctx, req.(*GetBlockRequestV2))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedApiServiceServer) ImportKeystore(ctx context.Context, req *ImportKeystoreRequest) (*ImportKeystoreResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ImportKeystore not implemented")
+
+func _ApiService_GetCoinbase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCoinbaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetCoinbase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetCoinbase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetCoinbase(ctx, req.(*GetCoinbaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedApiServiceServer) ImportKeystoreByDir(ctx context.Context, req *ImportKeystoreByDirRequest) (*ImportKeystoreByDirResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ImportKeystoreByDir not implemented")
+
+func _ApiService_GetTxPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetTxPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetTxPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTxPool(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedApiServiceServer) GetKeystore(ctx context.Context, req *emptypb.Empty) (*GetKeystoreResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetKeystore not implemented")
+
+func _ApiService_GetTxPoolVerbose0_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetTxPoolVerbose0(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetTxPoolVerbose0",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTxPoolVerbose0(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedApiServiceServer) GetKeystoreDetail(ctx context.Context, req *GetKeystoreDetailRequest) (*GetKeystoreDetailResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetKeystoreDetail not implemented")
+
+func _ApiService_GetTxPoolVerbose1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetTxPoolVerbose1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetTxPoolVerbose1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTxPoolVerbose1(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedApiServiceServer) UnlockWallet(ctx context.Context, req *UnlockWalletRequest) (*UnlockWalletResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnlockWallet not implemented")
+
+func _ApiService_GetStakingTxPoolInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetStakingTxPoolInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetStakingTxPoolInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetStakingTxPoolInfo(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedApiServiceServer) LockWallet(ctx context.Context, req *emptypb.Empty) (*LockWalletResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LockWallet not implemented")
+
+func _ApiService_GetStakingRewardRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStakingRewardRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetStakingRewardRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetStakingRewardRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetStakingRewardRecord(ctx, req.(*GetStakingRewardRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedApiServiceServer) ChangePrivatePass(ctx context.Context, req *ChangePrivatePassRequest) (*ChangePrivatePassResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangePrivatePass not implemented")
+
+func _ApiService_GetRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRawTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetRawTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetRawTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetRawTransaction(ctx, req.(*GetRawTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedApiServiceServer) ChangePublicPass(ctx context.Context, req *ChangePublicPassRequest) (*ChangePublicPassResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangePublicPass not implemented")
+
+func _ApiService_SendRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendRawTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).SendRawTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/SendRawTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).SendRawTransaction(ctx, req.(*SendRawTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedApiServiceServer) GetGovernConfig(ctx context.Context, req *GetGovernConfigRequest) (*GetGovernConfigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetGovernConfig not implemented")
+
+func _ApiService_TestMempoolAccept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestMempoolAcceptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).TestMempoolAccept(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/TestMempoolAccept",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).TestMempoolAccept(ctx, req.(*TestMempoolAcceptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedApiServiceServer) GetGovernConfigHistory(ctx context.Context, req *GetGovernConfigHistoryRequest) (*GetGovernConfigHistoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetGovernConfigHistory not implemented")
+
+func _ApiService_GetCapacitySpaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetCapacitySpaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetCapacitySpaces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetCapacitySpaces(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterApiServiceServer(s *grpc.Server, srv ApiServiceServer) {
-	s.RegisterService(&_ApiService_serviceDesc, srv)
+func _ApiService_ConfigureCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigureSpaceKeeperRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).ConfigureCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/ConfigureCapacity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).ConfigureCapacity(ctx, req.(*ConfigureSpaceKeeperRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetBestBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _ApiService_GetCapacitySpacesByDirs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetBestBlock(ctx, in)
+		return srv.(ApiServiceServer).GetCapacitySpacesByDirs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetBestBlock",
+		FullMethod: "/rpcprotobuf.ApiService/GetCapacitySpacesByDirs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetBestBlock(ctx, req.(*emptypb.Empty))
+		return srv.(ApiServiceServer).GetCapacitySpacesByDirs(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBlockRequest)
+func _ApiService_ConfigureCapacityByDirs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigureSpaceKeeperByDirsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetBlock(ctx, in)
+		return srv.(ApiServiceServer).ConfigureCapacityByDirs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetBlock",
+		FullMethod: "/rpcprotobuf.ApiService/ConfigureCapacityByDirs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetBlock(ctx, req.(*GetBlockRequest))
+		return srv.(ApiServiceServer).ConfigureCapacityByDirs(ctx, req.(*ConfigureSpaceKeeperByDirsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetBlockHashByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBlockHashByHeightRequest)
+func _ApiService_GetCapacitySpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkSpaceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetBlockHashByHeight(ctx, in)
+		return srv.(ApiServiceServer).GetCapacitySpace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetBlockHashByHeight",
+		FullMethod: "/rpcprotobuf.ApiService/GetCapacitySpace",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetBlockHashByHeight(ctx, req.(*GetBlockHashByHeightRequest))
+		return srv.(ApiServiceServer).GetCapacitySpace(ctx, req.(*WorkSpaceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetBlockByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBlockByHeightRequest)
+func _ApiService_PlotCapacitySpaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetBlockByHeight(ctx, in)
+		return srv.(ApiServiceServer).PlotCapacitySpaces(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetBlockByHeight",
+		FullMethod: "/rpcprotobuf.ApiService/PlotCapacitySpaces",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetBlockByHeight(ctx, req.(*GetBlockByHeightRequest))
+		return srv.(ApiServiceServer).PlotCapacitySpaces(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetBlockHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBlockHeaderRequest)
+func _ApiService_PlotCapacitySpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlotWorkSpaceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetBlockHeader(ctx, in)
+		return srv.(ApiServiceServer).PlotCapacitySpace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetBlockHeader",
+		FullMethod: "/rpcprotobuf.ApiService/PlotCapacitySpace",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetBlockHeader(ctx, req.(*GetBlockHeaderRequest))
+		return srv.(ApiServiceServer).PlotCapacitySpace(ctx, req.(*PlotWorkSpaceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetBlockHeightByPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBlockHeightByPubKeyRequest)
+func _ApiService_MineCapacitySpaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetBlockHeightByPubKey(ctx, in)
+		return srv.(ApiServiceServer).MineCapacitySpaces(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetBlockHeightByPubKey",
+		FullMethod: "/rpcprotobuf.ApiService/MineCapacitySpaces",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetBlockHeightByPubKey(ctx, req.(*GetBlockHeightByPubKeyRequest))
+		return srv.(ApiServiceServer).MineCapacitySpaces(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetBlockV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBlockRequestV2)
+func _ApiService_MineCapacitySpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkSpaceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetBlockV2(ctx, in)
+		return srv.(ApiServiceServer).MineCapacitySpace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetBlockV2",
+		FullMethod: "/rpcprotobuf.ApiService/MineCapacitySpace",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetBlockV2(ctx, req.(*GetBlockRequestV2))
+		return srv.(ApiServiceServer).MineCapacitySpace(ctx, req.(*WorkSpaceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetBlockHeaderV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBlockRequestV2)
+func _ApiService_StopCapacitySpaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetBlockHeaderV2(ctx, in)
+		return srv.(ApiServiceServer).StopCapacitySpaces(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetBlockHeaderV2",
+		FullMethod: "/rpcprotobuf.ApiService/StopCapacitySpaces",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetBlockHeaderV2(ctx, req.(*GetBlockRequestV2))
+		return srv.(ApiServiceServer).StopCapacitySpaces(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetBlockVerbose1V2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBlockRequestV2)
+func _ApiService_StopCapacitySpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkSpaceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetBlockVerbose1V2(ctx, in)
+		return srv.(ApiServiceServer).StopCapacitySpace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetBlockVerbose1V2",
+		FullMethod: "/rpcprotobuf.ApiService/StopCapacitySpace",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetBlockVerbose1V2(ctx, req.(*GetBlockRequestV2))
+		return srv.(ApiServiceServer).StopCapacitySpace(ctx, req.(*WorkSpaceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetCoinbase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCoinbaseRequest)
+func _ApiService_VerifyCapacitySpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyWorkSpaceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetCoinbase(ctx, in)
+		return srv.(ApiServiceServer).VerifyCapacitySpace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetCoinbase",
+		FullMethod: "/rpcprotobuf.ApiService/VerifyCapacitySpace",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetCoinbase(ctx, req.(*GetCoinbaseRequest))
+		return srv.(ApiServiceServer).VerifyCapacitySpace(ctx, req.(*VerifyWorkSpaceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetTxPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _ApiService_GetPlotQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetTxPool(ctx, in)
+		return srv.(ApiServiceServer).GetPlotQueue(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetTxPool",
+		FullMethod: "/rpcprotobuf.ApiService/GetPlotQueue",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetTxPool(ctx, req.(*emptypb.Empty))
+		return srv.(ApiServiceServer).GetPlotQueue(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetTxPoolVerbose0_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _ApiService_PausePlotCapacitySpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkSpaceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetTxPoolVerbose0(ctx, in)
+		return srv.(ApiServiceServer).PausePlotCapacitySpace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetTxPoolVerbose0",
+		FullMethod: "/rpcprotobuf.ApiService/PausePlotCapacitySpace",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetTxPoolVerbose0(ctx, req.(*emptypb.Empty))
+		return srv.(ApiServiceServer).PausePlotCapacitySpace(ctx, req.(*WorkSpaceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetTxPoolVerbose1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _ApiService_ResumePlotCapacitySpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkSpaceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetTxPoolVerbose1(ctx, in)
+		return srv.(ApiServiceServer).ResumePlotCapacitySpace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetTxPoolVerbose1",
+		FullMethod: "/rpcprotobuf.ApiService/ResumePlotCapacitySpace",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetTxPoolVerbose1(ctx, req.(*emptypb.Empty))
+		return srv.(ApiServiceServer).ResumePlotCapacitySpace(ctx, req.(*WorkSpaceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetStakingTxPoolInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _ApiService_MoveCapacitySpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveCapacitySpaceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetStakingTxPoolInfo(ctx, in)
+		return srv.(ApiServiceServer).MoveCapacitySpace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetStakingTxPoolInfo",
+		FullMethod: "/rpcprotobuf.ApiService/MoveCapacitySpace",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetStakingTxPoolInfo(ctx, req.(*emptypb.Empty))
+		return srv.(ApiServiceServer).MoveCapacitySpace(ctx, req.(*MoveCapacitySpaceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetStakingRewardRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetStakingRewardRecordRequest)
+func _ApiService_RebalanceCapacitySpaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebalanceCapacitySpacesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetStakingRewardRecord(ctx, in)
+		return srv.(ApiServiceServer).RebalanceCapacitySpaces(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetStakingRewardRecord",
+		FullMethod: "/rpcprotobuf.ApiService/RebalanceCapacitySpaces",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetStakingRewardRecord(ctx, req.(*GetStakingRewardRecordRequest))
+		return srv.(ApiServiceServer).RebalanceCapacitySpaces(ctx, req.(*RebalanceCapacitySpacesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRawTransactionRequest)
+func _ApiService_GetCapacitySpaceMoves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetRawTransaction(ctx, in)
+		return srv.(ApiServiceServer).GetCapacitySpaceMoves(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetRawTransaction",
+		FullMethod: "/rpcprotobuf.ApiService/GetCapacitySpaceMoves",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetRawTransaction(ctx, req.(*GetRawTransactionRequest))
+		return srv.(ApiServiceServer).GetCapacitySpaceMoves(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetCapacitySpaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _ApiService_PlanCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanCapacityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetCapacitySpaces(ctx, in)
+		return srv.(ApiServiceServer).PlanCapacity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetCapacitySpaces",
+		FullMethod: "/rpcprotobuf.ApiService/PlanCapacity",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetCapacitySpaces(ctx, req.(*emptypb.Empty))
+		return srv.(ApiServiceServer).PlanCapacity(ctx, req.(*PlanCapacityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_ConfigureCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConfigureSpaceKeeperRequest)
+func _ApiService_ApplyCapacityPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyCapacityPlanRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).ConfigureCapacity(ctx, in)
+		return srv.(ApiServiceServer).ApplyCapacityPlan(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/ConfigureCapacity",
+		FullMethod: "/rpcprotobuf.ApiService/ApplyCapacityPlan",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).ConfigureCapacity(ctx, req.(*ConfigureSpaceKeeperRequest))
+		return srv.(ApiServiceServer).ApplyCapacityPlan(ctx, req.(*ApplyCapacityPlanRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetCapacitySpacesByDirs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _ApiService_EstimateMiningRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateMiningRevenueRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetCapacitySpacesByDirs(ctx, in)
+		return srv.(ApiServiceServer).EstimateMiningRevenue(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetCapacitySpacesByDirs",
+		FullMethod: "/rpcprotobuf.ApiService/EstimateMiningRevenue",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetCapacitySpacesByDirs(ctx, req.(*emptypb.Empty))
+		return srv.(ApiServiceServer).EstimateMiningRevenue(ctx, req.(*EstimateMiningRevenueRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_ConfigureCapacityByDirs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConfigureSpaceKeeperByDirsRequest)
+func _ApiService_GetProofLatencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).ConfigureCapacityByDirs(ctx, in)
+		return srv.(ApiServiceServer).GetProofLatencies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/ConfigureCapacityByDirs",
+		FullMethod: "/rpcprotobuf.ApiService/GetProofLatencies",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).ConfigureCapacityByDirs(ctx, req.(*ConfigureSpaceKeeperByDirsRequest))
+		return srv.(ApiServiceServer).GetProofLatencies(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetCapacitySpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WorkSpaceRequest)
+func _ApiService_GetClientStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetCapacitySpace(ctx, in)
+		return srv.(ApiServiceServer).GetClientStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetCapacitySpace",
+		FullMethod: "/rpcprotobuf.ApiService/GetClientStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetCapacitySpace(ctx, req.(*WorkSpaceRequest))
+		return srv.(ApiServiceServer).GetClientStatus(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_PlotCapacitySpaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _ApiService_QuitClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).PlotCapacitySpaces(ctx, in)
+		return srv.(ApiServiceServer).QuitClient(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/PlotCapacitySpaces",
+		FullMethod: "/rpcprotobuf.ApiService/QuitClient",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).PlotCapacitySpaces(ctx, req.(*emptypb.Empty))
+		return srv.(ApiServiceServer).QuitClient(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_PlotCapacitySpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WorkSpaceRequest)
+func _ApiService_GenerateBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateBlocksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).PlotCapacitySpace(ctx, in)
+		return srv.(ApiServiceServer).GenerateBlocks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/PlotCapacitySpace",
+		FullMethod: "/rpcprotobuf.ApiService/GenerateBlocks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).PlotCapacitySpace(ctx, req.(*WorkSpaceRequest))
+		return srv.(ApiServiceServer).GenerateBlocks(ctx, req.(*GenerateBlocksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_MineCapacitySpaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _ApiService_GetShadowReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShadowReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).MineCapacitySpaces(ctx, in)
+		return srv.(ApiServiceServer).GetShadowReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/MineCapacitySpaces",
+		FullMethod: "/rpcprotobuf.ApiService/GetShadowReport",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).MineCapacitySpaces(ctx, req.(*emptypb.Empty))
+		return srv.(ApiServiceServer).GetShadowReport(ctx, req.(*GetShadowReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_MineCapacitySpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WorkSpaceRequest)
+func _ApiService_GetMinedBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMinedBlocksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).MineCapacitySpace(ctx, in)
+		return srv.(ApiServiceServer).GetMinedBlocks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/MineCapacitySpace",
+		FullMethod: "/rpcprotobuf.ApiService/GetMinedBlocks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).MineCapacitySpace(ctx, req.(*WorkSpaceRequest))
+		return srv.(ApiServiceServer).GetMinedBlocks(ctx, req.(*GetMinedBlocksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_StopCapacitySpaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _ApiService_GetPayoutAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).StopCapacitySpaces(ctx, in)
+		return srv.(ApiServiceServer).GetPayoutAddresses(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/StopCapacitySpaces",
+		FullMethod: "/rpcprotobuf.ApiService/GetPayoutAddresses",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).StopCapacitySpaces(ctx, req.(*emptypb.Empty))
+		return srv.(ApiServiceServer).GetPayoutAddresses(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_StopCapacitySpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WorkSpaceRequest)
+func _ApiService_AddPayoutAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPayoutAddressRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).StopCapacitySpace(ctx, in)
+		return srv.(ApiServiceServer).AddPayoutAddress(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/StopCapacitySpace",
+		FullMethod: "/rpcprotobuf.ApiService/AddPayoutAddress",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).StopCapacitySpace(ctx, req.(*WorkSpaceRequest))
+		return srv.(ApiServiceServer).AddPayoutAddress(ctx, req.(*AddPayoutAddressRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetClientStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _ApiService_RemovePayoutAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePayoutAddressRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetClientStatus(ctx, in)
+		return srv.(ApiServiceServer).RemovePayoutAddress(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetClientStatus",
+		FullMethod: "/rpcprotobuf.ApiService/RemovePayoutAddress",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetClientStatus(ctx, req.(*emptypb.Empty))
+		return srv.(ApiServiceServer).RemovePayoutAddress(ctx, req.(*RemovePayoutAddressRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_QuitClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _ApiService_SetPayoutStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPayoutStrategyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).QuitClient(ctx, in)
+		return srv.(ApiServiceServer).SetPayoutStrategy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/QuitClient",
+		FullMethod: "/rpcprotobuf.ApiService/SetPayoutStrategy",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).QuitClient(ctx, req.(*emptypb.Empty))
+		return srv.(ApiServiceServer).SetPayoutStrategy(ctx, req.(*SetPayoutStrategyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -7912,6 +11817,90 @@ func _ApiService_GetGovernConfigHistory_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_SubscribeBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiServiceServer).SubscribeBlocks(m, &apiServiceSubscribeBlocksServer{stream})
+}
+
+type ApiService_SubscribeBlocksServer interface {
+	Send(*BlockEvent) error
+	grpc.ServerStream
+}
+
+type apiServiceSubscribeBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiServiceSubscribeBlocksServer) Send(m *BlockEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApiService_SubscribeTxPool_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiServiceServer).SubscribeTxPool(m, &apiServiceSubscribeTxPoolServer{stream})
+}
+
+type ApiService_SubscribeTxPoolServer interface {
+	Send(*TxPoolEvent) error
+	grpc.ServerStream
+}
+
+type apiServiceSubscribeTxPoolServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiServiceSubscribeTxPoolServer) Send(m *TxPoolEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApiService_SubscribeWorkSpaces_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiServiceServer).SubscribeWorkSpaces(m, &apiServiceSubscribeWorkSpacesServer{stream})
+}
+
+type ApiService_SubscribeWorkSpacesServer interface {
+	Send(*WorkSpaceEvent) error
+	grpc.ServerStream
+}
+
+type apiServiceSubscribeWorkSpacesServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiServiceSubscribeWorkSpacesServer) Send(m *WorkSpaceEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApiService_SubscribeMining_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiServiceServer).SubscribeMining(m, &apiServiceSubscribeMiningServer{stream})
+}
+
+type ApiService_SubscribeMiningServer interface {
+	Send(*MiningEvent) error
+	grpc.ServerStream
+}
+
+type apiServiceSubscribeMiningServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiServiceSubscribeMiningServer) Send(m *MiningEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _ApiService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "rpcprotobuf.ApiService",
 	HandlerType: (*ApiServiceServer)(nil),
@@ -7980,6 +11969,14 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetRawTransaction",
 			Handler:    _ApiService_GetRawTransaction_Handler,
 		},
+		{
+			MethodName: "SendRawTransaction",
+			Handler:    _ApiService_SendRawTransaction_Handler,
+		},
+		{
+			MethodName: "TestMempoolAccept",
+			Handler:    _ApiService_TestMempoolAccept_Handler,
+		},
 		{
 			MethodName: "GetCapacitySpaces",
 			Handler:    _ApiService_GetCapacitySpaces_Handler,
@@ -8024,6 +12021,50 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StopCapacitySpace",
 			Handler:    _ApiService_StopCapacitySpace_Handler,
 		},
+		{
+			MethodName: "VerifyCapacitySpace",
+			Handler:    _ApiService_VerifyCapacitySpace_Handler,
+		},
+		{
+			MethodName: "GetPlotQueue",
+			Handler:    _ApiService_GetPlotQueue_Handler,
+		},
+		{
+			MethodName: "PausePlotCapacitySpace",
+			Handler:    _ApiService_PausePlotCapacitySpace_Handler,
+		},
+		{
+			MethodName: "ResumePlotCapacitySpace",
+			Handler:    _ApiService_ResumePlotCapacitySpace_Handler,
+		},
+		{
+			MethodName: "MoveCapacitySpace",
+			Handler:    _ApiService_MoveCapacitySpace_Handler,
+		},
+		{
+			MethodName: "RebalanceCapacitySpaces",
+			Handler:    _ApiService_RebalanceCapacitySpaces_Handler,
+		},
+		{
+			MethodName: "GetCapacitySpaceMoves",
+			Handler:    _ApiService_GetCapacitySpaceMoves_Handler,
+		},
+		{
+			MethodName: "PlanCapacity",
+			Handler:    _ApiService_PlanCapacity_Handler,
+		},
+		{
+			MethodName: "ApplyCapacityPlan",
+			Handler:    _ApiService_ApplyCapacityPlan_Handler,
+		},
+		{
+			MethodName: "EstimateMiningRevenue",
+			Handler:    _ApiService_EstimateMiningRevenue_Handler,
+		},
+		{
+			MethodName: "GetProofLatencies",
+			Handler:    _ApiService_GetProofLatencies_Handler,
+		},
 		{
 			MethodName: "GetClientStatus",
 			Handler:    _ApiService_GetClientStatus_Handler,
@@ -8032,6 +12073,34 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QuitClient",
 			Handler:    _ApiService_QuitClient_Handler,
 		},
+		{
+			MethodName: "GenerateBlocks",
+			Handler:    _ApiService_GenerateBlocks_Handler,
+		},
+		{
+			MethodName: "GetShadowReport",
+			Handler:    _ApiService_GetShadowReport_Handler,
+		},
+		{
+			MethodName: "GetMinedBlocks",
+			Handler:    _ApiService_GetMinedBlocks_Handler,
+		},
+		{
+			MethodName: "GetPayoutAddresses",
+			Handler:    _ApiService_GetPayoutAddresses_Handler,
+		},
+		{
+			MethodName: "AddPayoutAddress",
+			Handler:    _ApiService_AddPayoutAddress_Handler,
+		},
+		{
+			MethodName: "RemovePayoutAddress",
+			Handler:    _ApiService_RemovePayoutAddress_Handler,
+		},
+		{
+			MethodName: "SetPayoutStrategy",
+			Handler:    _ApiService_SetPayoutStrategy_Handler,
+		},
 		{
 			MethodName: "ExportKeystore",
 			Handler:    _ApiService_ExportKeystore_Handler,
@@ -8081,6 +12150,27 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			Handler:    _ApiService_GetGovernConfigHistory_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeBlocks",
+			Handler:       _ApiService_SubscribeBlocks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeTxPool",
+			Handler:       _ApiService_SubscribeTxPool_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeWorkSpaces",
+			Handler:       _ApiService_SubscribeWorkSpaces_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeMining",
+			Handler:       _ApiService_SubscribeMining_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "api.proto",
 }
@@ -1,7 +1,7 @@
 package rpc
 
 import (
-	"fmt"
+	"crypto/tls"
 	"net"
 
 	"github.com/Sukhavati-Labs/go-miner/blockchain"
@@ -10,16 +10,18 @@ import (
 	"github.com/Sukhavati-Labs/go-miner/logging"
 	"github.com/Sukhavati-Labs/go-miner/mining"
 	"github.com/Sukhavati-Labs/go-miner/netsync"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine"
 	"github.com/Sukhavati-Labs/go-miner/poc/engine/pocminer"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/spacekeeper"
 	"github.com/Sukhavati-Labs/go-miner/poc/wallet"
 	pb "github.com/Sukhavati-Labs/go-miner/rpc/proto"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/reflection"
 )
 
 const (
-	maxMsgSize        = 1e7
-	GRPCListenAddress = "127.0.0.1"
+	maxMsgSize = 1e7
 )
 
 type Server struct {
@@ -33,15 +35,43 @@ type Server struct {
 	syncManager *netsync.SyncManager
 	pocWallet   *wallet.PoCWallet
 	quitClient  func()
+	tlsConfig   *tls.Config // nil if TLS is disabled
+	gwToken     string
+	events      *eventHub
+	ledger      *mining.Ledger
 }
 
 func NewServer(db database.DB, pocMiner pocminer.PoCMiner, spaceKeeper mining.SpaceKeeper, chain *blockchain.Blockchain,
-	txMemPool *blockchain.TxPool, sm *netsync.SyncManager, pocWallet *wallet.PoCWallet, quitClient func(), config *config.Config) (*Server, error) {
+	txMemPool *blockchain.TxPool, sm *netsync.SyncManager, pocWallet *wallet.PoCWallet, ledger *mining.Ledger, quitClient func(), config *config.Config) (*Server, error) {
 	// set the size for receive Msg
 	opts := []grpc.ServerOption{
 		grpc.MaxRecvMsgSize(maxMsgSize),
 		grpc.MaxSendMsgSize(maxMsgSize),
 	}
+	rpcConfig := config.Network.Rpc
+	var tlsConfig *tls.Config
+	var err error
+	if rpcConfig.ApiTls {
+		if tlsConfig, err = loadTLSConfig(rpcConfig); err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	gatewayToken, err := newGatewayToken()
+	if err != nil {
+		return nil, err
+	}
+	ac, err := newAccessController(rpcConfig, gatewayToken)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "invalid rpc access config", logging.LogFormat{"err": err})
+		return nil, err
+	}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{metricsInterceptor}
+	if ac.enabled() {
+		unaryInterceptors = append(unaryInterceptors, ac.unaryInterceptor)
+		opts = append(opts, grpc.StreamInterceptor(ac.streamInterceptor))
+	}
+	opts = append(opts, grpc.ChainUnaryInterceptor(unaryInterceptors...))
 	s := grpc.NewServer(opts...)
 	srv := &Server{
 		rpcServer:   s,
@@ -54,6 +84,17 @@ func NewServer(db database.DB, pocMiner pocminer.PoCMiner, spaceKeeper mining.Sp
 		syncManager: sm,
 		pocWallet:   pocWallet,
 		quitClient:  quitClient,
+		tlsConfig:   tlsConfig,
+		gwToken:     gatewayToken,
+		events:      newEventHub(),
+		ledger:      ledger,
+	}
+	chain.RegisterListener(srv.events)
+	if notifier, ok := pocMiner.(pocminer.Notifier); ok {
+		notifier.RegisterListener(srv.events)
+	}
+	if notifier, ok := spaceKeeper.(spacekeeper.Notifier); ok {
+		notifier.RegisterListener(srv.events)
 	}
 	pb.RegisterApiServiceServer(s, srv)
 	// Register reflection service on gRPC server.
@@ -64,25 +105,41 @@ func NewServer(db database.DB, pocMiner pocminer.PoCMiner, spaceKeeper mining.Sp
 }
 
 func (s *Server) Start() error {
-	address := fmt.Sprintf("%s%s%s", GRPCListenAddress, ":", s.config.Network.Rpc.ApiPortGrpc)
+	rpcConfig := s.config.Network.Rpc
+	if ip := net.ParseIP(rpcConfig.ApiHostGrpc); (ip == nil || !ip.IsLoopback()) && len(rpcConfig.ApiKeys) == 0 {
+		logging.CPrint(logging.WARN, "gRPC server is reachable from other hosts without api keys",
+			logging.LogFormat{"host": rpcConfig.ApiHostGrpc})
+	}
+	address := net.JoinHostPort(rpcConfig.ApiHostGrpc, rpcConfig.ApiPortGrpc)
 	listen, err := net.Listen("tcp", address)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "failed to start tcp listener", logging.LogFormat{"port": s.config.Network.Rpc.ApiPortGrpc, "error": err})
 		return err
 	}
 	go s.rpcServer.Serve(listen)
-	logging.CPrint(logging.INFO, "gRPC server start", logging.LogFormat{"port": s.config.Network.Rpc.ApiPortGrpc})
+	go s.events.sampleWorkSpaces(func() ([]engine.WorkSpaceInfo, error) {
+		return s.spaceKeeper.WorkSpaceInfos(engine.SFAll)
+	})
+	logging.CPrint(logging.INFO, "gRPC server start", logging.LogFormat{"address": address, "tls": s.tlsConfig != nil})
 	return nil
 }
 
 func (s *Server) Stop() {
+	s.chain.UnregisterListener(s.events)
+	if notifier, ok := s.pocMiner.(pocminer.Notifier); ok {
+		notifier.UnregisterListener(s.events)
+	}
+	if notifier, ok := s.spaceKeeper.(spacekeeper.Notifier); ok {
+		notifier.UnregisterListener(s.events)
+	}
+	s.events.stop()
 	s.rpcServer.Stop()
 	logging.CPrint(logging.INFO, "API server stopped")
 }
 
 func (s *Server) RunGateway() {
 	go func() {
-		if err := Run(s.config); err != nil {
+		if err := Run(s.config, s.tlsConfig, s.gwToken); err != nil {
 			logging.CPrint(logging.ERROR, "failed to start gateway", logging.LogFormat{"port": s.config.Network.Rpc.ApiPortHttp, "error": err})
 		}
 	}()
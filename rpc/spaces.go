@@ -8,12 +8,17 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/Sukhavati-Labs/go-miner/blockchain"
 	"github.com/Sukhavati-Labs/go-miner/chainutil"
 	"github.com/Sukhavati-Labs/go-miner/config"
+	"github.com/Sukhavati-Labs/go-miner/consensus/difficulty"
 	"github.com/Sukhavati-Labs/go-miner/logging"
+	"github.com/Sukhavati-Labs/go-miner/mining"
 	"github.com/Sukhavati-Labs/go-miner/poc"
 	"github.com/Sukhavati-Labs/go-miner/poc/engine"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/spacekeeper/capacity"
 	"github.com/Sukhavati-Labs/go-miner/poc/wallet/keystore"
 	"github.com/Sukhavati-Labs/go-miner/pocec"
 	pb "github.com/Sukhavati-Labs/go-miner/rpc/proto"
@@ -22,6 +27,12 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+const (
+	defaultRevenueBlocks    = 1000
+	maxRevenueBlocks        = 10000
+	defaultRevenueBitLength = 32
+)
+
 func (s *Server) ConfigureCapacity(ctx context.Context, in *pb.ConfigureSpaceKeeperRequest) (*pb.WorkSpacesResponse, error) {
 	logging.CPrint(logging.INFO, "ConfigureCapacity called", logging.LogFormat{"capacity": in.Capacity, "payout_addresses": in.PayoutAddresses})
 	defer logging.CPrint(logging.INFO, "ConfigureCapacity responded")
@@ -31,30 +42,14 @@ func (s *Server) ConfigureCapacity(ctx context.Context, in *pb.ConfigureSpaceKee
 		return nil, err
 	}
 
-	if len(in.PayoutAddresses) == 0 {
-		logging.CPrint(logging.ERROR, "payout_addresses is empty")
-		return nil, status.New(ErrAPIMinerNoAddress, ErrCode[ErrAPIMinerNoAddress]).Err()
-	}
-	for _, addr := range in.PayoutAddresses {
-		err := checkAddressLen(addr)
-		if err != nil {
-			return nil, err
-		}
+	payoutAddresses, err := parsePayoutAddresses(in.PayoutAddresses)
+	if err != nil {
+		return nil, err
 	}
 	cointype := in.Cointype
 	if cointype == 0 {
 		cointype = config.ChainParams.HDCoinType
 	}
-	payoutAddresses, err := chainutil.NewAddressesFromStringList(in.PayoutAddresses, &config.ChainParams)
-	if err != nil {
-		logging.CPrint(logging.ERROR, "fail to decode coinbase_address", logging.LogFormat{"addr_list": in.PayoutAddresses})
-		return nil, status.New(ErrAPIMinerInvalidAddress, ErrCode[ErrAPIMinerInvalidAddress]).Err()
-	}
-	if len(payoutAddresses) > config.MaxMiningPayoutAddresses {
-		logging.CPrint(logging.ERROR, "coinbase_address is more than allowed",
-			logging.LogFormat{"allowed": config.MaxMiningPayoutAddresses, "count": len(payoutAddresses)})
-		return nil, status.New(ErrAPIMinerInvalidAddress, ErrCode[ErrAPIMinerInvalidAddress]).Err()
-	}
 
 	var diskSize = in.Capacity * poc.MiB
 	if err = checkMinerDiskSize(s.spaceKeeper, in.Capacity); err != nil {
@@ -118,30 +113,14 @@ func (s *Server) ConfigureCapacityByDirs(ctx context.Context, in *pb.ConfigureSp
 	if in.AutoCreate < 0 {
 		autoCreate = false
 	}
-	if len(in.PayoutAddresses) == 0 {
-		logging.CPrint(logging.ERROR, "payout_addresses is empty")
-		return nil, status.New(ErrAPIMinerNoAddress, ErrCode[ErrAPIMinerNoAddress]).Err()
-	}
-	for _, addr := range in.PayoutAddresses {
-		err := checkAddressLen(addr)
-		if err != nil {
-			return nil, err
-		}
+	payoutAddresses, err := parsePayoutAddresses(in.PayoutAddresses)
+	if err != nil {
+		return nil, err
 	}
 	cointype := in.Cointype
 	if cointype == 0 {
 		cointype = config.ChainParams.HDCoinType
 	}
-	payoutAddresses, err := chainutil.NewAddressesFromStringList(in.PayoutAddresses, &config.ChainParams)
-	if err != nil {
-		logging.CPrint(logging.ERROR, "fail to decode coinbase_address", logging.LogFormat{"addr_list": in.PayoutAddresses})
-		return nil, status.New(ErrAPIMinerInvalidAddress, ErrCode[ErrAPIMinerInvalidAddress]).Err()
-	}
-	if len(payoutAddresses) > config.MaxMiningPayoutAddresses {
-		logging.CPrint(logging.ERROR, "coinbase_address is more than allowed",
-			logging.LogFormat{"allowed": config.MaxMiningPayoutAddresses, "count": len(payoutAddresses)})
-		return nil, status.New(ErrAPIMinerInvalidAddress, ErrCode[ErrAPIMinerInvalidAddress]).Err()
-	}
 
 	dirs, capacities := make([]string, len(in.Allocations)), make([]uint64, len(in.Allocations))
 	for i, alloc := range in.Allocations {
@@ -270,7 +249,7 @@ func (s *Server) PlotCapacitySpaces(ctx context.Context, in *empty.Empty) (*pb.A
 	return resp, nil
 }
 
-func (s *Server) PlotCapacitySpace(ctx context.Context, in *pb.WorkSpaceRequest) (*pb.ActOnSpaceKeeperResponse, error) {
+func (s *Server) PlotCapacitySpace(ctx context.Context, in *pb.PlotWorkSpaceRequest) (*pb.ActOnSpaceKeeperResponse, error) {
 	logging.CPrint(logging.INFO, "Received a request for PlotCapacitySpace", logging.LogFormat{"in": in.String()})
 	err := checkSpaceIDLen(in.SpaceId)
 	if err != nil {
@@ -285,7 +264,11 @@ func (s *Server) PlotCapacitySpace(ctx context.Context, in *pb.WorkSpaceRequest)
 		s.spaceKeeper.Start()
 	}
 
-	_, err = s.actOnWorkSpace(in.SpaceId, engine.Plot)
+	if in.Threads == 0 {
+		_, err = s.actOnWorkSpace(in.SpaceId, engine.Plot)
+	} else {
+		_, err = s.plotWorkSpace(in.SpaceId, int(in.Threads))
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -379,6 +362,583 @@ func (s *Server) StopCapacitySpace(ctx context.Context, in *pb.WorkSpaceRequest)
 	return resp, nil
 }
 
+func (s *Server) VerifyCapacitySpace(ctx context.Context, in *pb.VerifyWorkSpaceRequest) (*pb.VerifyWorkSpaceResponse, error) {
+	logging.CPrint(logging.INFO, "Received a request for VerifyCapacitySpace", logging.LogFormat{"in": in.String()})
+	err := checkSpaceIDLen(in.SpaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.spaceKeeper.Configured() {
+		logging.CPrint(logging.ERROR, "spaceKeeper is not configured")
+		return nil, status.New(ErrAPIMinerNoConfig, ErrCode[ErrAPIMinerNoConfig]).Err()
+	}
+
+	wsi, err := s.getWorkSpaceInfo(in.SpaceId)
+	if err != nil {
+		return nil, err
+	}
+	if wsi.State != engine.Ready && wsi.State != engine.Mining {
+		logging.CPrint(logging.ERROR, "cannot verify space before plotted", logging.LogFormat{"sid": wsi.SpaceID, "state": wsi.State})
+		return nil, status.New(ErrAPIMinerSpaceNotReady, ErrCode[ErrAPIMinerSpaceNotReady]).Err()
+	}
+
+	samples := int(in.Samples)
+	if samples == 0 {
+		samples = defaultVerifySamples
+	}
+	if samples > maxVerifySamples {
+		logging.CPrint(logging.ERROR, "too many samples to verify space", logging.LogFormat{"samples": samples, "max": maxVerifySamples})
+		return nil, status.New(ErrAPIInvalidParameter, ErrCode[ErrAPIInvalidParameter]).Err()
+	}
+	report, err := s.spaceKeeper.VerifyWorkSpace(wsi.SpaceID, samples)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to verify WorkSpace", logging.LogFormat{"err": err, "sid": wsi.SpaceID})
+		return nil, status.New(ErrAPIMinerInternal, err.Error()).Err()
+	}
+
+	ranges := make([]*pb.VerifyWorkSpaceResponse_CorruptRange, len(report.Ranges))
+	for i, r := range report.Ranges {
+		ranges[i] = &pb.VerifyWorkSpaceResponse_CorruptRange{Offset: r.Offset, Length: r.Length}
+	}
+	resp := &pb.VerifyWorkSpaceResponse{
+		SpaceId:   wsi.SpaceID,
+		FilePath:  report.FilePath,
+		BitLength: uint32(report.BitLength),
+		Sampled:   uint64(report.Sampled),
+		Valid:     uint64(report.Valid),
+		Empty:     uint64(report.Empty),
+		Corrupted: uint64(report.Corrupted),
+		Health:    report.Health(),
+		Ranges:    ranges,
+	}
+	logging.CPrint(logging.INFO, "VerifyCapacitySpace completed", logging.LogFormat{"sid": wsi.SpaceID, "health": resp.Health})
+	return resp, nil
+}
+
+func (s *Server) GetPlotQueue(ctx context.Context, in *empty.Empty) (*pb.GetPlotQueueResponse, error) {
+	logging.CPrint(logging.INFO, "Received a request for GetPlotQueue")
+
+	if !s.spaceKeeper.Configured() {
+		logging.CPrint(logging.ERROR, "spaceKeeper is not configured")
+		return nil, status.New(ErrAPIMinerNoConfig, ErrCode[ErrAPIMinerNoConfig]).Err()
+	}
+	plots, err := s.spaceKeeper.PlotQueue()
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to get plot queue", logging.LogFormat{"err": err})
+		return nil, status.New(ErrAPIMinerInternal, err.Error()).Err()
+	}
+
+	resp := &pb.GetPlotQueueResponse{Plots: make([]*pb.GetPlotQueueResponse_Plot, len(plots))}
+	for i, plot := range plots {
+		eta := int64(-1)
+		if plot.ETA >= 0 {
+			eta = int64(plot.ETA / time.Second)
+		}
+		resp.Plots[i] = &pb.GetPlotQueueResponse_Plot{
+			SpaceId:    plot.SpaceID,
+			State:      plot.State,
+			Position:   uint32(plot.Position),
+			Progress:   plot.Progress,
+			Threads:    uint32(plot.Threads),
+			Memory:     plot.Memory,
+			Disk:       plot.Disk,
+			Throughput: plot.Throughput,
+			Eta:        eta,
+		}
+	}
+	logging.CPrint(logging.INFO, "GetPlotQueue completed", logging.LogFormat{"plots": len(resp.Plots)})
+	return resp, nil
+}
+
+func (s *Server) PausePlotCapacitySpace(ctx context.Context, in *pb.WorkSpaceRequest) (*pb.ActOnSpaceKeeperResponse, error) {
+	logging.CPrint(logging.INFO, "Received a request for PausePlotCapacitySpace", logging.LogFormat{"in": in.String()})
+	if err := s.actOnPlotQueue(in.SpaceId, s.spaceKeeper.PausePlotWorkSpace); err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ActOnSpaceKeeperResponse{}
+	logging.CPrint(logging.INFO, "PausePlotCapacitySpace completed", logging.LogFormat{"resp": resp})
+	return resp, nil
+}
+
+func (s *Server) ResumePlotCapacitySpace(ctx context.Context, in *pb.WorkSpaceRequest) (*pb.ActOnSpaceKeeperResponse, error) {
+	logging.CPrint(logging.INFO, "Received a request for ResumePlotCapacitySpace", logging.LogFormat{"in": in.String()})
+	if err := s.actOnPlotQueue(in.SpaceId, s.spaceKeeper.ResumePlotWorkSpace); err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ActOnSpaceKeeperResponse{}
+	logging.CPrint(logging.INFO, "ResumePlotCapacitySpace completed", logging.LogFormat{"resp": resp})
+	return resp, nil
+}
+
+func (s *Server) actOnPlotQueue(sid string, act func(sid string) error) error {
+	if err := checkSpaceIDLen(sid); err != nil {
+		return err
+	}
+	if !s.spaceKeeper.Configured() {
+		logging.CPrint(logging.ERROR, "spaceKeeper is not configured")
+		return status.New(ErrAPIMinerNoConfig, ErrCode[ErrAPIMinerNoConfig]).Err()
+	}
+	wsi, err := s.getWorkSpaceInfo(sid)
+	if err != nil {
+		return err
+	}
+	switch err = act(wsi.SpaceID); err {
+	case nil:
+		return nil
+	case capacity.ErrWorkSpaceIsNotQueued:
+		return status.New(ErrAPIMinerSpaceNotQueued, ErrCode[ErrAPIMinerSpaceNotQueued]).Err()
+	case capacity.ErrWorkSpaceIsNotPaused:
+		return status.New(ErrAPIMinerSpaceNotPaused, ErrCode[ErrAPIMinerSpaceNotPaused]).Err()
+	default:
+		logging.CPrint(logging.ERROR, "fail to act on plot queue", logging.LogFormat{"err": err, "sid": wsi.SpaceID})
+		return status.New(ErrAPIMinerInternal, err.Error()).Err()
+	}
+}
+
+func (s *Server) MoveCapacitySpace(ctx context.Context, in *pb.MoveCapacitySpaceRequest) (*pb.SpaceMove, error) {
+	logging.CPrint(logging.INFO, "Received a request for MoveCapacitySpace", logging.LogFormat{"in": in.String()})
+	if err := checkSpaceIDLen(in.SpaceId); err != nil {
+		return nil, err
+	}
+	if !s.spaceKeeper.Configured() {
+		logging.CPrint(logging.ERROR, "spaceKeeper is not configured")
+		return nil, status.New(ErrAPIMinerNoConfig, ErrCode[ErrAPIMinerNoConfig]).Err()
+	}
+	wsi, err := s.getWorkSpaceInfo(in.SpaceId)
+	if err != nil {
+		return nil, err
+	}
+	move, err := s.spaceKeeper.MoveWorkSpace(wsi.SpaceID, in.TargetDir)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to move space", logging.LogFormat{"err": err, "sid": wsi.SpaceID, "target_dir": in.TargetDir})
+		return nil, spaceMoveError(err)
+	}
+
+	resp := spaceMove2Proto(move)
+	logging.CPrint(logging.INFO, "MoveCapacitySpace completed", logging.LogFormat{"resp": resp})
+	return resp, nil
+}
+
+func (s *Server) RebalanceCapacitySpaces(ctx context.Context, in *pb.RebalanceCapacitySpacesRequest) (*pb.GetCapacitySpaceMovesResponse, error) {
+	logging.CPrint(logging.INFO, "Received a request for RebalanceCapacitySpaces", logging.LogFormat{"in": in.String()})
+	if !s.spaceKeeper.Configured() {
+		logging.CPrint(logging.ERROR, "spaceKeeper is not configured")
+		return nil, status.New(ErrAPIMinerNoConfig, ErrCode[ErrAPIMinerNoConfig]).Err()
+	}
+	moves, err := s.spaceKeeper.RebalanceWorkSpaces(in.Dirs)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to rebalance spaces", logging.LogFormat{"err": err, "dirs": in.Dirs})
+		return nil, spaceMoveError(err)
+	}
+
+	resp := &pb.GetCapacitySpaceMovesResponse{Moves: make([]*pb.SpaceMove, len(moves))}
+	for i, move := range moves {
+		resp.Moves[i] = spaceMove2Proto(move)
+	}
+	logging.CPrint(logging.INFO, "RebalanceCapacitySpaces completed", logging.LogFormat{"moves": len(resp.Moves)})
+	return resp, nil
+}
+
+func (s *Server) GetCapacitySpaceMoves(ctx context.Context, in *empty.Empty) (*pb.GetCapacitySpaceMovesResponse, error) {
+	logging.CPrint(logging.INFO, "Received a request for GetCapacitySpaceMoves")
+	moves, err := s.spaceKeeper.WorkSpaceMoves()
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to get space moves", logging.LogFormat{"err": err})
+		return nil, status.New(ErrAPIMinerInternal, err.Error()).Err()
+	}
+
+	resp := &pb.GetCapacitySpaceMovesResponse{Moves: make([]*pb.SpaceMove, len(moves))}
+	for i, move := range moves {
+		resp.Moves[i] = spaceMove2Proto(move)
+	}
+	logging.CPrint(logging.INFO, "GetCapacitySpaceMoves completed", logging.LogFormat{"moves": len(resp.Moves)})
+	return resp, nil
+}
+
+func (s *Server) GetProofLatencies(ctx context.Context, in *empty.Empty) (*pb.GetProofLatenciesResponse, error) {
+	logging.CPrint(logging.INFO, "Received a request for GetProofLatencies")
+	spaces, dirs, budget, err := s.spaceKeeper.ProofLatencies()
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to get proof latencies", logging.LogFormat{"err": err})
+		return nil, status.New(ErrAPIMinerInternal, err.Error()).Err()
+	}
+
+	resp := &pb.GetProofLatenciesResponse{
+		Budget:      durationMillis(budget),
+		Spaces:      make([]*pb.GetProofLatenciesResponse_Space, len(spaces)),
+		Directories: make([]*pb.GetProofLatenciesResponse_Directory, len(dirs)),
+	}
+	for i, space := range spaces {
+		resp.Spaces[i] = &pb.GetProofLatenciesResponse_Space{
+			SpaceId:   space.SpaceID,
+			Directory: space.Dir,
+			Slow:      space.Slow,
+			Demoted:   space.Demoted,
+			Latency:   proofLatency2Proto(space.ProofLatency),
+		}
+	}
+	for i, dir := range dirs {
+		resp.Directories[i] = &pb.GetProofLatenciesResponse_Directory{
+			Directory: dir.Dir,
+			Latency:   proofLatency2Proto(dir.ProofLatency),
+		}
+	}
+	logging.CPrint(logging.INFO, "GetProofLatencies completed", logging.LogFormat{"spaces": len(resp.Spaces), "directories": len(resp.Directories)})
+	return resp, nil
+}
+
+func (s *Server) PlanCapacity(ctx context.Context, in *pb.PlanCapacityRequest) (*pb.CapacityPlan, error) {
+	logging.CPrint(logging.INFO, "Received a request for PlanCapacity", logging.LogFormat{"in": in.String()})
+	cointype := in.Cointype
+	if cointype == 0 {
+		cointype = config.ChainParams.HDCoinType
+	}
+
+	var plan *capacity.CapacityPlan
+	var err error
+	if len(in.Allocations) == 0 {
+		plan, err = s.spaceKeeper.PlanBySize(in.Capacity*poc.MiB, cointype)
+	} else {
+		dirs, capacities := make([]string, len(in.Allocations)), make([]uint64, len(in.Allocations))
+		for i, alloc := range in.Allocations {
+			if alloc == nil || alloc.Directory == "" {
+				logging.CPrint(logging.ERROR, "miner allocation is nil", logging.LogFormat{"index": i})
+				return nil, status.New(ErrAPIMinerInvalidAllocation, ErrCode[ErrAPIMinerInvalidAllocation]).Err()
+			}
+			dirs[i], capacities[i] = alloc.Directory, alloc.Capacity*poc.MiB
+		}
+		plan, err = s.spaceKeeper.PlanByPath(dirs, capacities, in.AutoCreate >= 0, cointype)
+	}
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to plan capacity", logging.LogFormat{"err": err})
+		return nil, capacityPlanError(err)
+	}
+
+	resp := capacityPlan2Proto(plan)
+	logging.CPrint(logging.INFO, "PlanCapacity completed", logging.LogFormat{"plan_id": resp.PlanId, "bytes": resp.Bytes})
+	return resp, nil
+}
+
+func (s *Server) ApplyCapacityPlan(ctx context.Context, in *pb.ApplyCapacityPlanRequest) (*pb.WorkSpacesByDirsResponse, error) {
+	logging.CPrint(logging.INFO, "ApplyCapacityPlan called", logging.LogFormat{"plan_id": in.PlanId, "payout_addresses": in.PayoutAddresses})
+	defer logging.CPrint(logging.INFO, "ApplyCapacityPlan responded")
+
+	if err := checkPassLen(in.Passphrase); err != nil {
+		return nil, err
+	}
+	payoutAddresses, err := parsePayoutAddresses(in.PayoutAddresses)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.spaceKeeper.Started() {
+		logging.CPrint(logging.ERROR, "cannot configure while capacity is running")
+		return nil, status.New(ErrAPIMinerNotStopped, ErrCode[ErrAPIMinerNotStopped]).Err()
+	}
+
+	if err := s.pocMiner.SetPayoutAddresses(payoutAddresses); err != nil {
+		logging.CPrint(logging.ERROR, "missing miner payout address",
+			logging.LogFormat{"allowed": config.MaxMiningPayoutAddresses, "count": len(payoutAddresses)})
+		return nil, status.New(ErrAPIMinerInternal, ErrCode[ErrAPIMinerInternal]).Err()
+	}
+	if err := s.pocWallet.Unlock([]byte(in.Passphrase)); err != nil {
+		logging.CPrint(logging.ERROR, "fail to unlock poc wallet",
+			logging.LogFormat{"err": err})
+		return nil, status.New(ErrAPIMinerWrongPassphrase, ErrCode[ErrAPIMinerWrongPassphrase]).Err()
+	}
+	if _, err = s.spaceKeeper.ApplyPlan(in.PlanId, false, false); err != nil {
+		logging.CPrint(logging.ERROR, "fail to apply capacity plan", logging.LogFormat{"err": err, "plan_id": in.PlanId})
+		return nil, capacityPlanError(err)
+	}
+	allocations, err := s.getCapacitySpacesByDirs()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.WorkSpacesByDirsResponse{DirectoryCount: uint32(len(allocations)), Allocations: allocations}, nil
+}
+
+func (s *Server) EstimateMiningRevenue(ctx context.Context, in *pb.EstimateMiningRevenueRequest) (*pb.EstimateMiningRevenueResponse, error) {
+	logging.CPrint(logging.INFO, "Received a request for EstimateMiningRevenue", logging.LogFormat{"in": in.String()})
+	blocks := uint64(in.Blocks)
+	if blocks == 0 {
+		blocks = defaultRevenueBlocks
+	}
+	if blocks > maxRevenueBlocks {
+		logging.CPrint(logging.ERROR, "too many blocks to estimate revenue", logging.LogFormat{"blocks": blocks, "max": maxRevenueBlocks})
+		return nil, status.New(ErrAPIInvalidParameter, ErrCode[ErrAPIInvalidParameter]).Err()
+	}
+
+	var spaces []mining.RevenueSpace
+	if in.Capacity == 0 {
+		wsiList, err := s.getWorkSpaceInfos(engine.SFReady | engine.SFMining)
+		if err != nil {
+			return nil, err
+		}
+		started := s.pocMiner.Started()
+		for _, wsi := range wsiList {
+			if wsi.Unavailable {
+				continue
+			}
+			space, err := s.revenueSpace(wsi.PublicKey, wsi.BitLength, false)
+			if err != nil {
+				return nil, err
+			}
+			space.Mining = started && wsi.State == engine.Mining
+			spaces = append(spaces, space)
+		}
+	} else {
+		bitLength := int(in.BitLength)
+		if bitLength == 0 {
+			bitLength = defaultRevenueBitLength
+		}
+		if !poc.EnsureBitLength(bitLength) {
+			logging.CPrint(logging.ERROR, "invalid bit length to estimate revenue", logging.LogFormat{"bit_length": bitLength})
+			return nil, status.New(ErrAPIInvalidParameter, ErrCode[ErrAPIInvalidParameter]).Err()
+		}
+		count := in.Capacity * poc.MiB / uint64(poc.BitLengthDiskSize[bitLength])
+		if count == 0 {
+			logging.CPrint(logging.ERROR, "capacity is smaller than a space", logging.LogFormat{"capacity": in.Capacity, "bit_length": bitLength})
+			return nil, status.New(ErrAPIMinerInvalidCapacity, ErrCode[ErrAPIMinerInvalidCapacity]).Err()
+		}
+		space, err := s.revenueSpace(nil, bitLength, in.Binding)
+		if err != nil {
+			return nil, err
+		}
+		for i := uint64(0); i < count; i++ {
+			spaces = append(spaces, space)
+		}
+	}
+
+	network, err := s.chain.EstimateNetworkWeight(blocks)
+	if err == difficulty.ErrNotEnoughHeaders {
+		return nil, status.New(ErrAPIMinerNotEnoughBlocks, ErrCode[ErrAPIMinerNotEnoughBlocks]).Err()
+	}
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to estimate network weight", logging.LogFormat{"err": err, "blocks": blocks})
+		return nil, status.New(ErrAPIMinerInternal, ErrCode[ErrAPIMinerInternal]).Err()
+	}
+	est, err := mining.EstimateRevenue(network, spaces)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to estimate mining revenue", logging.LogFormat{"err": err})
+		return nil, status.New(ErrAPIMinerInternal, ErrCode[ErrAPIMinerInternal]).Err()
+	}
+
+	resp, err := revenueEstimate2Proto(est)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to convert mining revenue", logging.LogFormat{"err": err})
+		return nil, status.New(ErrAPIFailedToSukhavati, ErrCode[ErrAPIFailedToSukhavati]).Err()
+	}
+	logging.CPrint(logging.INFO, "EstimateMiningRevenue completed", logging.LogFormat{"blocks_per_day": resp.BlocksPerDay, "skt_per_day": resp.SktPerDay})
+	return resp, nil
+}
+
+// revenueSpace returns a space mining the next block with the subsidy of pubKey,
+// or of a public key with enough binding if bound is true.
+func (s *Server) revenueSpace(pubKey *pocec.PublicKey, bitLength int, bound bool) (mining.RevenueSpace, error) {
+	required, ok := blockchain.BindingRequiredAmount(bitLength)
+	binding := chainutil.ZeroAmount()
+	if bound {
+		binding = required
+	} else if pubKey != nil {
+		var err error
+		if binding, err = s.chain.FetchMatureBinding(pubKey, bitLength); err != nil {
+			logging.CPrint(logging.ERROR, "fail to fetch binding of public key", logging.LogFormat{"err": err, "bit_length": bitLength})
+			return mining.RevenueSpace{}, status.New(ErrAPIMinerInternal, ErrCode[ErrAPIMinerInternal]).Err()
+		}
+	}
+	miner, _, _, err := blockchain.CalcBlockSubsidy(s.chain.BestBlockHeight()+1, &config.ChainParams, binding, bitLength)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to calc block subsidy", logging.LogFormat{"err": err, "bit_length": bitLength})
+		return mining.RevenueSpace{}, status.New(ErrAPIMinerInternal, ErrCode[ErrAPIMinerInternal]).Err()
+	}
+	return mining.RevenueSpace{
+		BitLength: bitLength,
+		Bound:     ok && binding.Cmp(required) >= 0,
+		Subsidy:   miner,
+	}, nil
+}
+
+func revenueEstimate2Proto(est *mining.RevenueEstimate) (*pb.EstimateMiningRevenueResponse, error) {
+	msg := &pb.EstimateMiningRevenueResponse{
+		Height:            est.Network.Height,
+		Blocks:            uint32(est.Network.Blocks),
+		BlockInterval:     est.Network.Interval.Seconds(),
+		NetworkWeight:     est.Network.Weight,
+		NetworkSpace:      est.NetworkBytes,
+		NetworkSpaceLower: est.NetworkBytesLower,
+		NetworkSpaceUpper: est.NetworkBytesUpper,
+		Space:             est.Bytes,
+		Share:             est.Share,
+		BlocksPerDay:      est.BlocksPerDay,
+		BlocksPerDayLower: est.BlocksPerDayLower,
+		BlocksPerDayUpper: est.BlocksPerDayUpper,
+		BitLengths:        make([]*pb.EstimateMiningRevenueResponse_BitLength, len(est.Groups)),
+	}
+	var err error
+	if msg.SktPerDay, err = AmountToString(est.SktPerDay.IntValue()); err != nil {
+		return nil, err
+	}
+	if msg.SktPerDayLower, err = AmountToString(est.SktPerDayLower.IntValue()); err != nil {
+		return nil, err
+	}
+	if msg.SktPerDayUpper, err = AmountToString(est.SktPerDayUpper.IntValue()); err != nil {
+		return nil, err
+	}
+	for i, group := range est.Groups {
+		skt, err := AmountToString(group.SktPerDay.IntValue())
+		if err != nil {
+			return nil, err
+		}
+		msg.BitLengths[i] = &pb.EstimateMiningRevenueResponse_BitLength{
+			BitLength:    uint32(group.BitLength),
+			Count:        uint32(group.Count),
+			Bound:        uint32(group.Bound),
+			Bytes:        group.Bytes,
+			Share:        group.Share,
+			BlocksPerDay: group.BlocksPerDay,
+			SktPerDay:    skt,
+		}
+	}
+	return msg, nil
+}
+
+// parsePayoutAddresses decodes payout addresses given on configuring capacity.
+func parsePayoutAddresses(addrs []string) ([]chainutil.Address, error) {
+	if len(addrs) == 0 {
+		logging.CPrint(logging.ERROR, "payout_addresses is empty")
+		return nil, status.New(ErrAPIMinerNoAddress, ErrCode[ErrAPIMinerNoAddress]).Err()
+	}
+	for _, addr := range addrs {
+		err := checkAddressLen(addr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	payoutAddresses, err := chainutil.NewAddressesFromStringList(addrs, &config.ChainParams)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to decode coinbase_address", logging.LogFormat{"addr_list": addrs})
+		return nil, status.New(ErrAPIMinerInvalidAddress, ErrCode[ErrAPIMinerInvalidAddress]).Err()
+	}
+	if len(payoutAddresses) > config.MaxMiningPayoutAddresses {
+		logging.CPrint(logging.ERROR, "coinbase_address is more than allowed",
+			logging.LogFormat{"allowed": config.MaxMiningPayoutAddresses, "count": len(payoutAddresses)})
+		return nil, status.New(ErrAPIMinerInvalidAddress, ErrCode[ErrAPIMinerInvalidAddress]).Err()
+	}
+	return payoutAddresses, nil
+}
+
+func capacityPlanError(err error) error {
+	switch err {
+	case capacity.ErrCapacityPlanNotFound:
+		return status.New(ErrAPIMinerPlanNotFound, ErrCode[ErrAPIMinerPlanNotFound]).Err()
+	case capacity.ErrCapacityPlanOutdated:
+		return status.New(ErrAPIMinerPlanOutdated, ErrCode[ErrAPIMinerPlanOutdated]).Err()
+	case capacity.ErrInvalidDir, capacity.ErrConfigInvalidPathSize:
+		return status.New(ErrAPIMinerInvalidAllocation, ErrCode[ErrAPIMinerInvalidAllocation]).Err()
+	case capacity.ErrConfigUnderSizeTarget:
+		return status.New(ErrAPIMinerInvalidCapacity, ErrCode[ErrAPIMinerInvalidCapacity]).Err()
+	case capacity.ErrOSDiskSizeNotEnough:
+		return status.New(ErrAPIMinerNoDiskSpace, ErrCode[ErrAPIMinerNoDiskSpace]).Err()
+	case capacity.ErrSpaceKeeperIsRunning:
+		return status.New(ErrAPIMinerNotStopped, ErrCode[ErrAPIMinerNotStopped]).Err()
+	default:
+		return status.New(ErrAPIMinerInternal, err.Error()).Err()
+	}
+}
+
+func capacityPlan2Proto(plan *capacity.CapacityPlan) *pb.CapacityPlan {
+	msg := &pb.CapacityPlan{
+		PlanId:    plan.ID,
+		Method:    "capacity",
+		Bytes:     plan.Bytes,
+		PlotBytes: plan.PlotBytes,
+		PlotTime:  -1,
+		Created:   plan.Created.Unix(),
+	}
+	if plan.ByPath {
+		msg.Method = "directory"
+	}
+	if plan.PlotTime >= 0 {
+		msg.PlotTime = int64(plan.PlotTime / time.Second)
+	}
+	for _, pd := range plan.Dirs {
+		dir := &pb.CapacityPlan_Directory{
+			Directory: pd.Dir,
+			Capacity:  pd.Target / poc.MiB,
+			Bytes:     pd.Bytes,
+		}
+		for _, space := range pd.Spaces {
+			dir.Spaces = append(dir.Spaces, &pb.CapacityPlan_Space{
+				SpaceId:   space.SpaceID,
+				Ordinal:   space.Ordinal,
+				BitLength: uint32(space.BitLength),
+				Reused:    space.Reused,
+				Progress:  space.Progress,
+			})
+		}
+		msg.Directories = append(msg.Directories, dir)
+	}
+	for _, d := range plan.Disks {
+		msg.Disks = append(msg.Disks, &pb.CapacityPlan_Disk{
+			Disk:     d.Disk,
+			Free:     d.Free,
+			Required: d.Required,
+			Leftover: d.Leftover,
+		})
+	}
+	return msg
+}
+
+func proofLatency2Proto(latency capacity.ProofLatency) *pb.ProofLatency {
+	return &pb.ProofLatency{
+		Samples:    uint32(latency.Samples),
+		Total:      latency.Total,
+		OverBudget: uint32(latency.OverBudget),
+		P50:        durationMillis(latency.P50),
+		P90:        durationMillis(latency.P90),
+		P99:        durationMillis(latency.P99),
+		Max:        durationMillis(latency.Max),
+		Last:       durationMillis(latency.Last),
+	}
+}
+
+func durationMillis(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+func spaceMoveError(err error) error {
+	switch err {
+	case capacity.ErrWorkSpaceDoesNotExist:
+		return status.New(ErrAPIMinerSpaceNotFound, ErrCode[ErrAPIMinerSpaceNotFound]).Err()
+	case capacity.ErrInvalidDir, capacity.ErrMoveToSameDir:
+		return status.New(ErrAPIMinerInvalidDir, ErrCode[ErrAPIMinerInvalidDir]).Err()
+	case capacity.ErrWorkSpaceIsMoving, capacity.ErrWorkSpaceIsPlotting, capacity.ErrWorkSpaceIsUnavailable:
+		return status.New(ErrAPIMinerSpaceBusy, ErrCode[ErrAPIMinerSpaceBusy]).Err()
+	case capacity.ErrOSDiskSizeNotEnough:
+		return status.New(ErrAPIMinerNoDiskSpace, ErrCode[ErrAPIMinerNoDiskSpace]).Err()
+	default:
+		return status.New(ErrAPIMinerInternal, err.Error()).Err()
+	}
+}
+
+func spaceMove2Proto(move capacity.SpaceMove) *pb.SpaceMove {
+	msg := &pb.SpaceMove{
+		SpaceId: move.SpaceID,
+		From:    move.From,
+		To:      move.To,
+		State:   move.State,
+		Bytes:   move.Bytes,
+		Copied:  move.Copied,
+	}
+	if move.Err != nil {
+		msg.Error = move.Err.Error()
+	}
+	return msg
+}
+
 func decodeAPISpaceID(id string) (string, error) {
 	data := strings.Split(id, "-")
 	if len(data) != 2 {
@@ -412,12 +972,14 @@ func workSpaceInfo2ProtoWorkSpace(wsi engine.WorkSpaceInfo) (*pb.WorkSpace, erro
 		return nil, status.New(ErrAPIMinerInternal, ErrCode[ErrAPIMinerInternal]).Err()
 	}
 	return &pb.WorkSpace{
-		Ordinal:   wsi.Ordinal,
-		PublicKey: pkStr,
-		Address:   addr.EncodeAddress(),
-		BitLength: uint32(wsi.BitLength),
-		State:     wsi.State.String(),
-		Progress:  wsi.Progress,
+		Ordinal:     wsi.Ordinal,
+		PublicKey:   pkStr,
+		Address:     addr.EncodeAddress(),
+		BitLength:   uint32(wsi.BitLength),
+		State:       wsi.State.String(),
+		Progress:    wsi.Progress,
+		Unavailable: wsi.Unavailable,
+		Slow:        wsi.Slow,
 	}, nil
 }
 
@@ -530,6 +1092,18 @@ func (s *Server) actOnWorkSpace(sid string, action engine.ActionType) (*pb.WorkS
 	return s.getCapacitySpace(sid)
 }
 
+func (s *Server) plotWorkSpace(sid string, threads int) (*pb.WorkSpace, error) {
+	wsi, err := s.getWorkSpaceInfo(sid)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.spaceKeeper.PlotWorkSpace(wsi.SpaceID, threads); err != nil {
+		logging.CPrint(logging.ERROR, "fail to plot WorkSpace", logging.LogFormat{"err": err, "threads": threads})
+		return nil, err
+	}
+	return s.getCapacitySpace(sid)
+}
+
 func countErrors(errs map[string]error) (int, map[string]error) {
 	var count int
 	var resultMap = make(map[string]error)
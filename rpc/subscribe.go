@@ -0,0 +1,369 @@
+package rpc
+
+import (
+	"encoding/hex"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/Sukhavati-Labs/go-miner/logging"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/pocminer"
+	"github.com/Sukhavati-Labs/go-miner/poc/engine/spacekeeper"
+	pb "github.com/Sukhavati-Labs/go-miner/rpc/proto"
+	"github.com/Sukhavati-Labs/go-miner/wire"
+	"github.com/golang/protobuf/ptypes/empty"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// subscriberBufferSize is the count of events buffered for each subscriber,
+	// a subscriber is closed with errSlowSubscriber once its buffer is full,
+	// so that a slow subscriber never blocks the chain, txpool or miner.
+	subscriberBufferSize = 256
+	// maxSubscribers is the max count of subscribers of each topic.
+	maxSubscribers = 64
+
+	workSpaceSampleInterval = time.Second
+	// workSpaceProgressStep is the least progress change reported in percent.
+	workSpaceProgressStep = 1.0
+
+	blockEventConnected    = "connected"
+	blockEventDisconnected = "disconnected"
+
+	workSpaceEventAdded    = "added"
+	workSpaceEventRemoved  = "removed"
+	workSpaceEventState    = "state"
+	workSpaceEventProgress = "progress"
+
+	miningEventProofFound     = "proof_found"
+	miningEventBlockSubmitted = "block_submitted"
+)
+
+var (
+	errSlowSubscriber     = status.New(codes.ResourceExhausted, "subscriber is too slow, events buffer is full").Err()
+	errTooManySubscribers = status.New(codes.ResourceExhausted, "too many subscribers").Err()
+	errEventsStopped      = status.New(codes.Unavailable, "server is stopping").Err()
+	errNoMiningEvents     = status.New(codes.Unimplemented, "miner does not report mining events").Err()
+)
+
+type subscriber struct {
+	events chan interface{}
+	slow   chan struct{}
+}
+
+// broadcaster delivers events to subscribers without blocking.
+type broadcaster struct {
+	mu          sync.Mutex
+	subscribers map[*subscriber]struct{}
+}
+
+func newBroadcaster() *broadcaster {
+	return &broadcaster{subscribers: make(map[*subscriber]struct{})}
+}
+
+func (b *broadcaster) subscribe() (*subscriber, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.subscribers) >= maxSubscribers {
+		return nil, errTooManySubscribers
+	}
+	sub := &subscriber{
+		events: make(chan interface{}, subscriberBufferSize),
+		slow:   make(chan struct{}),
+	}
+	b.subscribers[sub] = struct{}{}
+	return sub, nil
+}
+
+func (b *broadcaster) unsubscribe(sub *subscriber) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.subscribers, sub)
+}
+
+func (b *broadcaster) count() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.subscribers)
+}
+
+func (b *broadcaster) publish(event interface{}) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for sub := range b.subscribers {
+		select {
+		case sub.events <- event:
+		default:
+			close(sub.slow)
+			delete(b.subscribers, sub)
+		}
+	}
+}
+
+// eventHub collects events of chain, txpool, spaceKeeper and miner,
+// it implements both blockchain.Listener and pocminer.Listener.
+type eventHub struct {
+	blocks *broadcaster
+	txs    *broadcaster
+	spaces *broadcaster
+	mining *broadcaster
+	quit   chan struct{}
+}
+
+func newEventHub() *eventHub {
+	return &eventHub{
+		blocks: newBroadcaster(),
+		txs:    newBroadcaster(),
+		spaces: newBroadcaster(),
+		mining: newBroadcaster(),
+		quit:   make(chan struct{}),
+	}
+}
+
+func (h *eventHub) stop() {
+	close(h.quit)
+}
+
+// serve sends events to the stream until the client leaves, the subscriber
+// falls behind or the server stops.
+func (h *eventHub) serve(ctx context.Context, b *broadcaster, send func(event interface{}) error) error {
+	sub, err := b.subscribe()
+	if err != nil {
+		return err
+	}
+	defer b.unsubscribe(sub)
+
+	for {
+		select {
+		case event := <-sub.events:
+			if err := send(event); err != nil {
+				return err
+			}
+		case <-sub.slow:
+			logging.CPrint(logging.WARN, "rpc closed slow subscriber", logging.LogFormat{"buffer": subscriberBufferSize})
+			return errSlowSubscriber
+		case <-ctx.Done():
+			return nil
+		case <-h.quit:
+			return errEventsStopped
+		}
+	}
+}
+
+func (h *eventHub) OnBlockConnected(block *wire.MsgBlock) error {
+	if h.blocks.count() > 0 {
+		h.blocks.publish(newBlockEvent(blockEventConnected, block))
+	}
+	return nil
+}
+
+func (h *eventHub) OnBlockDisconnected(block *wire.MsgBlock) error {
+	if h.blocks.count() > 0 {
+		h.blocks.publish(newBlockEvent(blockEventDisconnected, block))
+	}
+	return nil
+}
+
+func (h *eventHub) OnTransactionReceived(tx *wire.MsgTx) error {
+	if h.txs.count() > 0 {
+		h.txs.publish(&pb.TxPoolEvent{
+			TxId: tx.TxHash().String(),
+			Size: uint32(tx.PlainSize()),
+			Time: time.Now().Unix(),
+		})
+	}
+	return nil
+}
+
+func (h *eventHub) OnProofFound(event *pocminer.ProofFound) {
+	if h.mining.count() == 0 {
+		return
+	}
+	msg := &pb.MiningEvent{
+		Type:      miningEventProofFound,
+		Height:    event.Height,
+		SpaceId:   event.SpaceID,
+		BitLength: uint32(event.BitLength),
+		Time:      event.Time.Unix(),
+	}
+	if event.PublicKey != nil {
+		msg.PublicKey = hex.EncodeToString(event.PublicKey.SerializeCompressed())
+	}
+	if event.Quality != nil {
+		msg.Quality = event.Quality.String()
+	}
+	h.mining.publish(msg)
+}
+
+func (h *eventHub) OnBlockSubmitted(event *pocminer.BlockSubmitted) {
+	if h.mining.count() == 0 {
+		return
+	}
+	header := &event.Block.MsgBlock().Header
+	msg := &pb.MiningEvent{
+		Type:      miningEventBlockSubmitted,
+		Height:    header.Height,
+		PublicKey: hex.EncodeToString(header.PubKey.SerializeCompressed()),
+		BitLength: uint32(header.Proof.BitLength),
+		BlockHash: event.Block.Hash().String(),
+		Accepted:  event.Accepted,
+		Time:      header.Timestamp.Unix(),
+	}
+	if event.Err != nil {
+		msg.RejectReason = event.Err.Error()
+	}
+	if reward, err := AmountToString(event.Reward.IntValue()); err == nil {
+		msg.Reward = reward
+	}
+	h.mining.publish(msg)
+}
+
+func newBlockEvent(typ string, block *wire.MsgBlock) *pb.BlockEvent {
+	header := &block.Header
+	event := &pb.BlockEvent{
+		Type:      typ,
+		Hash:      block.BlockHash().String(),
+		Height:    header.Height,
+		Previous:  header.Previous.String(),
+		Timestamp: header.Timestamp.Unix(),
+		TxCount:   uint32(len(block.Transactions)),
+	}
+	if header.PubKey != nil {
+		event.PublicKey = hex.EncodeToString(header.PubKey.SerializeCompressed())
+	}
+	if header.Proof != nil {
+		event.BitLength = uint32(header.Proof.BitLength)
+	}
+	return event
+}
+
+// sampleWorkSpaces reports workspace changes by comparing WorkSpaceInfos of
+// every interval with the last reported ones, so that it works with any
+// SpaceKeeper backend. Nothing is sampled without subscribers.
+func (h *eventHub) sampleWorkSpaces(infos func() ([]engine.WorkSpaceInfo, error)) {
+	ticker := time.NewTicker(workSpaceSampleInterval)
+	defer ticker.Stop()
+
+	var reported map[string]engine.WorkSpaceInfo
+	for {
+		select {
+		case <-h.quit:
+			return
+		case <-ticker.C:
+		}
+
+		if h.spaces.count() == 0 {
+			reported = nil
+			continue
+		}
+		wsiList, err := infos()
+		if err != nil {
+			logging.CPrint(logging.WARN, "fail to sample workspaces", logging.LogFormat{"err": err})
+			continue
+		}
+		current := make(map[string]engine.WorkSpaceInfo, len(wsiList))
+		for _, wsi := range wsiList {
+			current[wsi.SpaceID] = wsi
+		}
+		// the first sample is the baseline for new subscribers
+		if reported == nil {
+			reported = current
+			continue
+		}
+
+		for sid, wsi := range current {
+			last, ok := reported[sid]
+			switch {
+			case !ok:
+				h.publishWorkSpace(workSpaceEventAdded, wsi, "")
+			case last.State != wsi.State:
+				h.publishWorkSpace(workSpaceEventState, wsi, last.State.String())
+			case math.Abs(wsi.Progress-last.Progress) >= workSpaceProgressStep ||
+				(wsi.Progress != last.Progress && wsi.Progress >= 100):
+				h.publishWorkSpace(workSpaceEventProgress, wsi, "")
+			default:
+				continue
+			}
+			reported[sid] = wsi
+		}
+		for sid, last := range reported {
+			if _, ok := current[sid]; !ok {
+				h.publishWorkSpace(workSpaceEventRemoved, last, last.State.String())
+				delete(reported, sid)
+			}
+		}
+	}
+}
+
+// OnWorkSpaceChanged reports plot files found, becoming unavailable or available
+// in proof_dir, which are not sampled by sampleWorkSpaces.
+func (h *eventHub) OnWorkSpaceChanged(change *spacekeeper.WorkSpaceChange) {
+	if h.spaces.count() == 0 {
+		return
+	}
+	space, err := workSpaceInfo2ProtoWorkSpace(change.Info)
+	if err != nil {
+		return
+	}
+	h.spaces.publish(&pb.WorkSpaceEvent{
+		Type:    change.Type,
+		SpaceId: change.Info.SpaceID,
+		Space:   space,
+		Dir:     change.Dir,
+	})
+}
+
+func (h *eventHub) publishWorkSpace(typ string, wsi engine.WorkSpaceInfo, previousState string) {
+	space, err := workSpaceInfo2ProtoWorkSpace(wsi)
+	if err != nil {
+		return
+	}
+	h.spaces.publish(&pb.WorkSpaceEvent{
+		Type:          typ,
+		SpaceId:       wsi.SpaceID,
+		Space:         space,
+		PreviousState: previousState,
+	})
+}
+
+func (s *Server) SubscribeBlocks(in *empty.Empty, stream pb.ApiService_SubscribeBlocksServer) error {
+	logging.CPrint(logging.INFO, "rpc SubscribeBlocks called")
+	defer logging.CPrint(logging.INFO, "rpc SubscribeBlocks responded")
+
+	return s.events.serve(stream.Context(), s.events.blocks, func(event interface{}) error {
+		return stream.Send(event.(*pb.BlockEvent))
+	})
+}
+
+func (s *Server) SubscribeTxPool(in *empty.Empty, stream pb.ApiService_SubscribeTxPoolServer) error {
+	logging.CPrint(logging.INFO, "rpc SubscribeTxPool called")
+	defer logging.CPrint(logging.INFO, "rpc SubscribeTxPool responded")
+
+	return s.events.serve(stream.Context(), s.events.txs, func(event interface{}) error {
+		return stream.Send(event.(*pb.TxPoolEvent))
+	})
+}
+
+func (s *Server) SubscribeWorkSpaces(in *empty.Empty, stream pb.ApiService_SubscribeWorkSpacesServer) error {
+	logging.CPrint(logging.INFO, "rpc SubscribeWorkSpaces called")
+	defer logging.CPrint(logging.INFO, "rpc SubscribeWorkSpaces responded")
+
+	return s.events.serve(stream.Context(), s.events.spaces, func(event interface{}) error {
+		return stream.Send(event.(*pb.WorkSpaceEvent))
+	})
+}
+
+func (s *Server) SubscribeMining(in *empty.Empty, stream pb.ApiService_SubscribeMiningServer) error {
+	logging.CPrint(logging.INFO, "rpc SubscribeMining called")
+	defer logging.CPrint(logging.INFO, "rpc SubscribeMining responded")
+
+	if _, ok := s.pocMiner.(pocminer.Notifier); !ok {
+		return errNoMiningEvents
+	}
+	return s.events.serve(stream.Context(), s.events.mining, func(event interface{}) error {
+		return stream.Send(event.(*pb.MiningEvent))
+	})
+}
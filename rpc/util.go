@@ -27,6 +27,11 @@ const (
 	LenPassMin  = 6
 	// evaluate value
 	LenSpaceIDMax = 80
+
+	// number of records sampled by VerifyCapacitySpace if not specified
+	defaultVerifySamples = 10000
+	// verification holds the workspace db, so samples are bounded
+	maxVerifySamples = 1000000
 )
 
 type heightList []uint64
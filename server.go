@@ -2,6 +2,7 @@ package main
 
 import (
 	"os"
+	"path/filepath"
 	"sync"
 	"sync/atomic"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/Sukhavati-Labs/go-miner/consensus"
 	"github.com/Sukhavati-Labs/go-miner/database"
 	"github.com/Sukhavati-Labs/go-miner/logging"
+	"github.com/Sukhavati-Labs/go-miner/metrics"
 	"github.com/Sukhavati-Labs/go-miner/mining"
 	"github.com/Sukhavati-Labs/go-miner/netsync"
 	"github.com/Sukhavati-Labs/go-miner/poc/engine/pocminer"
@@ -23,12 +25,14 @@ type server struct {
 	started     int32 // atomic
 	shutdown    int32 // atomic
 	apiServer   *rpc.Server
+	metrics     *metrics.Server
 	chainDB     database.DB
 	chain       *blockchain.Blockchain
 	syncManager *netsync.SyncManager
 	pocWallet   *wallet.PoCWallet
 	pocMiner    pocminer.PoCMiner
 	spaceKeeper spacekeeper.SpaceKeeper
+	ledger      *mining.Ledger
 	wg          sync.WaitGroup
 	quit        chan struct{}
 }
@@ -63,6 +67,10 @@ func (s *server) Start() {
 
 	s.apiServer.RunGateway()
 
+	if s.metrics != nil {
+		s.metrics.Start()
+	}
+
 	s.wg.Add(1)
 }
 
@@ -78,9 +86,20 @@ func (s *server) Stop() error {
 	// Shutdown apiServer
 	s.apiServer.Stop()
 
+	if s.metrics != nil {
+		if err := s.metrics.Stop(); err != nil {
+			logging.CPrint(logging.ERROR, "fail to stop metrics server", logging.LogFormat{"err": err})
+		}
+	}
+
 	// Stop the CPU miner if needed
 	s.pocMiner.Stop()
 
+	s.chain.UnregisterListener(s.ledger)
+	if notifier, ok := s.pocMiner.(pocminer.Notifier); ok {
+		notifier.UnregisterListener(s.ledger)
+	}
+
 	if s.spaceKeeper.Started() {
 		s.spaceKeeper.Stop()
 	}
@@ -141,18 +160,39 @@ func newServer(miningAddresses []chainutil.Address, db database.DB, pocWallet *w
 	}
 
 	// Create PoCMiner according to MinerBackend
-	s.pocMiner, err = pocminer.NewPoCMiner(cfg.Miner.PocminerBackend, cfg.Miner.AllowSolo, s.chain, s.syncManager, s.spaceKeeper, newBlockCh, miningAddresses)
+	s.pocMiner, err = pocminer.NewPoCMiner(cfg.Miner.PocminerBackend, cfg.Miner.AllowSolo, s.chain, s.syncManager, s.spaceKeeper, newBlockCh, miningAddresses, cfg)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "fail on NewPoCMiner", logging.LogFormat{"err": err, "backend": cfg.Miner.PocminerBackend})
 		return nil, err
 	}
 
+	// Record blocks mined by this node
+	s.ledger, err = mining.NewLedger(filepath.Join(cfg.Db.DataDir, mining.MinedBlocksFilename), s.chain)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "fail to load mined blocks", logging.LogFormat{"err": err})
+		return nil, err
+	}
+	s.chain.RegisterListener(s.ledger)
+	if notifier, ok := s.pocMiner.(pocminer.Notifier); ok {
+		notifier.RegisterListener(s.ledger)
+	}
+
 	// Create API Server
-	s.apiServer, err = rpc.NewServer(s.chainDB, s.pocMiner, mining.NewConfigurableSpaceKeeper(s.spaceKeeper), s.chain, s.chain.GetTxPool(), s.syncManager, pocWallet, func() { interruptChannel <- os.Interrupt }, cfg)
+	s.apiServer, err = rpc.NewServer(s.chainDB, s.pocMiner, mining.NewConfigurableSpaceKeeper(s.spaceKeeper), s.chain, s.chain.GetTxPool(), s.syncManager, pocWallet, s.ledger, func() { interruptChannel <- os.Interrupt }, cfg)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "new server", logging.LogFormat{"err": err})
 		return nil, err
 	}
 
+	// Create Metrics Server if listen address is configured
+	if cfg.Metrics.Listen != "" {
+		metrics.Registry.MustRegister(
+			blockchain.NewTxPoolCollector(s.chain.GetTxPool()),
+			netsync.NewCollector(s.syncManager),
+			spacekeeper.NewCollector(s.spaceKeeper),
+		)
+		s.metrics = metrics.NewServer(cfg.Metrics.Listen)
+	}
+
 	return s, nil
 }